@@ -0,0 +1,122 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RestoreSpec identifies the Syndesis installation to restore into and the backup to restore from
+// +k8s:openapi-gen=true
+type RestoreSpec struct {
+	// Name of the Syndesis installation, in the same namespace as this resource, to restore into
+	SyndesisName string `json:"syndesisName"`
+
+	// Source identifies the backup archive to restore
+	Source RestoreSource `json:"source"`
+
+	// For a Source of type wal, stops WAL replay at this time instead of replaying to the end of
+	// the archived WAL stream. Ignored for every other Source type
+	// +optional
+	RecoverToTime *metav1.Time `json:"recoverToTime,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=local;s3;azure;wal
+type RestoreSourceType string
+
+const (
+	RestoreSourceLocal RestoreSourceType = "local"
+	RestoreSourceS3    RestoreSourceType = "s3"
+	RestoreSourceAzure RestoreSourceType = "azure"
+	// RestoreSourceWal restores from the wal-g backups produced by Database.WalArchiving instead
+	// of a pg_dump archive, for point-in-time recovery
+	RestoreSourceWal RestoreSourceType = "wal"
+)
+
+// RestoreSource identifies a single, point-in-time backup to restore from. For type local, Path is a
+// directory or zip archive already present on the operator's backup volume. For s3/azure, Path is the
+// object key of a zipped archive, downloaded using the same syndesis-backup-s3/syndesis-backup-azure
+// secrets the backup process uploads it with. For type wal, Path is the wal-g backup name to fetch
+// ("LATEST" for the most recent), read from the same syndesis-backup-s3 secret the WalArchiving
+// sidecar ships WAL segments to.
+type RestoreSource struct {
+	// +optional
+	Type RestoreSourceType `json:"type,omitempty"`
+
+	// Path to the backup to restore
+	Path string `json:"path"`
+}
+
+// RestorePhase describes the operator's progress restoring a Restore resource
+type RestorePhase string
+
+const (
+	RestorePhasePending     RestorePhase = "Pending"
+	RestorePhaseScalingDown RestorePhase = "ScalingDown"
+	RestorePhaseRestoring   RestorePhase = "Restoring"
+	RestorePhaseScalingUp   RestorePhase = "ScalingUp"
+	RestorePhaseCompleted   RestorePhase = "Completed"
+	RestorePhaseFailed      RestorePhase = "Failed"
+)
+
+// RestoreStatus reports the operator's progress restoring Spec.Source into Spec.SyndesisName
+// +k8s:openapi-gen=true
+type RestoreStatus struct {
+	// +optional
+	Phase RestorePhase `json:"phase,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Schema version the backup was taken from, as recorded amongst its own backed up resources
+	// +optional
+	SchemaVersion string `json:"schemaVersion,omitempty"`
+
+	// Time the restore finished, successfully or not
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Restore drives a one-off, point-in-time restore of a Syndesis installation: the operator scales
+// syndesis-server and syndesis-meta down, checks that Spec.Source's schema version is compatible with
+// the running Syndesis, restores the database and infrastructure resources from it, then scales back
+// up, reporting progress on Status as it goes.
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:object:root=true
+type Restore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RestoreSpec   `json:"spec,omitempty"`
+	Status RestoreStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// RestoreList contains a list of Restore
+type RestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Restore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Restore{}, &RestoreList{})
+}
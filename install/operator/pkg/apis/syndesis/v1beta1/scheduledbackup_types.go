@@ -0,0 +1,120 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScheduledBackupSpec defines the desired backup schedule and retention for a Syndesis installation
+// +k8s:openapi-gen=true
+type ScheduledBackupSpec struct {
+	// Name of the Syndesis installation, in the same namespace as this resource, to back up
+	SyndesisName string `json:"syndesisName"`
+
+	// Cron expression controlling how often a backup is taken, e.g. "0 2 * * *" for daily at 02:00
+	Schedule string `json:"schedule"`
+
+	// Number of most recent backups to retain; older ones are pruned after each successful run.
+	// Zero or unset means backups are never pruned.
+	// +optional
+	Retention int32 `json:"retention,omitempty"`
+
+	// Destination the backup archive is uploaded to, in addition to the local copy kept on the
+	// CronJob's own backup volume
+	// +optional
+	Destination ScheduledBackupDestination `json:"destination,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=local;s3;azure
+type ScheduledBackupDestinationType string
+
+const (
+	ScheduledBackupDestinationLocal ScheduledBackupDestinationType = "local"
+	ScheduledBackupDestinationS3    ScheduledBackupDestinationType = "s3"
+	ScheduledBackupDestinationAzure ScheduledBackupDestinationType = "azure"
+)
+
+// ScheduledBackupDestination identifies where a scheduled backup is uploaded to. s3 and azure read
+// their credentials from the syndesis-backup-s3/syndesis-backup-azure secrets, the same secrets the
+// one-off `operator backup --remote` command uses.
+type ScheduledBackupDestination struct {
+	// +optional
+	Type ScheduledBackupDestinationType `json:"type,omitempty"`
+}
+
+// ScheduledBackupPhase describes the operator's progress reconciling a ScheduledBackup into a CronJob
+type ScheduledBackupPhase string
+
+const (
+	ScheduledBackupPhaseScheduled ScheduledBackupPhase = "Scheduled"
+	ScheduledBackupPhaseFailed    ScheduledBackupPhase = "Failed"
+)
+
+// ScheduledBackupStatus reports the CronJob the operator created and the outcome of the most recent
+// backup run it triggered
+// +k8s:openapi-gen=true
+type ScheduledBackupStatus struct {
+	// +optional
+	Phase ScheduledBackupPhase `json:"phase,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Name of the CronJob the operator created for this resource
+	// +optional
+	CronJobName string `json:"cronJobName,omitempty"`
+
+	// Time the most recently completed backup run finished
+	// +optional
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
+	// Name of the Job that produced the most recent backup
+	// +optional
+	LastBackupJobName string `json:"lastBackupJobName,omitempty"`
+	// Whether the most recent backup run succeeded
+	// +optional
+	LastBackupSucceeded bool `json:"lastBackupSucceeded,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ScheduledBackup drives periodic, retained backups of a Syndesis installation: the operator
+// reconciles it into a CronJob that produces a timestamped backup on each run and prunes backups
+// beyond Spec.Retention, reporting the outcome of the most recent run back onto Status.
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:object:root=true
+type ScheduledBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScheduledBackupSpec   `json:"spec,omitempty"`
+	Status ScheduledBackupStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// ScheduledBackupList contains a list of ScheduledBackup
+type ScheduledBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScheduledBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScheduledBackup{}, &ScheduledBackupList{})
+}
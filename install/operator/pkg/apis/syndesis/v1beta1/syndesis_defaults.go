@@ -0,0 +1,98 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+// Default implements sigs.k8s.io/controller-runtime/pkg/webhook/admission.Defaulter, so a
+// MutatingWebhookConfiguration can materialize the effective defaults into the CR at admission
+// time: `kubectl get syndesis -o yaml` then shows what will actually be installed instead of
+// requiring the reader to merge the operator's build/conf/config.yaml in their head.
+//
+// The interface gives Default() no way to return an error or reach the cluster, so the values
+// below are the same literal defaults as build/conf/config.yaml; they only need to be kept in
+// sync with that file, not re-derived from it, since a webhook has no business reading the
+// operator's on-disk config at admission time.
+//
+// This only covers resource/addon settings and the sidecar/addon images that config.yaml gives a
+// built-in default (PgBouncer, wal-g, Apicurito). The first-party component images (UI, S2I, Oauth,
+// Server, Meta, Database) aren't CR-configurable at all - they're env-var/config.yaml only - so they
+// have no field here to default, and optional sidecar images with no built-in default (the external
+// DB proxy, Keycloak, the logging forwarder, sample apps) are left for the user to supply.
+func (in *Syndesis) Default() {
+	spec := &in.Spec
+
+	if spec.Components.Server.Resources.Memory == "" {
+		spec.Components.Server.Resources.Memory = "800Mi"
+	}
+	if spec.Components.Server.Features.IntegrationStateCheckInterval == 0 {
+		spec.Components.Server.Features.IntegrationStateCheckInterval = 60
+	}
+
+	if spec.Components.Meta.Resources.Memory == "" {
+		spec.Components.Meta.Resources.Memory = "512Mi"
+	}
+	if spec.Components.Meta.Resources.VolumeCapacity == "" {
+		spec.Components.Meta.Resources.VolumeCapacity = "1Gi"
+	}
+
+	if spec.Components.Database.Resources.Memory == "" {
+		spec.Components.Database.Resources.Memory = "255Mi"
+	}
+	if spec.Components.Database.Resources.VolumeCapacity == "" {
+		spec.Components.Database.Resources.VolumeCapacity = "1Gi"
+	}
+	if spec.Components.Database.Resources.VolumeAccessMode == "" {
+		spec.Components.Database.Resources.VolumeAccessMode = ReadWriteOnce
+	}
+	if spec.Components.Database.Pooler.Image == "" {
+		spec.Components.Database.Pooler.Image = "docker.io/edoburu/pgbouncer:1.14.0"
+	}
+	if spec.Components.Database.WalArchiving.Image == "" {
+		spec.Components.Database.WalArchiving.Image = "docker.io/wal-g/wal-g:v1.1"
+	}
+
+	if spec.Components.Prometheus.Resources.Memory == "" {
+		spec.Components.Prometheus.Resources.Memory = "512Mi"
+	}
+	if spec.Components.Prometheus.Resources.VolumeCapacity == "" {
+		spec.Components.Prometheus.Resources.VolumeCapacity = "1Gi"
+	}
+
+	if spec.Addons.DV.Resources.Memory == "" {
+		spec.Addons.DV.Resources.Memory = "1024Mi"
+	}
+	if spec.Addons.Apicurito.Image == "" && !spec.FIPSMode {
+		// Left unset under fipsMode instead: this default is pinned to the floating "latest" tag,
+		// which validateFIPSImage rejects, and there is no FIPS-compliant build of this image to
+		// pin to instead. A user enabling both Apicurito and fipsMode has to supply their own image
+		spec.Addons.Apicurito.Image = "quay.io/apicurio/apicurito-ui:latest"
+	}
+	if spec.Addons.Jaeger.SamplerType == "" {
+		spec.Addons.Jaeger.SamplerType = "const"
+	}
+	if spec.Addons.Jaeger.SamplerParam == "" {
+		spec.Addons.Jaeger.SamplerParam = "0"
+	}
+	if spec.Addons.Jaeger.ImageAgent == "" {
+		spec.Addons.Jaeger.ImageAgent = "jaegertracing/jaeger-agent:1.13"
+	}
+	if spec.Addons.Jaeger.ImageAllInOne == "" {
+		spec.Addons.Jaeger.ImageAllInOne = "jaegertracing/all-in-one:1.13"
+	}
+	if spec.Addons.Jaeger.ImageOperator == "" {
+		spec.Addons.Jaeger.ImageOperator = "jaegertracing/jaeger-operator:1.13"
+	}
+}
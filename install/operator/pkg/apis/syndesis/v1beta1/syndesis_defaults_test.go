@@ -0,0 +1,71 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Default_fillsInZeroValues(t *testing.T) {
+	syndesis := &Syndesis{}
+
+	syndesis.Default()
+
+	assert.Equal(t, "800Mi", syndesis.Spec.Components.Server.Resources.Memory)
+	assert.Equal(t, 60, syndesis.Spec.Components.Server.Features.IntegrationStateCheckInterval)
+	assert.Equal(t, "512Mi", syndesis.Spec.Components.Meta.Resources.Memory)
+	assert.Equal(t, ReadWriteOnce, syndesis.Spec.Components.Database.Resources.VolumeAccessMode)
+	assert.Equal(t, "docker.io/edoburu/pgbouncer:1.14.0", syndesis.Spec.Components.Database.Pooler.Image)
+	assert.Equal(t, "docker.io/wal-g/wal-g:v1.1", syndesis.Spec.Components.Database.WalArchiving.Image)
+	assert.Equal(t, "quay.io/apicurio/apicurito-ui:latest", syndesis.Spec.Addons.Apicurito.Image)
+	assert.Equal(t, "const", syndesis.Spec.Addons.Jaeger.SamplerType)
+	assert.Equal(t, "jaegertracing/jaeger-agent:1.13", syndesis.Spec.Addons.Jaeger.ImageAgent)
+}
+
+func Test_Default_doesNotOverrideUserSuppliedValues(t *testing.T) {
+	syndesis := &Syndesis{}
+	syndesis.Spec.Components.Server.Resources.Memory = "2Gi"
+	syndesis.Spec.Components.Database.Pooler.Image = "quay.io/myorg/pgbouncer:custom"
+	syndesis.Spec.Addons.Jaeger.SamplerType = "probabilistic"
+
+	syndesis.Default()
+
+	assert.Equal(t, "2Gi", syndesis.Spec.Components.Server.Resources.Memory)
+	assert.Equal(t, "quay.io/myorg/pgbouncer:custom", syndesis.Spec.Components.Database.Pooler.Image)
+	assert.Equal(t, "probabilistic", syndesis.Spec.Addons.Jaeger.SamplerType)
+}
+
+func Test_Default_leavesUncoveredImagesEmpty(t *testing.T) {
+	syndesis := &Syndesis{}
+
+	syndesis.Default()
+
+	assert.Empty(t, syndesis.Spec.Components.Database.ExternalDbProxy.Image, "no built-in default exists for the external DB proxy image")
+	assert.Empty(t, syndesis.Spec.Addons.Keycloak.Image, "no built-in default exists for the Keycloak image")
+}
+
+func Test_Default_skipsLatestTaggedApicuritoDefaultUnderFIPSMode(t *testing.T) {
+	syndesis := &Syndesis{}
+	syndesis.Spec.FIPSMode = true
+
+	syndesis.Default()
+
+	assert.Empty(t, syndesis.Spec.Addons.Apicurito.Image, "the built-in Apicurito default is \"latest\"-tagged, which validateFIPSImage rejects under fipsMode")
+	assert.NoError(t, syndesis.Spec.Validate(), "defaulting must never hand Validate a spec it will itself reject")
+}
@@ -17,6 +17,7 @@
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -45,11 +46,158 @@ type SyndesisSpec struct {
 	// Something
 	ForceMigration bool `json:"forceMigration"`
 
+	// Node selector, tolerations and affinity applied to every Syndesis component pod
+	Scheduling SchedulingConfiguration `json:"scheduling,omitempty"`
+
+	// Additional labels merged into every resource generated by the operator
+	AdditionalLabels map[string]string `json:"additionalLabels,omitempty"`
+
+	// Additional annotations merged into every resource generated by the operator
+	AdditionalAnnotations map[string]string `json:"additionalAnnotations,omitempty"`
+
+	// Default imagePullPolicy for every component pod. Overridden per-component when set there.
+	// Falls back to Always/IfNotPresent based on DevSupport when left empty
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
+
+	// DeletionPolicy controls what happens to the database persistent volume claim when this
+	// Syndesis resource is deleted. Defaults to Retain when left empty
+	DeletionPolicy SyndesisDeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// Paused suspends reconciliation of this Syndesis resource. While true, the operator does not
+	// create, update or delete any managed resource, so an admin can safely perform manual
+	// maintenance (e.g. on syndesis-db) without the operator fighting them. Status is still refreshed.
+	Paused bool `json:"paused,omitempty"`
+
+	// AdoptExistingResources allows the operator to take ownership of pre-existing resources (e.g. a
+	// syndesis-db or route left over from a template-based install) that share the name of a resource
+	// it manages but are not already owned by this Syndesis resource. When false (the default) the
+	// operator leaves such resources untouched rather than risk adopting something it doesn't own.
+	AdoptExistingResources bool `json:"adoptExistingResources,omitempty"`
+
+	// Certificates configures the internal serving certificates used for service-to-service traffic
+	// between the server, meta and prometheus components
+	Certificates CertificateConfiguration `json:"certificates,omitempty"`
+
+	// CertManager references a cert-manager Issuer/ClusterIssuer the operator uses to request and
+	// keep renewed the TLS certificates for the Syndesis route and, when enabled, the public API
+	// route. Has no effect unless cert-manager's CRDs are installed on the cluster
+	CertManager CertManagerConfiguration `json:"certManager,omitempty"`
+
+	// InstallRetryPolicy controls how long the operator waits before retrying a reconcile action
+	// that failed (e.g. a route that hasn't been admitted yet)
+	InstallRetryPolicy InstallRetryPolicy `json:"installRetryPolicy,omitempty"`
+
+	// SecurityModel controls how restrictive a securityContext every component pod is rendered with.
+	// Left empty (the default), pods are rendered the way they always have been. Set to "restricted" to
+	// run every container as a non-root user with a read-only root filesystem, all capabilities
+	// dropped and the runtime default seccomp profile, compatible with the restricted SCC on
+	// OpenShift and the Pod Security Standards "restricted" level everywhere else
+	SecurityModel SyndesisSecurityModel `json:"securityModel,omitempty"`
+
+	// DisableNetworkPolicies stops the operator from rendering NetworkPolicies restricting traffic
+	// between the Syndesis components. Left false (the default), only the flows the components
+	// actually need (router to oauth-proxy, oauth-proxy to ui/server, server/meta to db, prometheus to
+	// the metrics endpoints and integrations to server) are allowed, and everything else is denied
+	DisableNetworkPolicies bool `json:"disableNetworkPolicies,omitempty"`
+
+	// EnableAuditTrail turns on recording of every effective configuration change the operator applies
+	// (which resources were created, updated or pruned, and the resourceVersion/generation of the
+	// Syndesis custom resource that triggered it) into the "<name>-audit-trail" ConfigMap, so regulated
+	// environments can audit what the operator did and when. Left false (the default), nothing is recorded
+	EnableAuditTrail bool `json:"enableAuditTrail,omitempty"`
+
+	// AuditWebhook, when EnableAuditTrail is true, is a URL the operator additionally POSTs each audit
+	// trail entry to as JSON, on a best-effort basis. Left empty, only the ConfigMap is written
+	AuditWebhook string `json:"auditWebhook,omitempty"`
+
+	// FIPSMode makes the operator generate every secret it manages (database and cookie passwords,
+	// the client-state encryption/authentication keys, etc.) with a FIPS-approved random source
+	// instead of the regular one, use a FIPS-approved algorithm for client-state authentication, and
+	// require the "-fips" tag suffix on every first-party component image, appending it automatically
+	// where it's missing. The operator fails fast rather than falling back to a non-compliant default
+	// if any of that can't be satisfied, e.g. a FIPS-approved random source is unavailable or a
+	// first-party image is pinned to the floating "latest" tag. Left false (the default), none of this
+	// applies
+	FIPSMode bool `json:"fipsMode,omitempty"`
+
+	// SHA, when true, has the operator resolve every component image's tag to its current digest
+	// at install time and deploy the digest-pinned reference (image@sha256:...) instead of the tag,
+	// so a pod restart always pulls the exact image that was validated at install time rather than
+	// whatever the tag has since moved on to. Resolved digests are recorded in status.resolvedImageDigests.
+	// Left false (the default), images are deployed by tag as usual
+	SHA bool `json:"sha,omitempty"`
+
+	// CosignPublicKey, when SHA is true, is a PEM-encoded public key the operator uses to verify a
+	// cosign signature for every resolved image digest before deploying it, failing the install if an
+	// image is unsigned or the signature doesn't verify. Requires SHA to be set. Left empty (the
+	// default), digests are resolved but their signatures are not checked
+	CosignPublicKey string `json:"cosignPublicKey,omitempty"`
+
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "operator-sdk generate k8s" to regenerate code after modifying this file
 	// Add custom validation using kubebuilder tags: https://book.kubebuilder.io/beyond_basics/generating_crd.html
 }
 
+// SchedulingConfiguration is applied verbatim to the pod spec of every Syndesis component
+type SchedulingConfiguration struct {
+	// Node selector applied to all Syndesis component pods
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations applied to all Syndesis component pods
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Affinity rules applied to all Syndesis component pods
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// PriorityClassName applied to all Syndesis component pods
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+}
+
+// CertificateConfiguration controls the internal serving certificates issued for service-to-service
+// traffic between the server, meta and prometheus components. On OpenShift these are provisioned by
+// the platform's service-serving-cert signer; everywhere else the operator generates and rotates them
+// itself using its own internal CA.
+type CertificateConfiguration struct {
+	// How many days a self-signed certificate generated by the operator remains valid for. Defaults to
+	// 365 when left empty. Has no effect on OpenShift.
+	ValidityDays int32 `json:"validityDays,omitempty"`
+
+	// How many days before expiry the operator rotates a self-signed certificate. Defaults to 30 when
+	// left empty. Has no effect on OpenShift.
+	RenewBeforeDays int32 `json:"renewBeforeDays,omitempty"`
+
+	// MutualTLS additionally enables mutual TLS between the server, meta, db-exporter and prometheus
+	// components: the operator always issues its own internal CA and a dual-purpose (server and
+	// client auth) certificate per component for this, even on OpenShift, since the platform's
+	// service-serving-cert signer only covers one-way TLS. Defaults to false
+	MutualTLS bool `json:"mutualTLS,omitempty"`
+}
+
+// CertManagerConfiguration references the cert-manager Issuer/ClusterIssuer the operator requests
+// route certificates from. Left with an empty IssuerName, cert-manager integration is disabled and
+// routes fall back to their existing certificate configuration
+type CertManagerConfiguration struct {
+	// Name of the Issuer or ClusterIssuer to request certificates from
+	IssuerName string `json:"issuerName,omitempty"`
+
+	// Kind of the referenced issuer: Issuer or ClusterIssuer. Defaults to ClusterIssuer when left empty
+	IssuerKind string `json:"issuerKind,omitempty"`
+}
+
+// InstallRetryPolicy controls how long the operator waits before retrying a reconcile action
+// that returned an error. The delay doubles after each consecutive failure of the same action,
+// up to MaxDelaySeconds, so a flaky cluster isn't hammered with retries while a dev environment
+// can still opt into a fast, non-backing-off retry loop.
+type InstallRetryPolicy struct {
+	// InitialDelaySeconds is how long to wait before the first retry after an action fails.
+	// Defaults to 10 when left empty
+	InitialDelaySeconds int32 `json:"initialDelaySeconds,omitempty"`
+
+	// MaxDelaySeconds caps how long the exponentially growing delay between retries can reach.
+	// Defaults to InitialDelaySeconds (no backoff growth) when left empty
+	MaxDelaySeconds int32 `json:"maxDelaySeconds,omitempty"`
+}
+
 // SyndesisStatus defines the observed state of Syndesis
 // +k8s:openapi-gen=true
 type SyndesisStatus struct {
@@ -62,6 +210,46 @@ type SyndesisStatus struct {
 	Version            string               `json:"version,omitempty"`
 	TargetVersion      string               `json:"targetVersion,omitempty"`
 	Backup             BackupStatus         `json:"backup,omitempty"`
+	// Conditions represent the latest available observations of the Syndesis installation's state
+	Conditions []SyndesisCondition `json:"conditions,omitempty"`
+	// Components reports the last observed readiness of each individually monitored Syndesis
+	// component, keyed by component name (server, meta, ui, db, oauth, prometheus)
+	Components map[SyndesisComponentName]SyndesisComponentHealth `json:"components,omitempty"`
+	// Phases reports the progress of the individual stages of an install, in the order they run,
+	// so a stuck install can be pinpointed to the exact stage that has not completed
+	Phases []SyndesisInstallStageStatus `json:"phases,omitempty"`
+	// UpgradeSteps reports the progress of the individual steps of the current upgrade attempt,
+	// in the order they run, so an upgrade interrupted by an operator restart can resume from the
+	// last step that completed successfully. Reset at the start of every new upgrade attempt.
+	UpgradeSteps []UpgradeStepStatus `json:"upgradeSteps,omitempty"`
+	// ActionFailures tracks, for each reconcile action, how many times in a row it has failed.
+	// Used to compute the retry backoff configured in Spec.InstallRetryPolicy and reset to zero
+	// once the action succeeds again
+	ActionFailures map[string]int32 `json:"actionFailures,omitempty"`
+
+	// IntegrationRedeploy reports the outcome of the last post-upgrade integration redeployment,
+	// if one has run
+	IntegrationRedeploy *IntegrationRedeployStatus `json:"integrationRedeploy,omitempty"`
+
+	// Addons reports the last observed readiness of each enabled addon, keyed by addon name
+	Addons map[SyndesisAddonName]SyndesisAddonHealth `json:"addons,omitempty"`
+
+	// ConfigHash is a digest of the effective configuration as of the last successful install,
+	// used to skip re-rendering and re-applying every resource when nothing has changed
+	ConfigHash string `json:"configHash,omitempty"`
+
+	// LastPasswordRotation records when the operator last rotated the internal database password,
+	// used together with Spec.Components.Database.PasswordRotation to schedule the next rotation
+	LastPasswordRotation *metav1.Time `json:"lastPasswordRotation,omitempty"`
+
+	// ResolvedImageDigests records, when Spec.SHA is true, the digest each deployed component image
+	// tag was resolved to as of the last successful install, keyed by the tag that was resolved
+	// (e.g. "registry/syndesis-server:1.2" -> "sha256:...")
+	ResolvedImageDigests map[string]string `json:"resolvedImageDigests,omitempty"`
+
+	// EncryptionKeyRotation reports the progress of the current, or most recently attempted,
+	// SYNDESIS_ENCRYPT_KEY rotation, if Spec.Components.Server.RequestedEncryptKey has ever been set
+	EncryptionKeyRotation *EncryptionKeyRotationStatus `json:"encryptionKeyRotation,omitempty"`
 
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "operator-sdk generate k8s" to regenerate code after modifying this file
@@ -75,12 +263,44 @@ type ComponentsSpec struct {
 	Oauth      OauthConfiguration      `json:"oauth,omitempty"`
 	Server     ServerConfiguration     `json:"server,omitempty"`
 	Meta       MetaConfiguration       `json:"meta,omitempty"`
+	UI         UIConfiguration         `json:"ui,omitempty"`
 	Database   DatabaseConfiguration   `json:"database,omitempty"`
 	Prometheus PrometheusConfiguration `json:"prometheus,omitempty"`
 	Grafana    GrafanaConfiguration    `json:"grafana,omitempty"`
 	Upgrade    UpgradeConfiguration    `json:"upgrade,omitempty"`
 }
 
+type UIConfiguration struct {
+	// Number of UI pod replicas to run
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Liveness/readiness probe timings, zero value fields fall back to the built-in defaults
+	Probes ProbeConfiguration `json:"probes,omitempty"`
+
+	// Overrides Spec.ImagePullPolicy for the UI pod
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
+}
+
+type ProbeConfiguration struct {
+	// Liveness probe initialDelaySeconds
+	LivenessInitialDelaySeconds int32 `json:"livenessInitialDelaySeconds,omitempty"`
+
+	// Liveness probe periodSeconds
+	LivenessPeriodSeconds int32 `json:"livenessPeriodSeconds,omitempty"`
+
+	// Liveness probe failureThreshold
+	LivenessFailureThreshold int32 `json:"livenessFailureThreshold,omitempty"`
+
+	// Readiness probe initialDelaySeconds
+	ReadinessInitialDelaySeconds int32 `json:"readinessInitialDelaySeconds,omitempty"`
+
+	// Readiness probe periodSeconds
+	ReadinessPeriodSeconds int32 `json:"readinessPeriodSeconds,omitempty"`
+
+	// Readiness probe failureThreshold
+	ReadinessFailureThreshold int32 `json:"readinessFailureThreshold,omitempty"`
+}
+
 // +kubebuilder:validation:Enum=hourly;daily;midnight;weekly;monthly;yearly;every 3m
 type BackupSchedule string
 
@@ -97,6 +317,44 @@ type BackupStatus struct {
 	Previous string `json:"previous,omitempty"`
 }
 
+// EncryptionKeyRotationPhase is the last observed state of an operator-driven re-encryption of
+// stored connection secrets
+type EncryptionKeyRotationPhase string
+
+const (
+	// EncryptionKeyRotationPhaseRunning means the re-encryption Job is scaling syndesis-server down,
+	// running, or scaling it back up
+	EncryptionKeyRotationPhaseRunning EncryptionKeyRotationPhase = "Running"
+	// EncryptionKeyRotationPhaseCompleted means the new key is now the one syndesis-server uses
+	EncryptionKeyRotationPhaseCompleted EncryptionKeyRotationPhase = "Completed"
+	// EncryptionKeyRotationPhaseFailed means the re-encryption Job did not succeed. Stored secrets
+	// are left encrypted with the previous key, which is still the one syndesis-server uses, so
+	// nothing is bricked. While this phase is recorded the operator starts no further rotation,
+	// whatever Spec.Components.Server.RequestedEncryptKey is set to
+	EncryptionKeyRotationPhaseFailed EncryptionKeyRotationPhase = "Failed"
+)
+
+// EncryptionKeyRotationStatus reports the progress of an operator-driven re-encryption of stored
+// connection secrets, triggered by changing Spec.Components.Server.RequestedEncryptKey
+type EncryptionKeyRotationStatus struct {
+	Phase EncryptionKeyRotationPhase `json:"phase,omitempty"`
+	// StartTime is when the current, or most recently attempted, rotation began
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// Reason carries the error that made the rotation fail, only set when Phase is Failed
+	Reason string `json:"reason,omitempty"`
+}
+
+// IntegrationRedeployStatus reports the outcome of redeploying every integration after an
+// upgrade, since the operator does not retry integrations that failed to redeploy
+type IntegrationRedeployStatus struct {
+	// Total number of integrations found at the start of the redeployment
+	Total int `json:"total,omitempty"`
+	// Number of integrations successfully redeployed
+	Redeployed int `json:"redeployed,omitempty"`
+	// Integrations that failed to redeploy, keyed by integration ID, value is the error encountered
+	Failed map[string]string `json:"failed,omitempty"`
+}
+
 type OauthConfiguration struct {
 	// Enable or disable SAR checks all together
 	DisableSarCheck bool `json:"disableSarCheck,omitempty"`
@@ -104,12 +362,51 @@ type OauthConfiguration struct {
 	// The user needs to have permissions to at least get a list of pods in the given project in order to be granted access to the Syndesis installation
 	SarNamespace string `json:"sarNamespace,omitempty"`
 
-	// Using an external auth provider, specify the name of the secret
-	// that stores the credentials, ie. provider type, client id, cookie & client secrets
+	// Resource the SAR check verifies the user can access, eg. "syndeses" to require access to the
+	// Syndesis CR itself instead of listing pods. Defaults to "pods" when left empty
+	SarResource string `json:"sarResource,omitempty"`
+
+	// Verb the SAR check verifies the user can perform against SarResource. Defaults to "get" when left empty
+	SarVerb string `json:"sarVerb,omitempty"`
+
+	// API group SarResource belongs to, eg. "syndesis.io" for the Syndesis CR. Defaults to the core
+	// API group when left empty, matching the built-in "pods" resource
+	SarAPIGroup string `json:"sarApiGroup,omitempty"`
+
+	// Using an external auth provider, such as a generic OIDC provider on a cluster without an
+	// embedded one, specify the name of the secret that stores the credentials, ie. provider type,
+	// client id, cookie & client secrets
 	CredentialsSecret string `json:"credentialsSecret,omitempty"`
 
 	// The name of the secret used to store the TLS certificate for secure HTTPS communication
 	CryptoCommsSecret string `json:"cryptoCommsSecret,omitempty"`
+
+	// Number of Oauth proxy pod replicas to run
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// How long an authenticated session stays valid for before the user has to log in again, eg. "168h"
+	// for a week. Left empty, the oauth proxy's own built-in default (3 hours) applies
+	CookieExpire string `json:"cookieExpire,omitempty"`
+
+	// How often the oauth proxy refreshes the access token backing a session with the identity
+	// provider, eg. "1h". Left empty, sessions are never refreshed and simply expire after CookieExpire
+	CookieRefresh string `json:"cookieRefresh,omitempty"`
+
+	// Additional OAuth scopes requested alongside the built-in ones, eg. "user:info" on OpenShift
+	Scopes []string `json:"scopes,omitempty"`
+
+	// Additional regular expressions of request paths the oauth proxy lets through without requiring
+	// authentication, alongside its built-in list covering static assets and health/version endpoints
+	SkipAuthRegex []string `json:"skipAuthRegex,omitempty"`
+
+	// Extra command line arguments appended to the oauth proxy container, for options this CRD does
+	// not otherwise expose
+	ExtraArguments []string `json:"extraArguments,omitempty"`
+
+	// The issuer URL of a generic OIDC provider to authenticate against, for clusters without an
+	// embedded auth provider such as vanilla Kubernetes. Leave empty on OpenShift, where the embedded
+	// provider is used instead. Client ID and secret are still read from CredentialsSecret
+	IssuerURL string `json:"issuerUrl,omitempty"`
 }
 
 type DatabaseConfiguration struct {
@@ -125,13 +422,176 @@ type DatabaseConfiguration struct {
 	// If specified, use an external database instead of the installed by syndesis
 	ExternalDbURL string `json:"externalDbURL,omitempty"`
 
+	// TLS mode used to connect to an external database, one of: disable, require,
+	// verify-ca, verify-full. Defaults to disable, ignored when ExternalDbURL is not set
+	ExternalDbSSLMode string `json:"externalDbSSLMode,omitempty"`
+
+	// Name of the secret holding the CA bundle (ca.crt) and, optionally, the client
+	// certificate (tls.crt) and key (tls.key) used to connect to an external database
+	ExternalDbCertificateSecret string `json:"externalDbCertificateSecret,omitempty"`
+
+	// Name of a user-provided secret holding the POSTGRESQL_USER and POSTGRESQL_PASSWORD
+	// keys used to authenticate against an external database, in place of User/Password
+	ExternalDbCredentialsSecret string `json:"externalDbCredentialsSecret,omitempty"`
+
+	// Set to true when ExternalDbURL points at an AWS RDS/Aurora instance configured for IAM
+	// database authentication, so the operator skips generating and storing a static password
+	// and instead relies on syndesis-server obtaining a short-lived auth token for the role
+	// granted by ExternalDbIAMRoleArn. ExternalDbCredentialsSecret, if also set, is only used
+	// for its POSTGRESQL_USER entry
+	ExternalDbIAMAuth bool `json:"externalDbIAMAuth,omitempty"`
+
+	// ARN of the IAM role syndesis-server assumes to obtain RDS auth tokens, granted to it via
+	// IRSA (IAM Roles for Service Accounts). Required, and only meaningful, when
+	// ExternalDbIAMAuth is true; annotated onto the syndesis-server ServiceAccount as
+	// eks.amazonaws.com/role-arn
+	ExternalDbIAMRoleArn string `json:"externalDbIAMRoleArn,omitempty"`
+
+	// Injects a database proxy sidecar (e.g. the Cloud SQL Auth Proxy or the Azure Database
+	// proxy) into syndesis-server and syndesis-meta, so ExternalDbURL can point at 127.0.0.1
+	// while the sidecar handles cloud IAM authentication and TLS to the managed instance
+	ExternalDbProxy ExternalDbProxyConfiguration `json:"externalDbProxy,omitempty"`
+
 	// Resource provision requirements of the database
 	Resources ResourcesWithPersistentVolume `json:"resources,omitempty"`
+
+	// Whether the sampledb and its demo connections are provisioned. Disable for production installs
+	Sampledb SampledbConfiguration `json:"sampledb,omitempty"`
+
+	// Must be set to true before the operator will run a PostgreSQL major version upgrade, since it
+	// is a destructive operation performed in place on the database volume. While a newer target
+	// version is detected and this is not set, the DatabaseUpgradeRequired condition reports the
+	// detected versions and the upgrade is held back
+	ApproveUpgrade bool `json:"approveUpgrade,omitempty"`
+
+	// Overrides Spec.ImagePullPolicy for the database and exporter containers
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
+
+	// Number of database pods to run. Defaults to 1 (a single, non-highly-available instance).
+	// Values greater than 1 deploy a primary plus (Replicas - 1) streaming replicas kept in sync
+	// through PostgreSQL's built-in replication, fronted by the syndesis-db-ro Service; failing over
+	// the primary itself is not automated and remains a manual operation
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Caps how far a PostgreSQL major version upgrade is allowed to go, as a "major.minor" string
+	// (e.g. "10"). When empty, the target is detected from the version baked into Image, as before.
+	// A multi-major jump (e.g. 9.6 to 12) runs as one checkpointed pg_upgrade hop per intervening
+	// version in upgrade.SupportedPostgresVersions, so this can be used to stop partway through
+	// a chain rather than upgrading all the way to the newest known version in a single attempt
+	TargetVersion string `json:"targetVersion,omitempty"`
+
+	// Deploys PgBouncer in front of the database and points syndesis-server at it instead of
+	// connecting to syndesis-db directly. Disabled by default
+	Pooler PoolerConfiguration `json:"pooler,omitempty"`
+
+	// Continuously ships WAL segments to the syndesis-backup-s3 bucket, on top of the periodic
+	// pg_dump backups, so that a Restore can recover to any point in time. Disabled by default
+	WalArchiving WalArchivingConfiguration `json:"walArchiving,omitempty"`
+
+	// Configures postgres_exporter, which scrapes the database for metrics in Prometheus format
+	Exporter ExporterConfiguration `json:"exporter,omitempty"`
+
+	// PostgreSQL configuration parameters (e.g. shared_buffers, max_connections, work_mem) merged
+	// into postgresql.conf on top of the built-in defaults. Keys are validated against a fixed set
+	// of known, safe-to-tune parameters; anything else is rejected
+	Tuning map[string]string `json:"tuning,omitempty"`
+
+	// How often the operator rotates the internal database password, expressed as a Go duration
+	// with an additional "d" (day) unit, e.g. "90d" or "720h". Left empty, the password is left
+	// alone once generated. Only applies to the operator-managed database, not ExternalDbURL
+	PasswordRotation string `json:"passwordRotation,omitempty"`
+
+	// Inline SQL run once against the database, the first time it is provisioned, after the
+	// sampledb (if enabled). Useful for creating extra schemas, extensions (e.g. uuid-ossp,
+	// postgis) or roles needed by custom connectors. Mutually exclusive with InitScriptsConfigMap
+	InitScripts string `json:"initScripts,omitempty"`
+
+	// Name of a user-provided ConfigMap whose keys ending in .sql are run once against the
+	// database, in filename order, the first time it is provisioned. Alternative to InitScripts
+	// for scripts too large, or too sensitive, to inline into the Syndesis custom resource
+	InitScriptsConfigMap string `json:"initScriptsConfigMap,omitempty"`
+
+	// Run the primary database as a StatefulSet with a stable pod identity, instead of a
+	// Deployment, so it addresses the same pod name/PVC pair across restarts. Recommended
+	// alongside Replicas, since streaming replicas already run as a StatefulSet for the same
+	// reason. Disabled by default for backward compatibility with existing installs
+	StatefulSet bool `json:"statefulSet,omitempty"`
+}
+
+// ExporterConfiguration configures postgres_exporter, which exports PostgreSQL metrics in
+// Prometheus format for the existing syndesis-db-metrics Prometheus scrape target
+type ExporterConfiguration struct {
+	// Extra queries appended to the built-in query set, in postgres_exporter's queries.yaml format
+	// (see https://github.com/wrouesnel/postgres_exporter#adding-new-metrics-via-a-config-file),
+	// letting operators scrape business metrics such as integration counts or activity table sizes
+	// alongside the built-in database metrics
+	Queries string `json:"queries,omitempty"`
+}
+
+// WalArchivingConfiguration configures the optional sidecar that continuously ships PostgreSQL WAL
+// segments to the same S3-compatible bucket used for scheduled backups (syndesis-backup-s3), enabling
+// point-in-time recovery via a Restore of type "wal"
+type WalArchivingConfiguration struct {
+	// Enable or disable the WAL archiving sidecar. Disabled by default
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Docker image for the wal-g sidecar
+	Image string `json:"image,omitempty"`
+}
+
+// ExternalDbProxyConfiguration configures the optional cloud database proxy sidecar (e.g. the
+// Cloud SQL Auth Proxy or the Azure Database proxy) injected into syndesis-server and
+// syndesis-meta, alongside an ExternalDbURL that points at the proxy's local port
+type ExternalDbProxyConfiguration struct {
+	// Enable or disable injecting the proxy sidecar. Disabled by default
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Docker image for the proxy sidecar, e.g. gcr.io/cloud-sql-connectors/cloud-sql-proxy
+	// or mcr.microsoft.com/azure-database-proxy
+	Image string `json:"image,omitempty"`
+
+	// Arguments passed to the proxy sidecar's entrypoint, e.g. the Cloud SQL instance connection
+	// name ("project:region:instance") or an Azure Database proxy connection string
+	Args []string `json:"args,omitempty"`
+
+	// Local port the proxy sidecar listens on; ExternalDbURL should target this port on
+	// 127.0.0.1. Defaults to 5432
+	Port int32 `json:"port,omitempty"`
+}
+
+// PoolerConfiguration configures the optional PgBouncer connection pooler deployed between
+// syndesis-server and the database
+type PoolerConfiguration struct {
+	// Enable or disable deploying PgBouncer. Defaults to false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Docker image for PgBouncer
+	Image string `json:"image,omitempty"`
+
+	// Maximum number of client connections PgBouncer accepts. Defaults to 100
+	MaxClientConn int32 `json:"maxClientConn,omitempty"`
+
+	// Number of server connections PgBouncer keeps open per user/database pair. Defaults to 20
+	DefaultPoolSize int32 `json:"defaultPoolSize,omitempty"`
+
+	// One of PgBouncer's pool_mode values: session, transaction or statement. Defaults to transaction
+	PoolMode string `json:"poolMode,omitempty"`
+
+	// Resource provision requirements of the pooler
+	Resources Resources `json:"resources,omitempty"`
+}
+
+type SampledbConfiguration struct {
+	// Enable or disable creation of the sampledb. Defaults to true
+	Enabled bool `json:"enabled,omitempty"`
 }
 
 type PrometheusConfiguration struct {
 	Rules     string              `json:"rules,omitempty"`
 	Resources ResourcesWithVolume `json:"resources,omitempty"`
+
+	// Overrides Spec.ImagePullPolicy for the prometheus pod
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
 }
 
 type GrafanaConfiguration struct {
@@ -141,10 +601,58 @@ type GrafanaConfiguration struct {
 type ServerConfiguration struct {
 	Resources Resources      `json:"resources,omitempty"`
 	Features  ServerFeatures `json:"features,omitempty"`
+
+	// Number of Server pod replicas to run
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Liveness/readiness probe timings, zero value fields fall back to the built-in defaults
+	Probes ProbeConfiguration `json:"probes,omitempty"`
+
+	// Overrides Spec.ImagePullPolicy for the server pod
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
+
+	// Name of a ConfigMap holding one or more additional CA certificates (PEM encoded) that the
+	// operator mounts into, and adds to the JVM truststore of, the server and meta pods and
+	// generated integrations, for connectors that talk to internal services signed by a private CA
+	AdditionalCA string `json:"additionalCA,omitempty"`
+
+	// RequestedEncryptKey requests a new encryption key for stored connection secrets, in place of
+	// the one the operator generated at install time. Leave empty (the default, and the safest
+	// choice) unless rotating the key. Setting it does not take effect immediately: the operator
+	// first runs a re-encryption job that decrypts every stored secret with the previous key and
+	// re-encrypts it with this one, coordinated with a syndesis-server restart and tracked via
+	// status.phase = EncryptionKeyRotating, before rolling the new key out
+	RequestedEncryptKey string `json:"requestedEncryptKey,omitempty"`
+
+	// ClientStateKeyLength is the length, in random bytes before base64 encoding, of a freshly
+	// generated client-state authentication/encryption key. Left zero (the default), the operator
+	// keeps generating its legacy fixed 32-character alphanumeric key instead, for backward
+	// compatibility with existing installations. Has no effect on a key already generated: rotate
+	// one by clearing its Secret entry so the operator regenerates it at this length
+	ClientStateKeyLength int `json:"clientStateKeyLength,omitempty"`
+
+	// ClientStateAuthenticationAlgorithm is the javax.crypto.Mac algorithm the server authenticates
+	// client-side stored state with, e.g. "HmacSHA256". Left empty, defaults to "HmacSHA1", or
+	// "HmacSHA256" when fipsMode is true
+	ClientStateAuthenticationAlgorithm string `json:"clientStateAuthenticationAlgorithm,omitempty"`
+
+	// ClientStateEncryptionAlgorithm is the javax.crypto.Cipher transformation the server encrypts
+	// client-side stored state with, e.g. "AES/GCM/NoPadding". Left empty, defaults to
+	// "AES/CBC/PKCS5Padding"
+	ClientStateEncryptionAlgorithm string `json:"clientStateEncryptionAlgorithm,omitempty"`
 }
 
 type MetaConfiguration struct {
 	Resources ResourcesWithVolume `json:"resources,omitempty"`
+
+	// Number of Meta pod replicas to run
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Liveness/readiness probe timings, zero value fields fall back to the built-in defaults
+	Probes ProbeConfiguration `json:"probes,omitempty"`
+
+	// Overrides Spec.ImagePullPolicy for the meta pod
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
 }
 
 type UpgradeConfiguration struct {
@@ -187,18 +695,70 @@ type ServerFeatures struct {
 	// Set repositories for maven
 	MavenRepositories map[string]string `json:"mavenRepositories,omitempty"`
 
-	// 3scale management URL
-	ManagementURLFor3scale string `json:"managementUrlFor3scale,omitempty"`
+	// Set mirrors for maven, keyed by mirror id, value is the mirror URL and
+	// the mirror always applies to "external:*" (i.e. everything not already
+	// matched by MavenRepositories)
+	MavenMirrors map[string]string `json:"mavenMirrors,omitempty"`
+
+	// Maximum number of integrations redeployed concurrently after an upgrade, defaults to 1 when
+	// unset or zero
+	IntegrationRedeployConcurrency int `json:"integrationRedeployConcurrency,omitempty"`
 }
 
 type AddonsSpec struct {
-	Jaeger    JaegerConfiguration    `json:"jaeger,omitempty"`
-	Ops       AddonSpec              `json:"ops,omitempty"`
-	Todo      AddonSpec              `json:"todo,omitempty"`
-	Knative   AddonSpec              `json:"knative,omitempty"`
-	DV        DvConfiguration        `json:"dv,omitempty"`
-	CamelK    AddonSpec              `json:"camelk,omitempty"`
-	PublicAPI PublicAPIConfiguration `json:"publicApi,omitempty"`
+	Jaeger     JaegerConfiguration      `json:"jaeger,omitempty"`
+	Ops        AddonSpec                `json:"ops,omitempty"`
+	SampleApps []SampleAppConfiguration `json:"sampleApps,omitempty"`
+	Knative    KnativeConfiguration     `json:"knative,omitempty"`
+	DV         DvConfiguration          `json:"dv,omitempty"`
+	CamelK     CamelKConfiguration      `json:"camelk,omitempty"`
+	Kafka      KafkaConfiguration       `json:"kafka,omitempty"`
+	Apicurito  ApicuritoConfiguration   `json:"apicurito,omitempty"`
+	ThreeScale ThreeScaleConfiguration  `json:"threeScale,omitempty"`
+	PublicAPI  PublicAPIConfiguration   `json:"publicApi,omitempty"`
+	Keycloak   KeycloakConfiguration    `json:"keycloak,omitempty"`
+	Logging    LoggingConfiguration     `json:"logging,omitempty"`
+}
+
+// SampleAppConfiguration describes one example application the operator deploys purely for demos
+// and QE testing, alongside (but independent of) Syndesis itself. Any number of sample apps can be
+// listed; each gets its own Service, Route/Ingress and Deployment named after it.
+type SampleAppConfiguration struct {
+	// Name identifies this sample app and is used to name the resources deployed for it
+	Name string `json:"name"`
+
+	// Docker image to deploy
+	Image string `json:"image,omitempty"`
+
+	// Environment variables passed to the sample app's container
+	Env map[string]string `json:"env,omitempty"`
+
+	// Name of the schema to create in the bundled sample database for this app to initialize and
+	// use. Left empty, the app is deployed without any database wired in
+	DatabaseSchema string `json:"databaseSchema,omitempty"`
+
+	// Hostname of the Route/Ingress exposing this sample app. Defaults to
+	// "<name>-<Syndesis.RouteHostname>" when empty
+	RouteHostname string `json:"routeHostname,omitempty"`
+}
+
+// ThreeScaleConfiguration configures discovery of Syndesis integrations by a 3scale API
+// Management tenant. When Enabled, the tenant's ManagementURL is exposed to syndesis-server so
+// integration authors can be pointed at it, and, if AutoServiceDiscovery is set, the services
+// backing published integrations are annotated with the discovery.3scale.net annotations 3scale
+// watches for, so they get registered as API products without a manual step.
+type ThreeScaleConfiguration struct {
+	Enabled bool `json:"enabled,omitempty"`
+
+	// URL of the 3scale tenant's management/admin API
+	ManagementURL string `json:"managementUrl,omitempty"`
+
+	// Name of the secret holding the access token used to authenticate against ManagementURL
+	AccessTokenSecret string `json:"accessTokenSecret,omitempty"`
+
+	// Annotate integration services so they are automatically discovered by 3scale, instead of
+	// requiring them to be registered as API products by hand
+	AutoServiceDiscovery bool `json:"autoServiceDiscovery,omitempty"`
 }
 
 type JaegerConfiguration struct {
@@ -212,6 +772,19 @@ type JaegerConfiguration struct {
 	CollectorURI  string `json:"collectorUri,omitempty"`
 	SamplerType   string `json:"samplerType,omitempty"`
 	SamplerParam  string `json:"samplerParam,omitempty"`
+
+	// How CredentialsSecret authenticates against a secured external Jaeger collector/query
+	// endpoint, one of: bearer, basic. Required when CredentialsSecret is set
+	AuthType string `json:"authType,omitempty"`
+
+	// Name of a user-provided secret used to authenticate against a secured external Jaeger
+	// endpoint. Holds a "token" key for AuthType "bearer", or "username"/"password" keys for
+	// AuthType "basic"
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+
+	// Name of a user-provided secret holding the CA bundle (ca.crt) used to verify a TLS-secured
+	// external Jaeger endpoint
+	CertificateSecret string `json:"certificateSecret,omitempty"`
 }
 
 type AddonSpec struct {
@@ -219,8 +792,162 @@ type AddonSpec struct {
 }
 
 type DvConfiguration struct {
-	Enabled   bool      `json:"enabled,omitempty"`
+	Enabled   bool        `json:"enabled,omitempty"`
+	Resources DvResources `json:"resources,omitempty"`
+
+	// Number of dv pod replicas to run, including 0 to scale the addon down without disabling
+	// it. Defaults to 1 when unset
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Node selector applied to the dv pod, in addition to Scheduling.NodeSelector
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations applied to the dv pod, in addition to Scheduling.Tolerations
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+type DvResources struct {
+	Memory string `json:"memory,omitempty"`
+
+	// CPU limit for the dv pod, eg. "750m". Left unset to not set a CPU limit
+	CPU string `json:"cpu,omitempty"`
+
+	// Capacity of the persistent volume used by the dv pod to cache materialized views. Left
+	// unset to run the dv pod without a persistent cache volume
+	VolumeCapacity string `json:"volumeCapacity,omitempty"`
+}
+
+// CamelKConfiguration configures the Camel K addon. When the required Camel K operator is not
+// already installed on the cluster, the operator subscribes to it via the Operator-Lifecycle-Manager,
+// using Channel/CatalogSource/CatalogSourceNamespace to pick the source when overridden
+type CamelKConfiguration struct {
+	Enabled bool `json:"enabled,omitempty"`
+
+	// The OLM channel to subscribe to when installing the Camel K operator automatically.
+	// Defaults to the channel this Syndesis release was tested against when empty
+	Channel string `json:"channel,omitempty"`
+
+	// The name of the CatalogSource to install the Camel K operator from. Defaults to the
+	// CatalogSource that publishes the resolved package when empty
+	CatalogSource string `json:"catalogSource,omitempty"`
+
+	// The namespace of the CatalogSource referenced by CatalogSource. Defaults to the
+	// CatalogSource's own namespace when empty
+	CatalogSourceNamespace string `json:"catalogSourceNamespace,omitempty"`
+}
+
+// KafkaConfiguration configures the Kafka addon, which either connects Syndesis to an existing
+// Kafka cluster or has the operator provision a small AMQ Streams/Strimzi Kafka cluster of its own
+type KafkaConfiguration struct {
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Provision a Strimzi Kafka cluster instead of connecting to an existing one. Requires the
+	// Strimzi/AMQ Streams operator to already be installed on the cluster
+	Provision bool `json:"provision,omitempty"`
+
+	// Number of broker replicas for the provisioned Kafka cluster. Defaults to 1 when unset
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Capacity of the persistent volume backing each provisioned broker, eg. "10Gi". Left unset
+	// to run the provisioned cluster with ephemeral storage
+	StorageCapacity string `json:"storageCapacity,omitempty"`
+
+	// Kafka version to provision. Defaults to the Strimzi operator's own default when empty
+	Version string `json:"version,omitempty"`
+
+	// Bootstrap servers of an existing Kafka cluster to connect to, eg.
+	// "my-cluster-kafka-bootstrap:9092". Ignored when Provision is true
+	BootstrapServers string `json:"bootstrapServers,omitempty"`
+
+	// Name of a user-provided secret holding credentials for authenticating against
+	// BootstrapServers. Ignored when Provision is true
+	CredentialSecret string `json:"credentialSecret,omitempty"`
+}
+
+// ApicuritoConfiguration configures the Apicurito (API Designer) addon, deployed alongside
+// Syndesis so syndesis-ui can embed it for designing the OpenAPI specifications used by
+// API-provider integrations
+type ApicuritoConfiguration struct {
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Docker image for Apicurito
+	Image string `json:"image,omitempty"`
+
 	Resources Resources `json:"resources,omitempty"`
+
+	// Hostname of the Route exposing Apicurito. Defaults to a hostname derived from
+	// Syndesis.RouteHostname when left empty
+	RouteHostname string `json:"routeHostname,omitempty"`
+}
+
+// KeycloakConfiguration configures the Keycloak addon, which either connects Syndesis to an
+// existing Keycloak/RH-SSO realm or has the operator provision a Keycloak instance of its own,
+// so users can authenticate against LDAP/SAML-federated identities instead of only OpenShift OAuth
+type KeycloakConfiguration struct {
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Provision a Keycloak instance instead of connecting to an existing one
+	Provision bool `json:"provision,omitempty"`
+
+	// Docker image for the provisioned Keycloak instance. Ignored when Provision is false
+	Image string `json:"image,omitempty"`
+
+	// Hostname of the Route exposing the provisioned Keycloak instance. Ignored when Provision
+	// is false
+	RouteHostname string `json:"routeHostname,omitempty"`
+
+	// Base URL of an existing Keycloak/RH-SSO instance to connect to, eg.
+	// "https://sso.example.com/auth". Ignored when Provision is true
+	URL string `json:"url,omitempty"`
+
+	// Realm to authenticate Syndesis users against
+	Realm string `json:"realm,omitempty"`
+
+	// Name of the secret holding the client ID/secret used to register Syndesis as a client of
+	// the realm
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// LoggingConfiguration configures the log forwarding addon, which injects a log-shipping sidecar
+// into syndesis-server so integration and component logs also reach an external Elasticsearch or
+// Loki endpoint, in addition to the container's own stdout
+type LoggingConfiguration struct {
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Docker image for the log-shipping sidecar, eg. a fluentd or Vector image configured to
+	// forward to Type
+	Image string `json:"image,omitempty"`
+
+	// Type of the external log store to forward to, one of: elasticsearch, loki
+	Type string `json:"type,omitempty"`
+
+	// Endpoint URL of the external Elasticsearch or Loki instance to forward logs to
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Index or log stream name logs are shipped under. Left empty, the sidecar's own default is used
+	Index string `json:"index,omitempty"`
+
+	// Name of the secret holding the credentials (username/password or API key) used to
+	// authenticate against Endpoint
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// KnativeConfiguration configures the Knative addon, which exposes integrations to Knative
+// Eventing brokers/channels in addition to plain HTTP routes
+type KnativeConfiguration struct {
+	Enabled bool `json:"enabled,omitempty"`
+
+	// The broker class to request for the default Broker provisioned for Syndesis, eg.
+	// "MTChannelBasedBroker" or "Kafka". Defaults to the cluster's default broker class when empty
+	BrokerClass string `json:"brokerClass,omitempty"`
+
+	// The Knative Channel CRD backing the default Broker, eg. "InMemoryChannel" or "KafkaChannel".
+	// Defaults to the cluster's default channel implementation when empty
+	ChannelImplementation string `json:"channelImplementation,omitempty"`
+
+	// Whether to label the Syndesis namespace with eventing.knative.dev/injection=enabled so
+	// Knative Eventing provisions the default Broker automatically
+	InjectNamespaceLabel bool `json:"injectNamespaceLabel,omitempty"`
 }
 
 type PublicAPIConfiguration struct {
@@ -230,10 +957,67 @@ type PublicAPIConfiguration struct {
 	// if set to true, then any authenticated user can access the API. otherwise the user
 	// needs access to get pods against the SarNamespace
 	DisableSarCheck bool `json:"disable-sar-check,omitempty"`
+
+	// Resource the SAR check verifies the user can access, eg. "syndeses" to require access to the
+	// Syndesis CR itself instead of listing pods. Defaults to "pods" when left empty
+	SarResource string `json:"sarResource,omitempty"`
+
+	// Verb the SAR check verifies the user can perform against SarResource. Defaults to "get" when left empty
+	SarVerb string `json:"sarVerb,omitempty"`
+
+	// API group SarResource belongs to, eg. "syndesis.io" for the Syndesis CR. Defaults to the core
+	// API group when left empty, matching the built-in "pods" resource
+	SarAPIGroup string `json:"sarApiGroup,omitempty"`
+
+	// Name of the secret holding a custom serving certificate (tls.crt), key (tls.key) and,
+	// optionally, a CA bundle (ca.crt) for the public API route. Left empty, the route is
+	// served with the cluster's default router certificate
+	CertificateSecret string `json:"certificateSecret,omitempty"`
+
+	// TLS termination policy for the public API route, one of: edge, reencrypt, passthrough.
+	// Defaults to reencrypt, matching the route's existing backend TLS configuration
+	TerminationPolicy string `json:"terminationPolicy,omitempty"`
+
+	// Maximum number of concurrent connections per source IP address the router accepts for the
+	// public API route. Zero (the default) disables rate limiting
+	RateLimitConnections int `json:"rateLimitConnections,omitempty"`
+
+	// CIDR ranges allowed to reach the public API route, eg. "10.0.0.0/8". Left empty, the route
+	// accepts connections from any source
+	IPAllowList []string `json:"ipAllowList,omitempty"`
 }
 
 type SyndesisPhase string
 
+// SyndesisDeletionPolicy controls whether the operator retains or removes persistent data
+// belonging to a Syndesis resource once it is deleted
+type SyndesisDeletionPolicy string
+
+const (
+	// SyndesisDeletionPolicyRetain leaves the database PVC in place when the Syndesis resource is deleted
+	SyndesisDeletionPolicyRetain SyndesisDeletionPolicy = "Retain"
+	// SyndesisDeletionPolicyDelete removes the database PVC when the Syndesis resource is deleted
+	SyndesisDeletionPolicyDelete SyndesisDeletionPolicy = "Delete"
+)
+
+// SyndesisSecurityModel controls how restrictive a securityContext Syndesis component pods run with
+type SyndesisSecurityModel string
+
+const (
+	// SyndesisSecurityModelDefault renders pods the way they always have been, without an explicit
+	// non-root securityContext
+	SyndesisSecurityModelDefault SyndesisSecurityModel = ""
+	// SyndesisSecurityModelRestricted renders every component pod compatible with the restricted SCC
+	// on OpenShift and the Pod Security Standards "restricted" level everywhere else: non-root
+	// securityContext, read-only root filesystem, all capabilities dropped and the runtime default
+	// seccomp profile
+	SyndesisSecurityModelRestricted SyndesisSecurityModel = "restricted"
+)
+
+// SyndesisFinalizer is added to a Syndesis resource so the operator can run cleanup logic
+// (cluster-scoped resources, and optionally the database PVC) before it is finally removed
+const SyndesisFinalizer = "finalizer.syndesis.io"
+
 type ResourcesWithVolume struct {
 	Memory         string `json:"memory,omitempty"`
 	VolumeCapacity string `json:"volumeCapacity,omitempty"`
@@ -255,6 +1039,10 @@ const (
 	SyndesisPhasePostUpgradeRunSucceed SyndesisPhase = "PostUpgradeRunSucceed"
 	SyndesisPhaseUpgradeFailureBackoff SyndesisPhase = "UpgradeFailureBackoff"
 	SyndesisPhaseUpgradeFailed         SyndesisPhase = "UpgradeFailed"
+	// SyndesisPhaseEncryptionKeyRotating means the operator is re-encrypting stored connection
+	// secrets after Spec.Components.Server.RequestedEncryptKey changed. Blocks the install action
+	// from rolling the new key out to syndesis-server before the re-encryption Job completes
+	SyndesisPhaseEncryptionKeyRotating SyndesisPhase = "EncryptionKeyRotating"
 )
 
 type SyndesisStatusReason string
@@ -269,6 +1057,293 @@ const (
 	SyndesisStatusReasonMigrated               SyndesisStatusReason = "Migrated"
 )
 
+// SyndesisComponentName identifies one of the individually health-checked Syndesis components
+type SyndesisComponentName string
+
+const (
+	SyndesisComponentServer     SyndesisComponentName = "server"
+	SyndesisComponentMeta       SyndesisComponentName = "meta"
+	SyndesisComponentUI         SyndesisComponentName = "ui"
+	SyndesisComponentDatabase   SyndesisComponentName = "db"
+	SyndesisComponentOauth      SyndesisComponentName = "oauth"
+	SyndesisComponentPrometheus SyndesisComponentName = "prometheus"
+)
+
+// SyndesisComponentHealth is the last observed readiness of a single Syndesis component
+type SyndesisComponentHealth string
+
+const (
+	// SyndesisComponentHealthReady means the component's Deployment has all its replicas ready
+	SyndesisComponentHealthReady SyndesisComponentHealth = "Ready"
+	// SyndesisComponentHealthNotReady means the component's Deployment exists but isn't fully ready yet
+	SyndesisComponentHealthNotReady SyndesisComponentHealth = "NotReady"
+	// SyndesisComponentHealthMissing means no Deployment was found for the component, e.g. an optional
+	// component such as prometheus that isn't enabled
+	SyndesisComponentHealthMissing SyndesisComponentHealth = "Missing"
+)
+
+// SyndesisAddonName identifies one of the optional addons that can be enabled under spec.addons
+type SyndesisAddonName string
+
+const (
+	SyndesisAddonJaeger    SyndesisAddonName = "jaeger"
+	SyndesisAddonDV        SyndesisAddonName = "dv"
+	SyndesisAddonCamelK    SyndesisAddonName = "camelk"
+	SyndesisAddonKnative   SyndesisAddonName = "knative"
+	SyndesisAddonKafka     SyndesisAddonName = "kafka"
+	SyndesisAddonApicurito SyndesisAddonName = "apicurito"
+	SyndesisAddonPublicAPI SyndesisAddonName = "publicApi"
+	SyndesisAddonKeycloak  SyndesisAddonName = "keycloak"
+	SyndesisAddonLogging   SyndesisAddonName = "logging"
+	SyndesisAddonOps       SyndesisAddonName = "ops"
+)
+
+// SyndesisAddonPhase is the last observed installation state of an enabled addon
+type SyndesisAddonPhase string
+
+const (
+	// SyndesisAddonPhaseInstalled means the addon's resources were applied but no further
+	// readiness signal is available for it
+	SyndesisAddonPhaseInstalled SyndesisAddonPhase = "Installed"
+	// SyndesisAddonPhaseReady means the addon's resources were applied and observed to be ready
+	SyndesisAddonPhaseReady SyndesisAddonPhase = "Ready"
+	// SyndesisAddonPhaseFailed means the addon is enabled but a required dependency (eg. an
+	// operator it relies on) was not found, see Reason for details
+	SyndesisAddonPhaseFailed SyndesisAddonPhase = "Failed"
+)
+
+// SyndesisAddonHealth is the last observed installation state of a single enabled addon
+type SyndesisAddonHealth struct {
+	Phase SyndesisAddonPhase `json:"phase,omitempty"`
+	// Reason explains a Failed phase, eg. "Jaeger operator not found"
+	Reason string `json:"reason,omitempty"`
+}
+
+// SyndesisConditionType is the type of a SyndesisCondition
+type SyndesisConditionType string
+
+const (
+	// SyndesisConditionTypeReady is true when the installation is fully up and serving traffic
+	SyndesisConditionTypeReady SyndesisConditionType = "Ready"
+	// SyndesisConditionTypeProgressing is true while the operator is installing or upgrading Syndesis
+	SyndesisConditionTypeProgressing SyndesisConditionType = "Progressing"
+	// SyndesisConditionTypeDegraded is true when the installation is up but one or more components are unhealthy
+	SyndesisConditionTypeDegraded SyndesisConditionType = "Degraded"
+	// SyndesisConditionTypeDatabaseUpgradeRequired is true when the database schema needs a manual/approved upgrade
+	SyndesisConditionTypeDatabaseUpgradeRequired SyndesisConditionType = "DatabaseUpgradeRequired"
+)
+
+// SyndesisCondition describes the state of a Syndesis installation at a certain point,
+// following the standard Kubernetes condition pattern
+type SyndesisCondition struct {
+	// Type of the condition
+	Type SyndesisConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown
+	Status corev1.ConditionStatus `json:"status"`
+	// LastTransitionTime is the last time the condition transitioned from one status to another
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// LastUpdateTime is the last time this condition was updated, even if the status did not change
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+	// Reason is a brief machine readable explanation for the condition's last transition
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable description of the details of the last transition
+	Message string `json:"message,omitempty"`
+}
+
+// GetSyndesisCondition returns the condition of the given type, or nil if the status does not have one yet
+func (status *SyndesisStatus) GetSyndesisCondition(t SyndesisConditionType) *SyndesisCondition {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == t {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetSyndesisCondition creates or updates the condition of the given type, tracking the transition
+// time only when the status actually changes
+func (status *SyndesisStatus) SetSyndesisCondition(t SyndesisConditionType, s corev1.ConditionStatus, reason string, message string) {
+	now := metav1.Now()
+	existing := status.GetSyndesisCondition(t)
+	if existing == nil {
+		status.Conditions = append(status.Conditions, SyndesisCondition{
+			Type:               t,
+			Status:             s,
+			LastTransitionTime: &now,
+			LastUpdateTime:     &now,
+			Reason:             reason,
+			Message:            message,
+		})
+		return
+	}
+
+	if existing.Status != s {
+		existing.LastTransitionTime = &now
+	}
+	existing.Status = s
+	existing.LastUpdateTime = &now
+	existing.Reason = reason
+	existing.Message = message
+}
+
+// SyndesisInstallStage identifies one of the named stages an install progresses through
+type SyndesisInstallStage string
+
+const (
+	SyndesisInstallStagePrerequisites SyndesisInstallStage = "Prerequisites"
+	SyndesisInstallStageSecrets       SyndesisInstallStage = "Secrets"
+	SyndesisInstallStageExposure      SyndesisInstallStage = "Exposure"
+	SyndesisInstallStageDatabase      SyndesisInstallStage = "Database"
+	SyndesisInstallStageBackend       SyndesisInstallStage = "Backend"
+	SyndesisInstallStageAddons        SyndesisInstallStage = "Addons"
+)
+
+// SyndesisInstallStageState is the outcome of a SyndesisInstallStage the last time it ran
+type SyndesisInstallStageState string
+
+const (
+	SyndesisInstallStageStateInProgress SyndesisInstallStageState = "InProgress"
+	SyndesisInstallStageStateCompleted  SyndesisInstallStageState = "Completed"
+	SyndesisInstallStageStateFailed     SyndesisInstallStageState = "Failed"
+)
+
+// SyndesisInstallStageStatus reports the progress of a single named install stage
+type SyndesisInstallStageStatus struct {
+	// Name of the install stage
+	Name SyndesisInstallStage `json:"name"`
+	// State the stage was left in the last time it ran
+	State SyndesisInstallStageState `json:"state"`
+	// StartedAt is when this stage was last started
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// CompletedAt is when this stage last finished, successfully or not
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// Message gives more detail, in particular the error that made the stage fail
+	Message string `json:"message,omitempty"`
+}
+
+// GetInstallStage returns the status of the given install stage, or nil if it hasn't run yet
+func (status *SyndesisStatus) GetInstallStage(name SyndesisInstallStage) *SyndesisInstallStageStatus {
+	for i := range status.Phases {
+		if status.Phases[i].Name == name {
+			return &status.Phases[i]
+		}
+	}
+	return nil
+}
+
+// SetInstallStageStarted records that the given install stage has started, discarding the
+// outcome of any previous run of that stage
+func (status *SyndesisStatus) SetInstallStageStarted(name SyndesisInstallStage) {
+	now := metav1.Now()
+	existing := status.GetInstallStage(name)
+	if existing == nil {
+		status.Phases = append(status.Phases, SyndesisInstallStageStatus{
+			Name:      name,
+			State:     SyndesisInstallStageStateInProgress,
+			StartedAt: &now,
+		})
+		return
+	}
+
+	existing.State = SyndesisInstallStageStateInProgress
+	existing.StartedAt = &now
+	existing.CompletedAt = nil
+	existing.Message = ""
+}
+
+// SetInstallStageFinished records the outcome of the given install stage's last run
+func (status *SyndesisStatus) SetInstallStageFinished(name SyndesisInstallStage, state SyndesisInstallStageState, message string) {
+	now := metav1.Now()
+	existing := status.GetInstallStage(name)
+	if existing == nil {
+		status.Phases = append(status.Phases, SyndesisInstallStageStatus{
+			Name:        name,
+			State:       state,
+			StartedAt:   &now,
+			CompletedAt: &now,
+			Message:     message,
+		})
+		return
+	}
+
+	existing.State = state
+	existing.CompletedAt = &now
+	existing.Message = message
+}
+
+// UpgradeStep identifies one of the steps the upgrade process runs, in order. Unlike
+// SyndesisInstallStage this isn't a closed set of constants: the upgrade process names its
+// steps dynamically (e.g. a database upgrade step is named after the versions it upgrades
+// between), so any non-empty value is valid.
+type UpgradeStep string
+
+// UpgradeStepStatus reports the progress of a single upgrade step, so an upgrade interrupted
+// by an operator restart can resume from the last step that completed successfully instead of
+// running everything again from scratch
+type UpgradeStepStatus struct {
+	// Name of the upgrade step
+	Name UpgradeStep `json:"name"`
+	// State the step was left in the last time it ran
+	State SyndesisInstallStageState `json:"state"`
+	// StartedAt is when this step was last started
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// CompletedAt is when this step last finished, successfully or not
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// Message gives more detail, in particular the error that made the step fail
+	Message string `json:"message,omitempty"`
+}
+
+// GetUpgradeStep returns the status of the given upgrade step, or nil if it hasn't run yet
+// during the current upgrade attempt
+func (status *SyndesisStatus) GetUpgradeStep(name UpgradeStep) *UpgradeStepStatus {
+	for i := range status.UpgradeSteps {
+		if status.UpgradeSteps[i].Name == name {
+			return &status.UpgradeSteps[i]
+		}
+	}
+	return nil
+}
+
+// SetUpgradeStepStarted records that the given upgrade step has started, discarding the
+// outcome of any previous run of that step
+func (status *SyndesisStatus) SetUpgradeStepStarted(name UpgradeStep) {
+	now := metav1.Now()
+	existing := status.GetUpgradeStep(name)
+	if existing == nil {
+		status.UpgradeSteps = append(status.UpgradeSteps, UpgradeStepStatus{
+			Name:      name,
+			State:     SyndesisInstallStageStateInProgress,
+			StartedAt: &now,
+		})
+		return
+	}
+
+	existing.State = SyndesisInstallStageStateInProgress
+	existing.StartedAt = &now
+	existing.CompletedAt = nil
+	existing.Message = ""
+}
+
+// SetUpgradeStepFinished records the outcome of the given upgrade step's last run
+func (status *SyndesisStatus) SetUpgradeStepFinished(name UpgradeStep, state SyndesisInstallStageState, message string) {
+	now := metav1.Now()
+	existing := status.GetUpgradeStep(name)
+	if existing == nil {
+		status.UpgradeSteps = append(status.UpgradeSteps, UpgradeStepStatus{
+			Name:        name,
+			State:       state,
+			StartedAt:   &now,
+			CompletedAt: &now,
+			Message:     message,
+		})
+		return
+	}
+
+	existing.State = state
+	existing.CompletedAt = &now
+	existing.Message = message
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Syndesis is the Schema for the syndeses API
@@ -0,0 +1,296 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// hostnameRegex matches a valid DNS-1123 hostname: lowercase alphanumeric labels
+// separated by dots, each label starting and ending with an alphanumeric character
+var hostnameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+
+// databaseUserRegex matches a valid, unquoted PostgreSQL identifier. passwordrotation.go passes this
+// value to psql as the connecting role, so it must look like a plain role name rather than something
+// that could be misread as a different psql argument or SQL identifier
+var databaseUserRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// imageRegex is a permissive check for a container image reference: an optional
+// registry/repository path followed by an optional :tag or @digest
+var imageRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._/-]*(:[a-zA-Z0-9._-]+)?(@[a-zA-Z0-9]+:[a-fA-F0-9]+)?$`)
+
+// Validate checks the fields of the spec that the CR author controls directly,
+// independently of the runtime environment, so it can run both from a webhook
+// and while building the operator's Config. It returns nil when the spec is valid,
+// otherwise an error combining every problem found.
+func (spec *SyndesisSpec) Validate() error {
+	var problems []string
+
+	if spec.RouteHostname != "" && !hostnameRegex.MatchString(spec.RouteHostname) {
+		problems = append(problems, fmt.Sprintf("routeHostname %q is not a valid hostname", spec.RouteHostname))
+	}
+
+	if spec.Components.Database.ExternalDbURL != "" {
+		if u, err := url.Parse(spec.Components.Database.ExternalDbURL); err != nil || u.Host == "" {
+			problems = append(problems, fmt.Sprintf("components.database.externalDbURL %q is not a valid URL", spec.Components.Database.ExternalDbURL))
+		}
+	}
+
+	if spec.AuditWebhook != "" {
+		if u, err := url.Parse(spec.AuditWebhook); err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+			problems = append(problems, fmt.Sprintf("auditWebhook %q is not a valid http(s) URL", spec.AuditWebhook))
+		}
+	}
+
+	problems = append(problems, validateMemory("components.server.resources.memory", spec.Components.Server.Resources.Memory)...)
+	problems = append(problems, validateMemory("components.meta.resources.memory", spec.Components.Meta.Resources.Memory)...)
+	problems = append(problems, validateVolumeCapacity("components.meta.resources.volumeCapacity", spec.Components.Meta.Resources.VolumeCapacity)...)
+	problems = append(problems, validateMemory("components.database.resources.memory", spec.Components.Database.Resources.Memory)...)
+	problems = append(problems, validateVolumeCapacity("components.database.resources.volumeCapacity", spec.Components.Database.Resources.VolumeCapacity)...)
+	problems = append(problems, validateMemory("components.prometheus.resources.memory", spec.Components.Prometheus.Resources.Memory)...)
+	problems = append(problems, validateVolumeCapacity("components.prometheus.resources.volumeCapacity", spec.Components.Prometheus.Resources.VolumeCapacity)...)
+	problems = append(problems, validateMemory("components.grafana.resources.memory", spec.Components.Grafana.Resources.Memory)...)
+	problems = append(problems, validateMemory("addons.dv.resources.memory", spec.Addons.DV.Resources.Memory)...)
+
+	if user := spec.Components.Database.User; user != "" && !databaseUserRegex.MatchString(user) {
+		problems = append(problems, fmt.Sprintf("components.database.user %q must be a valid, unquoted PostgreSQL identifier", user))
+	}
+
+	if spec.Components.Database.Replicas < 0 {
+		problems = append(problems, fmt.Sprintf("components.database.replicas %d must not be negative", spec.Components.Database.Replicas))
+	}
+	if spec.Components.Database.Replicas > 1 && spec.Components.Database.ExternalDbURL != "" {
+		problems = append(problems, "components.database.replicas cannot be set together with components.database.externalDbURL, since the operator does not manage replication for an external database")
+	}
+
+	if spec.Components.Database.ExternalDbIAMAuth {
+		if spec.Components.Database.ExternalDbURL == "" {
+			problems = append(problems, "components.database.externalDbIAMAuth requires components.database.externalDbURL to be set")
+		}
+		if spec.Components.Database.ExternalDbIAMRoleArn == "" {
+			problems = append(problems, "components.database.externalDbIAMAuth requires components.database.externalDbIAMRoleArn to be set")
+		}
+	}
+
+	if spec.Components.Database.Pooler.Enabled {
+		if spec.Components.Database.ExternalDbURL != "" {
+			problems = append(problems, "components.database.pooler.enabled cannot be set together with components.database.externalDbURL, since the operator only pools its own database")
+		}
+		if mode := spec.Components.Database.Pooler.PoolMode; mode != "" && mode != "session" && mode != "transaction" && mode != "statement" {
+			problems = append(problems, fmt.Sprintf("components.database.pooler.poolMode %q must be one of session, transaction or statement", mode))
+		}
+	}
+
+	if spec.Components.Database.WalArchiving.Enabled && spec.Components.Database.ExternalDbURL != "" {
+		problems = append(problems, "components.database.walArchiving.enabled cannot be set together with components.database.externalDbURL, since the operator does not manage WAL archiving for an external database")
+	}
+
+	if spec.Components.Database.ExternalDbProxy.Enabled {
+		if spec.Components.Database.ExternalDbURL == "" {
+			problems = append(problems, "components.database.externalDbProxy.enabled requires components.database.externalDbURL to be set")
+		}
+		if spec.Components.Database.ExternalDbProxy.Image == "" {
+			problems = append(problems, "components.database.externalDbProxy.enabled requires components.database.externalDbProxy.image to be set")
+		}
+	}
+
+	if secret := spec.Addons.Jaeger.CredentialsSecret; secret != "" {
+		if spec.Addons.Jaeger.AuthType != "bearer" && spec.Addons.Jaeger.AuthType != "basic" {
+			problems = append(problems, "addons.jaeger.credentialsSecret requires addons.jaeger.authType to be one of bearer or basic")
+		}
+	} else if spec.Addons.Jaeger.AuthType != "" {
+		problems = append(problems, "addons.jaeger.authType requires addons.jaeger.credentialsSecret to be set")
+	}
+
+	problems = append(problems, validateTuning(spec.Components.Database.Tuning)...)
+
+	if spec.Components.Database.InitScripts != "" && spec.Components.Database.InitScriptsConfigMap != "" {
+		problems = append(problems, "components.database.initScripts cannot be set together with components.database.initScriptsConfigMap")
+	}
+
+	if rotation := spec.Components.Database.PasswordRotation; rotation != "" {
+		if _, err := ParseRotationInterval(rotation); err != nil {
+			problems = append(problems, fmt.Sprintf("components.database.passwordRotation %q is invalid: %v", rotation, err))
+		}
+		if spec.Components.Database.ExternalDbURL != "" {
+			problems = append(problems, "components.database.passwordRotation cannot be set together with components.database.externalDbURL, since the operator does not manage credentials for an external database")
+		}
+	}
+
+	if model := spec.SecurityModel; model != SyndesisSecurityModelDefault && model != SyndesisSecurityModelRestricted {
+		problems = append(problems, fmt.Sprintf("securityModel %q must be either empty or restricted", model))
+	}
+
+	problems = append(problems, validateImage("addons.jaeger.imageAgent", spec.Addons.Jaeger.ImageAgent)...)
+	problems = append(problems, validateImage("addons.jaeger.imageAllInOne", spec.Addons.Jaeger.ImageAllInOne)...)
+	problems = append(problems, validateImage("addons.jaeger.imageOperator", spec.Addons.Jaeger.ImageOperator)...)
+
+	if spec.CosignPublicKey != "" {
+		if !spec.SHA {
+			problems = append(problems, "cosignPublicKey requires sha to be enabled, since signatures are verified against a resolved image digest")
+		}
+		if block, _ := pem.Decode([]byte(spec.CosignPublicKey)); block == nil || block.Type != "PUBLIC KEY" {
+			problems = append(problems, "cosignPublicKey must be a PEM-encoded public key")
+		}
+	}
+
+	if spec.Components.Server.ClientStateKeyLength < 0 {
+		problems = append(problems, fmt.Sprintf("components.server.clientStateKeyLength %d must not be negative", spec.Components.Server.ClientStateKeyLength))
+	}
+	if length := spec.Components.Server.ClientStateKeyLength; spec.FIPSMode && length > 0 && length < 16 {
+		problems = append(problems, fmt.Sprintf("components.server.clientStateKeyLength %d must be at least 16 (AES-128) when fipsMode is enabled", length))
+	}
+
+	if algorithm := spec.Components.Server.ClientStateAuthenticationAlgorithm; algorithm != "" && spec.FIPSMode && algorithm != "HmacSHA256" && algorithm != "HmacSHA512" {
+		problems = append(problems, fmt.Sprintf("components.server.clientStateAuthenticationAlgorithm %q is not a FIPS-approved MAC algorithm", algorithm))
+	}
+
+	if algorithm := spec.Components.Server.ClientStateEncryptionAlgorithm; algorithm != "" && spec.FIPSMode && !strings.HasPrefix(algorithm, "AES/") {
+		problems = append(problems, fmt.Sprintf("components.server.clientStateEncryptionAlgorithm %q is not a FIPS-approved cipher transformation", algorithm))
+	}
+
+	if spec.FIPSMode {
+		problems = append(problems, validateFIPSImage("components.database.externalDbProxy.image", spec.Components.Database.ExternalDbProxy.Image)...)
+		problems = append(problems, validateFIPSImage("addons.apicurito.image", spec.Addons.Apicurito.Image)...)
+		problems = append(problems, validateFIPSImage("addons.keycloak.image", spec.Addons.Keycloak.Image)...)
+		problems = append(problems, validateFIPSImage("addons.logging.image", spec.Addons.Logging.Image)...)
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid Syndesis spec: %s", strings.Join(problems, "; "))
+}
+
+func validateMemory(field string, value string) []string {
+	if value == "" {
+		return nil
+	}
+	if _, err := resource.ParseQuantity(value); err != nil {
+		return []string{fmt.Sprintf("%s %q is not a valid resource quantity: %v", field, value, err)}
+	}
+	return nil
+}
+
+func validateVolumeCapacity(field string, value string) []string {
+	return validateMemory(field, value)
+}
+
+// knownTuningParameters are the postgresql.conf settings Database.Tuning is allowed to override.
+// It is deliberately a small allow-list of parameters that only affect performance/resource usage,
+// excluding anything (e.g. listen_addresses, unix_socket_directories, ssl) that could break
+// connectivity or conflict with settings the operator itself manages
+var knownTuningParameters = map[string]bool{
+	"shared_buffers":                  true,
+	"effective_cache_size":            true,
+	"work_mem":                        true,
+	"maintenance_work_mem":            true,
+	"max_connections":                 true,
+	"max_worker_processes":            true,
+	"max_parallel_workers":            true,
+	"max_parallel_workers_per_gather": true,
+	"checkpoint_completion_target":    true,
+	"wal_buffers":                     true,
+	"random_page_cost":                true,
+	"effective_io_concurrency":        true,
+	"default_statistics_target":       true,
+	"min_wal_size":                    true,
+	"max_wal_size":                    true,
+}
+
+func validateTuning(tuning map[string]string) []string {
+	var problems []string
+	for param := range tuning {
+		if !knownTuningParameters[param] {
+			problems = append(problems, fmt.Sprintf("components.database.tuning %q is not a recognized PostgreSQL tuning parameter", param))
+		}
+	}
+	return problems
+}
+
+// ParseRotationInterval parses a Database.PasswordRotation value. It accepts everything
+// time.ParseDuration does, plus a trailing "d" unit for whole days (e.g. "90d"), since operators
+// think about rotation policies in days rather than hours.
+func ParseRotationInterval(value string) (time.Duration, error) {
+	if days := strings.TrimSuffix(value, "d"); days != value {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("not a positive number of days")
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("must be positive")
+	}
+	return d, nil
+}
+
+func validateImage(field string, value string) []string {
+	if value == "" {
+		return nil
+	}
+	if !imageRegex.MatchString(value) {
+		return []string{fmt.Sprintf("%s %q is not a valid container image reference", field, value)}
+	}
+	return nil
+}
+
+// validateFIPSImage rejects a user-supplied image override pinned to the floating "latest" tag, or left
+// without any tag at all (which defaults to "latest"), when fipsMode is enabled - the operator has no
+// way to verify an unpinned tag resolves to a FIPS-compliant build. Left unset, this is skipped entirely,
+// since the operator then either leaves the component undeployed or falls back to its own default, which
+// applyFIPSImages tags appropriately
+func validateFIPSImage(field string, value string) []string {
+	if value == "" {
+		return nil
+	}
+	if imageTag(value) == "" {
+		return []string{fmt.Sprintf("%s %q must be pinned to an explicit, non-\"latest\" tag when fipsMode is enabled", field, value)}
+	}
+	return nil
+}
+
+// imageTag returns the tag portion of an image reference, or "" if it is untagged or tagged
+// "latest". A colon after the last "/" is a tag separator; a colon before it is part of a
+// "registry:port" prefix (e.g. "myregistry:5000/image" is untagged, not pinned to port 5000).
+func imageTag(value string) string {
+	repo := value
+	if slash := strings.LastIndex(value, "/"); slash != -1 {
+		repo = value[slash+1:]
+	}
+	idx := strings.LastIndex(repo, ":")
+	if idx == -1 {
+		return ""
+	}
+	tag := repo[idx+1:]
+	if tag == "latest" {
+		return ""
+	}
+	return tag
+}
@@ -0,0 +1,209 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    SyndesisSpec
+		wantErr bool
+	}{
+		{
+			name: "empty spec is valid",
+			spec: SyndesisSpec{},
+		},
+		{
+			name: "valid routeHostname",
+			spec: SyndesisSpec{RouteHostname: "syndesis.example.com"},
+		},
+		{
+			name:    "invalid routeHostname",
+			spec:    SyndesisSpec{RouteHostname: "not a hostname!"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid externalDbURL",
+			spec:    SyndesisSpec{Components: ComponentsSpec{Database: DatabaseConfiguration{ExternalDbURL: "://not-a-url"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid database user",
+			spec:    SyndesisSpec{Components: ComponentsSpec{Database: DatabaseConfiguration{User: "syndesis'; DROP TABLE users; --"}}},
+			wantErr: true,
+		},
+		{
+			name: "valid database user",
+			spec: SyndesisSpec{Components: ComponentsSpec{Database: DatabaseConfiguration{User: "syndesis"}}},
+		},
+		{
+			name:    "negative database replicas",
+			spec:    SyndesisSpec{Components: ComponentsSpec{Database: DatabaseConfiguration{Replicas: -1}}},
+			wantErr: true,
+		},
+		{
+			name: "database replicas with externalDbURL",
+			spec: SyndesisSpec{Components: ComponentsSpec{Database: DatabaseConfiguration{
+				Replicas:      2,
+				ExternalDbURL: "postgresql://db:5432/syndesis",
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "externalDbIAMAuth without externalDbURL",
+			spec: SyndesisSpec{Components: ComponentsSpec{Database: DatabaseConfiguration{
+				ExternalDbIAMAuth: true,
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "invalid pooler poolMode",
+			spec: SyndesisSpec{Components: ComponentsSpec{Database: DatabaseConfiguration{
+				Pooler: PoolerConfiguration{Enabled: true, PoolMode: "bogus"},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "externalDbProxy enabled without image",
+			spec: SyndesisSpec{Components: ComponentsSpec{Database: DatabaseConfiguration{
+				ExternalDbURL:   "postgresql://db:5432/syndesis",
+				ExternalDbProxy: ExternalDbProxyConfiguration{Enabled: true},
+			}}},
+			wantErr: true,
+		},
+		{
+			name:    "jaeger credentialsSecret without authType",
+			spec:    SyndesisSpec{Addons: AddonsSpec{Jaeger: JaegerConfiguration{CredentialsSecret: "creds"}}},
+			wantErr: true,
+		},
+		{
+			name: "unknown database tuning parameter",
+			spec: SyndesisSpec{Components: ComponentsSpec{Database: DatabaseConfiguration{
+				Tuning: map[string]string{"listen_addresses": "*"},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "known database tuning parameter",
+			spec: SyndesisSpec{Components: ComponentsSpec{Database: DatabaseConfiguration{
+				Tuning: map[string]string{"shared_buffers": "256MB"},
+			}}},
+		},
+		{
+			name:    "invalid passwordRotation",
+			spec:    SyndesisSpec{Components: ComponentsSpec{Database: DatabaseConfiguration{PasswordRotation: "not-a-duration"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid securityModel",
+			spec:    SyndesisSpec{SecurityModel: "bogus"},
+			wantErr: true,
+		},
+		{
+			name:    "cosignPublicKey without sha",
+			spec:    SyndesisSpec{CosignPublicKey: "-----BEGIN PUBLIC KEY-----\nMA==\n-----END PUBLIC KEY-----"},
+			wantErr: true,
+		},
+		{
+			name:    "cosignPublicKey not PEM",
+			spec:    SyndesisSpec{SHA: true, CosignPublicKey: "not-pem"},
+			wantErr: true,
+		},
+		{
+			name:    "negative clientStateKeyLength",
+			spec:    SyndesisSpec{Components: ComponentsSpec{Server: ServerConfiguration{ClientStateKeyLength: -1}}},
+			wantErr: true,
+		},
+		{
+			name: "non-FIPS-approved clientStateAuthenticationAlgorithm under fipsMode",
+			spec: SyndesisSpec{
+				FIPSMode:   true,
+				Components: ComponentsSpec{Server: ServerConfiguration{ClientStateAuthenticationAlgorithm: "HmacSHA1"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unpinned image under fipsMode",
+			spec: SyndesisSpec{
+				FIPSMode: true,
+				Addons:   AddonsSpec{Apicurito: ApicuritoConfiguration{Image: "quay.io/apicurio/apicurito-ui:latest"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "pinned image under fipsMode",
+			spec: SyndesisSpec{
+				FIPSMode: true,
+				Addons:   AddonsSpec{Apicurito: ApicuritoConfiguration{Image: "quay.io/apicurio/apicurito-ui:1.2.3-fips"}},
+			},
+		},
+		{
+			name: "untagged image with a registry host:port under fipsMode",
+			spec: SyndesisSpec{
+				FIPSMode: true,
+				Addons:   AddonsSpec{Apicurito: ApicuritoConfiguration{Image: "myregistry:5000/apicurito-ui"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tagged image with a registry host:port under fipsMode",
+			spec: SyndesisSpec{
+				FIPSMode: true,
+				Addons:   AddonsSpec{Apicurito: ApicuritoConfiguration{Image: "myregistry:5000/apicurito-ui:1.2.3-fips"}},
+			},
+		},
+		{
+			name: "non-FIPS-approved clientStateEncryptionAlgorithm under fipsMode",
+			spec: SyndesisSpec{
+				FIPSMode:   true,
+				Components: ComponentsSpec{Server: ServerConfiguration{ClientStateEncryptionAlgorithm: "DES/CBC/PKCS5Padding"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "FIPS-approved clientStateEncryptionAlgorithm under fipsMode",
+			spec: SyndesisSpec{
+				FIPSMode:   true,
+				Components: ComponentsSpec{Server: ServerConfiguration{ClientStateEncryptionAlgorithm: "AES/GCM/NoPadding"}},
+			},
+		},
+		{
+			name: "clientStateKeyLength too short for fipsMode",
+			spec: SyndesisSpec{
+				FIPSMode:   true,
+				Components: ComponentsSpec{Server: ServerConfiguration{ClientStateKeyLength: 8}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ValidateCreate implements sigs.k8s.io/controller-runtime/pkg/webhook/admission.Validator so a
+// ValidatingWebhookConfiguration can reject a bad Syndesis resource at admission time, before the
+// operator ever reconciles it.
+func (in *Syndesis) ValidateCreate() error {
+	return in.Spec.Validate()
+}
+
+// ValidateUpdate re-runs the same checks as ValidateCreate; the old object isn't needed since
+// nothing about spec validity depends on the previous value.
+func (in *Syndesis) ValidateUpdate(old runtime.Object) error {
+	return in.Spec.Validate()
+}
+
+// ValidateDelete allows every deletion; there is nothing to validate.
+func (in *Syndesis) ValidateDelete() error {
+	return nil
+}
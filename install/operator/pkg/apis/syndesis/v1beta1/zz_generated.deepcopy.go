@@ -1,3 +1,4 @@
+//go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
 // Code generated by operator-sdk. DO NOT EDIT.
@@ -5,6 +6,7 @@
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -29,11 +31,22 @@ func (in *AddonsSpec) DeepCopyInto(out *AddonsSpec) {
 	*out = *in
 	out.Jaeger = in.Jaeger
 	out.Ops = in.Ops
-	out.Todo = in.Todo
+	if in.SampleApps != nil {
+		in, out := &in.SampleApps, &out.SampleApps
+		*out = make([]SampleAppConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.Knative = in.Knative
-	out.DV = in.DV
+	in.DV.DeepCopyInto(&out.DV)
 	out.CamelK = in.CamelK
-	out.PublicAPI = in.PublicAPI
+	out.Kafka = in.Kafka
+	out.Apicurito = in.Apicurito
+	out.ThreeScale = in.ThreeScale
+	in.PublicAPI.DeepCopyInto(&out.PublicAPI)
+	out.Keycloak = in.Keycloak
+	out.Logging = in.Logging
 	return
 }
 
@@ -47,6 +60,22 @@ func (in *AddonsSpec) DeepCopy() *AddonsSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApicuritoConfiguration) DeepCopyInto(out *ApicuritoConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApicuritoConfiguration.
+func (in *ApicuritoConfiguration) DeepCopy() *ApicuritoConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ApicuritoConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupConfig) DeepCopyInto(out *BackupConfig) {
 	*out = *in
@@ -79,12 +108,29 @@ func (in *BackupStatus) DeepCopy() *BackupStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CamelKConfiguration) DeepCopyInto(out *CamelKConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelKConfiguration.
+func (in *CamelKConfiguration) DeepCopy() *CamelKConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CamelKConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentsSpec) DeepCopyInto(out *ComponentsSpec) {
 	*out = *in
-	out.Oauth = in.Oauth
+	in.Oauth.DeepCopyInto(&out.Oauth)
 	in.Server.DeepCopyInto(&out.Server)
 	out.Meta = in.Meta
+	out.UI = in.UI
 	in.Database.DeepCopyInto(&out.Database)
 	out.Prometheus = in.Prometheus
 	out.Grafana = in.Grafana
@@ -106,6 +152,7 @@ func (in *ComponentsSpec) DeepCopy() *ComponentsSpec {
 func (in *DatabaseConfiguration) DeepCopyInto(out *DatabaseConfiguration) {
 	*out = *in
 	in.Resources.DeepCopyInto(&out.Resources)
+	in.ExternalDbProxy.DeepCopyInto(&out.ExternalDbProxy)
 	return
 }
 
@@ -123,6 +170,25 @@ func (in *DatabaseConfiguration) DeepCopy() *DatabaseConfiguration {
 func (in *DvConfiguration) DeepCopyInto(out *DvConfiguration) {
 	*out = *in
 	out.Resources = in.Resources
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -136,6 +202,86 @@ func (in *DvConfiguration) DeepCopy() *DvConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DvResources) DeepCopyInto(out *DvResources) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DvResources.
+func (in *DvResources) DeepCopy() *DvResources {
+	if in == nil {
+		return nil
+	}
+	out := new(DvResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalDbProxyConfiguration) DeepCopyInto(out *ExternalDbProxyConfiguration) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalDbProxyConfiguration.
+func (in *ExternalDbProxyConfiguration) DeepCopy() *ExternalDbProxyConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalDbProxyConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionKeyRotationStatus) DeepCopyInto(out *EncryptionKeyRotationStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyRotationStatus.
+func (in *EncryptionKeyRotationStatus) DeepCopy() *EncryptionKeyRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionKeyRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationRedeployStatus) DeepCopyInto(out *IntegrationRedeployStatus) {
+	*out = *in
+	if in.Failed != nil {
+		in, out := &in.Failed, &out.Failed
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationRedeployStatus.
+func (in *IntegrationRedeployStatus) DeepCopy() *IntegrationRedeployStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationRedeployStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GrafanaConfiguration) DeepCopyInto(out *GrafanaConfiguration) {
 	*out = *in
@@ -169,6 +315,70 @@ func (in *JaegerConfiguration) DeepCopy() *JaegerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConfiguration) DeepCopyInto(out *KafkaConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConfiguration.
+func (in *KafkaConfiguration) DeepCopy() *KafkaConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakConfiguration) DeepCopyInto(out *KeycloakConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakConfiguration.
+func (in *KeycloakConfiguration) DeepCopy() *KeycloakConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnativeConfiguration) DeepCopyInto(out *KnativeConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnativeConfiguration.
+func (in *KnativeConfiguration) DeepCopy() *KnativeConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KnativeConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingConfiguration) DeepCopyInto(out *LoggingConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingConfiguration.
+func (in *LoggingConfiguration) DeepCopy() *LoggingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetaConfiguration) DeepCopyInto(out *MetaConfiguration) {
 	*out = *in
@@ -189,6 +399,21 @@ func (in *MetaConfiguration) DeepCopy() *MetaConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OauthConfiguration) DeepCopyInto(out *OauthConfiguration) {
 	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SkipAuthRegex != nil {
+		in, out := &in.SkipAuthRegex, &out.SkipAuthRegex
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraArguments != nil {
+		in, out := &in.ExtraArguments, &out.ExtraArguments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -202,6 +427,22 @@ func (in *OauthConfiguration) DeepCopy() *OauthConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeConfiguration) DeepCopyInto(out *ProbeConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeConfiguration.
+func (in *ProbeConfiguration) DeepCopy() *ProbeConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrometheusConfiguration) DeepCopyInto(out *PrometheusConfiguration) {
 	*out = *in
@@ -222,6 +463,11 @@ func (in *PrometheusConfiguration) DeepCopy() *PrometheusConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicAPIConfiguration) DeepCopyInto(out *PublicAPIConfiguration) {
 	*out = *in
+	if in.IPAllowList != nil {
+		in, out := &in.IPAllowList, &out.IPAllowList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -291,48 +537,7 @@ func (in *ResourcesWithVolume) DeepCopy() *ResourcesWithVolume {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServerConfiguration) DeepCopyInto(out *ServerConfiguration) {
-	*out = *in
-	out.Resources = in.Resources
-	in.Features.DeepCopyInto(&out.Features)
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerConfiguration.
-func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
-	if in == nil {
-		return nil
-	}
-	out := new(ServerConfiguration)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServerFeatures) DeepCopyInto(out *ServerFeatures) {
-	*out = *in
-	if in.MavenRepositories != nil {
-		in, out := &in.MavenRepositories, &out.MavenRepositories
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerFeatures.
-func (in *ServerFeatures) DeepCopy() *ServerFeatures {
-	if in == nil {
-		return nil
-	}
-	out := new(ServerFeatures)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Syndesis) DeepCopyInto(out *Syndesis) {
+func (in *Restore) DeepCopyInto(out *Restore) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -341,18 +546,18 @@ func (in *Syndesis) DeepCopyInto(out *Syndesis) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Syndesis.
-func (in *Syndesis) DeepCopy() *Syndesis {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Restore.
+func (in *Restore) DeepCopy() *Restore {
 	if in == nil {
 		return nil
 	}
-	out := new(Syndesis)
+	out := new(Restore)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Syndesis) DeepCopyObject() runtime.Object {
+func (in *Restore) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -360,13 +565,13 @@ func (in *Syndesis) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SyndesisList) DeepCopyInto(out *SyndesisList) {
+func (in *RestoreList) DeepCopyInto(out *RestoreList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Syndesis, len(*in))
+		*out = make([]Restore, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -374,18 +579,18 @@ func (in *SyndesisList) DeepCopyInto(out *SyndesisList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisList.
-func (in *SyndesisList) DeepCopy() *SyndesisList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreList.
+func (in *RestoreList) DeepCopy() *RestoreList {
 	if in == nil {
 		return nil
 	}
-	out := new(SyndesisList)
+	out := new(RestoreList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SyndesisList) DeepCopyObject() runtime.Object {
+func (in *RestoreList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -393,58 +598,606 @@ func (in *SyndesisList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SyndesisSpec) DeepCopyInto(out *SyndesisSpec) {
+func (in *RestoreSource) DeepCopyInto(out *RestoreSource) {
 	*out = *in
-	out.Backup = in.Backup
-	in.Components.DeepCopyInto(&out.Components)
-	out.Addons = in.Addons
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisSpec.
-func (in *SyndesisSpec) DeepCopy() *SyndesisSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSource.
+func (in *RestoreSource) DeepCopy() *RestoreSource {
 	if in == nil {
 		return nil
 	}
-	out := new(SyndesisSpec)
+	out := new(RestoreSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SyndesisStatus) DeepCopyInto(out *SyndesisStatus) {
+func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 	*out = *in
-	if in.LastUpgradeFailure != nil {
-		in, out := &in.LastUpgradeFailure, &out.LastUpgradeFailure
+	out.Source = in.Source
+	if in.RecoverToTime != nil {
+		in, out := &in.RecoverToTime, &out.RecoverToTime
 		*out = (*in).DeepCopy()
 	}
-	out.Backup = in.Backup
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisStatus.
-func (in *SyndesisStatus) DeepCopy() *SyndesisStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSpec.
+func (in *RestoreSpec) DeepCopy() *RestoreSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SyndesisStatus)
+	out := new(RestoreSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *UpgradeConfiguration) DeepCopyInto(out *UpgradeConfiguration) {
+func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
 	*out = *in
-	out.Resources = in.Resources
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeConfiguration.
-func (in *UpgradeConfiguration) DeepCopy() *UpgradeConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatus.
+func (in *RestoreStatus) DeepCopy() *RestoreStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(UpgradeConfiguration)
+	out := new(RestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SampleAppConfiguration) DeepCopyInto(out *SampleAppConfiguration) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SampleAppConfiguration.
+func (in *SampleAppConfiguration) DeepCopy() *SampleAppConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SampleAppConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SampledbConfiguration) DeepCopyInto(out *SampledbConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SampledbConfiguration.
+func (in *SampledbConfiguration) DeepCopy() *SampledbConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SampledbConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledBackup) DeepCopyInto(out *ScheduledBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledBackup.
+func (in *ScheduledBackup) DeepCopy() *ScheduledBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScheduledBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledBackupDestination) DeepCopyInto(out *ScheduledBackupDestination) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledBackupDestination.
+func (in *ScheduledBackupDestination) DeepCopy() *ScheduledBackupDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledBackupDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledBackupList) DeepCopyInto(out *ScheduledBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScheduledBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledBackupList.
+func (in *ScheduledBackupList) DeepCopy() *ScheduledBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScheduledBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledBackupSpec) DeepCopyInto(out *ScheduledBackupSpec) {
+	*out = *in
+	out.Destination = in.Destination
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledBackupSpec.
+func (in *ScheduledBackupSpec) DeepCopy() *ScheduledBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledBackupStatus) DeepCopyInto(out *ScheduledBackupStatus) {
+	*out = *in
+	if in.LastBackupTime != nil {
+		in, out := &in.LastBackupTime, &out.LastBackupTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledBackupStatus.
+func (in *ScheduledBackupStatus) DeepCopy() *ScheduledBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingConfiguration) DeepCopyInto(out *SchedulingConfiguration) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingConfiguration.
+func (in *SchedulingConfiguration) DeepCopy() *SchedulingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerConfiguration) DeepCopyInto(out *ServerConfiguration) {
+	*out = *in
+	out.Resources = in.Resources
+	in.Features.DeepCopyInto(&out.Features)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerConfiguration.
+func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerFeatures) DeepCopyInto(out *ServerFeatures) {
+	*out = *in
+	if in.MavenRepositories != nil {
+		in, out := &in.MavenRepositories, &out.MavenRepositories
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MavenMirrors != nil {
+		in, out := &in.MavenMirrors, &out.MavenMirrors
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerFeatures.
+func (in *ServerFeatures) DeepCopy() *ServerFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyndesisAddonHealth) DeepCopyInto(out *SyndesisAddonHealth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisAddonHealth.
+func (in *SyndesisAddonHealth) DeepCopy() *SyndesisAddonHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(SyndesisAddonHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Syndesis) DeepCopyInto(out *Syndesis) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Syndesis.
+func (in *Syndesis) DeepCopy() *Syndesis {
+	if in == nil {
+		return nil
+	}
+	out := new(Syndesis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Syndesis) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyndesisCondition) DeepCopyInto(out *SyndesisCondition) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisCondition.
+func (in *SyndesisCondition) DeepCopy() *SyndesisCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(SyndesisCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyndesisInstallStageStatus) DeepCopyInto(out *SyndesisInstallStageStatus) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisInstallStageStatus.
+func (in *SyndesisInstallStageStatus) DeepCopy() *SyndesisInstallStageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SyndesisInstallStageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyndesisList) DeepCopyInto(out *SyndesisList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Syndesis, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisList.
+func (in *SyndesisList) DeepCopy() *SyndesisList {
+	if in == nil {
+		return nil
+	}
+	out := new(SyndesisList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SyndesisList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyndesisSpec) DeepCopyInto(out *SyndesisSpec) {
+	*out = *in
+	out.Backup = in.Backup
+	in.Components.DeepCopyInto(&out.Components)
+	in.Addons.DeepCopyInto(&out.Addons)
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	if in.AdditionalLabels != nil {
+		in, out := &in.AdditionalLabels, &out.AdditionalLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AdditionalAnnotations != nil {
+		in, out := &in.AdditionalAnnotations, &out.AdditionalAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisSpec.
+func (in *SyndesisSpec) DeepCopy() *SyndesisSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SyndesisSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyndesisStatus) DeepCopyInto(out *SyndesisStatus) {
+	*out = *in
+	if in.LastUpgradeFailure != nil {
+		in, out := &in.LastUpgradeFailure, &out.LastUpgradeFailure
+		*out = (*in).DeepCopy()
+	}
+	out.Backup = in.Backup
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]SyndesisCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make(map[SyndesisComponentName]SyndesisComponentHealth, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]SyndesisInstallStageStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpgradeSteps != nil {
+		in, out := &in.UpgradeSteps, &out.UpgradeSteps
+		*out = make([]UpgradeStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ActionFailures != nil {
+		in, out := &in.ActionFailures, &out.ActionFailures
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.IntegrationRedeploy != nil {
+		in, out := &in.IntegrationRedeploy, &out.IntegrationRedeploy
+		*out = new(IntegrationRedeployStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = make(map[SyndesisAddonName]SyndesisAddonHealth, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EncryptionKeyRotation != nil {
+		in, out := &in.EncryptionKeyRotation, &out.EncryptionKeyRotation
+		*out = new(EncryptionKeyRotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResolvedImageDigests != nil {
+		in, out := &in.ResolvedImageDigests, &out.ResolvedImageDigests
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyndesisStatus.
+func (in *SyndesisStatus) DeepCopy() *SyndesisStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SyndesisStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThreeScaleConfiguration) DeepCopyInto(out *ThreeScaleConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThreeScaleConfiguration.
+func (in *ThreeScaleConfiguration) DeepCopy() *ThreeScaleConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ThreeScaleConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UIConfiguration) DeepCopyInto(out *UIConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UIConfiguration.
+func (in *UIConfiguration) DeepCopy() *UIConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(UIConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeConfiguration) DeepCopyInto(out *UpgradeConfiguration) {
+	*out = *in
+	out.Resources = in.Resources
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeConfiguration.
+func (in *UpgradeConfiguration) DeepCopy() *UpgradeConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStepStatus) DeepCopyInto(out *UpgradeStepStatus) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStepStatus.
+func (in *UpgradeStepStatus) DeepCopy() *UpgradeStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStepStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,225 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FromV1Beta1 converts a v1beta1 Syndesis into its v1beta2 equivalent. It performs no I/O, so it
+// can be used both by the startup migration path (mirroring versions.ConvertV1Alpha1ToV1Beta1)
+// and, once available, by a CRD conversion webhook. v1beta1's stringly-typed, memory-only
+// Resources fields become a corev1.ResourceRequirements memory limit; fields present in both
+// versions are copied as-is. v1beta1 fields with no v1beta2 counterpart (for example Certificates,
+// SecurityModel, FIPSMode, the server's encryption and client-state settings, and the database's
+// IAM and proxy settings) are not carried over.
+func FromV1Beta1(src *v1beta1.Syndesis) *Syndesis {
+	dst := &Syndesis{
+		ObjectMeta: *src.ObjectMeta.DeepCopy(),
+		Status:     *src.Status.DeepCopy(),
+	}
+	dst.TypeMeta = metav1.TypeMeta{Kind: "Syndesis", APIVersion: SchemeGroupVersion.String()}
+
+	spec := &dst.Spec
+	spec.Backup = src.Spec.Backup
+	spec.RouteHostname = src.Spec.RouteHostname
+	spec.DemoData = src.Spec.DemoData
+	spec.ForceMigration = src.Spec.ForceMigration
+	spec.Scheduling = src.Spec.Scheduling
+	spec.AdditionalLabels = src.Spec.AdditionalLabels
+	spec.AdditionalAnnotations = src.Spec.AdditionalAnnotations
+	spec.ImagePullPolicy = src.Spec.ImagePullPolicy
+	spec.DeletionPolicy = src.Spec.DeletionPolicy
+	spec.Paused = src.Spec.Paused
+
+	spec.Components.Oauth = src.Spec.Components.Oauth
+	spec.Components.UI = src.Spec.Components.UI
+
+	spec.Components.Server.Features = src.Spec.Components.Server.Features
+	spec.Components.Server.Replicas = src.Spec.Components.Server.Replicas
+	spec.Components.Server.Probes = src.Spec.Components.Server.Probes
+	spec.Components.Server.ImagePullPolicy = src.Spec.Components.Server.ImagePullPolicy
+	spec.Components.Server.Resources.ResourceRequirements = resourceRequirementsFromMemory(src.Spec.Components.Server.Resources.Memory)
+
+	spec.Components.Meta.Replicas = src.Spec.Components.Meta.Replicas
+	spec.Components.Meta.Probes = src.Spec.Components.Meta.Probes
+	spec.Components.Meta.ImagePullPolicy = src.Spec.Components.Meta.ImagePullPolicy
+	spec.Components.Meta.Resources.ResourceRequirements = resourceRequirementsFromMemory(src.Spec.Components.Meta.Resources.Memory)
+	spec.Components.Meta.Resources.VolumeCapacity = quantityFor(src.Spec.Components.Meta.Resources.VolumeCapacity)
+
+	spec.Components.Database.User = src.Spec.Components.Database.User
+	spec.Components.Database.Name = src.Spec.Components.Database.Name
+	spec.Components.Database.URL = src.Spec.Components.Database.URL
+	spec.Components.Database.ExternalDbURL = src.Spec.Components.Database.ExternalDbURL
+	spec.Components.Database.ExternalDbSSLMode = src.Spec.Components.Database.ExternalDbSSLMode
+	spec.Components.Database.ExternalDbCertificateSecret = src.Spec.Components.Database.ExternalDbCertificateSecret
+	spec.Components.Database.ExternalDbCredentialsSecret = src.Spec.Components.Database.ExternalDbCredentialsSecret
+	spec.Components.Database.Sampledb = src.Spec.Components.Database.Sampledb
+	spec.Components.Database.ImagePullPolicy = src.Spec.Components.Database.ImagePullPolicy
+	spec.Components.Database.Resources.ResourceRequirements = resourceRequirementsFromMemory(src.Spec.Components.Database.Resources.Memory)
+	spec.Components.Database.Resources.VolumeCapacity = quantityFor(src.Spec.Components.Database.Resources.VolumeCapacity)
+	spec.Components.Database.Resources.VolumeName = src.Spec.Components.Database.Resources.VolumeName
+	spec.Components.Database.Resources.VolumeAccessMode = src.Spec.Components.Database.Resources.VolumeAccessMode
+	spec.Components.Database.Resources.VolumeStorageClass = src.Spec.Components.Database.Resources.VolumeStorageClass
+	spec.Components.Database.Resources.VolumeLabels = src.Spec.Components.Database.Resources.VolumeLabels
+
+	spec.Components.Prometheus.Rules = src.Spec.Components.Prometheus.Rules
+	spec.Components.Prometheus.ImagePullPolicy = src.Spec.Components.Prometheus.ImagePullPolicy
+	spec.Components.Prometheus.Resources.ResourceRequirements = resourceRequirementsFromMemory(src.Spec.Components.Prometheus.Resources.Memory)
+	spec.Components.Prometheus.Resources.VolumeCapacity = quantityFor(src.Spec.Components.Prometheus.Resources.VolumeCapacity)
+
+	spec.Components.Grafana.Resources.ResourceRequirements = resourceRequirementsFromMemory(src.Spec.Components.Grafana.Resources.Memory)
+
+	spec.Components.Upgrade.Resources.VolumeCapacity = quantityFor(src.Spec.Components.Upgrade.Resources.VolumeCapacity)
+
+	spec.Addons.Jaeger = src.Spec.Addons.Jaeger
+	spec.Addons.Ops = src.Spec.Addons.Ops
+	spec.Addons.SampleApps = src.Spec.Addons.SampleApps
+	spec.Addons.Knative = src.Spec.Addons.Knative
+	spec.Addons.CamelK = src.Spec.Addons.CamelK
+	spec.Addons.Kafka = src.Spec.Addons.Kafka
+	spec.Addons.Apicurito = src.Spec.Addons.Apicurito
+	spec.Addons.ThreeScale = src.Spec.Addons.ThreeScale
+	spec.Addons.PublicAPI = src.Spec.Addons.PublicAPI
+	spec.Addons.Keycloak = src.Spec.Addons.Keycloak
+	spec.Addons.Logging = src.Spec.Addons.Logging
+	spec.Addons.DV.Enabled = src.Spec.Addons.DV.Enabled
+	spec.Addons.DV.Resources.ResourceRequirements = resourceRequirementsFromMemory(src.Spec.Addons.DV.Resources.Memory)
+
+	return dst
+}
+
+// ToV1Beta1 converts a v1beta2 Syndesis back to v1beta1, taking the memory limit (if any) back out
+// of each ResourceRequirements. Any request/limit set on a resource other than memory, or any
+// request as opposed to a limit, has no v1beta1 equivalent and is dropped; this is the same
+// lossy-on-downgrade tradeoff the v1alpha1<->v1beta1 migration already makes for other fields.
+func ToV1Beta1(src *Syndesis) *v1beta1.Syndesis {
+	dst := &v1beta1.Syndesis{
+		ObjectMeta: *src.ObjectMeta.DeepCopy(),
+		Status:     *src.Status.DeepCopy(),
+	}
+	dst.TypeMeta = metav1.TypeMeta{Kind: "Syndesis", APIVersion: v1beta1.SchemeGroupVersion.String()}
+
+	spec := &dst.Spec
+	spec.Backup = src.Spec.Backup
+	spec.RouteHostname = src.Spec.RouteHostname
+	spec.DemoData = src.Spec.DemoData
+	spec.ForceMigration = src.Spec.ForceMigration
+	spec.Scheduling = src.Spec.Scheduling
+	spec.AdditionalLabels = src.Spec.AdditionalLabels
+	spec.AdditionalAnnotations = src.Spec.AdditionalAnnotations
+	spec.ImagePullPolicy = src.Spec.ImagePullPolicy
+	spec.DeletionPolicy = src.Spec.DeletionPolicy
+	spec.Paused = src.Spec.Paused
+
+	spec.Components.Oauth = src.Spec.Components.Oauth
+	spec.Components.UI = src.Spec.Components.UI
+
+	spec.Components.Server.Features = src.Spec.Components.Server.Features
+	spec.Components.Server.Replicas = src.Spec.Components.Server.Replicas
+	spec.Components.Server.Probes = src.Spec.Components.Server.Probes
+	spec.Components.Server.ImagePullPolicy = src.Spec.Components.Server.ImagePullPolicy
+	spec.Components.Server.Resources.Memory = memoryFrom(src.Spec.Components.Server.Resources.ResourceRequirements)
+
+	spec.Components.Meta.Replicas = src.Spec.Components.Meta.Replicas
+	spec.Components.Meta.Probes = src.Spec.Components.Meta.Probes
+	spec.Components.Meta.ImagePullPolicy = src.Spec.Components.Meta.ImagePullPolicy
+	spec.Components.Meta.Resources.Memory = memoryFrom(src.Spec.Components.Meta.Resources.ResourceRequirements)
+	spec.Components.Meta.Resources.VolumeCapacity = stringFor(src.Spec.Components.Meta.Resources.VolumeCapacity)
+
+	spec.Components.Database.User = src.Spec.Components.Database.User
+	spec.Components.Database.Name = src.Spec.Components.Database.Name
+	spec.Components.Database.URL = src.Spec.Components.Database.URL
+	spec.Components.Database.ExternalDbURL = src.Spec.Components.Database.ExternalDbURL
+	spec.Components.Database.ExternalDbSSLMode = src.Spec.Components.Database.ExternalDbSSLMode
+	spec.Components.Database.ExternalDbCertificateSecret = src.Spec.Components.Database.ExternalDbCertificateSecret
+	spec.Components.Database.ExternalDbCredentialsSecret = src.Spec.Components.Database.ExternalDbCredentialsSecret
+	spec.Components.Database.Sampledb = src.Spec.Components.Database.Sampledb
+	spec.Components.Database.ImagePullPolicy = src.Spec.Components.Database.ImagePullPolicy
+	spec.Components.Database.Resources.Memory = memoryFrom(src.Spec.Components.Database.Resources.ResourceRequirements)
+	spec.Components.Database.Resources.VolumeCapacity = stringFor(src.Spec.Components.Database.Resources.VolumeCapacity)
+	spec.Components.Database.Resources.VolumeName = src.Spec.Components.Database.Resources.VolumeName
+	spec.Components.Database.Resources.VolumeAccessMode = src.Spec.Components.Database.Resources.VolumeAccessMode
+	spec.Components.Database.Resources.VolumeStorageClass = src.Spec.Components.Database.Resources.VolumeStorageClass
+	spec.Components.Database.Resources.VolumeLabels = src.Spec.Components.Database.Resources.VolumeLabels
+
+	spec.Components.Prometheus.Rules = src.Spec.Components.Prometheus.Rules
+	spec.Components.Prometheus.ImagePullPolicy = src.Spec.Components.Prometheus.ImagePullPolicy
+	spec.Components.Prometheus.Resources.Memory = memoryFrom(src.Spec.Components.Prometheus.Resources.ResourceRequirements)
+	spec.Components.Prometheus.Resources.VolumeCapacity = stringFor(src.Spec.Components.Prometheus.Resources.VolumeCapacity)
+
+	spec.Components.Grafana.Resources.Memory = memoryFrom(src.Spec.Components.Grafana.Resources.ResourceRequirements)
+
+	spec.Components.Upgrade.Resources.VolumeCapacity = stringFor(src.Spec.Components.Upgrade.Resources.VolumeCapacity)
+
+	spec.Addons.Jaeger = src.Spec.Addons.Jaeger
+	spec.Addons.Ops = src.Spec.Addons.Ops
+	spec.Addons.SampleApps = src.Spec.Addons.SampleApps
+	spec.Addons.Knative = src.Spec.Addons.Knative
+	spec.Addons.CamelK = src.Spec.Addons.CamelK
+	spec.Addons.Kafka = src.Spec.Addons.Kafka
+	spec.Addons.Apicurito = src.Spec.Addons.Apicurito
+	spec.Addons.ThreeScale = src.Spec.Addons.ThreeScale
+	spec.Addons.PublicAPI = src.Spec.Addons.PublicAPI
+	spec.Addons.Keycloak = src.Spec.Addons.Keycloak
+	spec.Addons.Logging = src.Spec.Addons.Logging
+	spec.Addons.DV.Enabled = src.Spec.Addons.DV.Enabled
+	spec.Addons.DV.Resources.Memory = memoryFrom(src.Spec.Addons.DV.Resources.ResourceRequirements)
+
+	return dst
+}
+
+func resourceRequirementsFromMemory(memory string) corev1.ResourceRequirements {
+	if memory == "" {
+		return corev1.ResourceRequirements{}
+	}
+	q, err := resource.ParseQuantity(memory)
+	if err != nil {
+		return corev1.ResourceRequirements{}
+	}
+	return corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: q},
+	}
+}
+
+func memoryFrom(requirements corev1.ResourceRequirements) string {
+	if q, ok := requirements.Limits[corev1.ResourceMemory]; ok {
+		return q.String()
+	}
+	return ""
+}
+
+func quantityFor(value string) resource.Quantity {
+	if value == "" {
+		return resource.Quantity{}
+	}
+	q, err := resource.ParseQuantity(value)
+	if err != nil {
+		return resource.Quantity{}
+	}
+	return q
+}
+
+func stringFor(q resource.Quantity) string {
+	if q.IsZero() {
+		return ""
+	}
+	return q.String()
+}
@@ -0,0 +1,242 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+//
+// v1beta2 replaces the stringly-typed, memory-only Resources structs of v1beta1 with
+// corev1.ResourceRequirements/resource.Quantity, so limits and requests for every compute
+// resource (not just memory) can be set and are validated by the apiserver like any other
+// workload. Fields untouched by that change keep reusing their v1beta1 type directly rather than
+// being redefined here; see conversion.go for the v1beta1<->v1beta2 mapping.
+
+// SyndesisSpec defines the desired state of Syndesis
+// +k8s:openapi-gen=true
+type SyndesisSpec struct {
+	// Schedule backup
+	// +optional
+	Backup v1beta1.BackupConfig `json:"backup,omitempty"`
+
+	// The external hostname to access Syndesis
+	RouteHostname string `json:"routeHostname,omitempty"`
+
+	// Enable SampleDB and demo data for Syndesis
+	DemoData bool `json:"demoData,omitempty"`
+
+	// Components is used to configure all the core components of Syndesis
+	Components ComponentsSpec `json:"components,omitempty"`
+
+	// Optional add on features that can be enabled.
+	Addons AddonsSpec `json:"addons,omitempty"`
+
+	// Something
+	ForceMigration bool `json:"forceMigration"`
+
+	// Node selector, tolerations and affinity applied to every Syndesis component pod
+	Scheduling v1beta1.SchedulingConfiguration `json:"scheduling,omitempty"`
+
+	// Additional labels merged into every resource generated by the operator
+	AdditionalLabels map[string]string `json:"additionalLabels,omitempty"`
+
+	// Additional annotations merged into every resource generated by the operator
+	AdditionalAnnotations map[string]string `json:"additionalAnnotations,omitempty"`
+
+	// Default imagePullPolicy for every component pod. Overridden per-component when set there.
+	// Falls back to Always/IfNotPresent based on DevSupport when left empty
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
+
+	// DeletionPolicy controls what happens to the database persistent volume claim when this
+	// Syndesis resource is deleted. Defaults to Retain when left empty
+	DeletionPolicy v1beta1.SyndesisDeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// Paused suspends reconciliation of this Syndesis resource. While true, the operator does not
+	// create, update or delete any managed resource, so an admin can safely perform manual
+	// maintenance (e.g. on syndesis-db) without the operator fighting them. Status is still refreshed.
+	Paused bool `json:"paused,omitempty"`
+
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+}
+
+// +k8s:openapi-gen=true
+type ComponentsSpec struct {
+	Oauth      v1beta1.OauthConfiguration `json:"oauth,omitempty"`
+	Server     ServerConfiguration        `json:"server,omitempty"`
+	Meta       MetaConfiguration          `json:"meta,omitempty"`
+	UI         v1beta1.UIConfiguration    `json:"ui,omitempty"`
+	Database   DatabaseConfiguration      `json:"database,omitempty"`
+	Prometheus PrometheusConfiguration    `json:"prometheus,omitempty"`
+	Grafana    GrafanaConfiguration       `json:"grafana,omitempty"`
+	Upgrade    UpgradeConfiguration       `json:"upgrade,omitempty"`
+}
+
+// Resources is the compute resource requirements of a single-container component
+type Resources struct {
+	corev1.ResourceRequirements `json:",inline"`
+}
+
+// ResourcesWithVolume is Resources plus the capacity of a component's persistent volume
+type ResourcesWithVolume struct {
+	corev1.ResourceRequirements `json:",inline"`
+	VolumeCapacity              resource.Quantity `json:"volumeCapacity,omitempty"`
+}
+
+// ResourcesWithPersistentVolume is ResourcesWithVolume plus the rest of the knobs needed to
+// provision a PersistentVolumeClaim (used by the database, which is the only component whose
+// volume is user-addressable by name/storage class rather than fully operator-managed)
+type ResourcesWithPersistentVolume struct {
+	corev1.ResourceRequirements `json:",inline"`
+	VolumeCapacity              resource.Quantity        `json:"volumeCapacity,omitempty"`
+	VolumeName                  string                   `json:"volumeName,omitempty"`
+	VolumeAccessMode            v1beta1.VolumeAccessMode `json:"volumeAccessMode,omitempty"`
+	VolumeStorageClass          string                   `json:"volumeStorageClass,omitempty"`
+	VolumeLabels                map[string]string        `json:"volumeLabels,omitempty"`
+}
+
+// VolumeOnlyResources is the capacity of a component's persistent volume, for components that
+// have no other tunable compute resources (e.g. the upgrade job)
+type VolumeOnlyResources struct {
+	VolumeCapacity resource.Quantity `json:"volumeCapacity,omitempty"`
+}
+
+type ServerConfiguration struct {
+	Resources Resources                  `json:"resources,omitempty"`
+	Features  v1beta1.ServerFeatures     `json:"features,omitempty"`
+	Replicas  int32                      `json:"replicas,omitempty"`
+	Probes    v1beta1.ProbeConfiguration `json:"probes,omitempty"`
+
+	// Overrides Spec.ImagePullPolicy for the server pod
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
+}
+
+type MetaConfiguration struct {
+	Resources ResourcesWithVolume        `json:"resources,omitempty"`
+	Replicas  int32                      `json:"replicas,omitempty"`
+	Probes    v1beta1.ProbeConfiguration `json:"probes,omitempty"`
+
+	// Overrides Spec.ImagePullPolicy for the meta pod
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
+}
+
+type UpgradeConfiguration struct {
+	Resources VolumeOnlyResources `json:"resources,omitempty"`
+}
+
+type DatabaseConfiguration struct {
+	// Username for PostgreSQL user that will be used for accessing the database
+	User string `json:"user,omitempty"`
+
+	// Name of the PostgreSQL database accessed
+	Name string `json:"name,omitempty"`
+
+	// Host and port of the PostgreSQL database to access
+	URL string `json:"url,omitempty"`
+
+	// If specified, use an external database instead of the installed by syndesis
+	ExternalDbURL string `json:"externalDbURL,omitempty"`
+
+	// TLS mode used to connect to an external database, one of: disable, require,
+	// verify-ca, verify-full. Defaults to disable, ignored when ExternalDbURL is not set
+	ExternalDbSSLMode string `json:"externalDbSSLMode,omitempty"`
+
+	// Name of the secret holding the CA bundle (ca.crt) and, optionally, the client
+	// certificate (tls.crt) and key (tls.key) used to connect to an external database
+	ExternalDbCertificateSecret string `json:"externalDbCertificateSecret,omitempty"`
+
+	// Name of a user-provided secret holding the POSTGRESQL_USER and POSTGRESQL_PASSWORD
+	// keys used to authenticate against an external database, in place of User/Password
+	ExternalDbCredentialsSecret string `json:"externalDbCredentialsSecret,omitempty"`
+
+	// Resource provision requirements of the database
+	Resources ResourcesWithPersistentVolume `json:"resources,omitempty"`
+
+	// Whether the sampledb and its demo connections are provisioned. Disable for production installs
+	Sampledb v1beta1.SampledbConfiguration `json:"sampledb,omitempty"`
+
+	// Overrides Spec.ImagePullPolicy for the database and exporter containers
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
+}
+
+type PrometheusConfiguration struct {
+	Rules     string              `json:"rules,omitempty"`
+	Resources ResourcesWithVolume `json:"resources,omitempty"`
+
+	// Overrides Spec.ImagePullPolicy for the prometheus pod
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
+}
+
+type GrafanaConfiguration struct {
+	Resources Resources `json:"resources,omitempty"`
+}
+
+type AddonsSpec struct {
+	Jaeger     v1beta1.JaegerConfiguration      `json:"jaeger,omitempty"`
+	Ops        v1beta1.AddonSpec                `json:"ops,omitempty"`
+	SampleApps []v1beta1.SampleAppConfiguration `json:"sampleApps,omitempty"`
+	Knative    v1beta1.KnativeConfiguration     `json:"knative,omitempty"`
+	DV         DvConfiguration                  `json:"dv,omitempty"`
+	CamelK     v1beta1.CamelKConfiguration      `json:"camelk,omitempty"`
+	Kafka      v1beta1.KafkaConfiguration       `json:"kafka,omitempty"`
+	Apicurito  v1beta1.ApicuritoConfiguration   `json:"apicurito,omitempty"`
+	ThreeScale v1beta1.ThreeScaleConfiguration  `json:"threeScale,omitempty"`
+	PublicAPI  v1beta1.PublicAPIConfiguration   `json:"publicApi,omitempty"`
+	Keycloak   v1beta1.KeycloakConfiguration    `json:"keycloak,omitempty"`
+	Logging    v1beta1.LoggingConfiguration     `json:"logging,omitempty"`
+}
+
+type DvConfiguration struct {
+	Enabled   bool      `json:"enabled,omitempty"`
+	Resources Resources `json:"resources,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Syndesis is the Schema for the syndeses API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type Syndesis struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SyndesisSpec `json:"spec,omitempty"`
+	// Status is unchanged by the move to structured resource requirements, so v1beta2 reuses
+	// v1beta1's status type rather than duplicating it
+	Status v1beta1.SyndesisStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// SyndesisList contains a list of Syndesis
+type SyndesisList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Syndesis `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Syndesis{}, &SyndesisList{})
+}
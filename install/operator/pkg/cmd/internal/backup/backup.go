@@ -33,6 +33,8 @@ import (
 type Backup struct {
 	*internal.Options
 	backupDir string
+	remote    bool
+	retention int
 }
 
 func NewBackup(parent *internal.Options) *cobra.Command {
@@ -46,6 +48,8 @@ func NewBackup(parent *internal.Options) *cobra.Command {
 	}
 	cmd.PersistentFlags().StringVarP(&configuration.TemplateConfig, "operator-config", "", "/conf/config.yaml", "Path to the operator configuration file.")
 	cmd.Flags().StringVar(&o.backupDir, "backup", "backup", "The directory to store the back up in")
+	cmd.Flags().BoolVar(&o.remote, "remote", false, "also upload the backup using the configured syndesis-backup-s3/syndesis-backup-azure secret, if present")
+	cmd.Flags().IntVar(&o.retention, "retention", 0, "number of most recent remote backups to retain; older ones are pruned after a successful upload. 0 keeps them all")
 	cmd.PersistentFlags().AddFlagSet(zap.FlagSet())
 	cmd.PersistentFlags().AddFlagSet(util.FlagSet)
 	return &cmd
@@ -91,8 +95,8 @@ func (o *Backup) Run() error {
 		return err
 	}
 
-	// Only backup to local location
-	b.SetLocalOnly(true)
+	b.SetLocalOnly(!o.remote)
+	b.SetRetention(o.retention)
 
 	return b.Run()
 }
@@ -27,6 +27,8 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/capabilities"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/yaml"
 )
 
 const RoleName = "syndesis-installer"
@@ -37,6 +39,8 @@ type Grant struct {
 	Kind      string
 	User      string
 	cluster   bool
+	revoke    bool
+	dryRun    bool
 	ApiServer capabilities.ApiServerSpec
 }
 
@@ -51,6 +55,8 @@ func New(parent *internal.Options) *cobra.Command {
 	}
 
 	cmd.PersistentFlags().BoolVarP(&o.cluster, "cluster", "", false, "add the permission for all projects in the cluster(requires cluster admin privileges)")
+	cmd.PersistentFlags().BoolVarP(&o.revoke, "revoke", "", false, "remove the permissions instead of granting them")
+	cmd.PersistentFlags().BoolVarP(&o.dryRun, "dry-run", "", false, "render the RBAC resources that would be granted or revoked instead of applying them")
 	cmd.PersistentFlags().StringVarP(&o.User, "user", "u", "", "add permissions for the given User")
 	cmd.PersistentFlags().AddFlagSet(zap.FlagSet())
 	cmd.PersistentFlags().AddFlagSet(util.FlagSet)
@@ -119,10 +125,38 @@ func (o *Grant) grant() error {
 	}
 	resources = append(resources, pubRole...)
 
+	for i := range resources {
+		resources[i].SetNamespace(o.Namespace)
+	}
+
+	if o.dryRun {
+		for _, res := range resources {
+			data, err := yaml.Marshal(res.Object)
+			if err != nil {
+				return err
+			}
+			fmt.Println("---")
+			fmt.Print(string(data))
+		}
+		return nil
+	}
+
 	client, err := o.ClientTools().RuntimeClient()
-	for _, res := range resources {
-		res.SetNamespace(o.Namespace)
+	if err != nil {
+		return err
+	}
+
+	if o.revoke {
+		for _, res := range resources {
+			if err := client.Delete(o.Context, &res); err != nil && !k8serrors.IsNotFound(err) {
+				return errors.Wrap(err, util.Dump(res))
+			}
+		}
+		fmt.Println("role", o.Role, "revoked from", o.User)
+		return nil
+	}
 
+	for _, res := range resources {
 		_, _, err := util.CreateOrUpdate(o.Context, client, &res)
 		if err != nil {
 			return errors.Wrap(err, util.Dump(res))
@@ -109,3 +109,46 @@ func TestGrant(t *testing.T) {
 		}
 	}
 }
+
+// test grant --revoke removes what grant created
+func TestGrantRevoke(t *testing.T) {
+	ctx := context.TODO()
+	g := &Grant{
+		Role: RoleName,
+		User: user,
+		Options: &internal.Options{
+			Namespace: ns,
+			Context:   ctx,
+		},
+	}
+
+	g.SetClientTools(syntesting.FakeClientTools())
+	cl, err := g.ClientTools().RuntimeClient()
+	if err != nil {
+		t.Fatalf("\t%s\t got an error when configuring client: [%v]", failed, err)
+	}
+
+	if err := g.grant(); err != nil {
+		t.Fatalf("\t%s\t got an error when granting permissions: [%v]", failed, err)
+	}
+
+	t.Logf("\tTest: When running `operator grant --user user --revoke`, it should remove the role %s and its binding to the user %s", RoleName, user)
+	g.revoke = true
+	if err := g.grant(); err != nil {
+		t.Fatalf("\t%s\t got an error when revoking permissions: [%v]", failed, err)
+	}
+	t.Logf("\t%s\t permissions revoked without errors", succeed)
+
+	r := &v1.Role{}
+	if err := cl.Get(ctx, client.ObjectKey{Name: RoleName, Namespace: ns}, r); err == nil {
+		t.Fatalf("\t%s\t after revoking, role %s should no longer exist", failed, RoleName)
+	}
+	t.Logf("\t%s\t after revoking, role %s no longer exists", succeed, RoleName)
+
+	rb := &v1.RoleBinding{}
+	rbn := fmt.Sprintf("%s-%s", RoleName, user)
+	if err := cl.Get(ctx, client.ObjectKey{Name: rbn, Namespace: ns}, rb); err == nil {
+		t.Fatalf("\t%s\t after revoking, rolebinding %s should no longer exist", failed, rbn)
+	}
+	t.Logf("\t%s\t after revoking, rolebinding %s no longer exists", succeed, rbn)
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package images adds the 'operator images' CLI command, which resolves the operand images an
+// installation would use into a mapping file suitable for 'oc image mirror', so that a Syndesis
+// install can be mirrored into a registry reachable from an air-gapped cluster.
+package images
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+)
+
+type Images struct {
+	*internal.Options
+	file       string
+	configFile string
+	output     string
+	to         string
+}
+
+func New(parent *internal.Options) *cobra.Command {
+	o := Images{Options: parent}
+
+	cmd := cobra.Command{
+		Use:   "images",
+		Short: "generate an 'oc image mirror' mapping file for every operand image, for air-gapped installs",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return o.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&o.file, "file", "f", "", "path to the Syndesis custom resource to resolve images for. Defaults are used when omitted")
+	cmd.Flags().StringVar(&o.configFile, "config", configuration.TemplateConfig, "path to the operator configuration file to resolve images against")
+	cmd.Flags().StringVarP(&o.output, "output", "o", "mapping.txt", "path of the mapping file to write")
+	cmd.Flags().StringVar(&o.to, "to", "", "registry/repository to mirror images into, e.g. myregistry.local:5000/syndesis. Left as identity mappings when omitted")
+
+	return &cmd
+}
+
+func (o *Images) run() error {
+	syndesis := &v1beta1.Syndesis{}
+	if o.file != "" {
+		data, err := util.LoadJSONFromFile(o.file)
+		if err != nil {
+			return fmt.Errorf("could not read %s: %w", o.file, err)
+		}
+		if err := json.Unmarshal(data, syndesis); err != nil {
+			return fmt.Errorf("could not parse %s as a Syndesis custom resource: %w", o.file, err)
+		}
+	}
+
+	config, err := configuration.GetProperties(o.Context, o.configFile, nil, syndesis)
+	if err != nil {
+		return fmt.Errorf("could not build effective configuration: %w", err)
+	}
+
+	images := collectImages(config)
+
+	lines := make([]string, 0, len(images))
+	for _, image := range images {
+		lines = append(lines, image+"="+o.destination(image))
+	}
+
+	if err := ioutil.WriteFile(o.output, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		return err
+	}
+
+	fmt.Printf("wrote %d image mappings to %s\n", len(images), o.output)
+	return nil
+}
+
+// destination computes the mirrored image reference for src. When --to is unset the mapping is
+// an identity mapping, which is still valid input to 'oc image mirror' and lets the mapping file
+// double as an inventory of every operand image. SHA-referenced and tag-referenced images are
+// handled the same way, since 'oc image mirror' copies whichever reference the source uses.
+func (o *Images) destination(src string) string {
+	if o.to == "" {
+		return src
+	}
+
+	repository := src
+	if idx := strings.IndexAny(repository, "@:"); idx != -1 && strings.LastIndex(repository, "/") < idx {
+		repository = repository[:idx]
+	}
+	name := repository
+	if idx := strings.LastIndex(name, "/"); idx != -1 {
+		name = name[idx+1:]
+	}
+
+	reference := strings.TrimPrefix(src, repository)
+	return strings.TrimSuffix(o.to, "/") + "/" + name + reference
+}
+
+// collectImages returns the sorted, de-duplicated set of every operand image the effective
+// configuration references, including addon and database exporter images. Disabled addons are
+// skipped since their images are never pulled.
+func collectImages(config *configuration.Config) []string {
+	seen := map[string]bool{}
+	var images []string
+
+	add := func(image string) {
+		if image == "" || seen[image] {
+			return
+		}
+		seen[image] = true
+		images = append(images, image)
+	}
+
+	components := &config.Syndesis.Components
+	add(components.UI.Image)
+	add(components.S2I.Image)
+	add(components.Oauth.Image)
+	add(components.Oauth.NonEmbeddedImage)
+	add(components.Server.Image)
+	add(components.Meta.Image)
+	add(components.Database.Image)
+	add(components.Database.Exporter.Image)
+	add(components.Prometheus.Image)
+	add(components.Upgrade.Image)
+	add(components.AMQ.Image)
+
+	addons := &config.Syndesis.Addons
+	for _, sampleApp := range addons.SampleApps {
+		add(sampleApp.Image)
+	}
+	if addons.DV.Enabled {
+		add(addons.DV.Image)
+	}
+	if addons.CamelK.Enabled {
+		add(addons.CamelK.Image)
+	}
+	if addons.Apicurito.Enabled {
+		add(addons.Apicurito.Image)
+	}
+	if addons.Keycloak.Enabled && addons.Keycloak.Provision {
+		add(addons.Keycloak.Image)
+	}
+	if addons.Logging.Enabled {
+		add(addons.Logging.Image)
+	}
+	if addons.Jaeger.Enabled {
+		add(addons.Jaeger.ImageAgent)
+		add(addons.Jaeger.ImageAllInOne)
+		add(addons.Jaeger.ImageOperator)
+	}
+
+	sort.Strings(images)
+	return images
+}
@@ -119,6 +119,8 @@ func New(parent *internal.Options) *cobra.Command {
 	forge.PersistentFlags().StringVarP(&o.templateName, "template-name", "", "", "the name of the template")
 	cmd.AddCommand(forge)
 
+	cmd.AddCommand(o.newDryRunCommand())
+
 	cmd.PersistentFlags().StringVarP(&o.eject, "eject", "e", "", "eject configuration that would be applied to the cluster in the specified format instead of installing the configuration. One of: json|yaml")
 	cmd.PersistentFlags().StringVarP(&o.image, "image", "", pkg.DefaultOperatorImage, "sets operator image that gets installed")
 	cmd.PersistentFlags().StringVarP(&o.tag, "tag", "", pkg.DefaultOperatorTag, "sets operator tag that gets installed")
@@ -0,0 +1,140 @@
+package install
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// newDryRunCommand renders the same route/infrastructure/database/addon resources the operator's
+// install action would apply for a given custom resource, and prints them instead of applying
+// them, for GitOps workflows that commit manifests rather than letting the operator apply them
+// directly. Unlike --eject, which only covers the cluster/operator/app bootstrap resources, this
+// renders the full payload the running operator would reconcile the custom resource into.
+func (o *Install) newDryRunCommand() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "dry-run",
+		Short: "render the complete set of resources for a custom resource without applying them",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch output {
+			case "yaml", "json":
+			default:
+				return fmt.Errorf("invalid output format: %s", output)
+			}
+
+			if o.customResource == "" {
+				return errors.New("--custom-resource is required")
+			}
+
+			resources, err := o.renderAllResources()
+			if err != nil {
+				return err
+			}
+
+			value := util.UnstructuredsToRuntimeObject(resources)
+			if value == nil {
+				return nil
+			}
+
+			switch output {
+			case "yaml":
+				data, err := yaml.Marshal(value)
+				if err != nil {
+					return err
+				}
+				fmt.Print(string(data))
+			case "json":
+				data, err := json.Marshal(value)
+				if err != nil {
+					return err
+				}
+				fmt.Print(string(data))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "yaml", "output format, one of: yaml|json")
+	cmd.Flags().StringVarP(&o.customResource, "custom-resource", "", "", "path to the Syndesis custom resource to render resources for")
+	return cmd
+}
+
+// renderAllResources renders every resource the operator's install action would apply for
+// o.customResource: the route, the core infrastructure, the database (unless an external one is
+// configured) and every enabled addon.
+func (o *Install) renderAllResources() ([]unstructured.Unstructured, error) {
+	customResData, err := util.LoadJSONFromFile(o.customResource)
+	if err != nil {
+		return nil, err
+	}
+
+	syndesis := &v1beta1.Syndesis{}
+	if err := json.Unmarshal(customResData, syndesis); err != nil {
+		return nil, err
+	}
+	if syndesis.Namespace == "" {
+		syndesis.Namespace = o.Namespace
+	}
+
+	config, err := configuration.GetProperties(o.Context, configuration.TemplateConfig, o.ClientTools(), syndesis)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []unstructured.Unstructured
+
+	route, err := generator.RenderDir("./route/", config)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, route...)
+
+	infra, err := generator.RenderDir("./infrastructure/", config)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, infra...)
+
+	if len(config.Syndesis.Components.Database.ExternalDbURL) == 0 {
+		db, err := generator.RenderDir("./database/", config)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, db...)
+	}
+
+	for _, addonInfo := range configuration.GetAddonsInfo(*config) {
+		if !addonInfo.IsEnabled() {
+			continue
+		}
+
+		addonDir := "./addons/" + addonInfo.Name() + "/"
+		f, err := generator.GetAssetsFS().Open(addonDir)
+		if err != nil {
+			continue
+		}
+		f.Close()
+
+		addonResources, err := generator.RenderDir(addonDir, config)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, addonResources...)
+	}
+
+	for i := range resources {
+		resources[i].SetNamespace(syndesis.Namespace)
+	}
+
+	return resources, nil
+}
@@ -110,10 +110,8 @@ const (
 	EnvFuseDVImage         SyndesisEnvVar = "FUSE_DV_IMAGE"
 )
 
-//
 // The parameters provided for injecting values
 // into the resulting templates
-//
 var allTemplateParams = map[SyndesisEnvVar]ConfigSpec{
 	EnvRouteHostname:                 {Description: "The external hostname to access Syndesis"},
 	EnvOpenShiftMaster:               {Value: "https://localhost:8443", Required: true, Description: "Public OpenShift master address"},
@@ -189,7 +187,7 @@ func (o *Install) installForge() error {
 
 	configuration.OpenShiftConsoleURL = convertToParam(string(EnvOpenShiftConsoleURL))
 	components.Server.Features.OpenShiftMaster = convertToParam(string(EnvOpenShiftMaster))
-	components.Server.Features.ManagementURLFor3scale = convertToParam(string(EnvManagementURLFor3scale))
+	synConf.Addons.ThreeScale.ManagementURL = convertToParam(string(EnvManagementURLFor3scale))
 	components.Oauth.SarNamespace = convertToParam(string(EnvSarNamespace))
 
 	components.S2I.Image = retargetImage(EnvFuseS2iImage, &components.S2I.Image)
@@ -282,8 +280,10 @@ func (o *Install) installForge() error {
 				configuration.Syndesis.Addons.Knative.Enabled = true
 			case "publicApi":
 				configuration.Syndesis.Addons.PublicAPI.Enabled = true
-			case "todo":
-				configuration.Syndesis.Addons.Todo.Enabled = true
+			case "keycloak":
+				configuration.Syndesis.Addons.Keycloak.Enabled = true
+			case "logging":
+				configuration.Syndesis.Addons.Logging.Enabled = true
 			}
 
 			addonDir := filepath.Join(addonsPath, reqAddon)
@@ -417,12 +417,10 @@ func remove(s []interface{}, i int) []interface{} {
 	return append(s[:i], s[i+1:]...)
 }
 
-//
 // The camel.apache.org rule apiGroup cannot be installed
 // in the template by a regular user and not necessary in
 // the use-case for this template generation so we need to
 // identify it for removal
-//
 func isCamelRuleMap(value interface{}) bool {
 	switch v := value.(type) {
 	case map[string]interface{}:
@@ -0,0 +1,99 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+)
+
+func NewExport(parent *internal.Options) *cobra.Command {
+	o := integrationClient{Options: parent}
+	var output string
+
+	cmd := cobra.Command{
+		Use:   "export",
+		Short: "export resources from a running Syndesis installation",
+	}
+
+	sub := &cobra.Command{
+		Use:   "integrations [id...]",
+		Short: "export one or more integrations (or 'all') as a zip, via the syndesis-server public API",
+		RunE: func(_ *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("at least one integration id, or 'all', is required")
+			}
+			return o.exportIntegrations(args, output)
+		},
+	}
+	sub.Flags().StringVarP(&output, "output", "o", "export.zip", "path of the zip file to write")
+
+	cmd.PersistentFlags().StringVar(&o.token, "token", "", "bearer token to authenticate against the syndesis route (e.g. from 'oc whoami -t')")
+	cmd.AddCommand(sub)
+
+	return &cmd
+}
+
+func (o *integrationClient) exportIntegrations(ids []string, output string) error {
+	base, err := o.baseURL()
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	for _, id := range ids {
+		query.Add("id", id)
+	}
+
+	req, err := http.NewRequestWithContext(o.Context, http.MethodGet, base+"/export.zip?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	if o.token != "" {
+		req.Header.Set("Authorization", "Bearer "+o.token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("export failed: server responded with %s", resp.Status)
+	}
+
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		return err
+	}
+
+	fmt.Println("exported", ids, "to", output)
+	return nil
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+)
+
+func NewImport(parent *internal.Options) *cobra.Command {
+	o := integrationClient{Options: parent}
+	var file string
+
+	cmd := cobra.Command{
+		Use:   "import",
+		Short: "import a zip previously produced by 'operator export integrations', via the syndesis-server public API",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if file == "" {
+				return fmt.Errorf("--file is required")
+			}
+			return o.importIntegrations(file)
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "path to the zip file to import")
+	cmd.Flags().StringVar(&o.token, "token", "", "bearer token to authenticate against the syndesis route (e.g. from 'oc whoami -t')")
+
+	return &cmd
+}
+
+func (o *integrationClient) importIntegrations(file string) error {
+	base, err := o.baseURL()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	req, err := http.NewRequestWithContext(o.Context, http.MethodPost, base+"/import", f)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/zip")
+	if o.token != "" {
+		req.Header.Set("Authorization", "Bearer "+o.token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("import failed: server responded with %s", resp.Status)
+	}
+
+	fmt.Println("imported", file)
+	return nil
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package integration adds CLI commands that talk to a running syndesis-server's
+// integration-support REST API, to move integrations between environments without
+// going through the UI.
+package integration
+
+import (
+	"fmt"
+
+	osv1 "github.com/openshift/api/route/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+)
+
+// syndesisRouteName is the name of the Route exposing syndesis-server, matching
+// action.SyndesisRouteName.
+const syndesisRouteName = "syndesis"
+
+type integrationClient struct {
+	*internal.Options
+	token string
+}
+
+func (o *integrationClient) baseURL() (string, error) {
+	rtClient, err := o.ClientTools().RuntimeClient()
+	if err != nil {
+		return "", err
+	}
+
+	route := &osv1.Route{}
+	if err := rtClient.Get(o.Context, client.ObjectKey{Name: syndesisRouteName, Namespace: o.Namespace}, route); err != nil {
+		return "", fmt.Errorf("could not find the syndesis route in namespace %s: %w", o.Namespace, err)
+	}
+
+	if route.Spec.Host == "" {
+		return "", fmt.Errorf("syndesis route in namespace %s has no host assigned yet", o.Namespace)
+	}
+
+	return fmt.Sprintf("https://%s/api/v1/integration-support", route.Spec.Host), nil
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+)
+
+type Lint struct {
+	*internal.Options
+	file       string
+	configFile string
+}
+
+func New(parent *internal.Options) *cobra.Command {
+	o := Lint{Options: parent}
+
+	cmd := cobra.Command{
+		Use:   "lint",
+		Short: "validate a Syndesis custom resource and configuration file without a cluster",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return o.lint()
+		},
+	}
+
+	cmd.Flags().StringVarP(&o.file, "file", "f", "", "path to the Syndesis custom resource to validate")
+	cmd.Flags().StringVarP(&o.configFile, "config", "", configuration.TemplateConfig, "path to the operator configuration file to validate against")
+	cobra.MarkFlagRequired(cmd.Flags(), "file")
+
+	return &cmd
+}
+
+func (o *Lint) lint() error {
+	customResData, err := util.LoadJSONFromFile(o.file)
+	if err != nil {
+		return fmt.Errorf("could not read %s: %w", o.file, err)
+	}
+
+	syndesis := &v1beta1.Syndesis{}
+	if err := json.Unmarshal(customResData, syndesis); err != nil {
+		return fmt.Errorf("could not parse %s as a Syndesis custom resource: %w", o.file, err)
+	}
+
+	if err := syndesis.Spec.Validate(); err != nil {
+		return err
+	}
+
+	// GetProperties runs the same defaulting/merging pipeline the operator applies before
+	// reconciling a Syndesis resource. With no ClientTools, it skips every step that would
+	// otherwise talk to a cluster (loading secrets, discovering the API server, resolving an
+	// external database), leaving only what can be checked from the CR and config file alone.
+	if _, err := configuration.GetProperties(o.Context, o.configFile, nil, syndesis); err != nil {
+		return fmt.Errorf("could not build effective configuration: %w", err)
+	}
+
+	fmt.Println(o.file, "is valid")
+	return nil
+}
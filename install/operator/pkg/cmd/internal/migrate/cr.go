@@ -0,0 +1,179 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1alpha1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/versions"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+)
+
+type Cr struct {
+	*internal.Options
+	file   string
+	output string
+}
+
+func (o *Migrate) newCrCommand() *cobra.Command {
+	c := Cr{Options: o.Options}
+
+	cmd := cobra.Command{
+		Use:   "cr",
+		Short: "convert a Syndesis custom resource file to the latest API version, offline",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return c.migrate()
+		},
+	}
+
+	cmd.Flags().StringVarP(&c.file, "file", "f", "", "path to the Syndesis custom resource to convert")
+	cmd.Flags().StringVarP(&c.output, "output", "o", "", "path to write the converted custom resource to")
+	cobra.MarkFlagRequired(cmd.Flags(), "file")
+	cobra.MarkFlagRequired(cmd.Flags(), "output")
+
+	return &cmd
+}
+
+// apiVersionSniff is used to peek at the apiVersion of a custom resource before we know
+// which concrete type to unmarshal it into.
+type apiVersionSniff struct {
+	APIVersion string `json:"apiVersion"`
+}
+
+func (o *Cr) migrate() error {
+	data, err := util.LoadJSONFromFile(o.file)
+	if err != nil {
+		return fmt.Errorf("could not read %s: %w", o.file, err)
+	}
+
+	sniff := apiVersionSniff{}
+	if err := json.Unmarshal(data, &sniff); err != nil {
+		return fmt.Errorf("could not parse %s as a Syndesis custom resource: %w", o.file, err)
+	}
+
+	var result *v1beta2.Syndesis
+	var warnings []string
+
+	switch sniff.APIVersion {
+	case v1alpha1.SchemeGroupVersion.String():
+		src := &v1alpha1.Syndesis{}
+		if err := json.Unmarshal(data, src); err != nil {
+			return fmt.Errorf("could not parse %s as a %s Syndesis custom resource: %w", o.file, sniff.APIVersion, err)
+		}
+
+		beta1 := &v1beta1.Syndesis{ObjectMeta: *src.ObjectMeta.DeepCopy()}
+		beta1.TypeMeta = metav1.TypeMeta{Kind: "Syndesis", APIVersion: v1beta1.SchemeGroupVersion.String()}
+		versions.ConvertV1Alpha1ToV1Beta1(src, beta1)
+
+		warnings = append(warnings, unmappedV1Alpha1Fields(src)...)
+		result = v1beta2.FromV1Beta1(beta1)
+	case v1beta1.SchemeGroupVersion.String():
+		src := &v1beta1.Syndesis{}
+		if err := json.Unmarshal(data, src); err != nil {
+			return fmt.Errorf("could not parse %s as a %s Syndesis custom resource: %w", o.file, sniff.APIVersion, err)
+		}
+
+		result = v1beta2.FromV1Beta1(src)
+	case v1beta2.SchemeGroupVersion.String():
+		fmt.Println(o.file, "is already at the latest API version,", sniff.APIVersion)
+		src := &v1beta2.Syndesis{}
+		if err := json.Unmarshal(data, src); err != nil {
+			return fmt.Errorf("could not parse %s as a %s Syndesis custom resource: %w", o.file, sniff.APIVersion, err)
+		}
+		result = src
+	default:
+		return fmt.Errorf("%s has an unrecognised apiVersion %q", o.file, sniff.APIVersion)
+	}
+
+	out, err := yaml.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(o.output, out, 0644); err != nil {
+		return err
+	}
+
+	for _, warning := range warnings {
+		fmt.Println("warning:", warning)
+	}
+
+	fmt.Println("converted", o.file, "to", o.output)
+	return nil
+}
+
+// unmappedV1Alpha1Fields reports the fields of a v1alpha1 Syndesis that
+// versions.ConvertV1Alpha1ToV1Beta1 does not carry over, so that anyone relying on
+// them can adjust the converted custom resource by hand.
+func unmappedV1Alpha1Fields(src *v1alpha1.Syndesis) []string {
+	var warnings []string
+
+	notMapped := func(name string, set bool) {
+		if set {
+			warnings = append(warnings, fmt.Sprintf("spec.%s is not carried over by the v1alpha1 to v1beta1 conversion", name))
+		}
+	}
+
+	notMapped("demoData", src.Spec.DemoData != nil)
+	notMapped("deployIntegrations", src.Spec.DeployIntegrations != nil)
+	notMapped("testSupport", src.Spec.TestSupport != nil)
+	notMapped("imageStreamNamespace", src.Spec.ImageStreamNamespace != "")
+	notMapped("registry", src.Spec.Registry != "")
+	notMapped("openshiftMaster", src.Spec.OpenShiftMaster != "")
+	notMapped("openshiftConsoleUrl", src.Spec.OpenShiftConsoleURL != "")
+	notMapped("devSupport", src.Spec.DevSupport)
+	notMapped("components.imagePrefix", src.Spec.Components.ImagePrefix != "")
+	notMapped("components.scheduled", src.Spec.Components.Scheduled)
+	notMapped("components.server.registry", src.Spec.Components.Server.Registry != "")
+	notMapped("components.server.imagePrefix", src.Spec.Components.Server.ImagePrefix != "")
+	notMapped("components.server.tag", src.Spec.Components.Server.Tag != "")
+	notMapped("components.meta.registry", src.Spec.Components.Meta.Registry != "")
+	notMapped("components.meta.imagePrefix", src.Spec.Components.Meta.ImagePrefix != "")
+	notMapped("components.meta.tag", src.Spec.Components.Meta.Tag != "")
+	notMapped("components.ui.registry", src.Spec.Components.UI.Registry != "")
+	notMapped("components.ui.imagePrefix", src.Spec.Components.UI.ImagePrefix != "")
+	notMapped("components.ui.tag", src.Spec.Components.UI.Tag != "")
+	notMapped("components.s2i.registry", src.Spec.Components.S2I.Registry != "")
+	notMapped("components.s2i.imagePrefix", src.Spec.Components.S2I.ImagePrefix != "")
+	notMapped("components.s2i.tag", src.Spec.Components.S2I.Tag != "")
+	notMapped("components.db.registry", src.Spec.Components.Db.Registry != "")
+	notMapped("components.db.imagePrefix", src.Spec.Components.Db.ImagePrefix != "")
+	notMapped("components.db.tag", src.Spec.Components.Db.Tag != "")
+	notMapped("components.psql.registry", src.Spec.Components.PostgresExporter.Registry != "")
+	notMapped("components.psql.imagePrefix", src.Spec.Components.PostgresExporter.ImagePrefix != "")
+	notMapped("components.psql.tag", src.Spec.Components.PostgresExporter.Tag != "")
+	notMapped("components.prometheus.tag", src.Spec.Components.Prometheus.Tag != "")
+	notMapped("components.komodo.registry", src.Spec.Components.Komodo.Registry != "")
+	notMapped("components.komodo.imagePrefix", src.Spec.Components.Komodo.ImagePrefix != "")
+	notMapped("components.komodo.tag", src.Spec.Components.Komodo.Tag != "")
+	notMapped("components.upgrade.registry", src.Spec.Components.Upgrade.Registry != "")
+	notMapped("components.upgrade.imagePrefix", src.Spec.Components.Upgrade.ImagePrefix != "")
+	notMapped("components.upgrade.tag", src.Spec.Components.Upgrade.Tag != "")
+	notMapped("components.oauth.tag", src.Spec.Components.Oauth.Tag != "")
+
+	return warnings
+}
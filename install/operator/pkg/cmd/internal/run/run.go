@@ -21,7 +21,10 @@ import (
 	"fmt"
 	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/client-go/rest"
+	"os"
 	"runtime"
+	"strconv"
+	"time"
 
 	kubemetrics "github.com/operator-framework/operator-sdk/pkg/kube-metrics"
 
@@ -33,7 +36,6 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg"
 
 	"github.com/operator-framework/operator-sdk/pkg/k8sutil"
-	"github.com/operator-framework/operator-sdk/pkg/leader"
 	"github.com/operator-framework/operator-sdk/pkg/log/zap"
 	"github.com/operator-framework/operator-sdk/pkg/metrics"
 	"github.com/pkg/errors"
@@ -41,10 +43,12 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/readiness"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	consolev1 "github.com/openshift/api/console/v1"
 	sdkVersion "github.com/operator-framework/operator-sdk/version"
@@ -148,16 +152,36 @@ func (o *options) run() error {
 
 	ctx := o.Context
 
-	// Become the leader before proceeding
-	err = leader.Become(ctx, "syndesis-operator-lock")
-	if err != nil {
-		return err
+	leaderElectionEnabled := boolEnv("SYNDESIS_LEADER_ELECTION_ENABLED", true)
+	if !leaderElectionEnabled {
+		log.Info("leader election is disabled, this operator will act as leader unconditionally")
+	}
+
+	leaderElectionNamespace := os.Getenv("SYNDESIS_LEADER_ELECTION_NAMESPACE")
+	if leaderElectionNamespace == "" {
+		// Falls back to the namespace the operator itself is deployed into, since in
+		// cluster-scoped mode `namespace` is empty and can't host the lock.
+		if operatorNamespace, err := k8sutil.GetOperatorNamespace(); err == nil {
+			leaderElectionNamespace = operatorNamespace
+		} else {
+			leaderElectionNamespace = namespace
+		}
 	}
 
+	leaseDuration := durationEnv("SYNDESIS_LEADER_ELECTION_LEASE_DURATION", 15*time.Second)
+	renewDeadline := durationEnv("SYNDESIS_LEADER_ELECTION_RENEW_DEADLINE", 10*time.Second)
+	retryPeriod := durationEnv("SYNDESIS_LEADER_ELECTION_RETRY_PERIOD", 2*time.Second)
+
 	// Create a new Cmd to provide shared dependencies and start components
 	mgr, err := manager.New(o.ClientTools().RestConfig(), manager.Options{
-		Namespace:          namespace,
-		MetricsBindAddress: fmt.Sprintf("%s:%d", metricsHost, metricsPort),
+		Namespace:               namespace,
+		MetricsBindAddress:      fmt.Sprintf("%s:%d", metricsHost, metricsPort),
+		LeaderElection:          leaderElectionEnabled,
+		LeaderElectionNamespace: leaderElectionNamespace,
+		LeaderElectionID:        "syndesis-operator-lock",
+		LeaseDuration:           &leaseDuration,
+		RenewDeadline:           &renewDeadline,
+		RetryPeriod:             &retryPeriod,
 	})
 	if err != nil {
 		return err
@@ -180,13 +204,25 @@ func (o *options) run() error {
 		return err
 	}
 
-	am, err := versions.APIMigrator(o.Context, cli, namespace)
+	// In cluster-scoped mode (WATCH_NAMESPACE=""), each namespace holding a Syndesis CR is
+	// migrated independently, since they are unrelated, single-tenant installations.
+	var migrators []versions.SyndesisAPIMigrator
+	if namespace == "" {
+		log.Info("watching all namespaces for Syndesis resources")
+		migrators, err = versions.APIMigrators(o.Context, cli)
+	} else {
+		var am versions.SyndesisAPIMigrator
+		am, err = versions.APIMigrator(o.Context, cli, namespace)
+		migrators = []versions.SyndesisAPIMigrator{am}
+	}
 	if err != nil {
 		return err
 	}
 
-	if err = am.Migrate(); err != nil {
-		return err
+	for _, am := range migrators {
+		if err = am.Migrate(); err != nil {
+			return err
+		}
 	}
 
 	// Setup all Controllers
@@ -194,8 +230,16 @@ func (o *options) run() error {
 		return err
 	}
 
-    // Add the Metrics Service
-    addMetrics(ctx, cfg, namespace)
+	if err := addValidatingWebhook(mgr); err != nil {
+		return err
+	}
+
+	if err := mgr.AddMetricsExtraHandler("/readyz-detail", readiness.Handler(cli, namespace)); err != nil {
+		return err
+	}
+
+	// Add the Metrics Service
+	addMetrics(ctx, cfg, namespace)
 
 	if err := mgr.Start(signals.SetupSignalHandler()); err != nil {
 		return err
@@ -207,60 +251,100 @@ func (o *options) run() error {
 // addMetrics will create the Services and Service Monitors to allow the operator export the metrics by using
 // the Prometheus operator
 func addMetrics(ctx context.Context, cfg *rest.Config, namespace string) {
-    if err := serveCRMetrics(cfg); err != nil {
-        if errors.Is(err, k8sutil.ErrRunLocal) {
-            log.Info("Skipping CR metrics server creation; not running in a cluster.")
-            return
-        }
-        log.Info("Could not generate and serve custom resource metrics", "error", err.Error())
-    }
-
-    // Add to the below struct any other metrics ports you want to expose.
-    servicePorts := []v1.ServicePort{
-        {Port: metricsPort, Name: metrics.OperatorPortName, Protocol: v1.ProtocolTCP, TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: metricsPort}},
-        {Port: operatorMetricsPort, Name: metrics.CRPortName, Protocol: v1.ProtocolTCP, TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: operatorMetricsPort}},
-    }
-
-    // Create Service object to expose the metrics port(s).
-    service, err := metrics.CreateMetricsService(ctx, cfg, servicePorts)
-    if err != nil {
-        log.Info("Could not create metrics Service", "error", err.Error())
-    }
-
-    // CreateServiceMonitors will automatically create the prometheus-operator ServiceMonitor resources
-    // necessary to configure Prometheus to scrape metrics from this operator.
-    services := []*v1.Service{service}
-    _, err = metrics.CreateServiceMonitors(cfg, namespace, services)
-    if err != nil {
-        log.Info("Could not create ServiceMonitor object", "error", err.Error())
-        // If this operator is deployed to a cluster without the prometheus-operator running, it will return
-        // ErrServiceMonitorNotPresent, which can be used to safely skip ServiceMonitor creation.
-        if err == metrics.ErrServiceMonitorNotPresent {
-            log.Info("Install prometheus-operator in your cluster to create ServiceMonitor objects", "error", err.Error())
-        }
-    }
+	if err := serveCRMetrics(cfg); err != nil {
+		if errors.Is(err, k8sutil.ErrRunLocal) {
+			log.Info("Skipping CR metrics server creation; not running in a cluster.")
+			return
+		}
+		log.Info("Could not generate and serve custom resource metrics", "error", err.Error())
+	}
+
+	// Add to the below struct any other metrics ports you want to expose.
+	servicePorts := []v1.ServicePort{
+		{Port: metricsPort, Name: metrics.OperatorPortName, Protocol: v1.ProtocolTCP, TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: metricsPort}},
+		{Port: operatorMetricsPort, Name: metrics.CRPortName, Protocol: v1.ProtocolTCP, TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: operatorMetricsPort}},
+	}
+
+	// Create Service object to expose the metrics port(s).
+	service, err := metrics.CreateMetricsService(ctx, cfg, servicePorts)
+	if err != nil {
+		log.Info("Could not create metrics Service", "error", err.Error())
+	}
+
+	// CreateServiceMonitors will automatically create the prometheus-operator ServiceMonitor resources
+	// necessary to configure Prometheus to scrape metrics from this operator.
+	services := []*v1.Service{service}
+	_, err = metrics.CreateServiceMonitors(cfg, namespace, services)
+	if err != nil {
+		log.Info("Could not create ServiceMonitor object", "error", err.Error())
+		// If this operator is deployed to a cluster without the prometheus-operator running, it will return
+		// ErrServiceMonitorNotPresent, which can be used to safely skip ServiceMonitor creation.
+		if err == metrics.ErrServiceMonitorNotPresent {
+			log.Info("Install prometheus-operator in your cluster to create ServiceMonitor objects", "error", err.Error())
+		}
+	}
 }
 
 // serveCRMetrics gets the Operator/CustomResource GVKs and generates metrics based on those types.
 // It serves those metrics on "http://metricsHost:operatorMetricsPort".
 func serveCRMetrics(cfg *rest.Config) error {
-    // Below function returns filtered operator/CustomResource specific GVKs.
-    // For more control override the below GVK list with your own custom logic.
-    filteredGVK, err := k8sutil.GetGVKsFromAddToScheme(apis.AddToScheme)
-    if err != nil {
-        return err
-    }
-    // Get the namespace the operator is currently deployed in.
-    operatorNs, err := k8sutil.GetOperatorNamespace()
-    if err != nil {
-        return err
-    }
-    // To generate metrics in other namespaces, add the values below.
-    ns := []string{operatorNs}
-    // Generate and serve custom resource specific metrics.
-    err = kubemetrics.GenerateAndServeCRMetrics(cfg, ns, filteredGVK, metricsHost, operatorMetricsPort)
-    if err != nil {
-        return err
-    }
-    return nil
+	// Below function returns filtered operator/CustomResource specific GVKs.
+	// For more control override the below GVK list with your own custom logic.
+	filteredGVK, err := k8sutil.GetGVKsFromAddToScheme(apis.AddToScheme)
+	if err != nil {
+		return err
+	}
+	// Get the namespace the operator is currently deployed in.
+	operatorNs, err := k8sutil.GetOperatorNamespace()
+	if err != nil {
+		return err
+	}
+	// To generate metrics in other namespaces, add the values below.
+	ns := []string{operatorNs}
+	// Generate and serve custom resource specific metrics.
+	err = kubemetrics.GenerateAndServeCRMetrics(cfg, ns, filteredGVK, metricsHost, operatorMetricsPort)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// addValidatingWebhook registers the validating and defaulting webhook handlers for the Syndesis
+// CR on the manager's webhook server: the validating one rejects invalid resources at admission
+// time instead of only after the operator has already started reconciling them, and the
+// defaulting one materializes the effective defaults into the CR itself. Both are opt-in via
+// SYNDESIS_WEBHOOK_ENABLED because they require their WebhookConfigurations and a serving
+// certificate (SYNDESIS_WEBHOOK_CERT_DIR) to already be provisioned in the cluster.
+func addValidatingWebhook(mgr manager.Manager) error {
+	if !boolEnv("SYNDESIS_WEBHOOK_ENABLED", false) {
+		return nil
+	}
+
+	server := mgr.GetWebhookServer()
+	server.CertDir = os.Getenv("SYNDESIS_WEBHOOK_CERT_DIR")
+	server.Register("/validate-syndesis-io-v1beta1-syndesis", admission.ValidatingWebhookFor(&v1beta1.Syndesis{}))
+	server.Register("/mutate-syndesis-io-v1beta1-syndesis", admission.DefaultingWebhookFor(&v1beta1.Syndesis{}))
+	return nil
+}
+
+// boolEnv returns the boolean value of the named env var, or def if it is unset or unparsable
+func boolEnv(name string, def bool) bool {
+	if v := os.Getenv(name); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+		log.Info("ignoring invalid boolean value for env var, using default", "name", name, "value", v, "default", def)
+	}
+	return def
+}
+
+// durationEnv returns the duration value of the named env var, or def if it is unset or unparsable
+func durationEnv(name string, def time.Duration) time.Duration {
+	if v := os.Getenv(name); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+		log.Info("ignoring invalid duration value for env var, using default", "name", name, "value", v, "default", def)
+	}
+	return def
 }
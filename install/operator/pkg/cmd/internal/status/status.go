@@ -0,0 +1,163 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+)
+
+type Status struct {
+	*internal.Options
+	output string
+}
+
+// syndesisStatus is the JSON shape printed by `-o json`, kept separate from v1beta1.SyndesisStatus
+// so the reported events aren't tied to the CR's own (much narrower) status schema.
+type syndesisStatus struct {
+	Name       string                                                            `json:"name"`
+	Phase      v1beta1.SyndesisPhase                                             `json:"phase"`
+	Reason     v1beta1.SyndesisStatusReason                                      `json:"reason,omitempty"`
+	Message    string                                                            `json:"message,omitempty"`
+	Components map[v1beta1.SyndesisComponentName]v1beta1.SyndesisComponentHealth `json:"components,omitempty"`
+	Events     []string                                                          `json:"recentEvents,omitempty"`
+}
+
+func New(parent *internal.Options) *cobra.Command {
+	o := Status{Options: parent}
+
+	cmd := cobra.Command{
+		Use:   "status",
+		Short: "summarize the health of the Syndesis installation in the current namespace",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return o.status()
+		},
+	}
+
+	cmd.Flags().StringVarP(&o.output, "output", "o", "", "output format, one of: json")
+
+	return &cmd
+}
+
+func (o *Status) status() error {
+	switch o.output {
+	case "", "json":
+	default:
+		return fmt.Errorf("invalid output format: %s", o.output)
+	}
+
+	rtClient, err := o.ClientTools().RuntimeClient()
+	if err != nil {
+		return err
+	}
+
+	syndesisList := &v1beta1.SyndesisList{}
+	if err := rtClient.List(o.Context, syndesisList, client.InNamespace(o.Namespace)); err != nil {
+		return err
+	}
+
+	if len(syndesisList.Items) == 0 {
+		fmt.Println("no Syndesis installation found in namespace", o.Namespace)
+		return nil
+	}
+
+	events := &corev1.EventList{}
+	if err := rtClient.List(o.Context, events, client.InNamespace(o.Namespace)); err != nil {
+		return err
+	}
+
+	statuses := make([]syndesisStatus, 0, len(syndesisList.Items))
+	for i := range syndesisList.Items {
+		statuses = append(statuses, buildStatus(&syndesisList.Items[i], events))
+	}
+
+	if o.output == "json" {
+		data, err := json.MarshalIndent(statuses, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	for _, s := range statuses {
+		printTable(s)
+	}
+	return nil
+}
+
+func buildStatus(syndesis *v1beta1.Syndesis, events *corev1.EventList) syndesisStatus {
+	s := syndesisStatus{
+		Name:       syndesis.Name,
+		Phase:      syndesis.Status.Phase,
+		Reason:     syndesis.Status.Reason,
+		Message:    syndesis.Status.Description,
+		Components: syndesis.Status.Components,
+	}
+
+	for i := range events.Items {
+		event := &events.Items[i]
+		if event.InvolvedObject.Kind != "Syndesis" || event.InvolvedObject.Name != syndesis.Name {
+			continue
+		}
+		s.Events = append(s.Events, fmt.Sprintf("%s: %s %s", event.LastTimestamp.Format("2006-01-02T15:04:05Z07:00"), event.Reason, event.Message))
+	}
+
+	return s
+}
+
+func printTable(s syndesisStatus) {
+	fmt.Printf("%s\n  phase:   %s\n", s.Name, s.Phase)
+	if s.Reason != "" {
+		fmt.Printf("  reason:  %s\n", s.Reason)
+	}
+	if s.Message != "" {
+		fmt.Printf("  message: %s\n", s.Message)
+	}
+
+	if len(s.Components) > 0 {
+		names := make([]string, 0, len(s.Components))
+		for name := range s.Components {
+			names = append(names, string(name))
+		}
+		sort.Strings(names)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "  COMPONENT\tHEALTH")
+		for _, name := range names {
+			fmt.Fprintf(w, "  %s\t%s\n", name, s.Components[v1beta1.SyndesisComponentName(name)])
+		}
+		w.Flush()
+	}
+
+	if len(s.Events) > 0 {
+		fmt.Println("  recent events:")
+		for _, e := range s.Events {
+			fmt.Println("   -", e)
+		}
+	}
+}
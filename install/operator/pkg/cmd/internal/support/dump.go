@@ -0,0 +1,223 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package support
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+)
+
+// appLabelSelector matches every resource, and pod, that make up a Syndesis installation, mirroring
+// the label the infrastructure templates stamp on them (see 04-syndesis-server.yml.tmpl and friends).
+const appLabelSelector = "syndesis.io/app=syndesis"
+
+// logTail bounds how much of each container's log is captured, so a runaway component can't blow up
+// the size of the dump.
+var logTail = int64(10000)
+
+type Dump struct {
+	*Support
+	output string
+}
+
+func (o *Support) newDumpCommand() *cobra.Command {
+	d := Dump{Support: o}
+
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Short: "collect a tar.gz of diagnostic information for attaching to a support case",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return d.dump()
+		},
+	}
+
+	cmd.Flags().StringVarP(&d.output, "output", "o", "", "path of the tar.gz file to write (defaults to syndesis-support-<namespace>.tar.gz)")
+
+	return cmd
+}
+
+func (o *Dump) dump() error {
+	if o.output == "" {
+		o.output = fmt.Sprintf("syndesis-support-%s.tar.gz", o.Namespace)
+	}
+
+	rtClient, err := o.ClientTools().RuntimeClient()
+	if err != nil {
+		return err
+	}
+
+	apiClient, err := o.ClientTools().ApiClient()
+	if err != nil {
+		return err
+	}
+
+	selector, err := labels.Parse(appLabelSelector)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(o.output)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	defer gz.Close()
+
+	tw := tar.NewWriter(gz)
+	defer tw.Close()
+
+	syndesisList := &v1beta1.SyndesisList{}
+	if err := rtClient.List(o.Context, syndesisList, client.InNamespace(o.Namespace)); err != nil {
+		return err
+	}
+	for i := range syndesisList.Items {
+		syndesis := &syndesisList.Items[i]
+
+		data, err := yaml.Marshal(syndesis)
+		if err != nil {
+			return err
+		}
+		if err := addFile(tw, fmt.Sprintf("syndesis-%s.yaml", syndesis.Name), data); err != nil {
+			return err
+		}
+
+		config, err := configuration.GetProperties(o.Context, configuration.TemplateConfig, o.ClientTools(), syndesis)
+		if err != nil {
+			if err := addFile(tw, fmt.Sprintf("configuration-%s.error.txt", syndesis.Name), []byte(err.Error())); err != nil {
+				return err
+			}
+			continue
+		}
+
+		data, err = yaml.Marshal(config)
+		if err != nil {
+			return err
+		}
+		if err := addFile(tw, fmt.Sprintf("configuration-%s.yaml", syndesis.Name), redactSecrets(data)); err != nil {
+			return err
+		}
+
+		database := config.Syndesis.Components.Database
+		version, err := util.PostgreSQLVersionAt(database.User, database.Password, database.Name, database.URL)
+		if err != nil {
+			if err := addFile(tw, fmt.Sprintf("postgresql-version-%s.error.txt", syndesis.Name), []byte(err.Error())); err != nil {
+				return err
+			}
+		} else if err := addFile(tw, fmt.Sprintf("postgresql-version-%s.txt", syndesis.Name), []byte(fmt.Sprintf("%.1f\n", version))); err != nil {
+			return err
+		}
+	}
+
+	pods := &corev1.PodList{}
+	if err := rtClient.List(o.Context, pods, client.InNamespace(o.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return err
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+
+		data, err := yaml.Marshal(pod)
+		if err != nil {
+			return err
+		}
+		if err := addFile(tw, fmt.Sprintf("pods/%s.yaml", pod.Name), data); err != nil {
+			return err
+		}
+
+		for _, c := range pod.Spec.Containers {
+			logs, err := fetchLogs(o.Context, apiClient, o.Namespace, pod.Name, c.Name)
+			if err != nil {
+				if err := addFile(tw, fmt.Sprintf("logs/%s/%s.error.txt", pod.Name, c.Name), []byte(err.Error())); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := addFile(tw, fmt.Sprintf("logs/%s/%s.log", pod.Name, c.Name), logs); err != nil {
+				return err
+			}
+		}
+	}
+
+	events := &corev1.EventList{}
+	if err := rtClient.List(o.Context, events, client.InNamespace(o.Namespace)); err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(events)
+	if err != nil {
+		return err
+	}
+	if err := addFile(tw, "events.yaml", data); err != nil {
+		return err
+	}
+
+	fmt.Println("support dump written to", o.output)
+	return nil
+}
+
+func fetchLogs(ctx context.Context, apiClient kubernetes.Interface, namespace, pod, container string) ([]byte, error) {
+	req := apiClient.CoreV1().Pods(namespace).GetLogs(pod, &corev1.PodLogOptions{
+		Container: container,
+		TailLines: &logTail,
+	})
+
+	stream, err := req.Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	return ioutil.ReadAll(stream)
+}
+
+var secretFieldPattern = regexp.MustCompile(`(?im)^(\s*\w*(password|secret|token)\w*:).*$`)
+
+// redactSecrets blanks out the value of any YAML field whose name suggests it carries a credential,
+// so the effective configuration can be safely attached to a support case.
+func redactSecrets(data []byte) []byte {
+	return secretFieldPattern.ReplaceAll(data, []byte("$1 <redacted>"))
+}
+
+func addFile(tw *tar.Writer, name string, data []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Size:    int64(len(data)),
+		Mode:    0644,
+		ModTime: time.Now(),
+	}); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package support
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+)
+
+type Support struct {
+	*internal.Options
+}
+
+func New(parent *internal.Options) *cobra.Command {
+	o := Support{Options: parent}
+
+	cmd := cobra.Command{
+		Use:   "support",
+		Short: "gather diagnostic information for a support case",
+	}
+
+	cmd.AddCommand(o.newDumpCommand())
+
+	return &cmd
+}
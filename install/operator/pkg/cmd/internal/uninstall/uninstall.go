@@ -24,13 +24,26 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
+// appLabelSelector matches the infrastructure resources the operator stamps with the labels it also
+// uses to back them up (see backup.backupResources), so uninstall and backup agree on what "all of
+// Syndesis" means.
+const appLabelSelector = "syndesis.io/app=syndesis"
+
 type Uninstall struct {
 	*internal.Options
+	keepPVCs        bool
+	keepSecrets     bool
+	keepOAuthClient bool
 }
 
 func New(parent *internal.Options) *cobra.Command {
@@ -43,6 +56,10 @@ func New(parent *internal.Options) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().BoolVar(&o.keepPVCs, "keep-pvcs", false, "leave persistent volume claims in place instead of deleting them")
+	cmd.Flags().BoolVar(&o.keepSecrets, "keep-secrets", false, "leave secrets in place instead of deleting them")
+	cmd.Flags().BoolVar(&o.keepOAuthClient, "keep-oauth-client", false, "leave the OAuthClient in place instead of deleting it")
+
 	return &cmd
 }
 
@@ -69,17 +86,114 @@ func (o *Uninstall) uninstall() error {
 		return err
 	}
 
-	err = c.List(o.Context, sl)
+	removed := 0
+
+	if err := c.List(o.Context, sl); err != nil {
+		return err
+	}
 	for _, res := range sl.Items {
-		err = c.Delete(o.Context, &res)
-		if err != nil {
+		if err := c.Delete(o.Context, &res); err != nil {
 			if !errors.IsNotFound(err) {
 				fmt.Println(err, "could not deleted", "custom resource", res.Name, "namespace", res.GetNamespace())
+				continue
 			}
 		} else {
 			fmt.Println("resource deleted", "custom resource", res.Name, "namespace", res.GetNamespace())
+			removed++
 		}
 	}
 
+	selector, err := labels.Parse(appLabelSelector)
+	if err != nil {
+		return err
+	}
+
+	if o.keepSecrets {
+		fmt.Println("keeping secrets, as requested")
+	} else {
+		n, err := o.deleteSecrets(c, selector)
+		if err != nil {
+			return err
+		}
+		removed += n
+	}
+
+	if o.keepPVCs {
+		fmt.Println("keeping persistent volume claims, as requested")
+	} else {
+		n, err := o.deletePersistentVolumeClaims(c, selector)
+		if err != nil {
+			return err
+		}
+		removed += n
+	}
+
+	if o.keepOAuthClient {
+		fmt.Println("keeping OAuthClient, as requested")
+	} else {
+		n, err := o.deleteOAuthClients(c, selector)
+		if err != nil {
+			return err
+		}
+		removed += n
+	}
+
+	fmt.Printf("uninstall complete: %d resource(s) removed\n", removed)
 	return nil
 }
+
+func (o *Uninstall) deleteSecrets(c client.Client, selector labels.Selector) (int, error) {
+	list := &corev1.SecretList{}
+	if err := c.List(o.Context, list, client.InNamespace(o.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return 0, err
+	}
+
+	for i := range list.Items {
+		if err := c.Delete(o.Context, &list.Items[i]); err != nil && !errors.IsNotFound(err) {
+			return 0, err
+		}
+		fmt.Println("resource deleted", "secret", list.Items[i].Name)
+	}
+
+	return len(list.Items), nil
+}
+
+func (o *Uninstall) deletePersistentVolumeClaims(c client.Client, selector labels.Selector) (int, error) {
+	list := &corev1.PersistentVolumeClaimList{}
+	if err := c.List(o.Context, list, client.InNamespace(o.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return 0, err
+	}
+
+	for i := range list.Items {
+		if err := c.Delete(o.Context, &list.Items[i]); err != nil && !errors.IsNotFound(err) {
+			return 0, err
+		}
+		fmt.Println("resource deleted", "persistentvolumeclaim", list.Items[i].Name)
+	}
+
+	return len(list.Items), nil
+}
+
+// deleteOAuthClients removes any OAuthClient carrying the app label. OAuthClient is cluster-scoped and
+// only exists on OpenShift, so a missing CRD/kind is not an error: it just means there is nothing to do.
+func (o *Uninstall) deleteOAuthClients(c client.Client, selector labels.Selector) (int, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion("oauth.openshift.io/v1")
+	list.SetKind("OAuthClientList")
+
+	if err := c.List(o.Context, list, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		if meta.IsNoMatchError(err) || errors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	for i := range list.Items {
+		if err := c.Delete(o.Context, &list.Items[i]); err != nil && !errors.IsNotFound(err) {
+			return 0, err
+		}
+		fmt.Println("resource deleted", "oauthclient", list.Items[i].GetName())
+	}
+
+	return len(list.Items), nil
+}
@@ -29,8 +29,14 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/backup"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/grant"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/images"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/install"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/integration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/lint"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/migrate"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/run"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/status"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/support"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/uninstall"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 )
@@ -66,12 +72,19 @@ func NewOperator(ctx context.Context) (*cobra.Command, error) {
 	cmd.PersistentFlags().StringVarP(&options.Namespace, "namespace", "n", namespace, "namespace to run against")
 
 	cmd.AddCommand(install.New(&options))
+	cmd.AddCommand(lint.New(&options))
 	cmd.AddCommand(grant.New(&options))
 	cmd.AddCommand(run.New(&options))
 	cmd.AddCommand(uninstall.New(&options))
 	cmd.AddCommand(backup.NewBackup(&options))
 	cmd.AddCommand(backup.NewRestore(&options))
 	cmd.AddCommand(olm.New(&options))
+	cmd.AddCommand(support.New(&options))
+	cmd.AddCommand(status.New(&options))
+	cmd.AddCommand(integration.NewExport(&options))
+	cmd.AddCommand(integration.NewImport(&options))
+	cmd.AddCommand(migrate.New(&options))
+	cmd.AddCommand(images.New(&options))
 
 	return &cmd, nil
 }
@@ -0,0 +1,10 @@
+package controller
+
+import (
+	"github.com/syndesisio/syndesis/install/operator/pkg/controller/scheduledbackup"
+)
+
+func init() {
+	// AddToManagerFuncs is a list of functions to create controllers and add them to a manager.
+	AddToManagerFuncs = append(AddToManagerFuncs, scheduledbackup.Add)
+}
@@ -0,0 +1,252 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/backup"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+)
+
+var log = logf.Log.WithName("controller_restore")
+
+// scaledDeployments are stopped before a restore and started back up afterwards, the same pair
+// upgrade.scale stops during an in-place upgrade so no request touches the database mid-restore.
+var scaledDeployments = []string{"syndesis-meta", "syndesis-server"}
+
+const (
+	scaleTimeout  = 360 * time.Second
+	scaleInterval = 10 * time.Second
+)
+
+// Add creates a new Restore Controller and adds it to the Manager
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+func newReconciler(mgr manager.Manager) *ReconcileRestore {
+	clientTools := &clienttools.ClientTools{}
+	clientTools.SetRuntimeClient(mgr.GetClient())
+
+	return &ReconcileRestore{
+		clientTools: clientTools,
+		scheme:      mgr.GetScheme(),
+	}
+}
+
+func add(mgr manager.Manager, r *ReconcileRestore) error {
+	c, err := controller.New("restore-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	return c.Watch(&source.Kind{Type: &v1beta1.Restore{}}, &handler.EnqueueRequestForObject{})
+}
+
+type ReconcileRestore struct {
+	clientTools *clienttools.ClientTools
+	scheme      *runtime.Scheme
+}
+
+// Reconcile performs a one-off, point-in-time restore of a Syndesis installation. It runs the restore
+// the first time a Restore resource is seen and is a no-op once Status.Phase has reached a terminal
+// value, since re-running a completed restore is never desired.
+func (r *ReconcileRestore) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	ctx := context.TODO()
+
+	cl, err := r.clientTools.RuntimeClient()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	restore := &v1beta1.Restore{}
+	if err := cl.Get(ctx, request.NamespacedName, restore); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if restore.Status.Phase == v1beta1.RestorePhaseCompleted || restore.Status.Phase == v1beta1.RestorePhaseFailed {
+		return reconcile.Result{}, nil
+	}
+
+	target := restore.DeepCopy()
+	now := metav1.Now()
+
+	if err := r.restore(ctx, cl, target); err != nil {
+		reqLogger.Error(err, "restore failed")
+		target.Status.Phase = v1beta1.RestorePhaseFailed
+		target.Status.Message = err.Error()
+		target.Status.CompletionTime = &now
+		if updateErr := cl.Update(ctx, target); updateErr != nil {
+			reqLogger.Error(updateErr, "failed to record restore failure")
+		}
+		return reconcile.Result{}, err
+	}
+
+	target.Status.Phase = v1beta1.RestorePhaseCompleted
+	target.Status.Message = ""
+	target.Status.CompletionTime = &now
+	return reconcile.Result{}, cl.Update(ctx, target)
+}
+
+// restore carries out the scale down / restore / scale up sequence, updating target.Status.Phase as it
+// progresses so onlookers can see where a long-running restore currently stands.
+func (r *ReconcileRestore) restore(ctx context.Context, cl client.Client, target *v1beta1.Restore) error {
+	syndesis := &v1beta1.Syndesis{}
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: target.Namespace, Name: target.Spec.SyndesisName}, syndesis); err != nil {
+		return fmt.Errorf("could not find Syndesis %q to restore into: %w", target.Spec.SyndesisName, err)
+	}
+
+	if err := r.setPhase(ctx, cl, target, v1beta1.RestorePhaseScalingDown); err != nil {
+		return err
+	}
+	if err := scaleDeployments(ctx, cl, target.Namespace, 0); err != nil {
+		return fmt.Errorf("failed to scale down syndesis-server/syndesis-meta: %w", err)
+	}
+
+	if err := r.setPhase(ctx, cl, target, v1beta1.RestorePhaseRestoring); err != nil {
+		return err
+	}
+
+	if target.Spec.Source.Type == v1beta1.RestoreSourceWal {
+		b, err := backup.NewBackup(ctx, r.clientTools, syndesis, "")
+		if err != nil {
+			return err
+		}
+		basebackup := target.Spec.Source.Path
+		if basebackup == "" {
+			basebackup = "LATEST"
+		}
+		if err := b.RestoreWAL(basebackup, target.Spec.RecoverToTime); err != nil {
+			return err
+		}
+	} else {
+		backupDir, cleanup, err := r.resolveSource(ctx, syndesis, target.Spec.Source)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		b, err := backup.NewBackup(ctx, r.clientTools, syndesis, backupDir)
+		if err != nil {
+			return err
+		}
+
+		schemaVersion, err := b.SchemaVersion()
+		if err != nil {
+			return fmt.Errorf("could not determine the backup's schema version: %w", err)
+		}
+		target.Status.SchemaVersion = schemaVersion
+
+		if err := b.ValidateSchemaVersion(syndesis.Status.Version); err != nil {
+			return err
+		}
+
+		if err := b.Restore(); err != nil {
+			return err
+		}
+	}
+
+	if err := r.setPhase(ctx, cl, target, v1beta1.RestorePhaseScalingUp); err != nil {
+		return err
+	}
+	if err := scaleDeployments(ctx, cl, target.Namespace, 1); err != nil {
+		return fmt.Errorf("failed to scale syndesis-server/syndesis-meta back up: %w", err)
+	}
+
+	return nil
+}
+
+// resolveSource returns a local directory holding the backup to restore, downloading it first when
+// Source is remote. cleanup removes anything resolveSource downloaded and must always be called.
+func (r *ReconcileRestore) resolveSource(ctx context.Context, syndesis *v1beta1.Syndesis, src v1beta1.RestoreSource) (dir string, cleanup func(), err error) {
+	if src.Type == "" || src.Type == v1beta1.RestoreSourceLocal {
+		return src.Path, func() {}, nil
+	}
+
+	tmpDir, err := ioutil.TempDir("", "restore-")
+	if err != nil {
+		return "", func() {}, err
+	}
+	cleanup = func() { os.RemoveAll(tmpDir) }
+
+	b, err := backup.NewBackup(ctx, r.clientTools, syndesis, tmpDir)
+	if err != nil {
+		cleanup()
+		return "", func() {}, err
+	}
+
+	downloader, err := b.Downloader(src.Type, src.Path)
+	if err != nil {
+		cleanup()
+		return "", func() {}, err
+	}
+
+	if !downloader.Enabled() {
+		cleanup()
+		return "", func() {}, fmt.Errorf("%s backup source is not configured: its secret is missing", src.Type)
+	}
+
+	if err := downloader.Download(tmpDir); err != nil {
+		cleanup()
+		return "", func() {}, fmt.Errorf("failed to download backup %q: %w", src.Path, err)
+	}
+
+	return filepath.Join(tmpDir, filepath.Base(src.Path)), cleanup, nil
+}
+
+func (r *ReconcileRestore) setPhase(ctx context.Context, cl client.Client, target *v1beta1.Restore, phase v1beta1.RestorePhase) error {
+	target.Status.Phase = phase
+	return cl.Update(ctx, target)
+}
+
+// scaleDeployments scales syndesis-server and syndesis-meta to replicas and waits for them to reach it.
+func scaleDeployments(ctx context.Context, cl client.Client, namespace string, replicas int32) error {
+	for _, name := range scaledDeployments {
+		dep := &appsv1.Deployment{}
+		if err := cl.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, dep); err != nil {
+			return err
+		}
+		if *dep.Spec.Replicas != replicas {
+			dep.Spec.Replicas = &replicas
+			if err := cl.Update(ctx, dep); err != nil {
+				return err
+			}
+		}
+	}
+
+	return wait.Poll(scaleInterval, scaleTimeout, func() (bool, error) {
+		for _, name := range scaledDeployments {
+			dep := &appsv1.Deployment{}
+			if err := cl.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, dep); err != nil {
+				return false, err
+			}
+			if dep.Status.AvailableReplicas != replicas {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
@@ -0,0 +1,208 @@
+package scheduledbackup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+)
+
+var log = logf.Log.WithName("controller_scheduledbackup")
+
+// backupServiceAccount is the operator's own service account, already granted the RBAC the backup
+// command needs (reading the syndesis-backup-s3/syndesis-backup-azure secrets, exec into the
+// database pod, listing infrastructure resources)
+const backupServiceAccount = "syndesis-operator"
+
+// Add creates a new ScheduledBackup Controller and adds it to the Manager
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+func newReconciler(mgr manager.Manager) *ReconcileScheduledBackup {
+	clientTools := &clienttools.ClientTools{}
+	clientTools.SetRuntimeClient(mgr.GetClient())
+
+	return &ReconcileScheduledBackup{
+		clientTools: clientTools,
+		scheme:      mgr.GetScheme(),
+	}
+}
+
+func add(mgr manager.Manager, r *ReconcileScheduledBackup) error {
+	c, err := controller.New("scheduledbackup-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	if err := c.Watch(&source.Kind{Type: &v1beta1.ScheduledBackup{}}, &handler.EnqueueRequestForObject{}); err != nil {
+		return err
+	}
+
+	ownerHandler := &handler.EnqueueRequestForOwner{
+		IsController: true,
+		OwnerType:    &v1beta1.ScheduledBackup{},
+	}
+	if err := c.Watch(&source.Kind{Type: &batchv1beta1.CronJob{}}, ownerHandler); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type ReconcileScheduledBackup struct {
+	clientTools *clienttools.ClientTools
+	scheme      *runtime.Scheme
+}
+
+// Reconcile turns a ScheduledBackup resource into a CronJob that runs `operator backup` on the
+// requested schedule, and reports the outcome of the most recent run it produced back onto Status.
+func (r *ReconcileScheduledBackup) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	ctx := context.TODO()
+
+	cl, err := r.clientTools.RuntimeClient()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	scheduledBackup := &v1beta1.ScheduledBackup{}
+	if err := cl.Get(ctx, request.NamespacedName, scheduledBackup); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	cronJob := r.desiredCronJob(scheduledBackup)
+	if err := controllerutil.SetControllerReference(scheduledBackup, cronJob, r.scheme); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	target := scheduledBackup.DeepCopy()
+
+	if _, _, err := util.CreateOrUpdate(ctx, cl, cronJob); err != nil {
+		target.Status.Phase = v1beta1.ScheduledBackupPhaseFailed
+		target.Status.Message = err.Error()
+		if updateErr := cl.Update(ctx, target); updateErr != nil {
+			reqLogger.Error(updateErr, "failed to record CronJob reconcile failure")
+		}
+		return reconcile.Result{}, err
+	}
+
+	target.Status.Phase = v1beta1.ScheduledBackupPhaseScheduled
+	target.Status.Message = ""
+	target.Status.CronJobName = cronJob.Name
+
+	if err := r.reportLastBackup(ctx, cl, target); err != nil {
+		reqLogger.Error(err, "failed to look up the most recent backup Job")
+	}
+
+	if err := cl.Update(ctx, target); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// desiredCronJob builds the CronJob that runs `operator backup` on scheduledBackup.Spec.Schedule
+func (r *ReconcileScheduledBackup) desiredCronJob(scheduledBackup *v1beta1.ScheduledBackup) *batchv1beta1.CronJob {
+	args := []string{"backup", "--namespace", scheduledBackup.Namespace, "--backup", "/tmp/backup"}
+	if scheduledBackup.Spec.Destination.Type != "" && scheduledBackup.Spec.Destination.Type != v1beta1.ScheduledBackupDestinationLocal {
+		args = append(args, "--remote", "--retention", fmt.Sprintf("%d", scheduledBackup.Spec.Retention))
+	}
+
+	return &batchv1beta1.CronJob{
+		TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1beta1", Kind: "CronJob"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup-" + scheduledBackup.Name,
+			Namespace: scheduledBackup.Namespace,
+		},
+		Spec: batchv1beta1.CronJobSpec{
+			Schedule:          scheduledBackup.Spec.Schedule,
+			ConcurrencyPolicy: batchv1beta1.ForbidConcurrent,
+			JobTemplate: batchv1beta1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					BackoffLimit: pointerInt32(2),
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							RestartPolicy:      corev1.RestartPolicyOnFailure,
+							ServiceAccountName: backupServiceAccount,
+							Containers: []corev1.Container{
+								{
+									Name:  "backup",
+									Image: fmt.Sprintf("%s:%s", pkg.DefaultOperatorImage, pkg.DefaultOperatorTag),
+									Args:  args,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// reportLastBackup finds the most recently created Job owned by target's CronJob and, once it has
+// finished, copies its outcome onto target.Status
+func (r *ReconcileScheduledBackup) reportLastBackup(ctx context.Context, cl client.Client, target *v1beta1.ScheduledBackup) error {
+	jobs := &batchv1.JobList{}
+	if err := cl.List(ctx, jobs, client.InNamespace(target.Namespace)); err != nil {
+		return err
+	}
+
+	var owned []batchv1.Job
+	for _, job := range jobs.Items {
+		for _, ownerRef := range job.OwnerReferences {
+			if ownerRef.Name == target.Status.CronJobName && ownerRef.Kind == "CronJob" {
+				owned = append(owned, job)
+				break
+			}
+		}
+	}
+
+	if len(owned) == 0 {
+		return nil
+	}
+
+	sort.Slice(owned, func(i, j int) bool {
+		return owned[i].CreationTimestamp.After(owned[j].CreationTimestamp.Time)
+	})
+
+	latest := owned[0]
+	if latest.Status.Succeeded == 0 && latest.Status.Failed == 0 {
+		// still running
+		return nil
+	}
+
+	target.Status.LastBackupJobName = latest.Name
+	target.Status.LastBackupSucceeded = latest.Status.Succeeded > 0
+	if latest.Status.CompletionTime != nil {
+		target.Status.LastBackupTime = latest.Status.CompletionTime
+	}
+
+	return nil
+}
+
+func pointerInt32(v int32) *int32 {
+	return &v
+}
@@ -2,19 +2,31 @@ package syndesis
 
 import (
 	"context"
+	"math"
+	"os"
 	"reflect"
+	"strconv"
 	"time"
 
 	consolev1 "github.com/openshift/api/console/v1"
+	"golang.org/x/time/rate"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
@@ -22,14 +34,66 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/action"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/capabilities"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/metrics"
 )
 
 var log = logf.Log.WithName("controller")
 
 var (
 	actions []action.SyndesisOperatorAction
+
+	// resyncInterval is how often an Installed Syndesis resource is re-reconciled even without
+	// any triggering event, overridable with the SYNDESIS_RESYNC_INTERVAL env var (e.g. "30s")
+	resyncInterval = durationEnv("SYNDESIS_RESYNC_INTERVAL", 15*time.Second)
+
+	// operatorConfigMapName is the ConfigMap mounted as the operator's config.yaml, overridable
+	// with the SYNDESIS_OPERATOR_CONFIG_MAP env var, so an edit to it is picked up on the next
+	// reconcile instead of waiting for the periodic resync
+	operatorConfigMapName = stringEnv("SYNDESIS_OPERATOR_CONFIG_MAP", "syndesis-operator-config")
 )
 
+// stringEnv reads a string from the named env var, falling back to def when unset
+func stringEnv(name string, def string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return def
+}
+
+// durationEnv reads a time.Duration from the named env var, falling back to def when unset or invalid
+func durationEnv(name string, def time.Duration) time.Duration {
+	if v := os.Getenv(name); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+		log.Info("Ignoring invalid duration, using default", "env", name, "value", v, "default", def)
+	}
+	return def
+}
+
+// intEnv reads an int from the named env var, falling back to def when unset or invalid
+func intEnv(name string, def int) int {
+	if v := os.Getenv(name); v != "" {
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+		log.Info("Ignoring invalid integer, using default", "env", name, "value", v, "default", def)
+	}
+	return def
+}
+
+// controllerRateLimiter builds the workqueue rate limiter used to back off requeued reconciles,
+// overridable with SYNDESIS_RATE_LIMITER_BASE_DELAY / SYNDESIS_RATE_LIMITER_MAX_DELAY (e.g. "5ms" / "1000s")
+func controllerRateLimiter() workqueue.RateLimiter {
+	baseDelay := durationEnv("SYNDESIS_RATE_LIMITER_BASE_DELAY", 5*time.Millisecond)
+	maxDelay := durationEnv("SYNDESIS_RATE_LIMITER_MAX_DELAY", 1000*time.Second)
+	return workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(baseDelay, maxDelay),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	)
+}
+
 // Add creates a new Syndesis Controller and adds it to the Manager. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager) error {
@@ -56,7 +120,11 @@ func newReconciler(mgr manager.Manager) (*ReconcileSyndesis, error) {
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
 func add(mgr manager.Manager, r *ReconcileSyndesis) error {
 	// Create a new controller
-	c, err := controller.New("syndesis-controller", mgr, controller.Options{Reconciler: r})
+	c, err := controller.New("syndesis-controller", mgr, controller.Options{
+		Reconciler:              r,
+		MaxConcurrentReconciles: intEnv("SYNDESIS_MAX_CONCURRENT_RECONCILES", 1),
+		RateLimiter:             controllerRateLimiter(),
+	})
 	if err != nil {
 		return err
 	}
@@ -67,10 +135,69 @@ func add(mgr manager.Manager, r *ReconcileSyndesis) error {
 		return err
 	}
 
+	// Watch owned resources that are most prone to manual drift, so that edits or deletions are
+	// reverted on the next reconcile instead of only being caught by the periodic poll.
+	ownerHandler := &handler.EnqueueRequestForOwner{
+		IsController: true,
+		OwnerType:    &syndesisv1beta1.Syndesis{},
+	}
+	for _, ownedType := range []runtime.Object{&appsv1.Deployment{}, &corev1.Service{}} {
+		if err := c.Watch(&source.Kind{Type: ownedType}, ownerHandler); err != nil {
+			return err
+		}
+	}
+
+	// Watch the config sources GetProperties reads out-of-band: the syndesis-global-config Secret
+	// (passwords) and the operator's own config ConfigMap (defaults). Neither is owned by a Syndesis
+	// resource, so an edit is mapped to a reconcile of every Syndesis resource in the same namespace
+	// instead of relying on an owner reference.
+	configSourceHandler := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(enqueueSyndesisInNamespace(mgr.GetClient())),
+	}
+	if err := c.Watch(&source.Kind{Type: &corev1.Secret{}}, configSourceHandler, namedObjectPredicate(configuration.SyndesisGlobalConfigSecret)); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &corev1.ConfigMap{}}, configSourceHandler, namedObjectPredicate(operatorConfigMapName)); err != nil {
+		return err
+	}
+
 	actions = action.NewOperatorActions(mgr, r.clientTools)
 	return nil
 }
 
+// namedObjectPredicate only lets events for the object with the given name through, so a watch on a
+// broad type like Secret or ConfigMap doesn't trigger a reconcile for unrelated objects sharing it.
+func namedObjectPredicate(name string) predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return e.Meta.GetName() == name },
+		UpdateFunc:  func(e event.UpdateEvent) bool { return e.MetaNew.GetName() == name },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return e.Meta.GetName() == name },
+		GenericFunc: func(e event.GenericEvent) bool { return e.Meta.GetName() == name },
+	}
+}
+
+// enqueueSyndesisInNamespace builds a Mapper that, given an event on a config source object, lists
+// every Syndesis resource in that object's namespace and enqueues a reconcile request for each, so
+// e.g. editing the syndesis-global-config Secret triggers an immediate reconcile of all the
+// Syndesis resources it affects.
+func enqueueSyndesisInNamespace(cl client.Client) func(handler.MapObject) []reconcile.Request {
+	return func(obj handler.MapObject) []reconcile.Request {
+		list := &syndesisv1beta1.SyndesisList{}
+		if err := cl.List(context.TODO(), list, client.InNamespace(obj.Meta.GetNamespace())); err != nil {
+			log.Error(err, "failed to list Syndesis resources to requeue after a config source change", "namespace", obj.Meta.GetNamespace())
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(list.Items))
+		for _, syndesis := range list.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: syndesis.Namespace, Name: syndesis.Name},
+			})
+		}
+		return requests
+	}
+}
+
 var _ reconcile.Reconciler = &ReconcileSyndesis{}
 
 // ReconcileSyndesis reconciles a Syndesis object
@@ -85,17 +212,22 @@ type ReconcileSyndesis struct {
 // Note:
 // The Controller will requeue the Request to be processed again if the returned error is non-nil or
 // Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
-func (r *ReconcileSyndesis) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+func (r *ReconcileSyndesis) Reconcile(request reconcile.Request) (result reconcile.Result, err error) {
 	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 	reqLogger.V(2).Info("Reconciling Syndesis")
 
+	start := time.Now()
+	defer func() {
+		metrics.ReconcileDuration.WithLabelValues(metrics.Outcome(err)).Observe(time.Since(start).Seconds())
+	}()
+
 	// Fetch the Syndesis syndesis
 	syndesis := &syndesisv1beta1.Syndesis{}
 
 	ctx := context.TODO()
 
 	client, _ := r.clientTools.RuntimeClient()
-	err := client.Get(ctx, request.NamespacedName, syndesis)
+	err = client.Get(ctx, request.NamespacedName, syndesis)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			// Request object not found, could have been deleted after reconcile request.
@@ -120,6 +252,25 @@ func (r *ReconcileSyndesis) Reconcile(request reconcile.Request) (reconcile.Resu
 		}, err
 	}
 
+	if syndesis.GetDeletionTimestamp() != nil {
+		return r.finalizeSyndesis(ctx, client, syndesis)
+	}
+
+	if !hasFinalizer(syndesis, syndesisv1beta1.SyndesisFinalizer) {
+		controllerutil.AddFinalizer(syndesis, syndesisv1beta1.SyndesisFinalizer)
+		if err := client.Update(ctx, syndesis); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	if syndesis.Spec.Paused {
+		if err := r.markPaused(ctx, client, syndesis); err != nil {
+			log.Error(err, "Error updating status while paused", "name", syndesis.Name)
+		}
+		return reconcile.Result{Requeue: true, RequeueAfter: resyncInterval}, nil
+	}
+
 	for _, a := range actions {
 		// Don't want to do anything if the syndesis resource has been updated in the meantime
 		// This happens when a processing takes more tha the resync period
@@ -132,24 +283,92 @@ func (r *ReconcileSyndesis) Reconcile(request reconcile.Request) (reconcile.Resu
 		}
 
 		if a.CanExecute(syndesis) {
-			log.V(2).Info("Running action", "action", reflect.TypeOf(a))
-			if err := a.Execute(ctx, syndesis); err != nil {
-				log.Error(err, "Error reconciling", "action", reflect.TypeOf(a), "phase", syndesis.Status.Phase)
+			actionName := reflect.TypeOf(a).String()
+			log.V(2).Info("Running action", "action", actionName)
+			actionStart := time.Now()
+			actionErr := a.Execute(ctx, syndesis)
+			metrics.ActionDuration.WithLabelValues(actionName, metrics.Outcome(actionErr)).Observe(time.Since(actionStart).Seconds())
+			if actionErr != nil {
+				log.Error(actionErr, "Error reconciling", "action", actionName, "phase", syndesis.Status.Phase)
+				delay := r.recordActionFailure(ctx, client, syndesis, actionName)
 				return reconcile.Result{
 					Requeue:      true,
-					RequeueAfter: 10 * time.Second,
+					RequeueAfter: delay,
 				}, nil
 			}
+
+			r.clearActionFailure(ctx, client, syndesis, actionName)
 		}
 	}
 
 	// Requeuing because actions expect this behaviour
 	return reconcile.Result{
 		Requeue:      true,
-		RequeueAfter: 15 * time.Second,
+		RequeueAfter: resyncInterval,
 	}, nil
 }
 
+// recordActionFailure persists that actionName has failed again and returns how long to wait
+// before retrying it, backing off exponentially per Spec.InstallRetryPolicy
+func (r *ReconcileSyndesis) recordActionFailure(ctx context.Context, cl client.Client, syndesis *syndesisv1beta1.Syndesis, actionName string) time.Duration {
+	target := syndesis.DeepCopy()
+	if target.Status.ActionFailures == nil {
+		target.Status.ActionFailures = map[string]int32{}
+	}
+	target.Status.ActionFailures[actionName]++
+	failures := target.Status.ActionFailures[actionName]
+
+	if err := cl.Update(ctx, target); err != nil {
+		log.Error(err, "Error persisting action failure count", "action", actionName)
+	} else {
+		syndesis.Status = target.Status
+	}
+
+	return retryDelay(syndesis.Spec.InstallRetryPolicy, failures)
+}
+
+// clearActionFailure resets the persisted consecutive-failure count for actionName once it
+// succeeds again
+func (r *ReconcileSyndesis) clearActionFailure(ctx context.Context, cl client.Client, syndesis *syndesisv1beta1.Syndesis, actionName string) {
+	if syndesis.Status.ActionFailures[actionName] == 0 {
+		return
+	}
+
+	target := syndesis.DeepCopy()
+	delete(target.Status.ActionFailures, actionName)
+	if err := cl.Update(ctx, target); err != nil {
+		log.Error(err, "Error clearing action failure count", "action", actionName)
+		return
+	}
+	syndesis.Status = target.Status
+}
+
+// retryDelay computes the exponential backoff delay for the given number of consecutive
+// failures of the same action, governed by policy. A zero-value policy retries at a fixed
+// 10 second interval, matching the operator's previous, non-configurable behaviour.
+func retryDelay(policy syndesisv1beta1.InstallRetryPolicy, failures int32) time.Duration {
+	initialDelay := policy.InitialDelaySeconds
+	if initialDelay <= 0 {
+		initialDelay = 10
+	}
+	maxDelay := policy.MaxDelaySeconds
+	if maxDelay <= 0 {
+		maxDelay = initialDelay
+	}
+
+	power := failures - 1
+	if power < 0 {
+		power = 0
+	}
+
+	delay := time.Duration(float64(initialDelay)*math.Pow(2, float64(power))) * time.Second
+	if maxDuration := time.Duration(maxDelay) * time.Second; delay > maxDuration {
+		delay = maxDuration
+	}
+
+	return delay
+}
+
 func (r *ReconcileSyndesis) isLatestVersion(ctx context.Context, syndesis *syndesisv1beta1.Syndesis) (bool, error) {
 	refreshed := syndesis.DeepCopy()
 	client, _ := r.clientTools.RuntimeClient()
@@ -190,3 +409,76 @@ func (r *ReconcileSyndesis) removeConsoleLink(ctx context.Context, syndesis *syn
 	}
 	return reconcile.Result{}, err
 }
+
+// finalizeSyndesis runs cleanup of resources that would otherwise be orphaned once the Syndesis
+// resource disappears (cluster-scoped resources are not owned, so garbage collection won't remove
+// them, and namespaced resources such as the database PVC are only removed when explicitly opted in
+// via Spec.DeletionPolicy), then removes the finalizer so deletion of the CR can proceed.
+func (r *ReconcileSyndesis) finalizeSyndesis(ctx context.Context, cl client.Client, syndesis *syndesisv1beta1.Syndesis) (reconcile.Result, error) {
+	if !hasFinalizer(syndesis, syndesisv1beta1.SyndesisFinalizer) {
+		return reconcile.Result{}, nil
+	}
+
+	if _, err := r.removeConsoleLink(ctx, syndesis); err != nil {
+		log.Error(err, "Error removing console link during finalization", "name", syndesis.Name)
+		return reconcile.Result{Requeue: true, RequeueAfter: 10 * time.Second}, nil
+	}
+
+	if syndesis.Spec.DeletionPolicy == syndesisv1beta1.SyndesisDeletionPolicyDelete {
+		if err := r.deletePersistentVolumeClaims(ctx, cl, syndesis); err != nil {
+			log.Error(err, "Error deleting persistent volume claims during finalization", "name", syndesis.Name)
+			return reconcile.Result{Requeue: true, RequeueAfter: 10 * time.Second}, nil
+		}
+	}
+
+	controllerutil.RemoveFinalizer(syndesis, syndesisv1beta1.SyndesisFinalizer)
+	if err := cl.Update(ctx, syndesis); err != nil {
+		return reconcile.Result{Requeue: true, RequeueAfter: 5 * time.Second}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *ReconcileSyndesis) deletePersistentVolumeClaims(ctx context.Context, cl client.Client, syndesis *syndesisv1beta1.Syndesis) error {
+	selector, err := labels.Parse("syndesis.io/app=syndesis")
+	if err != nil {
+		return err
+	}
+
+	list := corev1.PersistentVolumeClaimList{}
+	if err := cl.List(ctx, &list, &client.ListOptions{Namespace: syndesis.Namespace, LabelSelector: selector}); err != nil {
+		return err
+	}
+
+	for i := range list.Items {
+		pvc := &list.Items[i]
+		if err := cl.Delete(ctx, pvc); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// markPaused refreshes the Progressing condition to reflect that reconciliation is suspended,
+// without touching any resource the operator manages. It is a no-op once the condition already
+// reflects the paused state, to avoid pointlessly updating the resource on every reconcile.
+func (r *ReconcileSyndesis) markPaused(ctx context.Context, cl client.Client, syndesis *syndesisv1beta1.Syndesis) error {
+	if existing := syndesis.Status.GetSyndesisCondition(syndesisv1beta1.SyndesisConditionTypeProgressing); existing != nil &&
+		existing.Status == corev1.ConditionFalse && existing.Reason == "Paused" {
+		return nil
+	}
+
+	target := syndesis.DeepCopy()
+	target.Status.SetSyndesisCondition(syndesisv1beta1.SyndesisConditionTypeProgressing, corev1.ConditionFalse, "Paused", "Reconciliation is paused via spec.paused")
+	log.Info("Syndesis resource reconciliation is paused", "name", syndesis.Name)
+	return cl.Update(ctx, target)
+}
+
+func hasFinalizer(syndesis *syndesisv1beta1.Syndesis, finalizer string) bool {
+	for _, f := range syndesis.GetFinalizers() {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
+}
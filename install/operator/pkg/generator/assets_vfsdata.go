@@ -25,6 +25,17 @@ var assets = func() http.FileSystem {
 			name:    "addons",
 			modTime: time.Time{},
 		},
+		"/addons/apicurito": &vfsgen۰DirInfo{
+			name:    "apicurito",
+			modTime: time.Time{},
+		},
+		"/addons/apicurito/addon-apicurito.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "addon-apicurito.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 2228,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\x41\x6f\xdb\x3c\x0c\xbd\xe7\x57\x10\xbd\xf4\x94\xf4\xeb\xb7\xad\x18\x74\x2b\xd6\x61\x2b\xb0\x0e\x41\x5b\xec\xae\x4a\x4c\x42\x4c\x16\x35\x91\x2e\x60\x04\xfe\xef\x83\x1d\xbb\xb5\x33\xb7\x4d\x81\x61\x40\x75\xb2\xe8\x47\xea\x3d\x3d\x91\x73\xb0\x89\x7e\x60\x16\xe2\x68\xe0\xfe\x74\x06\xf0\x93\xa2\x37\x70\x83\xf9\x9e\x1c\xce\x00\x0a\x54\xeb\xad\x5a\x33\x03\x00\x08\xf6\x0e\x83\xec\xbe\x01\x6c\x4a\x06\xa4\x8a\x1e\x85\xa4\x8b\xf5\xdb\x05\xf1\xc9\x4b\xff\xb5\x4a\x68\x80\xe2\x2a\x5b\xd1\x5c\x3a\x2d\x33\x4e\xc0\x1c\x17\x89\x23\x46\x35\x0d\x5d\x57\x66\x52\x6e\x61\xd1\x16\x38\x8e\x49\x42\xb7\x63\x97\x38\x6b\x47\x74\xde\x6e\x0c\x7c\xfc\xaf\x2b\x9e\x32\x2b\x3b\x0e\x06\x6e\x3f\x2d\xbb\x98\xda\xbc\x46\x5d\x76\xc0\x0e\x2a\x18\xd0\x29\xe7\xbf\x25\x78\x52\xc9\xd8\x84\xcc\xa5\xe2\x82\x13\x46\xd9\xd0\x4a\x9b\xac\x81\x2f\xd7\xcd\xdf\x37\xec\xca\x76\x3b\x07\x5a\xc1\xe2\xa6\x2f\x74\xee\x3d\x47\x59\x9c\xf7\xe8\x45\xab\xf0\x2b\x8b\x36\x65\xea\xba\xcd\xda\xb0\xa8\x81\xed\xf6\x95\x69\xcd\x61\x18\x7d\xb7\x6b\xdf\xc0\x73\x66\xeb\xe3\x15\x52\x14\x74\x65\xc6\xcf\x7e\x8d\xb7\x98\x0b\x8a\x56\x89\xe3\x92\x03\xb9\xca\xc0\x35\x7a\xca\xe8\xb4\xaf\xf6\x88\x30\x80\x7e\xbd\xbb\x2d\xe5\xbe\xda\x7e\x4b\x4d\x5d\xd2\xf8\x11\xd8\x94\x64\x68\xfb\x05\xa6\xc0\x55\x81\x51\xdf\xb0\xf7\x19\x53\x20\x67\xc5\xc0\xe9\x64\x73\x15\x56\xdd\xe6\xdb\x48\xce\xb4\xa0\x97\x25\x1d\xc8\x56\x34\x5b\xc5\x75\xf5\xf0\x2c\x5a\xf5\xd7\xe8\x32\x5a\xed\x5c\xc4\x22\x05\xab\xf8\x40\x72\x74\xf9\x7f\x1a\xf0\x34\xe7\x43\x58\x1f\x6c\xc6\xc1\x12\x87\x06\x34\xcb\x71\x54\x4b\x11\xf3\x80\xf1\x7c\xc2\xb6\x7e\x51\x61\xd7\x68\xe0\xf8\xd9\xe6\xbb\x6c\x40\x75\x7d\xbc\x9f\xb7\x2c\x43\xe8\x7b\xe6\x72\xf5\x9d\x75\x99\x51\x76\x8f\xb8\x5f\x83\x31\xdd\x93\x79\xa0\xb8\xd7\x9f\xfd\xda\x91\xdd\xa8\xa6\x41\x38\xd0\x3d\x46\x14\x59\x66\xbe\x43\x33\xc2\x37\xc8\x2f\xa8\xe3\x20\x40\xb2\xba\x31\x70\x74\x72\xb4\x1f\x9f\x3e\x95\x22\x29\xd9\x70\x81\xc1\x56\x37\xe8\x38\x7a\x31\xf0\x6e\x88\xc9\x68\x3d\xfd\x7b\x0e\xa7\x23\x0a\xc2\x65\x76\x28\xe3\x83\x02\x15\xa4\xb2\x7f\x78\x81\x05\xe7\xaa\x99\xab\x2f\x8d\xe4\xbe\xea\xe2\xaa\x4d\x81\xba\xde\x6e\x5f\x9f\x80\x41\x10\xea\xfa\xff\x0f\x67\x57\xd4\x6c\xa3\x87\x6e\x34\x3f\xd2\xff\x55\xa2\x3c\xcd\xf4\xec\xfd\x15\xcd\x7e\x07\x00\x00\xff\xff\x32\xbd\x0f\x09\xb4\x08\x00\x00"),
+		},
 		"/addons/camelk": &vfsgen۰DirInfo{
 			name:    "camelk",
 			modTime: time.Time{},
@@ -39,9 +50,9 @@ var assets = func() http.FileSystem {
 		"/addons/camelk/maven-settings.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "maven-settings.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 1750,
+			uncompressedSize: 2104,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x41\x6f\xd3\x4c\x10\xbd\xfb\x57\x8c\xac\x1e\x3f\xef\x26\x1f\x17\x64\x6d\x5c\x89\x42\x11\x52\xcb\x81\x14\xc4\x75\x6a\x4f\x92\x55\xd7\xbb\xd6\xce\x26\x71\x14\xf2\xdf\x91\x9d\x3a\xb5\x9d\x52\x7a\x40\x9c\x38\xfa\xcd\x9b\xf7\x66\xe6\x49\x6b\xac\xf4\x37\xf2\xac\x9d\x4d\x61\x33\x8d\x0a\x0c\x98\x46\x00\x4c\x21\x68\xbb\x64\x51\x97\x26\x85\x1f\x49\x04\x00\xa0\x2e\xeb\xd2\xc0\xe6\x48\x9f\xc5\x53\x31\x89\x81\x6c\xee\x0a\x6d\x97\xb3\xf8\xeb\xdd\x75\xf2\x36\xbe\xcc\x8e\xd4\x4e\x00\xea\xd2\x58\x9e\xc5\xab\x10\xaa\x54\xca\x12\x37\x64\x05\x56\x98\xaf\x48\x38\xbf\x94\xf3\x0f\x77\x77\x9f\x3e\x7f\x9c\xcb\xa9\x98\x34\x7a\x2d\x3d\xad\x59\x9f\x5a\xb6\xdb\xad\xd8\xbe\x69\xc9\xff\x4f\x26\x53\xf9\xfd\xf6\x66\x9e\xaf\xa8\xc4\x44\x5b\x0e\x68\x73\x8a\xa1\x66\x9d\x72\x0b\xde\xb8\x1c\x43\x3b\xde\xeb\x1c\xa1\xa1\xf1\x73\xbc\x9a\x0b\xd9\x6d\x91\xb4\x5c\x51\x73\x11\x1f\xf7\x03\x50\xc6\xe5\x68\xbe\x50\xe5\x58\x07\xe7\x77\x99\x92\x63\xa4\x63\x56\xde\x2d\xb4\x21\xee\x80\x27\xe8\x09\x01\x50\xba\xc8\xda\x21\x92\xce\x55\x49\x5d\x0c\x18\x98\x07\xbd\x69\xd7\xeb\xc3\x5d\x81\xde\xed\xde\xd3\x02\xd7\x26\x64\xc1\xaf\x49\xc9\x31\xda\x97\x92\x7d\xad\xc7\xc2\x7e\x9f\x80\x5e\x80\x98\xef\x6c\x41\xac\x59\x5c\xb9\xb2\x72\x96\x6c\x60\x31\x27\xbf\x21\x2f\xae\x09\xc3\xda\x13\x8b\xdb\x66\xd2\xd3\xae\x9a\xf8\x70\xe8\xcb\xfb\x5e\x65\x38\x6b\x63\xe2\xd1\x2e\x09\x2e\x74\xf1\x1f\x5c\xac\xbd\x81\x74\xf6\x27\x4c\x87\xc6\xbb\x6c\x54\x3a\x5e\x78\xbf\x6f\x7c\xe1\x70\x18\x1f\xf7\x91\xb2\xf6\xa6\xe5\x34\x63\x35\xa4\xe6\xfb\x9c\xc5\x16\x2b\x5e\xb9\xc0\xe7\x35\x00\x45\x16\xef\x0d\x15\xd9\x02\x0d\x93\x92\xdd\xe7\xb9\x8c\x7c\x41\x47\x79\x32\x84\x3c\x3e\xdf\xc8\xe2\x18\xf4\x0b\x0e\xbf\x52\x69\x2a\x4f\x97\x3a\x4b\x88\x6c\x73\xa3\xe8\x59\xfe\x28\x52\x55\x99\xf5\x52\x0f\x62\xf9\xab\x89\x8f\xec\xff\xe5\xfe\x9b\xdc\xcf\xef\xf5\x9a\xf4\x9f\x0b\x39\x1a\x74\xf4\x1a\x94\x1c\xbd\x6f\x27\xe0\x71\x1e\x75\x7a\x59\xb3\xe8\x41\xdb\x22\x85\x2b\x67\x17\x7a\x79\x8b\x55\x54\x52\xc0\xee\x37\x64\xf0\x9e\x0c\xa7\x6d\x0f\x56\x55\x0a\x39\x96\x64\x92\x87\x08\xc0\x62\x49\xa7\xef\x64\xf8\x70\x46\x3f\x03\x00\x00\xff\xff\x62\xd5\xd9\x0b\xd6\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4f\x8f\xd3\x3e\x10\xbd\xe7\x53\x8c\xa2\x3d\xfd\xf4\x4b\xdc\xc2\x05\x45\x6e\x56\x62\x61\x11\xd2\x16\x24\xba\x20\xae\xb3\xc9\xb4\xb5\xd6\xb1\x23\xdb\x6d\x53\x95\x7e\x77\x94\x74\x93\x26\x6e\xf7\x0f\x02\x71\xe2\xe8\x37\xcf\xef\x8d\xe7\x59\x36\x96\xe2\x1b\x19\x2b\xb4\x4a\x60\x3d\x0e\x72\x74\x98\x04\x00\x96\x9c\x13\x6a\x61\xe3\xaa\x90\x09\xfc\x88\x02\x00\x00\x7e\x59\x15\x12\xd6\x07\xfa\x24\x1c\xc7\xa3\x10\x48\x65\x3a\x17\x6a\x31\x09\xbf\xde\x5e\x47\x6f\xc2\xcb\xf4\x40\x6d\x05\xa0\x2a\xa4\xb2\x93\x70\xe9\x5c\x99\x30\x56\xe0\x9a\x54\x8c\x25\x66\x4b\x8a\xb5\x59\xb0\xd9\xfb\xdb\xdb\x8f\x9f\x3e\xcc\xd8\x38\x1e\xd5\x7a\x0d\x3d\xa9\xac\xe8\xb6\x6c\x36\x9b\x78\xf3\xba\x21\xbf\x1a\x8d\xc6\xec\xfb\xf4\x66\x96\x2d\xa9\xc0\x48\x28\xeb\x50\x65\x14\x42\x65\x45\x62\x1b\xf0\x46\x67\xe8\x9a\xf6\x5e\xe6\x08\x35\xcd\x9e\xe3\x55\x36\x67\xed\x29\xa2\x86\x1b\x57\x36\x0f\x0f\xe7\x03\xe0\x52\x67\x28\xbf\x50\xa9\xad\x70\xda\x6c\x53\xce\x7c\xa4\x65\x96\x46\xcf\x85\x24\xdb\x02\x47\xe8\x88\x00\x70\x91\xa7\x4d\x13\x51\xeb\xca\x99\xc8\x07\x0c\xcc\x9c\x58\x37\xc7\xeb\xc3\x6d\x81\xde\x6e\xdf\xd1\x1c\x57\xd2\xa5\xce\xac\x88\x33\x1f\xed\x4b\xb1\xbe\xd6\x43\x61\xb7\x8b\x40\xcc\x21\x9e\x6d\x55\x4e\x56\xd8\xf8\x4a\x17\xa5\x56\xa4\x9c\x8d\x67\x64\xd6\x64\xe2\x6b\x42\xb7\x32\x64\xe3\x69\xdd\x69\x77\x56\x41\x76\xbf\xef\xcb\x9b\x5e\x65\xd8\x6b\x6d\x62\x50\x2d\x08\x2e\x44\xfe\x3f\x5c\xac\x8c\x84\x64\xf2\x27\x4c\x87\xc6\xdb\xd4\x2b\x1d\x26\xbc\xdb\xd5\xbe\xb0\xdf\xfb\xc3\x7d\xa0\xac\x8c\x6c\x38\x75\x5b\x35\xa9\x5e\x9f\xb2\xac\xc2\xd2\x2e\xb5\xb3\xa7\x35\x00\x4e\x0a\xef\x24\xe5\xe9\x1c\xa5\x25\xce\xda\xe5\xa9\x0c\x7b\x42\x87\x1b\x92\x84\xd6\x1f\x9f\x67\x71\x08\xfa\x09\x87\xc7\x54\xea\xca\x71\x52\x27\x09\x91\xaa\x67\x14\x9c\xe5\x7b\x91\xf2\x52\xae\x16\x62\x10\xcb\x5f\x4d\xdc\xb3\xff\x97\xfb\x33\xb9\x9f\xce\xeb\x25\xe9\x9f\x0b\x39\x18\xec\xe8\x6d\xe0\xcc\x7b\xdf\x3a\xa0\xeb\xe7\x97\xdf\x9a\xa9\x30\x46\x9b\x63\xfe\xbc\x38\x00\xc7\x03\xfe\xf6\x3d\xf3\x2d\x3a\x13\xff\x99\x7e\xf4\x32\x3d\x77\x8d\x1e\xf4\x3e\xcf\x53\xaa\x1c\x19\x85\x32\xf9\x8f\xb3\x0e\xec\x0d\xd0\x37\x3e\x89\xa5\xa5\x0c\x26\x7a\x8c\x81\x77\x7f\x57\x1a\xdc\x0b\x95\x27\x70\xa5\xd5\x5c\x2c\xa6\x58\x06\x05\x39\x6c\x3f\x7a\x89\x77\x24\x6d\xd2\xec\xc1\xb2\x4c\x20\xc3\x82\x64\x74\x1f\x00\x28\x2c\xa8\x5b\x47\xc3\xaf\x29\xf8\x19\x00\x00\xff\xff\xff\x38\xb1\x8a\x38\x08\x00\x00"),
 		},
 		"/addons/camelk/platform.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "platform.yml.tmpl",
@@ -57,9 +68,9 @@ var assets = func() http.FileSystem {
 		"/addons/dv/addon-dv-server.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "addon-dv-server.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 4952,
+			uncompressedSize: 6158,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\x6f\x6f\xdb\xbc\x11\x7f\x9f\x4f\x71\xd0\x30\xb8\x05\x1e\xcb\x69\xda\x34\xad\x80\x60\x53\x62\x27\xf5\x10\xdb\xaa\xa5\x74\xc3\xda\xc2\x60\xa5\xb3\xcd\x44\x22\x39\x92\x72\x66\x18\xfe\xee\x03\x25\x5b\x96\x6c\xba\x49\x81\xbd\x78\xea\x37\x89\xc4\xfb\xf3\xe3\xdd\xf1\xf8\x3b\xb5\x81\x08\xfa\x05\xa5\xa2\x9c\x79\xb0\x78\x73\x02\xf0\x48\x59\xe2\x41\x88\x72\x41\x63\x3c\x01\xc8\x50\x93\x84\x68\xe2\x9d\x00\x00\xa4\xe4\x07\xa6\xaa\xfc\x1f\x80\x08\xe1\x81\x5a\xb2\x04\x15\x55\x9b\x77\xdb\x47\x97\xf2\xce\x73\xeb\x7a\x29\xd0\x03\xca\xa6\x92\x28\x2d\xf3\x58\xe7\x12\x2d\x62\x31\xcf\x04\x67\xc8\xf4\xce\x58\x3b\x59\x14\x82\x8c\x64\xb8\xff\x56\x09\x8c\x4b\x84\x82\x4b\xbd\x01\xdb\x2e\x1e\x3c\xf8\x70\xba\x71\x20\x24\xd7\x3c\xe6\xa9\x07\xd1\x75\xb0\x79\xa7\x89\x9c\xa1\x0e\x36\x82\x1b\x51\x85\x29\xc6\x9a\xcb\xff\xd7\xa6\x8f\xec\xa6\x99\x0a\x22\x84\xea\xd4\xf2\xd1\x45\x91\xf2\x65\x86\x4c\x1f\xa4\x64\xb5\x6a\x03\x9d\x02\x61\x09\xb8\xbe\xa0\x26\x73\x28\xdd\x7e\x46\x66\x18\x6a\x89\x24\x53\xe0\x76\x71\x11\xe6\xc2\x44\x60\xbd\x2e\x94\x08\x63\x5c\x13\x4d\x39\xab\x92\xf9\x97\xed\x5f\x08\x51\x2b\x20\xa0\x25\x9d\xcd\x50\x02\x67\xa0\xe7\x54\x41\x52\x61\x00\xcd\x81\x2f\x50\x3e\x49\xaa\xb1\x52\xd3\x73\x04\x8d\x99\x48\x89\x46\xd7\x24\xc1\xa5\x06\x84\x89\xb4\x40\xa9\x97\x05\x44\xc1\x69\xa1\xdf\xd0\xaa\xc5\x01\x4a\x1d\x55\x20\x77\xf7\xa0\x15\x6b\x2e\x17\xc8\xd4\x9c\x4e\x75\x51\x42\x25\x48\xe5\x41\xeb\xeb\xca\x99\x4a\x9e\x39\xde\xca\x31\x41\x73\x3c\xa7\x16\x84\x88\xcc\x9c\x3f\x1c\x53\x2e\x8e\xe7\xd4\xfc\x79\x06\xad\xd2\xce\xfa\x0f\x67\x4a\x31\x4d\x02\xa2\xe7\x46\xc2\xc0\x6f\x6e\x26\xe6\x4c\x13\xca\x50\xaa\xaf\x7f\x7b\xf5\x77\xd7\x98\xba\xbc\xfc\x56\xb7\xf5\xcd\x79\xfd\xbd\xdc\xb3\xb3\xfe\xde\xda\x24\x07\x59\xb2\x89\xf9\xef\x75\x76\x24\x8a\x94\xc6\x44\x79\xf0\xc6\x7a\x0c\x32\xa2\xe3\xf9\x5d\x63\x4b\xf6\x4d\x3d\xbf\xad\x17\x23\x56\x5a\x12\x8d\xb3\xe5\xd6\xa1\x44\xc5\x73\x19\x63\x0d\x41\x4a\x33\xaa\x6b\xcf\xe6\xb4\x64\x5c\x2e\x3d\x70\xce\xce\xdf\x0f\xa8\x53\xad\x48\xfc\x4f\x8e\xea\x98\xec\xe9\x4e\xb4\x8c\xf4\x18\x63\x89\x64\x53\xee\xdb\xca\xa8\x82\xd1\x38\x91\x87\xc9\x3e\x1e\x9b\x97\x44\xe7\xc5\x89\xff\x85\x50\xd6\x53\x5d\xa6\xb7\x68\xf8\x7e\x1c\xf3\x9c\xe9\x61\xb3\x34\x54\xd1\x53\x2a\xd9\xdd\x49\xd8\xe9\xb7\xad\xe5\xb4\xfd\x21\x5b\xdc\x48\x9e\xd5\xc3\x61\x54\x62\xce\xa6\x74\x36\x20\x62\x8c\xd3\xe6\x1a\x54\xf5\x99\x2c\xda\x4a\xf1\x76\x29\x7a\x20\xc3\x85\xe9\x61\x24\xf5\x40\xcb\x1c\x9b\x1e\xeb\x16\xb7\xf0\xfe\xe1\x7f\xf1\x27\x7e\x10\x4c\xba\xfd\x71\xc3\xd8\x82\xa4\x39\x7a\xd0\xd9\x35\x39\x75\x4c\x7d\x14\x44\xfd\xd1\x30\xb4\xa9\x3b\xed\xee\x03\x59\x10\x97\xa1\x76\x85\xc4\x29\xca\x7e\xb0\x78\x17\x6a\x12\x3f\x5e\x1a\x80\xd0\xee\xe6\x0a\xa5\x3b\xe7\x19\x5e\x76\x74\x26\xc0\xaa\xe0\x27\x89\x44\xa5\x50\x6d\x95\x52\x3e\x7b\xf7\xe0\xa6\xdc\xb4\x3a\x97\xcb\x99\x4b\x04\x89\xe7\xe8\xce\xb5\x16\x97\xdd\xde\xd5\xfd\xad\x63\x41\x3b\xf4\x07\xbd\x30\xf0\xaf\x7b\x87\x50\x0f\xb3\x01\x50\xf4\x3f\x6b\x26\xaa\xce\xe8\x55\x95\x5e\xf4\x3f\x25\x48\x8c\x16\xc7\xb7\xd7\x93\x81\xff\xaf\xc9\xa0\x17\xf9\x85\xff\x49\xd8\xff\xb7\x05\x84\x07\xce\xf9\x9b\x33\x1b\xf2\xab\xfb\xfe\x5d\x77\xd2\x1f\xf8\xb7\xbd\x49\x18\x8d\x7b\xfe\xc0\xa6\xbd\xab\xcf\x33\xea\xad\x56\xa0\xc9\x6c\x34\x05\x37\xdc\x9e\x80\xeb\x6d\xf9\x2b\x37\x3c\xeb\x97\x57\x22\x6c\x9a\x71\xd3\x5f\x30\x0a\xa3\xdb\x71\x2f\xfc\x7c\x37\x09\xfc\x30\xfc\xe7\x68\xdc\xb5\x39\x5c\xad\xac\xc6\xbb\x44\x93\x1f\x44\xa1\x1b\x10\xa5\x9e\xb8\x4c\x9e\xf3\x71\x1f\xf6\xac\xd5\xf7\x9c\xfd\x7b\x85\xf2\x39\xdb\x5d\x3f\xf2\xaf\xfc\xd0\x1a\xee\xe7\xec\x9b\x63\x6f\xb5\x3f\x0a\x7a\xc3\xf0\x53\xff\x26\x9a\x0c\xfc\xa1\x7f\xdb\x1b\xf4\x86\xd1\xe4\x7e\x7c\x37\xb9\x19\x8d\xdf\x86\xd7\xfe\x9d\xd5\x5d\xeb\x88\xbf\x0d\x45\xb9\x41\x62\x7a\x97\x72\x07\x84\x91\x19\x9a\x33\x77\x3f\xbe\xbb\xe1\xf2\xad\x8a\x49\x8a\xeb\x75\xcb\x82\xa4\x37\xec\x06\xa3\xfe\x30\x0a\x27\x51\x2f\x8c\x26\xe1\x7d\x10\x8c\xc6\xd1\xa4\x37\xf4\xaf\xee\x7a\xd6\xa4\xb5\x56\xab\x23\x35\xb1\x07\x23\x42\xa5\x37\x2c\x09\x8c\xf3\xd5\x8a\x4e\x2d\xd4\xa9\x89\xa7\xe8\x08\xc5\x09\xb4\xd6\xb7\x39\xbf\xce\x49\x9d\x05\x94\xbf\x82\x25\xec\x63\xf3\x93\x84\x33\xe5\x76\xbf\x54\xa5\xfa\x0c\x88\xc2\x4a\x90\xa7\x69\xc0\x53\x1a\x2f\x3d\xf0\xd3\x27\xb2\x54\xc6\x5f\xaa\xf0\xe7\xa2\xfd\xe9\x90\xeb\x40\xa2\x32\x9c\xf2\x10\x60\x4a\x17\xc8\x50\xa9\x40\xf2\x1f\xd8\xec\x08\xa6\xe9\xdc\xa2\xde\x6f\x13\xa2\x49\x9b\x6b\x0b\x45\xe3\x70\x3a\xc9\xa2\x43\x62\x9d\x13\xcd\x65\x67\x8e\x24\xd5\x73\x67\x4f\xd2\x58\xfe\x84\x24\x69\xdc\x2c\xcd\x78\xfb\x71\x8c\x42\x1f\x74\xa8\x6d\xae\x89\x28\x58\x8b\xb9\x14\x3a\x0f\x8a\xb3\x56\x43\x92\x32\xaa\x29\x49\xbb\x98\x92\x65\x88\x31\x67\x89\xf2\xe0\x7d\x13\xaf\x40\x49\x79\x52\xad\x9e\x35\x57\x35\xcd\x90\xe7\xba\x5a\x3e\xaf\xad\x4a\x24\x09\xfd\xc5\x98\xbd\x38\x34\x47\xa3\xfb\x7b\xc7\xac\x36\xa1\x6d\x31\x57\xec\x22\xb0\x6f\xb9\xdc\x93\xd9\xf6\xcf\xd4\x3e\x5e\x5c\x7c\xb4\xa8\x09\xc9\x33\xd4\x73\xcc\xd5\x4f\x7d\x5e\x5c\x7c\xb0\x28\x3f\xf0\x94\x3f\x52\x52\x5b\x79\xe2\xf2\x91\xb2\x59\x97\xca\xa3\xd4\x61\xc1\xd3\x3c\xc3\x81\x61\x55\xca\xc6\x48\x4a\x62\xd3\x2e\xc5\x1a\x4e\x33\xa3\x53\xde\xba\x75\xdb\x9d\x03\x2a\x54\x4c\x6c\xf0\x99\x87\x10\xa7\x44\x29\x33\x9d\x39\xb7\x39\x91\x84\x69\xc4\xc4\x81\x57\x25\x29\x86\xcb\xcb\x8a\xf4\xbe\x6e\xa8\x47\xc5\x78\xc7\x51\xb1\x96\x2e\xf6\x64\x66\xbe\x51\x38\x02\xa2\xcc\x7c\x26\x11\xa8\x19\x08\xa7\xf4\xbf\x98\x80\x34\x95\xd2\x50\x37\x53\x57\x49\xbc\x8d\xeb\x2d\x29\x87\x57\x1f\x4e\xff\x0a\x71\x2e\x25\x32\x9d\x2e\x5f\xbb\xd0\xda\x7a\x6f\x19\x7b\x74\xc6\xb8\xc4\xa4\x74\xd0\x38\x46\x07\xa4\x1e\xac\xc4\x1e\x6a\x84\xbd\x7e\xd3\xec\xda\xe8\x78\x6b\xca\x1d\x14\x72\x8d\x0e\x67\x7e\xb1\xc8\x3d\xb8\x38\x3f\xcd\x1a\xef\x6d\x93\x41\xdd\x59\x31\x48\xd8\x2c\xbd\xad\x5b\x2a\x33\x6a\xa1\xc8\xc7\x32\x5e\xb1\x61\xcf\x52\x7b\x7b\x5c\x7c\xcb\x87\x4f\xea\xd3\xff\xee\x96\x38\xf6\x25\x60\xbd\xde\xfb\xce\x60\x99\xa8\x6b\x5f\x1d\x6a\xaa\x07\x9f\x1d\xec\x74\xff\xcf\x35\xe3\xee\x46\x1c\x4d\x66\xd5\x87\xa0\x12\xb9\xb3\x19\xfc\x37\xf6\xa6\x0d\x42\x7c\xb0\xfd\x88\xec\x0e\x5c\xa9\xdf\x3a\xfc\x88\xd0\xaa\xee\xd1\xff\x05\x00\x00\xff\xff\x64\x1e\x9a\xb2\x58\x13\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\x5f\x73\xdb\xb8\x11\x7f\xf7\xa7\xd8\x61\xaf\xa3\x64\x26\xa2\x1c\xdf\xf9\x9c\xe3\x8c\xa7\x65\x2c\xc5\xa7\x8e\x25\xf1\x44\x3a\xd7\xf6\xee\x46\x83\x90\x2b\x0a\x31\x09\xb0\x00\xa8\x54\xa3\xea\xbb\x77\xc0\xff\x34\x29\xcb\xee\xe4\xa1\xd1\x8b\x24\x60\xff\x61\xb1\xbb\xd8\xdf\x0e\x81\x24\xf4\x23\x0a\x49\x39\xb3\x60\xfb\xf6\x0c\xe0\x81\xb2\xc0\x02\x17\xc5\x96\xfa\x78\x06\x10\xa3\x22\x01\x51\xc4\x3a\x03\x00\x88\xc8\x27\x8c\x64\xfe\x1b\x80\x24\x89\x05\x72\xc7\x02\x94\x54\x16\x6b\xe5\x5f\x93\xf2\xd1\xa9\x7d\xb5\x4b\xd0\x02\xca\xd6\x82\x48\x25\x52\x5f\xa5\x02\x7b\xc8\x7c\x1e\x27\x9c\x21\x53\xb5\xb0\x61\xb0\xcd\x08\x19\x89\xf1\xf1\xaa\x4c\xd0\xcf\x2d\x4c\xb8\x50\x85\xb1\xc3\xec\x8f\x05\xef\xce\x0b\x05\x89\xe0\x8a\xfb\x3c\xb2\xc0\xbb\x71\x8a\x35\x45\x44\x88\xca\x29\x08\x0b\x52\x89\x11\xfa\x8a\x8b\xaf\x75\xe8\x23\xa7\xd9\xef\x87\x40\xd7\x60\xba\x25\xa9\x1d\x04\x9c\x49\x73\xfc\xd1\x5c\xa2\xe4\xa9\xf0\x51\x9a\x1f\x79\x94\xc6\x78\x43\x12\xe2\x53\xb5\x3b\x1c\xce\x8e\x5e\xa0\xa3\xd7\xa4\x42\xa6\x0a\x9e\x88\xd0\xf8\x1b\xbf\x4e\xe2\xfb\x28\xe5\x8c\x07\x58\x5d\xea\x12\x49\xf0\xab\xa0\x0a\x17\xcc\xcf\x75\x89\xd2\x59\xe5\xa9\x04\xfe\x2b\x45\xa9\xaa\xff\x00\x52\x71\x41\x42\xb4\x60\xbf\x7f\xa1\xbb\xf5\x25\x21\x0b\x3a\x8e\x27\x49\x22\x47\x0d\xef\x8f\x31\x89\xf8\x2e\x46\xa6\x3a\x2e\x2f\xee\x99\xb0\x00\x4c\x3b\xa1\x3a\xd1\x50\x98\xd3\x98\x84\xe8\x2a\x81\x24\x96\x60\x8e\x71\xeb\xa6\x89\x0e\xd8\xc3\x21\x3f\x39\x63\x5c\x11\x45\x39\xab\x8e\xf1\xa7\xf2\x1b\x5c\x54\x12\x08\x28\x41\xc3\x10\x05\x70\x06\x6a\x43\x25\x04\x95\x0d\xa0\x38\xf0\x2d\x8a\x2f\xda\x51\x15\x9b\xda\x20\x28\x8c\x93\x88\x28\x34\xb5\x93\x4d\xaa\x8d\xd0\x89\x91\xa0\x50\xbb\xcc\xc4\x84\xd3\x8c\xbf\xc5\xd5\xb8\x1f\xc8\x79\x64\x66\xb9\xf9\xc8\xb4\x6c\xcf\xe4\x09\x32\xb9\xa1\x6b\x95\x85\x48\x6e\xa4\xb4\x60\xf0\xdb\xde\x58\x0b\x1e\x1b\xd6\xde\xd0\x4e\x33\x2c\xa3\xe1\x04\x8f\x84\xc6\x1b\x43\x87\x83\x61\x19\x0d\x7d\x96\xb6\x56\x2a\xe3\xf0\xc6\x58\x53\x8c\x02\x87\xa8\x8d\xa6\xd0\xe6\xb7\x0f\xe3\x73\xa6\x08\x65\x28\xe4\x6f\x7f\x79\xf5\x57\x53\x8b\xba\xbe\xfe\xbd\x29\xeb\x77\xe3\xf5\x1f\xf9\x99\x8d\xc3\x1f\x83\xe2\x72\xf2\xdb\xfd\xf6\x72\x43\x60\x12\x51\x9f\x48\x1d\xd4\xc7\xeb\x48\x4e\x03\x87\xc3\x7e\xff\x0c\x0a\x8c\x24\xc2\xe1\xf0\x56\xff\x64\x01\x14\x7e\x79\x5c\x0f\x63\xa2\xfc\xcd\x5d\xcb\x59\xfd\xee\x3a\xed\xb0\x67\xfb\x42\x2a\x41\x14\x86\xbb\x3a\xc7\x1f\x25\x3d\x40\x44\x63\xda\x4c\x7a\x9d\x87\x31\x17\x3b\x0b\x8c\x8b\xcb\x1f\x67\xd4\xa8\x76\xba\x05\xa2\x49\x7b\x5e\x93\xe6\x77\xb8\x44\x5f\x20\x29\x12\xa9\x8c\xb9\xca\x19\xad\x5c\xef\x86\xd1\x71\xdf\x3c\xc7\x3b\xcf\x0e\xa9\x17\xb8\xb2\x19\x44\xf9\xf5\x66\x2f\xbf\xed\xfb\x3c\x65\x6a\xde\x0e\x3a\x99\x55\xab\x8a\xb6\xce\xb1\x9a\x7f\xd8\x1b\xa8\xe5\x07\xd9\xf6\x83\xe0\x71\xd3\x1d\x9a\xc5\xe7\x6c\x4d\xc3\x19\x49\x96\xb8\x6e\xef\x41\x15\xf9\xc1\x76\x28\x25\x1f\xe6\xa4\x1d\x1a\x9e\xe8\xea\x48\x22\x0b\x94\x48\xb1\xad\xb1\x29\xb1\x34\xef\x6f\xf6\x47\x7b\x65\x3b\xce\x6a\x3c\x5d\xb6\x84\x6d\x49\x94\xa2\x05\xa3\xba\x7c\xca\x63\xec\x0b\xc7\x9b\x2e\xe6\x6e\x1f\xbb\x31\x1c\x7f\x26\x5b\x62\x32\x54\x66\x22\x70\x8d\x62\xea\x6c\x7f\x70\x15\xf1\x1f\xae\xb5\x81\x30\x1c\xa7\x12\x85\xb9\xe1\x31\x5e\x8f\x54\x9c\x40\x2f\x83\x1d\x04\x02\xa5\x44\x59\x32\x45\x3c\xfc\xe1\xb3\x19\x71\x5d\x44\x4d\x2e\x42\x53\xbf\x4d\x1b\x34\x37\x4a\x25\xd7\xe3\xc9\xfb\xfb\x5b\xa3\xc7\xda\xb9\x3d\x9b\xb8\x8e\x7d\x33\xe9\x9a\xda\xbd\x0d\x80\xac\xb2\xf6\xde\x44\x55\x73\xad\x2a\xd2\xb3\xca\x2a\x13\xe2\x63\x8f\xe2\xdb\x9b\xd5\xcc\xfe\xfb\x6a\x36\xf1\xec\x4c\xff\xca\x9d\xfe\xb3\xc7\x08\x0b\x8c\xcb\xb7\x17\x7d\x96\xbf\xbf\x9f\xde\x8d\x57\xd3\x99\x7d\x3b\x59\xb9\xde\x72\x62\xcf\xfa\xb8\xeb\xf8\xbc\xa0\xd6\x7e\x0f\x8a\x84\x8b\x66\x09\xbc\x29\xc3\x5f\x9a\xee\xc5\x34\x7f\x6c\xcb\x72\xd6\xd6\xe7\x2c\x5c\xef\x76\x39\x71\x7f\xb9\x5b\x39\xb6\xeb\xfe\xba\x58\x8e\xfb\x14\x36\x1b\x87\x86\xf0\x31\x51\xe4\x13\x91\x68\x3a\x44\xca\x2f\x5c\x04\xa7\x74\xdc\xbb\x93\xde\xe8\x3b\x25\xff\x5e\xa2\x38\x25\x7b\x6c\x7b\xf6\x7b\xdb\xed\x75\xf7\x29\xf9\x3a\xed\x7b\xe5\x2f\x9c\xc9\xdc\xfd\x79\xfa\xc1\x5b\xcd\xec\xb9\x7d\x3b\x99\x4d\xe6\xde\xea\x7e\x79\xb7\xfa\xb0\x58\x7e\xef\xde\xd8\x77\xbd\xea\x06\x3d\x8d\x96\xb7\x11\x88\xae\x4f\x22\x34\x67\x84\x91\x10\x75\xaa\xdd\x2f\xef\x0e\x87\x41\x8f\xde\xc9\x7c\xec\x2c\xa6\x73\xcf\x5d\x79\x13\xd7\x5b\xb9\xf7\x8e\xb3\x58\x7a\xab\xc9\xdc\x7e\x7f\x37\xe9\xbd\xa2\x41\xeb\x89\x6b\x46\x40\xde\x71\x7d\x40\xa2\x0b\xa6\x34\x3d\x94\xaa\xe8\xb6\x40\x2b\xdf\xef\xf5\xf3\xd9\x69\xc1\xda\xf6\x64\xf9\x9f\xe5\x5b\x6f\x34\xeb\x6c\x35\xce\x9a\xdd\x44\xfe\xc9\xba\x8d\xc7\xb6\xd5\xcf\x6f\x19\x98\x27\x8c\xc8\xa4\x38\x69\x14\x39\x3c\xa2\xfe\xce\x02\x3b\xfa\x42\x76\x52\xeb\x8b\x24\x3e\x4d\x3a\x5d\xcf\xb9\x72\x04\x4a\xdd\x9b\x76\x0d\x8c\xe8\x16\x19\x4a\xe9\x08\xfe\x09\xdb\xf9\xaf\x4b\xcc\x2d\xaa\xc7\x45\x21\x69\xa3\xa5\xc6\x46\x56\x26\x8c\x51\xb0\x1d\x11\x5f\xa5\x44\x71\x31\xda\x20\x89\xd4\xc6\x78\x44\xa9\x25\xff\x8c\x24\x68\xbd\x23\x6d\x7f\xdb\xbe\x8f\x89\xea\xd4\xa3\xf2\xae\x49\x92\xf5\x2d\xfa\x09\x18\x7d\x96\x9c\x0d\x5a\x94\x94\x51\x45\x49\x34\xc6\x88\xec\x5c\xf4\x39\x0b\xa4\x05\x3f\xb6\xed\x4d\x50\x50\x1e\x54\xbb\x17\xed\x5d\x45\x63\xe4\xa9\xaa\xb6\x2f\x1b\xbb\x02\x49\x40\x5f\xe8\xb3\x67\xbb\xe6\xa8\x77\xbf\x6d\x9f\x35\x80\x79\x69\x73\xd5\x4b\x38\xfd\x47\xce\xcf\xa4\x8f\xfd\x14\xdb\x4f\x57\x57\x3f\xf5\xb0\x25\x82\xc7\xa8\x36\x98\xca\x27\x75\x5e\x5d\xbd\xeb\x61\xfe\xcc\x23\xfe\x40\x49\x63\xe7\x0b\x17\x0f\x94\x85\x63\x2a\x8e\x36\x0a\xdb\x0c\x3a\xce\x74\x0f\x25\xfb\xfa\x8f\xbc\x8d\x19\xe6\x64\x2d\xa5\xb1\xe6\xc9\xdf\xd8\xa6\xec\x51\xd1\xf8\xfc\x6f\x73\x82\xae\x01\xc1\x76\xe8\xeb\xd6\xe1\xa8\xee\x2d\x11\x3a\x40\x73\xa2\x1a\xf8\xd6\xc4\x19\xf2\x84\x5f\xb8\x0b\x7e\x44\xa4\xd4\x28\xd3\xb8\x4d\x89\x20\x4c\x21\x06\x06\xbc\xca\x5b\x70\xb8\xbe\xae\x5a\xec\xd7\x2d\x76\x2f\x83\xa9\x1c\x25\x1b\xa8\xcc\xa7\x1a\xbb\x2e\xdc\x05\x10\xa9\x71\xa6\x40\xa0\x1a\xd8\xae\xe9\xbf\x31\x00\xa1\x23\xb5\xc5\xae\xd1\x63\xde\xe6\x6b\xd5\x25\x04\x80\x57\xef\xce\xff\x0c\x7e\x2a\x04\x32\x15\xed\x5e\x9b\x30\x28\xb5\x0f\xb4\x3c\x1a\x32\x2e\x30\xc8\x15\xb4\xd2\xb8\x03\x21\xa0\x17\x46\x40\x03\x1e\x9c\x1a\x20\xcc\x32\xba\x96\xd7\xf4\xc7\x4f\xd2\xa7\x71\x5a\xc9\x7f\xe3\xdc\x3f\x05\xd6\x3a\x64\x05\x62\xbb\xba\x3c\x8f\xdb\xa0\xad\x3e\x65\x17\xeb\x34\x0f\x94\x41\xa3\x3e\x6b\xbf\xbf\x3c\x8f\x9f\x8a\xbd\x39\x0f\xd0\x2d\x90\x61\x43\x27\x6b\x2c\xd7\xf3\x0f\x41\x58\x88\xf0\xdd\x03\xee\xde\xc0\x77\x59\x3d\x02\xeb\xfa\x25\x62\xb5\x98\x8c\x1f\x0e\x87\xfc\x39\x2d\xc4\x94\xdd\x43\x1d\xaf\xed\x5f\x47\xac\xf7\x78\x84\x22\x9f\xaf\x34\xb4\xa8\x7a\xd5\x3a\xd3\x6d\x25\xff\x07\x89\xa3\x53\x02\xe0\x3f\x40\x59\x80\x4c\xc1\x3b\x68\xe9\x2f\xc5\xe6\x39\xdf\x03\x99\x8e\xd5\x84\x0a\x1d\x59\x3d\xd5\xe9\x11\x36\x1b\x7e\x95\x32\xf1\x44\x91\x48\xfa\x06\x8b\x6d\xc3\x7c\xbd\x34\xef\xa0\xc0\xda\x13\x67\xcd\x21\x58\xdd\xe4\x1c\x1b\x88\x75\xc6\x6d\x3d\x83\xa5\xc6\xf0\xad\xc1\xda\x99\xbe\xf5\x63\xd3\xff\xaf\x51\x4f\x8d\xc7\x15\x09\xab\x49\x67\x6e\xb9\x51\xcc\xbf\x0a\x79\xeb\x16\x7a\xeb\x1c\xdf\x23\x35\x50\xce\xf9\x07\xdd\x59\xda\xa0\x6a\x03\xff\x1b\x00\x00\xff\xff\xe5\x45\x79\xf1\x0e\x18\x00\x00"),
 		},
 		"/addons/jaeger": &vfsgen۰DirInfo{
 			name:    "jaeger",
@@ -79,14 +90,38 @@ var assets = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x53\xcd\x6e\xdb\x3c\x10\xbc\xeb\x29\x06\xf0\x21\xdf\x07\x54\x6a\x83\x06\x41\xa1\x5b\x90\x1e\x9a\x5e\x12\xc0\x41\xef\x6b\x6a\x2d\xb3\xa1\x96\x2c\xb9\x32\x22\x18\x7e\xf7\x42\xbf\x4e\x8c\xa4\xbd\x96\x17\x91\xcb\xe1\x6a\x38\x33\x3c\x1c\x72\xd8\x2d\x48\x2a\xfc\x27\x5e\x51\xac\x3b\xa9\x38\xd9\x54\xdc\x54\x95\x97\x54\x7c\x27\xae\x39\x16\xb7\xce\xb2\xe8\xbd\xb8\xee\xff\x3f\x03\xef\x03\x47\x52\x1f\x07\xe8\xf1\x98\xe5\xa0\x60\x7f\x70\x4c\xd6\x4b\x89\x9f\x03\x48\x23\x19\x2b\x75\x61\xfd\xc7\xfd\x65\x06\x3c\x59\xa9\x4a\x8c\x0d\x32\xa0\x61\xa5\x8a\x94\xca\x0c\x00\x1c\x6d\xd8\xa5\x71\x0e\x50\x08\x25\xd2\xf4\xeb\xa9\x36\x2f\xfb\x7e\x7f\xdb\xd7\x2e\x70\x09\x2b\xdb\x48\x49\x63\x6b\xb4\x8d\xfc\x06\xcc\xf8\x26\x78\x61\xd1\x99\xf2\x80\x11\x6a\xf8\xd4\x3d\x5f\x76\x52\x60\x33\x12\x4c\x1a\x49\xb9\xee\x4a\x90\x73\x77\x72\x2f\x63\xf3\x79\x31\x82\x26\xcd\x07\x15\x6f\x82\x5d\x73\xdc\xf7\xc2\xb9\x66\xdd\x86\xe0\xa3\xe2\x78\x9c\x28\xad\xe6\x2f\xbe\xda\xed\xd6\x9a\xd6\x29\xd4\xc3\x78\xd1\xe8\x1d\x74\xc7\xb0\x0d\xd5\x8c\xfd\x28\x30\xac\x80\x04\xb3\x07\xdf\xda\x0d\x58\xf6\x67\xcd\x86\x13\x25\x2e\x0e\x87\x77\x4d\xbc\xeb\x21\x37\x13\x69\x1c\x8f\x17\x0b\x6f\x96\x6a\x61\xe7\x83\x5a\x2f\x8b\x35\xbd\x71\x8d\x8f\xdd\x69\x0d\x34\xf4\x9c\xf7\x6e\x73\x2a\x71\xf9\xa9\x1f\x33\x09\xa9\x23\xa7\x17\x67\x59\x68\xe3\xb8\x2a\xb1\x25\x97\x38\x5b\x65\x2b\x3c\xee\x6c\x82\x4d\x48\x1c\xf7\xd6\x70\x3f\xdd\x71\x64\x50\x02\x61\x47\xe6\xa9\xd7\xa2\xf1\x91\xc1\x94\xac\xeb\x40\xc6\x70\x4a\x83\x2c\xbf\x5a\x8e\x5d\x1f\x3d\x6c\xa3\x6f\x16\xcf\x3e\x60\xd3\x05\x4a\xc9\x4a\x9d\xad\x06\xa0\xa7\x56\x77\x08\xd1\x3f\x77\x45\x71\x96\xd6\x17\xe1\x5c\x8f\x1c\xfe\xfd\x74\xca\x70\xa1\xfc\x2c\xa4\xf9\xa0\xc7\xab\xa8\xf6\x49\x5b\x88\xe7\xd3\xe9\x19\x36\x8e\x1e\x52\xe2\xea\xea\xf3\xa9\x12\xbd\x7a\xe3\x5d\x89\xc7\xdb\x87\xa5\xaa\x14\x6b\xd6\x87\x01\x7d\x79\x7d\xfd\xe5\x7a\x7c\x0b\xec\xd8\xa8\x8f\xaf\xc4\x79\xc1\x77\xa8\x14\x4f\xed\x86\xa3\xb0\xf2\xf9\xcd\xc8\xb9\xdc\x4a\xee\x85\xdf\x45\xbf\xfd\x1e\x4f\x31\xfd\x1d\x00\x00\xff\xff\xe9\x2b\xd6\xa3\xdd\x04\x00\x00"),
 		},
+		"/addons/kafka": &vfsgen۰DirInfo{
+			name:    "kafka",
+			modTime: time.Time{},
+		},
+		"/addons/kafka/addon-kafka-cluster.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "addon-kafka-cluster.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 1481,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x53\xb1\x8e\xdb\x30\x0c\xdd\xf3\x15\xfc\x01\xab\xcd\x6d\xe7\xad\xb8\xb1\x43\x8b\x1e\xd0\x9d\x27\xd3\x29\x61\x99\x12\x44\x26\x40\x62\xf8\xdf\x0b\x3b\xb2\x93\xa0\xd7\x34\xe8\xd2\xa1\x9b\xf5\xde\xd3\x23\xf1\x9e\x3c\x0c\x15\x70\x0b\xee\xf5\x28\x0d\x29\xab\xfb\xd4\x34\x51\xd4\x7d\xc6\xb6\x43\xf7\x35\xc7\x03\x2b\x47\x19\xc7\x4d\x05\x98\xf8\x3b\xe5\xe9\x58\x43\x37\xf3\x6a\x99\xfb\x13\x3b\x8e\x1f\x0e\xdb\x37\x32\x7c\xda\x00\x74\x2c\x4d\x0d\xb3\xc1\x06\xa0\x27\xc3\x06\x0d\xeb\x0d\x00\x40\xc0\x37\x0a\x7a\xfe\x06\xc0\x94\x6a\xd0\x32\xb9\x60\xcb\x71\xf2\xfc\x13\x6f\xc7\x44\x35\xb0\xb4\x19\xd5\xf2\xde\xdb\x3e\xd3\x3b\x32\x1f\xfb\x14\x85\xc4\x2e\x66\x55\x57\xd6\x03\x10\xec\xe9\x1d\x42\x13\xf9\xf3\x9e\x33\xb2\xac\x9c\x29\x05\xf6\xa8\x35\x0c\xc3\x9d\xe0\xbe\x15\x19\x8c\xe3\x30\x3c\x26\xa2\xa0\x04\xe3\xb8\x9d\x3e\xa5\x81\x71\x2c\x13\xef\x37\x54\x0a\x59\xd5\x87\xa5\xa0\x61\x78\xf0\xc6\xe4\x4f\xd2\xac\xe7\xc0\x6a\x24\x94\xd7\x96\x00\xaa\x12\x52\x0a\xc8\xb2\xa2\x00\x29\x66\xab\xe1\xf9\xe3\xf3\xd3\x15\xb8\x94\x62\x94\x05\xc3\x35\x11\xb4\x86\x16\x83\x2e\x1d\xf9\x28\x2d\xef\x2e\x63\x62\xdb\x2a\x99\x3a\x8b\x89\xbd\x2b\x49\x1b\x47\x71\x2d\x7a\x8b\xb9\x86\xed\xaa\xb5\x8c\xa2\xe8\x67\x56\x0d\x8d\x5c\x88\xbb\xbf\xba\xd3\xb3\x38\xd6\x2b\xa1\x5a\xcc\xb8\xa3\xcb\x5e\xf7\x1b\x78\x3d\xcb\x5f\x30\xa1\x67\x3b\xae\x39\x2e\x49\xa4\x29\xef\x29\x52\xab\x7c\x40\xee\x57\x5a\xf9\x44\x77\x7a\xfa\xbd\x6f\x43\x81\x8c\x5e\x26\xb3\xdb\x40\x4b\x99\x41\xe9\x97\x2d\x28\xfd\xa0\x9e\xf2\x55\x21\xb7\xbd\x9f\x62\xec\x88\x12\xe5\x7f\xf7\xd0\xff\xcb\xdc\x49\x8c\xed\xf8\x25\x51\xc6\xe9\xb5\x16\xd5\xfc\x07\xac\x20\x0c\x8b\xeb\x5e\x29\xdf\xc0\x17\xb3\x9f\x01\x00\x00\xff\xff\x6a\xc8\xd5\xb7\xc9\x05\x00\x00"),
+		},
+		"/addons/keycloak": &vfsgen۰DirInfo{
+			name:    "keycloak",
+			modTime: time.Time{},
+		},
+		"/addons/keycloak/addon-keycloak.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "addon-keycloak.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 2336,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x56\xdf\x4b\x23\x31\x10\x7e\xef\x5f\x31\xf8\xe2\x53\xab\x15\x14\xc9\x9b\xa8\xdc\x89\xf6\x5c\xaa\x1c\xdc\x93\xc4\xec\xb4\x1d\xcc\x66\x72\xc9\x6c\x61\x29\xfd\xdf\x8f\x6d\x77\x75\x53\xab\xed\xc1\x71\x60\x9e\x9a\xc9\xfc\xf8\xbe\xf9\x32\xd9\x2e\x16\x7d\xa0\x09\x0c\x1e\x2a\x97\x63\xa4\x38\xb8\xc8\x73\x76\x71\x70\x8b\x95\xb1\xac\x5f\x06\x59\xe0\x39\x45\x62\x07\xcb\x65\xaf\x0f\xda\xd3\x4f\x0c\xf5\x5e\xc1\x7c\xd8\x03\x78\x21\x97\x2b\x78\xc0\x30\x27\x83\x3d\x80\x02\x45\xe7\x5a\xb4\xea\x01\x00\x58\xfd\x8c\x36\xae\x7f\x03\x68\xef\x15\xc4\xa6\x52\x63\x6b\xb7\x03\xe2\xa3\x5d\xe7\x52\x79\x54\x40\x6e\x12\x74\x94\x50\x1a\x29\x03\x6e\x71\x33\x5c\x78\x76\xe8\x44\xc1\x4b\x43\x63\xe5\xe5\x74\x81\x89\x29\x7a\x34\x6b\x6c\x9e\x83\x34\x30\xfb\xab\x8d\x82\xf3\xe3\xf3\xe3\x26\xb9\x0f\x2c\x6c\xd8\x2a\x78\xbc\xcc\x1a\x9b\xe8\x30\x45\xc9\x52\xd7\x88\x16\x8d\x70\xf8\x57\x84\xb7\x31\x49\x35\x08\x5c\x0a\x0e\xd8\xa3\x8b\x33\x9a\x48\x1d\xd4\x91\x65\x5c\x9f\x7e\x59\x51\x76\xde\xcd\x15\xbd\xef\x1c\xa5\x4e\xb2\x5c\xae\x82\x66\x1c\x45\xc1\x62\xf1\x77\x51\x75\x29\x74\x79\xb3\x5b\x5d\x80\xcf\x74\x96\xb7\xf6\x91\x8b\x68\xca\x80\xd7\xf9\x14\x1f\x31\x14\xe4\xb4\x10\xbb\x8c\x2d\x99\x4a\xc1\x18\x73\x0a\x68\xa4\xcd\xf6\xe6\xa1\x00\xf3\xe9\xba\x53\xc2\x6d\xb6\xcd\x69\xda\xd2\xa1\x54\x7f\xed\x7d\xec\x2a\x7e\x85\xde\x72\x55\xa0\x93\x2f\x2b\x7b\x40\x6f\xc9\xe8\xa8\x60\xb8\x75\xa8\x0a\x2d\x66\x76\x97\x90\xd9\x4e\x67\x37\xa1\xfd\xb0\x46\x09\x5a\x70\x5a\xbd\x5e\x89\x15\xf5\x31\x9a\x80\x5a\x1a\x05\xb1\xf0\x56\x0b\xbe\x62\x4c\x3a\xff\xbe\xfb\x1f\x43\xde\x07\xf4\xde\x4a\xec\xcb\xb0\xdb\xfe\x7a\x19\x76\xa2\xc9\x61\xe8\x00\xee\xbf\xd7\xac\x5d\x54\xe8\x29\x2a\x38\xfc\x6c\xea\x6e\x6a\x9f\xe5\xf2\x70\x33\x2c\x2b\xad\x6d\x87\xe5\x66\xf2\x83\x25\x0b\x18\xd7\xd7\xb7\x5d\xe8\xe6\xdd\xbe\xed\x7e\x16\x50\xdb\xa2\x19\xe5\x14\xfb\xed\xf5\xaf\xcb\xbb\xfb\x8b\xdb\xa7\x9b\x51\x76\x3f\x7e\x7c\x1a\x5f\x5f\xdc\x8d\x3a\x7e\x00\x73\x6d\x4b\xdc\xf1\x7e\xbc\x4b\x9f\xbe\x1e\xeb\xd5\xf9\xa2\xb4\x18\x5e\xbb\x9a\x6d\x7e\x60\xd6\x6b\x8d\x71\x26\xe2\x3b\x66\x4b\x73\x74\x18\x63\x16\xf8\x19\x55\xe2\x5f\x7b\x7e\x43\x49\x8d\x00\x5e\xcb\x4c\xc1\xc1\xd1\xc1\xa6\x7d\x7b\x55\x72\x24\xa4\xed\x15\x5a\x5d\x3d\xa0\x61\x97\x47\x05\x67\x5d\x9f\x80\x3a\xa7\xff\x8f\x61\x98\x62\x88\x5c\x06\x83\x31\xad\x64\xa9\x20\x89\x9b\xd5\x0b\x2c\x38\x54\x0a\x4e\x87\x27\x23\x4a\xce\x02\xfe\x2e\x31\x7e\x1c\x71\x72\x7a\x36\xa2\x5e\xa3\x67\xfd\x8f\xe7\x4f\x00\x00\x00\xff\xff\xcf\x4a\xd7\xd7\x20\x09\x00\x00"),
+		},
 		"/addons/knative": &vfsgen۰DirInfo{
 			name:    "knative",
 			modTime: time.Time{},
 		},
-		"/addons/knative/empty.yml": &vfsgen۰FileInfo{
-			name:    "empty.yml",
-			modTime: time.Time{},
-			content: []byte("\x23\x20\x45\x6e\x61\x62\x6c\x69\x6e\x67\x20\x74\x68\x65\x20\x4b\x6e\x61\x74\x69\x76\x65\x20\x61\x64\x64\x6f\x6e\x20\x73\x69\x6d\x70\x6c\x79\x20\x63\x68\x61\x6e\x67\x65\x73\x20\x74\x68\x65\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x20\x6f\x66\x20\x6f\x74\x68\x65\x72\x20\x6d\x6f\x64\x75\x6c\x65\x73\x0a"),
+		"/addons/knative/addon-knative-broker.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "addon-knative-broker.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 1044,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x53\xcd\x6e\xa3\x30\x10\xbe\xf3\x14\xf3\x02\x10\xed\x95\xdb\x6e\x4e\xab\xaa\x6a\xa5\x48\xbd\x4f\xf0\x90\xb8\xb1\xc7\x96\x3d\x41\x8a\x28\xef\x5e\xc5\x26\xa1\x2a\xb4\x49\x8f\xe5\x04\xe3\x31\xdf\x7c\x3f\xd3\xf7\x25\xe8\x16\xaa\xcd\x89\x15\x45\x1d\xab\xbf\x4a\x39\x8e\xd5\x03\xa3\xe8\x8e\xaa\xf5\x1e\x99\xc9\xfc\xb7\xde\x90\x25\x16\x14\xed\x78\x18\x8a\x12\xd0\xeb\x17\x0a\x51\x3b\xae\xa1\xfb\x53\x00\x1c\x34\xab\x1a\xd6\x8e\x5b\xbd\x7b\x44\x5f\x00\x58\x12\x54\x28\x58\x17\x00\x00\x06\xb7\x64\x62\x7e\x07\x40\xef\x6b\x88\x23\xea\x58\xbb\x7c\x56\xda\xad\x6e\x9d\xcb\xc9\x53\x0d\x9a\xdb\x80\x51\xc2\xb1\x91\x63\xa0\x85\xb6\xc6\x59\xef\x98\x58\xa6\x9f\x95\xdb\xe0\x0e\x14\x52\x33\xa3\xa5\xd9\x49\xd9\x64\xd2\x05\xc0\x34\xfd\x58\x2b\x85\xac\x37\x28\x54\x46\x4f\x4d\x0d\x6f\x57\x3a\x93\x1a\x96\x62\xc4\x9d\xe6\x5d\x75\x18\x55\x54\xd4\xad\x92\x46\xe7\x27\xeb\xd4\xf7\x3f\xd6\xfc\xec\x15\xb1\x9a\xa9\x4f\x1d\xb1\x2c\xc2\x65\xa8\x7f\x17\xbe\xbf\xc5\x0f\x45\x2d\x1e\x8d\xa4\xca\xad\x80\x66\x72\x6b\x83\x31\x0e\x43\xba\x81\xcc\x2e\x6b\x76\x25\xb7\xa8\x50\x86\xad\x9a\xf3\xd5\x6f\xfd\x98\x43\x4c\x4e\x00\xa4\x1c\xdc\x35\xea\x57\xbe\xa6\x78\xa5\xb5\xa9\x17\xd2\xf4\x29\x37\x1f\xf7\xeb\x9e\x04\x5f\x7a\xa2\xc7\x86\x12\xcd\x27\x4f\xbc\xd9\xeb\x56\x9e\x83\x7b\xa5\x46\x66\x9c\xde\x03\x00\x00\xff\xff\x29\xe1\x2e\x7c\x14\x04\x00\x00"),
 		},
 		"/addons/ops": &vfsgen۰DirInfo{
 			name:    "ops",
@@ -176,6 +211,13 @@ var assets = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x54\xdf\x8b\x1b\x47\x0c\x7e\xbf\xbf\x42\x38\x29\xb1\xc1\xde\xf5\x3d\xf8\x21\x03\x67\xb8\x87\x94\x14\x0a\x3d\x8e\x50\x02\xa5\x18\x79\x57\xde\x9d\xdc\xee\x68\x22\xcd\xf8\x6a\x8c\xf3\xb7\x97\x19\xff\xa6\x21\x6d\xc3\xbd\xb4\xfb\x34\xab\x91\xf4\x7d\xfa\x24\x0d\x7a\xfb\x2b\x89\x5a\x76\x06\x7a\x76\x36\xb0\x58\xd7\x14\x15\x0b\xb1\x16\x15\xf7\xe5\xfa\xf6\xe6\xc9\xba\xda\xc0\x83\x70\x4f\xa1\xa5\xa8\x8f\xb1\xa3\x9b\x9e\x02\xd6\x18\xd0\xdc\x00\x74\xb8\xa4\x4e\xd3\x09\x00\xbd\x37\xa0\x1b\x57\x93\x5a\xcd\x96\xe3\x4f\x61\xb9\xfc\xf6\x6d\xd8\x78\x32\x60\xdd\x4a\x50\x83\xc4\x2a\x44\xa1\xec\xe4\x4f\xd8\x26\x01\x74\xb6\xc2\x60\xd9\x4d\xce\x94\xb3\x9b\x70\x47\x06\xb0\x23\x09\x13\x89\x1d\xed\x11\xce\x4e\x93\x27\xda\x18\xe8\x6d\x5d\x77\xf4\x8c\x87\xdc\x5f\xcf\x67\x60\x10\x24\xd2\xe0\x06\xc0\x61\x4f\x67\xd2\x93\x4c\x6f\x92\xca\x9f\x64\xa4\x94\x77\x0f\xa6\x9e\xaa\x24\x42\x23\x1c\xfd\x41\x8e\xc9\x3f\x0f\x87\xfc\xe5\xb3\x39\xfc\x00\xbc\x82\xfb\xe4\x05\x2b\x16\x40\xb7\x39\xe7\x49\x19\xe0\xfe\xe1\x27\x20\x57\x7b\xb6\x2e\xc0\x73\x6b\xab\x16\x5a\x54\x40\x68\x6d\xd3\x82\x60\x20\xe0\x15\xbc\xff\xf0\xe1\x01\x66\x1f\x3f\x82\x90\x7a\x76\x7a\xc2\x4a\xf4\x32\x09\x03\x3f\x46\xa5\x5f\x5c\x67\x1d\x3d\x92\x86\x7b\x6f\xdf\xdb\xa6\x7d\x77\x48\xfd\x4e\x84\xe5\x11\x03\x9d\xe2\x00\xd0\x39\x0e\x59\xb5\x0b\xb6\x59\x6e\x52\xc5\x86\x0c\xcc\x27\x57\x76\xc8\x18\xb0\x07\x01\x25\x59\xdb\x8a\x60\xbb\x7d\xbd\x9f\x9e\xe2\x60\xd9\xed\xc0\x3a\xf0\x5c\x5f\x5c\x79\xae\x77\xbb\x71\x56\x52\x3d\x5e\x45\x9d\x6c\xc9\x21\xd7\xee\x80\x12\xdd\x7d\xf5\xc3\x63\xc9\x50\x71\x4d\x30\xbf\x83\xd9\x74\x3a\x4a\xa2\x6c\xb7\xaf\xd7\xd8\xc5\x84\x97\xfd\x15\x3c\x09\x28\x55\xec\x6a\x60\x77\x96\xf5\xcd\x19\x2c\xcd\x60\xa2\x72\x81\x1f\xc5\xee\x76\x6f\x8a\xab\x4a\x95\xfd\x22\x4a\x67\xa0\x0d\xc1\xab\x29\xcb\xc6\x86\x36\x2e\xf3\x3a\x1d\xfb\x67\xf9\x74\x2c\x97\x1d\x2f\xcb\x1e\x35\x90\x94\x35\x57\x65\x8f\x0e\x1b\xeb\x9a\x05\xb9\xb5\x15\x76\x3d\xb9\xa0\x65\x60\x6f\x2b\x2d\x8f\x43\xb3\x50\xf6\x05\xd6\x5c\xbd\x5a\x45\x25\xce\xaa\x0a\x69\x40\x6f\x53\xf3\x8f\xf4\x73\x6d\x72\xdd\x3a\xfa\xc3\x8b\x81\xf9\x15\xe7\xa1\xc6\x7e\x98\xfc\x86\x89\xf4\x22\x35\x83\x64\x21\xf4\x39\x92\x06\x5d\xec\x75\xd1\x45\xc5\xd1\x85\xad\x06\x0c\x51\xef\xbe\x0c\x66\x45\x31\x18\xc3\x27\x5e\xde\x7d\x19\x5c\x4d\xe6\x60\xf7\xdb\xac\xff\x7d\x34\x82\xe5\x06\x86\x9f\x78\x39\xf6\x5c\x8f\x4f\xbd\x1a\xef\x85\x1c\x47\xb1\xe3\x43\xdb\x47\x23\x28\xbf\x9f\xd0\x0b\x31\x98\xc3\xb4\xb8\xbd\x60\xb1\x62\x31\x30\xeb\x2f\x2c\x97\x2f\xdd\xa9\xdd\xb4\x26\xb1\x61\x63\xe0\x19\xc5\xa5\xb7\xe8\xfb\xb6\x97\x84\xe0\xed\xf4\x87\x34\x9c\xc7\x32\xa1\xc5\x35\x01\x42\x87\x81\x5c\xb5\x49\x57\x73\xb8\xfd\xb7\xeb\xfb\xf3\x3e\xfa\x3f\xb0\xbc\xf9\xd9\x3a\x16\x9b\x44\xfb\x9f\x6c\x61\xf7\x97\x0e\x7c\x6d\x07\x5b\xab\x81\x1b\xc1\x7e\xf1\x39\xa2\x0b\xb6\xa3\xe1\xb4\x78\x3b\x86\xbf\xdf\x82\x65\xac\x9e\xe8\xdb\x6b\x30\x87\xdb\x62\xfa\x02\xb3\xfd\x67\x00\x00\x00\xff\xff\x26\x50\x05\xf0\x32\x08\x00\x00"),
 		},
+		"/addons/ops/addon-ops-operator-servicemonitor.yml": &vfsgen۰CompressedFileInfo{
+			name:             "addon-ops-operator-servicemonitor.yml",
+			modTime:          time.Time{},
+			uncompressedSize: 364,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x41\x4a\x04\x41\x0c\x45\xf7\x7d\x8a\x5c\xa0\x7a\x70\x5b\x67\x50\x10\x04\xf7\xb1\xea\x33\x13\xa6\x2b\x29\x52\x61\xa4\x6f\x2f\x3d\xad\xd8\x20\xce\x32\xc9\x5b\xbc\x17\xee\xf2\x0e\x1f\x62\x9a\xa9\x99\x4a\x98\x8b\x9e\xe7\x62\x0e\x1b\x73\xb1\x76\xba\x3d\x4d\x57\xd1\x9a\xe9\x0d\x7e\x93\x82\x97\x9d\x9a\x1a\x82\x2b\x07\xe7\x89\x48\xb9\x21\xd3\x58\xb5\x62\xc8\x48\xd6\xe1\xbc\x31\x44\x0b\x7f\x60\x19\x1b\x43\xc4\xbd\xff\x42\xf7\xcd\xcf\x30\x8b\x9d\x1e\x5f\x8b\xb5\x6e\x0a\x8d\x4c\x63\xf7\x48\xdf\xba\x7f\xd0\x58\x3b\x32\x1d\x1c\xe8\x50\x96\xae\x58\x33\x35\xa9\x75\xc1\x27\x3b\xa6\xd1\x51\x36\x3d\x68\xed\x26\x1a\x77\xd7\x44\xc1\x7e\x46\xbc\x9a\x47\xa6\x4b\x44\x4f\x0d\xe1\x52\x36\xb1\x81\x05\x25\xcc\xf7\xa8\xc6\x51\x2e\xcf\x87\xca\xff\xbf\xf1\x15\x00\x00\xff\xff\x93\x0e\x43\xbb\x6c\x01\x00\x00"),
+		},
 		"/addons/ops/addon-ops-server-alerting-rules.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-server-alerting-rules.yml",
 			modTime:          time.Time{},
@@ -186,9 +228,9 @@ var assets = func() http.FileSystem {
 		"/addons/ops/addon-ops-servicemonitor.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-servicemonitor.yml",
 			modTime:          time.Time{},
-			uncompressedSize: 536,
+			uncompressedSize: 568,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xc1\x4e\x03\x31\x0c\x44\xef\xfb\x15\xfe\x81\x6d\xc5\x35\x77\x0e\x48\x20\x21\x21\x71\x77\x93\xa1\x44\xdd\xd8\x91\xe3\x16\xfa\xf7\x28\xbb\x65\x8b\x44\x05\xc7\x8c\xc7\xce\x1b\x9b\x6b\x7e\x85\xb5\xac\x12\xa8\xa8\x64\x57\xcb\xb2\xdf\x44\x35\x68\xdb\x44\x2d\xdb\xd3\xdd\x70\xc8\x92\x02\xbd\xc0\x4e\x39\xe2\x69\x71\x0d\x05\xce\x89\x9d\xc3\x40\x24\x5c\x10\xa8\x9d\x25\xa1\xe5\x36\x66\x79\x33\x1e\x88\x26\xde\x61\x6a\xdd\x40\xc4\xb5\x5e\x1d\xb3\xf2\xfd\xd8\x64\xdd\xfe\x5d\x8d\x5a\xaa\x0a\xc4\x03\xb5\x05\x62\xbc\xb0\xfe\xb2\xfa\xb9\x22\xd0\x0c\xd0\xdc\x8e\xd1\x8f\x86\xd9\x74\x0d\x37\x1e\x70\x0e\x54\x72\x4a\x13\x3e\xd8\x30\xb4\x8a\xd8\x21\x21\xa9\x6a\x16\x9f\x89\x47\x72\xb6\x3d\xfc\x59\xcd\x03\x15\xb8\xe5\xd8\xc9\x1a\x26\x44\x57\x5b\x52\x15\xf6\xf8\xfe\xf8\x23\xe6\x7f\xb1\xe6\x86\xfb\xcf\x6a\x68\x7d\xe9\x6b\xd7\x48\x33\xd5\xcd\xd4\x17\x0b\x91\x56\x18\xf7\xbf\xe9\x41\x56\xf1\xc4\xd3\x11\xeb\x9c\x65\xd6\x7a\x89\xb4\xbb\xad\xf7\xe3\xdd\xae\xf4\x05\xc3\x86\xaf\x00\x00\x00\xff\xff\x64\xc0\xa3\x41\x18\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xc1\x4e\x2b\x31\x0c\x45\xf7\xf3\x15\xfe\x81\x69\xf5\xb6\xd9\xbf\x05\x12\x48\x48\x48\xec\xdd\xe4\x52\xa2\x4e\xec\xc8\x71\x0b\xfd\x7b\x94\x99\x32\x45\xa2\xc0\x72\xec\x6b\xcf\x39\x31\xd7\xfc\x0c\x6b\x59\x25\x50\x51\xc9\xae\x96\x65\xbf\x89\x6a\xd0\xb6\x89\x5a\xb6\xa7\x7f\xc3\x21\x4b\x0a\xf4\x04\x3b\xe5\x88\x87\x25\x35\x14\x38\x27\x76\x0e\x03\x91\x70\x41\xa0\x76\x96\x84\x96\xdb\x98\xe5\xc5\x78\x20\x9a\x78\x87\xa9\xf5\x00\x11\xd7\x7a\x4d\xcc\x95\xcf\x8f\x4d\xd6\xed\xef\xdd\xa8\xa5\xaa\x40\x3c\x50\x5b\x20\xc6\x0b\xeb\xb7\xa8\x9f\x2b\x02\xcd\x00\xcd\xed\x18\xfd\x68\x98\x43\x57\xb9\xf1\x80\x73\xa0\x92\x53\x9a\xf0\xc6\x86\xa1\x55\xc4\x0e\x09\x49\x55\xb3\xf8\x4c\x3c\x92\xb3\xed\xe1\x8f\x6a\x1e\xa8\xc0\x2d\xc7\x4e\xd6\x30\x21\xba\xda\x62\x55\xd8\xe3\xeb\xfd\x17\xcd\xbf\xb4\xe6\x81\xff\xef\xd5\xd0\xfa\xa3\xaf\x53\x23\xcd\x54\x37\xad\x2f\x11\x22\xad\x30\xee\xff\xa6\x3b\x59\x8b\x27\x9e\x8e\x58\xf7\x2c\xbb\xd6\x4b\xa4\xdd\x4f\xf5\xf1\xaa\x74\xa3\xdf\x8f\x7b\xbb\xd3\x0f\x00\x1b\x3e\x02\x00\x00\xff\xff\xcf\x3d\xa9\xd3\x38\x02\x00\x00"),
 		},
 		"/addons/publicApi": &vfsgen۰DirInfo{
 			name:    "publicApi",
@@ -197,20 +239,20 @@ var assets = func() http.FileSystem {
 		"/addons/publicApi/addon-public-oauthproxy.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "addon-public-oauthproxy.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 4411,
+			uncompressedSize: 5590,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x51\x6f\xdb\x36\x10\x7e\xcf\xaf\x20\xf4\xd2\x0d\xa8\xac\xb4\x6b\x87\x81\x80\x1f\x82\x34\x5d\x82\xb6\x89\x10\x7b\xc3\xf6\x14\x9c\xa9\x8b\xcd\x99\x22\x39\xf2\xa4\x4d\x33\xfc\xdf\x07\x4a\xb6\x22\x59\x4a\xe2\x16\x03\x5a\x4c\x4f\x91\xee\x8e\xfc\xbe\xfb\x8e\xc7\x8b\x63\x06\x56\xfe\x8a\xce\x4b\xa3\x39\x2b\x5f\x9d\x30\xb6\x96\x3a\xe3\x6c\x86\xae\x94\x02\xcf\x84\x30\x85\xa6\x13\xc6\x72\x24\xc8\x80\x80\x9f\x30\xc6\x98\x86\x1c\x39\xf3\x95\xce\xd0\x4b\x1f\xdb\x62\xa1\xa4\x88\x0d\x14\xb4\xb2\xce\xfc\x5d\xd5\x4e\x0a\x16\xa8\x7c\x13\xc0\x18\x58\xfb\x10\xb1\xfb\xb6\x7f\x9d\x48\x93\x3c\x67\xa7\xca\x22\x67\x52\xdf\x3b\xf0\xe4\x0a\x41\x85\xc3\x11\x37\x61\x72\x6b\x34\x6a\x7a\x12\xde\x73\xbc\x07\x84\xbf\x61\x2e\x35\x20\xad\x0d\x01\x49\xa3\x5b\x8c\xbe\x61\x32\x01\x65\x57\x30\x31\x16\xb5\x5f\xc9\x7b\x0a\xeb\xd6\x26\xbd\x8c\x05\x3a\x8a\x3d\x0a\x87\x14\x3f\xa7\x68\x4c\xca\x1f\x2b\xbd\xb7\x28\x1a\x18\xd6\x38\xda\x21\x8a\xeb\x17\xce\x7e\x7a\xf3\xe6\x87\x1d\x44\xeb\x0c\x19\x61\x14\x67\xf3\xf3\x74\xf7\x8d\xc0\x2d\x91\xd2\xbe\xab\x47\x85\x82\x8c\xfb\xaf\xf2\xff\x25\x45\xe2\x4c\x41\xd8\xcf\x63\xa7\x6e\x6e\x83\xf5\x6b\x55\xcd\x88\xfa\xc2\x68\x6f\xd4\xa8\xfa\xa6\x0c\xf2\xe3\x5f\x31\x58\x1b\xd7\xac\x38\x8b\xc8\x15\x18\x3d\xa1\x2f\x58\xd9\x13\x76\x65\x3c\x71\xb6\xd9\xb0\xc9\x6c\x8f\xf0\x2c\xcb\x8c\xf6\x93\xb4\x0e\x38\x4b\xaf\x26\x75\x4e\x2e\x8d\xa7\xb0\x24\xdb\x6e\xdb\x8a\xe0\x4f\x69\x4d\x0f\x09\x93\xda\xa3\x28\x1c\x5e\x64\x4b\x9c\xa3\xcb\xa5\xae\x49\xa6\x46\x49\x51\x71\x76\x8b\x99\x74\x28\x68\xbf\xda\x83\x07\x67\x0e\x51\x0b\x57\xd9\xc6\x48\x66\xbf\xe4\xe1\x29\x3f\xaa\xa2\xfb\x85\x00\xd6\xfa\xae\xf4\xef\xd0\x2a\x53\xe5\x38\xd2\x26\xbf\xf1\xae\xf1\x59\x47\xd9\xa1\x55\x52\x80\xe7\xec\xd5\xe8\x99\xcc\x81\xc4\xea\x63\x8f\xf0\x38\xe5\xe7\x49\x7f\x29\x1f\x4f\x0e\x08\x97\xd5\x7e\x7f\x87\xde\x14\x4e\x60\x07\x90\x92\xb9\xa4\xce\x7b\x50\x2c\x37\xae\xe2\x2c\x7a\xfd\xf6\xc7\x4f\x32\x6a\x2d\x0e\xff\x2c\xd0\x3f\xe6\x7b\xfa\xe0\xda\xc8\x72\x1b\xda\x28\x50\x23\x08\x61\x6e\x15\x10\xb6\xb9\xe9\x55\xc5\xb0\x32\x1e\x4f\xd5\x31\xc9\x3a\xba\x4a\xbe\x3c\xb3\xdd\x42\x60\x4d\x7b\x21\x90\x1a\x5d\x87\x42\x7c\xdc\x54\xd0\x3c\x32\x87\x25\x72\xf6\xa2\xd7\x41\xce\xf7\x78\xfc\xe4\x26\x04\x4d\xae\x82\x17\xdb\x6e\x5f\x74\xf3\xe4\x96\xbd\xbc\x85\x8d\xe3\xd8\x3a\x53\xca\x0c\xdd\xb4\x6d\x75\x03\x17\xa1\x24\x6a\x8a\x65\x36\xf5\x95\x27\xcc\xf9\xee\x86\x84\x66\xc6\xe1\x9b\xcd\xe4\xc6\xa2\x9e\x85\xe0\xd4\x99\x3f\x50\xd0\x76\xcb\x5b\x36\x35\x8d\xdd\x22\x83\xb5\x0b\xeb\xc9\x21\xe4\xd3\x15\x91\xe5\x49\xd2\x46\x85\x3d\xd0\x25\x60\x65\x52\xbe\x4a\x9a\x8c\x24\x83\x70\x52\xbe\xbe\x8c\xa7\x09\x92\x48\x48\xf9\xc4\x3a\x59\x02\x61\xf8\x7b\x22\xdc\x70\xc3\x10\xb1\xc6\x6a\x3c\x60\x8d\xd5\x30\x41\xe0\x7d\x5c\x78\x74\xf1\x02\xc1\xa1\x8b\xc9\xac\x51\x0f\xdc\xfc\x5a\xda\x36\x99\xf1\xa2\x20\x32\x43\x27\xb0\xc1\x03\x54\x70\xcc\x2d\x4d\xa1\x20\x33\x70\x6a\x85\x88\x05\x34\x30\xed\x5a\xd6\x50\x03\x53\x9f\x08\x88\x17\x85\xce\x14\x8e\xf2\xeb\x47\x97\xe0\x12\x57\xe8\xa4\x99\x55\x7c\xb2\x2e\x16\xe8\x34\x12\xfa\x76\x9c\x69\x75\x4c\x04\x3c\xb3\x62\x86\x0a\x97\x40\x18\x17\x4e\xf9\xe9\x26\xea\xab\x13\xf1\x4d\x14\x0a\xd9\x5b\x10\x18\xf1\x68\xb4\x2c\xa2\x97\xd1\xbe\xb9\x44\x3c\xb2\x26\xf3\xd1\xcb\xa8\x44\xb7\x88\x78\xb4\x44\x8a\xb6\xdb\x93\xcd\x86\xc9\x7b\xf6\x9d\x36\xf4\xd4\x1d\xf9\x4e\x7a\x58\x28\x9c\x81\x3b\x5f\xa1\x58\x7f\xbf\xbf\x26\xc7\x91\x7b\x70\xd3\x01\xba\xc7\x8f\xcf\x0c\xdc\xf5\xde\xf7\x08\xcc\x01\x32\xea\xac\x0f\x01\x75\xd9\x3d\x6d\xfb\x43\x7e\x73\xf6\xcb\xfc\xf2\xf5\x5d\x7a\x7b\xf3\xdb\xef\x77\xe7\x37\x37\x1f\xae\x2e\xee\x66\x17\xe7\xb7\x17\xf3\x1e\xfc\x12\x54\x81\xef\x9d\xc9\xfb\x27\x36\xdc\x1e\x41\xc9\x0f\x58\xdd\xe2\xfd\xa1\x6d\x70\x31\x2d\x95\x59\x80\x8a\x85\xd1\xf7\x72\x39\x70\x5e\x63\xb5\xc3\xf3\x28\x90\x71\xd4\x1f\xaf\x2e\xae\xe7\x5f\x17\x75\x7a\x71\x3d\xbb\xbc\x7a\x3f\xbf\xdb\xe1\x7f\x04\x52\x67\x8c\xde\x13\x6a\x3b\x70\x7a\x38\x55\x77\xc1\x34\x25\xdd\x33\x8c\x0d\xdd\xac\xbe\xef\x20\x93\x1a\xbd\x4f\x9d\x59\x60\x9f\x5d\xe8\x6a\x3f\x23\x1d\x52\x1e\x0c\xf4\xad\x01\x68\xc5\x59\x52\xb7\xcc\x64\x85\xa0\x68\xf5\xcf\x61\x2a\xc5\x0a\x03\xc2\xcb\xf9\x3c\x9d\xf5\x6c\x52\x4b\x92\xa0\xde\xa1\x82\x6a\x86\xc2\xe8\x2c\xcc\x1c\x6f\x7b\x3e\x24\x73\x34\x05\x3d\x98\x4f\x3b\x66\x25\x4b\xfc\x5f\x10\x29\x8d\x2a\x72\xfc\x14\xba\xda\x81\xfa\x79\xf8\x96\x36\xe0\x0e\x6e\x80\x91\x2a\x38\xe2\xbf\xba\xe6\x19\x19\x98\xd8\xe8\xd0\xc4\x3a\xc3\xd0\xeb\xd3\xd3\x4f\xb2\x67\x1b\x1b\x9d\xfa\x11\x9d\x00\xdf\xfb\xa5\xe1\xfa\xd8\x11\xa2\xc9\xcd\x67\x8d\x1f\x07\x64\x9b\xc3\xdc\xc7\xd8\x7c\x7b\x0e\x44\xbd\xd0\xbf\x01\x00\x00\xff\xff\xb2\x70\xd2\x7f\x3b\x11\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\xdd\x6e\xdb\xb8\x12\xbe\xcf\x53\x10\x42\x0f\xda\x02\x95\x95\xf6\xb4\x07\x85\x00\x5f\x04\x4e\xda\x04\x4d\x13\xc3\xf6\x29\x76\xaf\x82\x31\x35\xb1\xb9\xa6\x48\x2e\x39\x72\xab\x55\xfd\xee\x0b\x4a\xfe\x91\x6c\xc5\x76\x82\x2e\x5a\xac\xaf\x2c\x71\x7e\xbe\x6f\x66\xc8\x19\x2a\x64\x60\xc4\x17\xb4\x4e\x68\x15\xb3\xf9\xeb\x13\xc6\x66\x42\x25\x31\x1b\xa2\x9d\x0b\x8e\x67\x9c\xeb\x4c\xd1\x09\x63\x29\x12\x24\x40\x10\x9f\x30\xc6\x98\x82\x14\x63\xe6\x72\x95\xa0\x13\x2e\x34\xd9\x58\x0a\x1e\x6a\xc8\x68\x6a\xac\xfe\x96\x97\x42\x12\xc6\x28\x5d\xa5\xc0\x18\x18\xb3\xd1\x58\xbe\x5b\x3d\x76\x84\x8e\x0e\xad\x53\x6e\x30\x66\x42\xdd\x5b\x70\x64\x33\x4e\x99\xc5\x16\x31\xae\x53\xa3\x15\x2a\xda\x0b\xef\x10\xef\x1d\xc2\xbf\x30\x97\x12\x90\x52\x9a\x80\x84\x56\x6b\x8c\xae\x62\xd2\x01\x69\xa6\xd0\xd1\x06\x95\x9b\x8a\x7b\xf2\x76\xcb\x25\x35\x09\x39\x5a\x0a\x1d\x72\x8b\x14\x1e\xca\x68\x48\xd2\x1d\x9b\x7a\x67\x90\x57\x30\x8c\xb6\xb4\x44\x14\x96\x0f\x31\x7b\xff\xf6\xed\x7f\x97\x10\x8d\xd5\xa4\xb9\x96\x31\x1b\xf5\xfa\xcb\x77\x04\x76\x82\xd4\x6f\x8a\x3a\x94\xc8\x49\xdb\x1f\x15\xff\xa7\x14\x89\xd5\x19\x61\x33\x8e\xb5\xba\x19\xf8\xd5\x9f\x55\x35\x2d\xd9\xe7\x5a\x39\x2d\x5b\xb3\xaf\xe7\x3e\xfd\xf8\x35\x04\x63\xc2\x92\x55\xcc\x02\xb2\x19\x06\x27\x45\x11\x32\x71\xcf\x3a\xc3\x95\xd7\xb3\x24\xd1\xca\x75\xfa\x65\x6c\xce\xfa\x57\x9d\x01\x10\x5e\x8b\x54\x50\x4f\x2b\x85\xbc\xf4\xc9\x16\x8b\xa5\xd7\x29\x94\x91\xeb\x94\x56\x6d\xd3\xad\x05\xc2\x50\x7a\xd5\x90\x6f\x74\xd7\xae\x9f\x6a\xa0\xc3\xb5\xe2\x99\xb5\xa8\x28\x24\x6e\x62\x16\x14\xc5\x13\x08\x54\xdc\x51\x25\x9e\xcc\xe1\x30\x5c\xf5\xcf\xa4\xd4\x5f\xaf\x85\xa3\xe3\xd8\x0b\x73\x07\x5e\x43\x0a\x47\x15\x46\x0b\x6a\x82\xec\x99\x78\xc5\x9e\x71\x91\x58\x16\x77\x8f\x77\x58\x14\x1e\xdf\x33\xc1\x16\x0b\x56\x14\x4b\xd8\x45\xb1\xb4\x54\xfe\xad\xde\x35\x68\x3d\xbc\x7b\xc1\x88\xc6\xb6\x9d\x6a\x8f\xf2\x40\x20\x3d\xcb\x4b\xed\xc8\x9b\x5c\x99\x2f\xb7\xf8\xbe\x9d\x4c\x9b\xed\x20\x94\x43\x9e\x59\xbc\x48\x26\x38\x42\x9b\x0a\x55\x96\x70\x5f\x4b\xc1\xf3\x98\x0d\x30\x11\x16\x39\xad\xac\x6d\x24\x4a\x64\xfb\xf3\xb3\x63\xaf\x0a\xca\xe3\x35\x50\x3a\x4f\xce\x22\x2a\x6e\x73\x43\xeb\xc8\x1e\x51\x24\x3d\xb4\x24\xee\x05\x07\xc2\x61\x79\xc8\x9e\x03\xc1\xa6\x5c\xf8\x66\x39\x66\xdf\x4f\x8a\x82\x19\x2b\x14\xdd\xb3\xe0\x3f\x2e\x60\x2f\x84\x4a\xf0\xdb\xe3\xcd\x07\x24\x5d\x87\x5b\x0a\x5e\xb2\xef\xcc\xdb\x50\xc4\xde\x6f\x9c\xce\x30\xff\xd1\xce\x66\x98\xef\x38\x5b\x06\x27\x05\x73\x09\xee\x13\xe6\x4f\x30\xcd\xa1\xa4\x51\x8b\x17\xf4\xfe\x99\x88\x2d\x3d\xb5\x71\xa8\xe5\xba\xb6\x89\x48\xaf\x6a\x78\x7b\x68\x38\xaa\x41\x36\xfb\x0a\x18\xe3\xea\x9d\xe4\x1c\x8d\xd4\x79\x8a\x2d\x53\xd7\x2f\x3e\x84\x3c\x6a\x32\xb0\x68\xa4\xe0\xe0\x62\xf6\xba\xb5\xc5\xa7\x40\x7c\x7a\xdd\x20\xdc\x4e\xf9\x30\xe9\xa7\xf2\x71\xe4\x5b\xce\x24\x5f\xf9\xb7\xe8\x74\x66\x39\xd6\x00\x95\xed\xa8\xf6\xec\x33\x96\x6a\x9b\xc7\x2c\x78\xf3\xee\x7f\x9f\x45\xb0\x5e\xb1\xf8\x67\x86\xee\x21\xd9\xd3\x8d\x68\x95\x96\x81\xaf\x4f\xa0\x2a\x21\x84\xa9\x91\xbe\xe8\x57\xb1\x69\x54\xc5\x6e\x65\x3c\x1c\xaa\x63\x82\x75\x74\x95\x3c\x3d\xb2\xf5\x42\x60\xd5\xb4\x42\x20\x14\xda\x1a\x85\xf0\xb8\x4b\x46\xf5\x13\x29\x4c\x30\x66\xcf\x1b\x67\x7c\x6f\x85\xc7\x75\x6e\xbd\x52\xe7\xca\x4b\xb1\xc5\xe2\x79\x3d\x4e\x76\xd2\x88\x9b\x77\x1c\x86\xc6\xea\xb9\x48\xd0\x76\xd7\x4d\x7c\x47\x84\x4b\xe1\x67\x0e\x91\x74\x5d\xee\x08\xd3\x78\x39\x70\x43\x75\x65\x8a\x8b\xa2\x73\x6b\x50\x0d\xbd\x72\xdf\xea\x3f\x90\xd3\x62\x11\xaf\xd9\x94\x34\x96\x46\x76\x6c\x67\xc6\x91\x45\x48\xbb\x53\x22\x13\x47\xd1\x5a\xcb\xfb\x40\x1b\x81\x11\xd1\xfc\x75\x54\x45\x24\xda\x51\x27\xe9\xca\xd9\xbe\x1b\x21\xf1\x88\xa4\x8b\x8c\x15\x73\x20\x8c\x96\xcd\xa1\x55\x63\x86\x79\xbb\xc2\x0c\xf3\xdd\x00\x81\x73\x61\xe6\xd0\x86\x63\x04\x8b\x36\x24\x3d\x43\xb5\x23\xe6\x66\xc2\xac\x83\x19\x8e\x33\x22\xbd\x2b\x04\xc6\x4b\x80\xf4\x82\xa9\xa1\x2e\x64\xa4\x77\x84\xd6\x89\x08\x39\x54\x30\xcd\x4c\x94\x50\x3d\x53\x17\x71\x08\xc7\x99\x4a\x24\xb6\xf2\x6b\x6a\xcf\xc1\x46\x36\x53\x51\x75\xf5\x71\xd1\x2c\x1b\xa3\x55\x48\xe8\xd6\xb7\xa3\x75\x1e\xa3\xaa\x3b\xec\xb1\x98\xa0\xc4\x89\x9f\x4d\x33\x2b\x5d\xb7\x08\x9a\xd9\x09\xe2\x22\xf0\x85\xec\x0c\x70\x0c\xe2\xa0\xb5\x2c\x82\x57\xc1\xea\x70\x09\xe2\xc0\xe8\xc4\x05\xaf\x82\x39\xda\x71\x10\x07\x13\xa4\xa0\x6c\x41\xbe\xa1\xbe\x50\x9a\xf6\x75\xb8\x73\xe1\x60\x2c\x71\x08\xb6\x37\x45\x3e\x7b\xb9\xe9\x9e\x6d\xc8\x1d\xd8\x6e\x51\x30\x07\xf6\x83\x84\xc9\xbe\x9d\x33\x04\x7b\xb3\x22\xb1\xcf\xff\x10\xec\x60\x49\xe4\x80\xd8\x17\xb4\xe3\x03\x22\x67\xfd\xab\x8f\x56\x67\xa6\xea\xc0\xf5\x06\x5c\xfd\x50\xcd\xeb\x3b\x77\x75\x60\xdc\x9e\xfd\x7f\x74\xf9\xe6\xae\x3f\xb8\xfd\xed\xf7\xbb\xde\xed\xed\xa7\xab\x8b\xbb\xe1\x45\x6f\x70\x31\x6a\x84\x62\x0e\x32\xc3\x0f\x56\xa7\xcd\xdd\xef\x3b\x91\xaf\x8a\x4f\x98\x0f\xf0\x7e\x7b\x6d\xa7\xc9\x4d\xa4\x1e\x83\xf4\x17\x92\x7b\x31\xd9\x11\x2e\xa7\xad\x12\xcf\x83\x40\xda\x51\x5f\x5f\x5d\xdc\x8c\x7e\x2e\xea\xfe\xc5\xcd\xf0\xf2\xea\xc3\xe8\x6e\x89\xff\x01\x48\xb5\x1b\xfe\x8a\xd0\xfa\x34\xef\x6f\x5f\xf8\xeb\x60\xaa\xed\xd1\x58\x68\xfb\x1e\xc0\xca\xde\x09\x89\x50\xe8\x5c\xdf\xea\x31\x36\xd9\xf9\x13\xf2\x23\xd2\x36\xe5\x9d\x6f\x0d\xeb\x05\xa0\x69\xcc\xa2\xf2\xf8\x8d\xa6\x08\x92\xa6\x7f\x6d\x87\x92\x4f\xd1\x23\xbc\x1c\x8d\xfa\xc3\xc6\x9a\x50\x82\x04\xc8\x73\x94\x90\x0f\x91\x6b\x95\xf8\xf9\xe5\x5d\x43\x86\x44\x8a\x3a\xa3\xcd\xf2\x69\x6d\x59\x8a\x39\xfe\x2b\x88\xcc\xb5\xcc\x52\xfc\xec\x4f\xc8\xad\xec\xa7\xfe\x5d\xbf\x02\xb7\xd5\x4d\x5a\xaa\xe0\x88\x0f\x4e\xd5\xaf\x65\xf8\x62\xad\x03\x18\xab\x0d\x56\x6f\x4e\x4f\x3f\x8b\xc6\x5a\xdb\x18\xd6\xd4\xa8\x29\xb8\xc6\x47\xd0\x9b\x63\xc7\x91\x2a\x36\x8f\x1a\x65\xb6\xc8\x56\x9b\xb9\x89\xb1\x7a\x77\x08\x44\x69\xe8\xef\x00\x00\x00\xff\xff\xd5\x6f\x79\x8b\xd6\x15\x00\x00"),
 		},
-		"/addons/todo": &vfsgen۰DirInfo{
-			name:    "todo",
+		"/addons/sampleapps": &vfsgen۰DirInfo{
+			name:    "sampleapps",
 			modTime: time.Time{},
 		},
-		"/addons/todo/04-todo-example.yml.tmpl": &vfsgen۰CompressedFileInfo{
-			name:             "04-todo-example.yml.tmpl",
+		"/addons/sampleapps/04-sampleapps.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "04-sampleapps.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 3358,
+			uncompressedSize: 3315,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\xcd\x6e\xe3\x36\x10\xbe\xe7\x29\x08\xa3\x40\x2e\x6b\x39\x69\xd1\x62\xc1\x9b\xd7\xf1\x6e\x02\x34\xbb\x42\xe4\xee\xa5\x28\x02\x5a\x1a\xcb\x44\x48\x0e\x4b\x8e\x9c\x35\x8c\xbc\x7b\x21\x51\x7f\x56\xac\xb8\x05\xda\x45\x75\x32\x39\xc3\xf9\xfb\xbe\x19\xcf\xe1\x20\x37\x2c\x4a\xf6\x26\x03\x2f\x7d\x34\xcf\x32\x34\x3e\x5a\x61\x86\xd1\xd2\x88\xb5\x82\x8c\xbd\xbc\x5c\x4c\x99\xb0\xf2\x2b\x38\x2f\xd1\x70\xb6\xbb\xbe\x60\xec\x49\x9a\x8c\xb3\x04\xdc\x4e\xa6\x70\xc1\x98\x06\x12\x99\x20\xc1\x2f\x18\x63\x4c\x89\x35\x28\x1f\x7e\x33\x26\xac\xe5\xcc\xd7\x3e\xea\xbb\xe6\x18\x49\x9c\x55\x72\xc2\x0c\x4f\xc8\x52\xd4\x16\x0d\x18\xea\x69\x18\xa1\xa1\x3d\x7a\x0b\x69\x70\x64\xd1\x51\xed\x73\x5a\x1d\x38\x7b\x7f\xf5\xfe\xaa\x36\x6a\x1d\x12\xa6\xa8\x38\x5b\x2d\xe2\xfa\x8e\x84\xcb\x81\xe2\x63\x55\x0f\x0a\x52\x42\xf7\x5f\x44\x3f\xa8\xa4\xc3\x82\x20\x42\x0b\xc6\x6f\xe5\x86\xca\x17\xbd\xe2\x3e\x94\xd2\xff\x47\x69\xb7\xe8\x6b\xad\xe9\xe1\xd0\xd1\xa5\x8a\xf0\x16\x3d\x95\xcf\x5e\x5e\x02\x0a\x82\xb6\x9c\xcd\x5a\x44\xf8\x5b\xb5\xa6\x2e\x13\x69\x3c\xa4\x85\x83\x65\x96\xc3\x0a\x9c\x96\x46\x90\x44\x13\xa3\x92\xe9\x9e\xb3\xb9\x52\xf8\xdc\x98\xea\xc4\x9c\x41\x96\x43\xb0\x85\x8d\xa9\x21\x37\x5f\x25\x56\x7e\xcf\x20\xf3\x2d\x71\x76\x7d\x75\x35\x84\x45\x6a\x91\x8f\xc3\x72\x57\x4a\x13\x72\x20\xf4\xbf\x0a\xce\x48\xe9\x15\xe2\x53\x61\xeb\x32\xd4\x46\x14\xa6\x42\x71\xb6\x11\xca\x97\xf9\x79\x12\x54\xd4\x5e\x49\xe4\xad\xff\x29\x93\x04\xba\x3d\x56\x42\xce\x94\x20\xf0\x34\xcc\x79\x5d\x48\x95\x8d\xe6\xfc\xa1\x94\x2e\xd0\x6c\x64\xfe\x3d\x72\xb6\xe8\x69\x81\x5a\x4b\xe2\xec\x10\x68\xe5\xc0\x63\xe1\x52\xf0\xdd\x4d\xd1\x92\x23\x01\x27\x85\x0a\xdd\x5b\x69\x35\xd1\xe4\x92\xba\xec\x0b\x27\x39\xbb\xdc\x12\x59\xcf\x67\xb3\x5c\xd2\xb6\x58\x47\x29\xea\x59\x13\x9f\xc4\x59\x45\x71\xf8\x26\xb4\x55\x10\xe5\x92\x2e\x1b\xca\xed\x2d\x70\xf6\x49\x52\x75\xc6\x82\x6c\xd1\x31\x1b\x3b\x1f\xaf\x28\xb2\x12\x79\x2b\x0c\xd9\x5e\x96\x3e\x78\x40\x21\x98\xf7\xe4\x04\x41\xde\xc2\x1b\x72\x48\x06\xb7\x8c\x6d\x1c\xea\xee\xd4\x38\xbb\xc1\xf4\x09\x5c\xe5\xb2\x27\x0b\xbe\x0e\x87\x91\xe1\x5e\xa9\xb3\xba\x65\x9b\xf4\x92\xca\x6f\xa0\x91\x93\x79\x0e\xae\x47\xa5\xa0\x12\x38\xb0\xd8\x0a\x93\xc3\x90\x42\xc2\x5a\xdf\x27\xcd\x0d\x58\x85\x7b\x0d\x86\xbe\x07\x67\x1c\x58\x25\x53\xe1\x39\xbb\x3e\x39\xc6\xb5\xa0\x74\xfb\xeb\x91\xdf\xd3\x9e\xdf\x1e\xa0\xe7\x47\xe8\x10\xcd\x8e\xb9\xad\x09\x25\xb5\x24\xdf\x87\x52\x83\x46\xb7\xe7\x6c\xf2\xe3\xcf\xbf\xdc\xcb\x49\x2b\x71\xf0\x67\x01\x7e\x4c\xf7\xaa\x53\x0d\xf0\x3c\x40\xea\x40\x50\x8d\x21\x68\x5b\xd2\xac\xad\xc0\x11\x04\x55\xfa\xc6\x20\x55\x73\xf4\xc8\xc1\xd1\x14\x48\xd1\x90\x90\x06\x5c\x54\x66\x18\x85\xd9\x08\x86\xdc\xde\xa2\x2c\x13\xbf\xfc\x7d\xd2\xea\x4c\x3b\xc1\xe4\xdd\x64\xb6\x96\x66\xe6\xb7\x93\x77\x93\x69\x3a\x79\x37\xf9\x21\x59\xdd\x3d\x26\x8b\x87\xbb\x78\x95\x3c\xc6\xf3\xd5\xed\xac\xf0\x22\x87\xc9\x1f\x97\xad\xef\x2a\x7a\x89\x66\x25\x35\x78\x12\xda\x72\x66\x0a\xa5\xba\xc2\x0d\xf0\x1b\x43\xf0\x1c\x86\xe7\x51\xec\x53\xab\x8a\xac\x49\xf1\xc8\xfb\x94\x81\xd9\xf5\x2f\xc2\x65\xa0\xe8\xea\xcb\xcd\x97\xc7\x9b\x0f\x8f\xc9\xf2\xe1\xeb\xf2\x61\xa0\xc4\xd8\x4e\xa8\x02\xba\xd8\xa7\xd9\xfa\x8c\x9d\xcf\xf3\xfb\xe5\xa8\x95\x6a\x62\x9d\x35\xf1\x5b\xf2\x46\x20\x7f\xcf\x44\x3c\x4f\x92\x31\x13\xfd\xdd\x60\xd1\x14\xd5\x47\x37\x82\xc4\x5a\x78\x88\x92\xda\x45\x2c\xbc\x7f\x46\x97\xb5\xf3\x67\xb4\x74\x8b\xdb\xe5\xfd\xfc\x1f\x45\x5c\x11\x34\x2e\x94\xea\x36\x87\x67\xb1\xf7\x47\x3a\xaf\x96\x81\xde\xd3\x53\x03\xba\xf9\x4e\xb4\x72\xf8\x5e\x37\x74\xf8\x9a\x56\xad\xba\x7a\x20\x3d\xd5\xda\xe7\x5e\xf5\x56\xdc\x7e\xd1\x5a\x72\xc6\xc3\xad\x77\x98\x73\xf9\xef\x77\x24\xea\xed\x52\xf7\xe0\xcb\x7e\x8c\xc3\x02\x97\xc1\x6e\xd6\x13\x4e\x15\xe6\xe7\x1e\xd6\x05\xff\x28\x55\xf7\x4f\x94\x19\xdf\xdc\x2f\x54\xe1\x09\xdc\x47\xe9\x3c\xf5\x06\x9c\x27\xe1\x68\x04\x2c\x9f\x6e\x21\x2b\x14\xb8\xcf\x55\xf4\x19\x6c\x44\xa1\x68\xda\x5e\x77\x8a\xe5\xee\x28\x69\xbf\x40\x43\xf0\xad\x5b\x1b\x06\x91\x7e\x72\x22\x85\x18\x9c\xc4\x2c\x81\x14\x4d\xe6\x39\xfb\xa9\x5e\x45\xa1\x5c\x71\xc3\x4a\x75\x38\x80\x29\xc9\xf9\x57\x00\x00\x00\xff\xff\x6a\x90\x3a\xc4\x1e\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\xcd\x6e\xe3\x36\x10\xbe\xfb\x29\x06\x41\x80\xbd\xd4\xda\xa4\x45\x8b\x80\x40\x0f\x6e\xe2\xed\x2e\xd0\x04\x46\x9c\xee\xb5\xa0\xa5\xb1\x4c\x98\x22\x59\xce\xc8\x5e\xc1\xc8\xbb\x17\xd4\xbf\x15\xcb\xf1\x65\xb1\xe5\x49\x1a\x7e\xf3\xc3\x99\x6f\xc8\x39\x1c\xa6\xe0\xa5\x49\x11\xa2\x65\x61\x12\x24\x45\xd1\x2c\x49\xac\xa1\x68\x29\x33\xa7\x71\xe6\x1c\xc1\xeb\xeb\x64\x0a\xd2\xa9\xaf\xe8\x49\x59\x23\x60\x77\x3b\x01\xd8\x2a\x93\x08\x58\xa2\xdf\xa9\x18\x27\x00\x19\xb2\x4c\x24\x4b\x31\x01\x00\xd0\x72\x85\x9a\xaa\x6f\x00\xe9\x9c\x00\xaa\x3d\xd4\xb2\xe6\x37\x52\xf6\x63\xb9\x7f\x38\x40\xf4\x24\x33\x0c\xfe\xde\x42\x62\x9b\x39\x6b\xd0\xf0\x5b\xa0\x91\x19\x0e\xa5\xe4\x30\xae\xbc\x3b\xeb\xb9\x0e\x64\x5a\xfe\x08\xb8\xbb\xb9\xbb\xa9\x5d\x38\x6f\xd9\xc6\x56\x0b\x78\xb9\x5f\xd4\x32\x96\x3e\x45\x5e\x1c\x43\x09\x35\xc6\x6c\xfd\xf7\x3f\xd2\xe4\x70\x00\xb5\x86\xeb\x68\xe6\x54\x48\x2f\xfa\xe8\xd9\xe6\x8c\x27\x0a\xe1\x83\x3c\xb2\x0e\x0d\x6d\xd4\x9a\x83\xd1\x5e\x6d\x4a\xad\xff\x71\x65\x36\x96\x2a\x1d\xb5\x86\xea\x88\x9f\x2d\xb1\xa9\x90\x41\xe9\x84\x0c\x35\x35\xbb\xb5\xc9\xe9\xe1\x00\xd7\x1d\x7d\x4f\xe9\x98\xa4\x09\xc8\x49\xde\x08\xf8\xd8\x12\x43\x9c\x2b\x39\x77\x69\x52\x86\x30\xce\x3d\xce\x93\x14\x5f\xd0\x67\xca\x48\x56\xd6\x2c\xac\x56\x71\x21\x60\xa6\xb5\xdd\x37\xa6\xba\x6d\x01\x98\xa4\x58\xd9\xb2\x8d\xa9\x61\xdf\x8c\xe5\x29\xac\x3d\xaa\x74\xc3\x02\x6e\x6f\x6e\x26\xa1\x57\xeb\xd3\x0f\x58\x60\x90\xf7\xd6\x6f\x95\x49\xa3\xed\x1d\x55\x24\x58\x21\xcb\x8e\x09\x5f\x4c\xea\x91\xe8\x87\x72\x41\x1a\x63\xb9\x4c\x4b\xeb\xd0\xa4\xca\x7c\x8b\x54\x15\x5c\xb4\xcd\x57\xe8\x0d\x32\x96\x96\x1a\x69\xac\x25\x91\x80\xab\x12\x7b\x75\x01\xab\x7c\xae\xb1\xed\xf7\x1f\x40\x31\x80\x0d\xb3\x6b\x8e\x58\x51\x8e\xba\xdf\xe9\x11\x07\xab\xb5\x92\xf1\x16\x4d\x22\x7a\xa2\x70\xe1\x94\x0c\x79\x1a\xa1\xc6\x11\xa8\x47\xdc\x92\x26\x55\x34\x03\x9a\x48\xe7\xa8\x7f\x3d\x3c\xa0\xd3\xb6\xc8\xd0\xf0\xf7\xe0\xc5\x3b\x45\x42\xa7\x55\x2c\x49\xc0\xed\xc9\xdb\x35\x93\x1c\x6f\xfe\x3a\x8a\xe2\x74\x1c\x17\x31\xf4\x62\x8e\x12\x7b\xc9\x98\x16\x8d\x4f\x8f\x64\x73\x1f\x63\x2f\x08\xad\x32\xc5\xd4\x2f\x55\x86\x99\xf5\x85\x80\xab\x9f\x7f\xfd\xed\x51\x5d\xb5\x3b\x1e\xff\xcd\x91\xc6\xb0\x37\x1d\x94\x0b\x87\x02\x9e\x31\xf6\x28\xb9\xbe\x2e\x30\x73\x5a\x32\xb6\xf9\x38\x2a\x4f\x58\x25\x58\x59\xf3\xa2\x32\x24\x96\x99\x13\x60\x72\xad\xbb\x38\x07\xc9\x1b\x4b\xdf\x85\x09\xbc\x38\x85\xfd\x2a\x97\x71\x5a\xc3\x52\x19\xf4\x47\xb1\x04\x8e\xee\x44\x49\xd6\xd0\x99\x0f\x92\xe5\x4a\x12\x2e\xe3\x0d\x66\x72\x48\xf2\x00\xaf\xe8\xf4\xf0\xc7\x3f\xcb\xf9\xf3\xd7\xf9\xf3\x60\x1f\x60\x27\x75\x8e\xdd\xf1\xa6\xc9\x6a\xdc\xc4\xd3\xec\x71\x3e\x6a\xa0\x1c\x7b\xce\x69\xff\xbd\x3c\xe3\xfe\x5d\xed\xc5\x6c\xb9\x1c\xd3\x3e\xbe\x65\xee\x9b\x1c\x53\x9b\x9e\x7a\x28\x4b\x56\x0b\x49\xb4\xb7\x3e\x39\x9f\xa9\xfb\xcf\xf3\xc7\xd9\x19\x67\x27\xd2\xde\xbb\x3d\xba\xd9\xf0\x7a\x8b\xc5\x4f\x70\x5d\x2a\x82\xf8\x1d\xa2\xb9\xd9\x8d\x7b\x0e\xa7\xd8\x62\xf1\x16\xd0\xba\xfe\x10\x20\x95\xb5\xd7\xd7\x0f\x7d\x9f\x7d\xb0\xca\x64\x8a\x8b\x5c\xeb\xee\x91\xdd\xcb\x82\x8e\x30\x63\xef\x66\xcf\x42\xe5\x2e\xfa\x12\xbe\x4b\x77\x7d\xc4\x89\xfe\xae\xd6\xdb\x2e\xaf\x56\xd3\xbf\x65\xab\x0f\x76\x4f\xf5\xfb\x7b\x5a\xbd\xe1\xb4\x9f\xc9\xb6\x69\x16\xc3\x79\x75\x78\xf6\xf0\xd6\x1c\x6d\xf5\xc6\x8f\x47\x24\x0a\x39\xac\xde\x9b\x04\x77\x1f\x7b\x9b\x53\x6d\xd3\xf7\x14\xeb\xc4\x7f\x52\x1a\x5b\x68\x62\xa8\x91\xdf\xeb\x9c\x18\xfd\x27\xe5\x89\x7b\xb7\x1e\xb1\xf4\x3c\x52\x34\x8a\x37\x98\xe4\x1a\x7d\xf5\xac\x25\xb8\x96\xb9\xe6\x69\x2b\xee\x80\x61\xdc\x52\x5c\xdc\x5b\xc3\xf8\x2d\xdc\x33\x5d\x6d\x7b\x91\xfe\xe9\x65\x8c\x0b\xf4\xca\x26\x4b\x8c\xad\x49\x48\xc0\x2f\xf5\xf4\x86\xe1\xe9\x5f\x4b\x4d\xd8\xa7\xd8\x7f\x01\x00\x00\xff\xff\xae\x3c\x79\xac\xf3\x0c\x00\x00"),
 		},
 		"/backup": &vfsgen۰DirInfo{
 			name:    "backup",
@@ -219,16 +261,23 @@ var assets = func() http.FileSystem {
 		"/backup/syndesis-backup-job.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "syndesis-backup-job.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 1529,
+			uncompressedSize: 1997,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\xcb\x6e\xdb\x30\x10\xbc\xfb\x2b\x16\x3a\x04\xed\x41\x56\x9c\xa6\x09\x2a\xa3\x07\xb7\x8e\x9b\x06\x76\x2c\xc4\x71\x2f\x41\x10\x50\xd4\xca\x62\xc3\x17\x48\xca\x81\xa1\xfa\xdf\x0b\xfa\xa1\xaa\xb1\x1c\xa0\x87\xf2\x44\x2d\x39\x33\xcb\xe1\x50\x44\xb3\x1f\x68\x2c\x53\x32\x86\x94\x38\x5a\x44\xcb\x5e\xe7\x99\xc9\x2c\x86\x1b\x95\x76\x04\x3a\x92\x11\x47\xe2\x0e\x80\x24\x02\x63\xa8\xaa\xee\x8d\x4a\xd7\xeb\x8e\xd5\x48\x7d\x39\x25\xf4\x59\xe5\xf9\x98\x09\xe6\x62\x38\xef\x00\x68\x62\x08\xe7\xc8\x99\x15\x31\xf4\x3a\x00\xce\xf1\x19\x52\x25\x33\x3b\xc8\x1d\x9a\x11\x93\xcc\x16\x98\xc5\xd0\x3b\x3d\xf5\xcb\x28\x34\x27\x0e\x3d\x1b\x40\x53\xd2\x8f\x57\xb2\xdb\x22\x27\x29\x72\xbb\xdf\x02\xf0\x53\xa5\x61\xcb\xc6\x7d\x8f\x7e\x18\xb4\x8e\x18\x97\x28\xce\xe8\x2a\x86\xa9\x1c\x11\xc6\x4b\x83\xbb\xe5\xa5\xe2\xa5\xc0\x9a\x32\xdc\xe9\x3a\xa1\x43\xbd\xf0\xfd\xd4\x5a\x28\xb4\x5b\x0d\x99\x89\xa1\xda\xb7\x43\x95\x74\x84\x49\x34\x07\x78\xef\x4e\xa9\xc3\x2c\x0d\xa9\x12\x9a\x71\x34\x35\x0f\x13\x64\x81\x31\x04\xd4\x94\x92\x16\x2b\xaf\x11\xed\xe6\x5e\xb2\x14\x3a\xa6\x28\x9d\xb2\x97\xe1\xa7\xee\x45\xb7\x77\x11\x9e\x77\xcf\xba\xbd\xa0\x26\xd8\xb6\x3c\x51\xa5\x74\x0d\x2b\x42\x10\xbe\x92\x10\x57\xc4\x10\xbd\xea\x1d\xde\x38\x96\x5c\x36\x49\xb6\xfb\x92\x6f\xc3\xf9\x24\x79\xba\x9e\xce\xee\x1b\x1c\x4b\xc2\xcb\xad\xd5\xd7\xca\xba\xfa\x52\x0e\x60\xc9\xf4\xae\x05\x16\x54\x55\x37\x51\xc6\xad\xd7\xc1\x31\xe0\x7c\x76\x75\xd7\xaa\x37\xb7\x68\xde\xd0\x1b\xcc\x66\xad\xb0\x84\x58\xfb\xa2\x4c\x76\x1c\x3a\xfc\xd2\x0a\xbc\x25\x02\x8f\x83\x46\xdf\xc7\x57\xb7\x83\xc9\x55\x2b\x74\xc4\x38\xbe\x0d\x1f\x8c\xc7\x2d\xee\xe4\x84\x5b\x3c\xea\xcc\xd7\xf9\xec\x7e\x3a\x79\x9a\x26\xf7\x2d\x27\x0d\xc2\x30\x57\x46\x10\xf7\x99\x96\xd6\x29\xf1\x87\x85\x2a\x21\x88\xcc\x9a\x17\x1c\xa5\x4c\x46\x29\xb1\x45\x5d\x23\x66\xf1\x57\x8e\x82\x90\x36\xfb\x08\x22\xa5\x5d\x44\xb5\xd8\x20\x37\x8f\xa9\x6b\x0b\x38\x39\x01\xcb\x11\x35\x7c\xf4\x53\xa7\x4a\x5a\xec\x63\x17\xe9\x45\xe8\x73\xbc\xc9\x3e\x47\x57\x1f\xeb\xf0\x75\x70\xb5\x58\x1c\xbe\x8d\x6d\xfe\xe3\xcb\xff\x14\x79\xff\x53\x20\xd9\x54\xf2\x55\x0c\xce\x94\xf8\x6f\x76\x1d\x18\xf4\xab\x41\xfc\xee\xa5\x60\x1c\x37\xac\x7d\xc8\x14\xb0\x1c\x1e\x1e\x20\xcc\x21\x38\xea\x0d\x3c\x3e\xf6\xc1\x15\x28\xf7\x7e\xf6\x21\x35\x48\x9e\xfb\x90\xb3\xfe\xae\xf6\xc1\x93\x49\x7c\xdf\x50\xa2\xc4\x41\x55\x85\xb0\x09\xdc\x90\x99\xf5\x3a\xaa\x3f\x5f\xe5\x0f\x76\x2c\xbd\xb3\xd3\xce\xef\x00\x00\x00\xff\xff\x21\xb7\x3b\xca\xf9\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\x4d\x6f\xdb\x46\x10\xbd\xeb\x57\x0c\x78\x08\xda\x03\x49\x2b\x4d\x93\x96\x42\x0f\x6e\x6c\x37\x0d\xac\x48\x88\xec\x02\x45\x10\x04\xc3\xe5\x50\xdc\x7a\xbf\xba\x3b\xb4\x2b\x30\xfa\xef\xc5\xca\x12\xc3\xc8\x92\xe1\x1e\x0a\x84\x27\x6a\xc5\xf7\xde\xbc\x37\x33\x24\x3a\xf9\x07\xf9\x20\xad\x29\xa0\x44\x16\x4d\x7e\x3b\x1e\xdd\x48\x53\x15\xf0\xd6\x96\x23\x4d\x8c\x15\x32\x16\x23\x00\x83\x9a\x0a\xe8\xba\xec\xad\x2d\xd7\xeb\x51\x70\x24\xe2\x71\x89\xe2\xc6\xd6\xf5\xa5\xd4\x92\x0b\x78\x31\x02\x70\xe8\x51\x29\x52\x32\xe8\x02\xc6\x23\x00\x66\xb5\x20\x61\x4d\x15\x4e\x6b\x26\x7f\x21\x8d\x0c\x0d\x55\x05\x8c\x4f\x4e\xe2\xdf\xa4\x9d\x42\xa6\xc8\x06\x30\x94\x8c\xd7\x9e\x6c\xd7\xa5\x20\x6b\xa0\xbf\x21\x5b\x90\x68\xbd\xe4\xd5\xd4\x56\xa4\x20\xf1\x14\xd8\x4b\xc1\x54\x25\xeb\xf5\x16\x8c\xc6\x58\x46\x96\xd6\x84\x1d\x1f\x40\x20\x21\xac\x76\x59\xd8\xe2\x33\x54\xae\xc1\xec\xa6\x2d\xc9\x1b\x62\x0a\x99\xb4\xb9\xb3\x55\x01\xbe\x35\x2c\x35\xe5\x15\xd5\xd8\x2a\xde\x88\x93\xa9\x7a\x7a\x85\x25\xa9\x01\xf3\x5f\xb6\x4c\xf7\xea\xdd\x08\x6e\xa3\x8a\x57\xac\x12\x3d\xcf\xad\x92\x62\x55\xc0\xcc\x5c\xa0\x54\xad\xa7\x0d\xf7\x9d\xe4\x06\x9c\xad\x76\xce\x5e\x5b\xc3\xf4\x0f\xef\x5b\xed\xf5\xc3\xd7\xcf\x15\xa3\xae\x03\xb6\x7f\xa2\x56\x90\xc1\x67\x90\xa6\x22\xc3\xf0\x13\x6c\x73\x1b\x96\x7e\x6b\x55\xab\xa9\xaf\x3d\xdd\xe6\xcc\xda\xa5\x6e\x19\xf3\xef\x4d\x91\x76\xbc\x3a\x93\xbe\x80\x6e\x07\x16\xd6\x30\x4a\x43\xfe\x01\x3e\x4e\x43\xeb\xd2\xaa\x4c\x63\xc4\x52\x91\xff\x62\xac\x47\x3d\xd5\xde\x53\x0d\x8e\x4f\x0e\x39\x04\x90\x1a\x97\x54\x40\x22\x7c\x6b\x44\xb3\x8a\xae\xf2\xed\x7d\x34\xd9\x6a\x57\x08\x32\x6c\xc3\xab\xf4\xe7\xec\x65\x36\x7e\x99\xbe\xc8\x9e\x67\xe3\xa4\x27\xb8\x0f\x69\x6a\x5b\xc3\x83\x2e\xa7\xa0\xe3\xc9\x1c\xb9\x29\x20\xdf\x4b\x0b\x1e\x09\xd2\xdc\x0e\x49\xee\x9f\x9b\xff\x76\x76\x3d\x9d\x7f\x7a\x33\x5b\x5c\x0d\x38\x6e\x51\xb5\xf7\x53\xf4\xc6\x06\x1e\x58\xda\x83\xcd\x67\xef\x0f\xc0\x92\xae\xcb\xe6\xd6\xf3\x7a\x9d\x1c\x03\x5e\x2f\xce\xdf\x1f\xd4\xbb\x0e\xe4\x1f\xd1\x3b\x5d\x2c\x0e\xc2\xe6\x18\xc2\x9d\xf5\xd5\x71\xe8\xd9\xaf\x07\x81\xef\x50\xd3\x71\xd0\xc5\xef\x97\xe7\xef\x4e\xa7\xe7\x07\xa1\x17\x52\xd1\xe3\xf0\xd3\xcb\xcb\x03\xe9\xd4\xa8\x02\x1d\x4d\xe6\xf5\xf5\xe2\x6a\x36\xfd\x34\x9b\x5f\x1d\x70\x9a\xa4\x69\x6d\xbd\x46\xfe\x45\xb4\x81\xad\xfe\xc2\x22\xac\xd6\x68\xaa\x61\x83\xf3\x52\x9a\xbc\xc4\xd0\xf4\x67\xe8\x97\x5f\xcd\x51\x92\x8a\x61\x1d\x49\x6e\x1d\xe7\xc2\xe9\x0d\x72\xf3\x9e\xc8\x42\x03\xcf\x9e\x41\x50\x44\x0e\x7e\x8c\xb7\x6c\x5b\xd1\xec\xc6\x2e\x77\xcb\x34\xce\xf1\x66\xdb\x14\x71\x6f\xeb\xe1\x3e\x2a\xbb\x5c\x7e\x0b\xdb\x78\xbf\x71\xc5\xab\xff\x69\xc9\xe2\x1b\x16\xab\x99\x51\xab\x02\xd8\xb7\xf4\xdf\x1a\xf4\xa0\x25\x9f\x07\xc4\xdf\xdd\x35\x52\xd1\x86\x75\x02\x95\x8d\x9f\xa1\x0f\x1f\x20\xad\x21\x39\xda\x0d\xf8\xf8\x71\x02\xdc\x90\xd9\x75\x70\x02\xa5\x27\xbc\x99\x40\x2d\x27\xdb\xb3\x1f\x22\x99\xa1\xef\x07\x4a\x02\x19\x62\x80\x9b\x11\x3f\x93\x7e\xbd\xce\xfb\x9f\x7b\x13\x0f\x5b\x96\xf1\xf3\x93\xd1\xbf\x01\x00\x00\xff\xff\xb6\xa3\xf6\x73\xcd\x07\x00\x00"),
 		},
 		"/backup/syndesis-restore-job.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "syndesis-restore-job.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 1182,
+			uncompressedSize: 1536,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x5b\x6f\xe2\x46\x14\x7e\xe7\x57\x1c\xf9\xa9\x7d\xb0\x1d\xda\xed\xb6\x35\x4f\x2c\x4b\xbb\xdd\x96\xc5\xc2\x50\xa9\x5a\x45\xd1\x78\xe6\x80\xa7\xcc\xad\x33\xc7\xa4\xc8\xe1\xbf\xaf\x4c\x1c\xe4\x10\x82\xe2\x27\x74\x98\xef\x3a\x17\xe6\xe4\xdf\xe8\x83\xb4\x26\x83\x92\x11\xaf\xd2\xdd\x70\xb0\x95\x46\x64\xf0\xd9\x96\x03\x8d\xc4\x04\x23\x96\x0d\x00\x0c\xd3\x98\x41\xd3\x24\x9f\x6d\x79\x38\x0c\x82\x43\xde\x8e\x4b\xc6\xb7\x76\xbd\xfe\x4b\x6a\x49\x19\xbc\x1b\x00\x10\xa9\x02\xb9\x35\x22\x8c\xd7\x84\xfe\x37\x69\x64\xa8\x50\x64\x30\xbc\xb9\x69\xff\x46\xed\x14\x23\x6c\xc1\x00\x7d\x85\xf6\x3b\x53\x69\x9a\x18\xe4\x1a\xf0\x3f\x48\x0a\xe4\xb5\x97\xb4\x9f\x59\x81\x0a\x22\x8f\x81\xbc\xe4\x84\x22\x3a\x1c\x3a\x30\x33\xc6\x12\x23\x69\x4d\x78\xe2\x03\x08\xc8\xb9\xd5\x2e\x09\x1d\x3e\x61\xca\x55\x2c\xd9\xd6\x25\x7a\x83\x84\x21\x91\x36\x75\x56\x64\xe0\x6b\x43\x52\x63\x2a\x70\xcd\x6a\x45\x47\x71\x34\xe2\x44\xaf\x58\x89\xaa\xc7\xfc\xaf\x2d\xe3\x33\xbf\x47\xc1\xae\x99\xf6\x6b\x5d\x32\x4f\xb9\x55\x92\xef\x33\xf8\x82\x3b\xf4\x47\xde\x7b\x49\x15\x38\x2b\x9e\x52\x4d\xac\x21\xfc\x9f\xce\x63\x9e\xb4\xc3\xf3\x75\xd9\xa0\x69\x80\xec\x3f\x4c\x2b\x48\xe0\x01\xa4\x11\x68\x08\x7e\x81\xae\xb3\xbe\xed\x9d\x55\xb5\xc6\x93\xef\xb8\xeb\x98\xb4\x8b\xdd\xa6\xed\xfe\x14\x08\xb5\xa3\xfd\x47\xe9\x33\x68\x9e\xc0\xdc\x1a\x62\xd2\xa0\x7f\x81\x6f\xa3\x59\x8f\xb1\x28\xe3\xb6\x5f\xa9\xfa\xc9\x4e\xb0\xb7\xe6\x7b\x6b\xc2\xe1\xcd\xa5\x88\x00\x52\xb3\x0d\x66\x10\x71\x5f\x1b\x5e\xed\xdb\x58\x69\xf7\x3b\x76\x9b\xce\x6b\xc6\xd1\x90\x0d\x3f\xc7\xbf\x26\xef\x93\xe1\xfb\xf8\x5d\xf2\x43\x32\x8c\x4e\x1c\x8f\x45\xcd\x6c\x6d\xa8\xb7\xcb\x31\xe8\x76\x92\x33\xaa\x32\x48\xcf\x1a\x83\x2b\x65\x9a\x5d\x9f\xe4\x71\x5d\xfe\xfb\x62\x5a\x2c\xe7\x8b\xe9\xdd\xa7\x79\xb1\xec\xd1\xec\x98\xaa\x1f\x0f\xd2\x27\x1b\xa8\x17\xec\x25\x32\x9f\x2f\x2e\x20\xa3\xa6\x49\x72\xeb\xe9\x70\x88\xae\x60\x57\xc5\x74\x71\x19\xbb\x0a\xe8\xaf\x63\xf3\x71\x51\xbc\xa2\xcb\x42\xb8\xb7\x5e\x5c\xc7\x7f\xfc\x70\x19\xfd\x85\x69\xbc\x8e\xfc\x30\x9e\xfc\xb9\xca\xef\x96\x7f\xcc\xa6\xc5\x72\x3c\xcb\x2f\xf3\x2c\xa5\x6e\x6f\x9b\x76\xd7\xc9\x26\xab\x62\x39\x9f\xdd\xcd\xf3\xe5\x2b\x69\x26\x75\x20\xab\xe7\xee\xf8\x8e\xf4\xb9\xb8\xd5\x9a\x19\xd1\xdf\xd5\xb4\x94\x26\x2d\x59\xa8\x4e\x33\xe6\x37\xcf\x0e\x4f\x14\xf3\xbe\x9b\x87\x9e\xe6\x77\xf7\x95\x54\x08\xe4\x6b\x1c\x01\x08\xdb\xbe\x74\x5f\xbf\x42\xbc\x86\xa8\x3b\x67\xa9\xdb\xc4\xb5\x53\x96\x89\xe3\x1d\x53\x48\x18\xc1\xed\xed\x08\xa8\x42\x03\x41\x21\x3a\xf8\x69\x04\xa5\x47\xb6\x1d\xc1\x5a\x8e\xba\xd9\x8f\x23\x10\xd6\xe0\xf7\x3d\xb1\xd4\x3a\x4a\xb9\xd3\x47\xc7\xc7\x47\x29\x09\xd5\xe0\x5b\x00\x00\x00\xff\xff\x69\xe6\x68\xda\x00\x06\x00\x00"),
+		},
+		"/backup/syndesis-restore-wal-job.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "syndesis-restore-wal-job.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 2457,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x93\x5f\x4f\xdb\x30\x14\xc5\xdf\xfb\x29\xae\xf2\xb4\x3d\x38\xa1\x1b\x63\x5a\xfa\x54\xa0\x1b\x63\x2b\x8d\x9a\x74\x2f\x08\x21\xc7\xb9\x6d\x3c\xfc\x4f\xf6\x4d\x11\xea\xfa\xdd\xa7\xd0\x52\x65\xac\xc4\x4f\xd1\xf5\xfd\x9d\x73\x6c\xdf\x70\x27\x7f\xa1\x0f\xd2\x9a\x14\x4a\x4e\xa2\x4e\xd6\xc3\xc1\x83\x34\x55\x0a\xd7\xb6\x1c\x68\x24\x5e\x71\xe2\xe9\x00\xc0\x70\x8d\x29\x6c\x36\xf1\xb5\x2d\xb7\xdb\x41\x70\x28\xda\x72\xc9\xc5\x83\x5d\x2e\x7f\x4a\x2d\x29\x85\xd3\x01\x00\x91\xca\x51\x58\x53\x85\xf1\x92\xd0\x7f\x95\x46\x86\x1a\xab\x14\x86\x27\x27\xed\x36\x6a\xa7\x38\x61\x0b\x03\x74\x1d\xda\xf5\xca\x65\x57\x54\xbc\x44\x15\x5e\x5a\x00\x7e\xdb\x92\x1d\x69\x7c\x89\xd4\x2e\x8f\x81\xb8\xa7\xcc\x2a\x29\x9e\x52\xb8\xc1\x35\xfa\xfd\xd6\xda\xaa\x46\xe3\x41\x8e\xed\x3d\x49\x3b\xe6\x56\x6d\x96\x83\x0f\x6a\x47\x4f\x97\xd2\xa7\xb0\x79\x89\x22\xac\x21\x2e\x0d\xfa\xff\xf8\xd6\xd1\x7a\x64\x55\xc9\x84\xd5\x4e\xaa\x83\x21\x80\xd4\x7c\x85\x29\x44\xc2\x37\x46\xd4\x4f\xad\x49\xb2\xff\x66\x6e\xb5\x27\x53\x81\x86\x6c\xf8\xcc\xbe\xc4\x67\xf1\xf0\x8c\x9d\xc6\x1f\xe2\x61\x74\xd0\xd8\xc5\x9e\xda\xc6\x50\xe7\x2a\x18\xe8\xb6\x92\x71\xaa\x53\x48\x5e\xe5\x87\x9e\xa3\x99\x75\x57\x64\xd7\x97\x7d\x9b\x4f\xf2\x62\x36\x9f\xdc\x5f\xcd\xf2\xa2\x23\xb3\xe6\xaa\xd9\xdd\xf6\x95\x0d\x74\x78\x97\x63\x64\x36\x9b\x1f\x21\xa3\xcd\x26\xce\xac\xa7\xed\x36\xea\x61\x17\xf9\x64\x7e\x9c\x5d\x04\xf4\xfd\x6c\x36\xce\xf3\x37\x7c\x79\x08\x8f\xd6\x57\xfd\xfc\xe5\xf9\x71\xfa\x86\x6b\xec\x27\xcf\xc7\x17\x3f\x16\xd9\x7d\xf1\x7d\x3a\xc9\x8b\xf1\x34\x3b\xae\x53\x48\xdd\x8e\xa4\x76\xfd\x62\x17\x8b\xbc\x98\x4d\xef\x67\x59\xf1\xc6\x69\x2e\x9a\x40\x56\xcf\x1c\x49\x6b\x42\x57\x4b\x58\xad\xb9\xa9\xba\xaf\x9a\x94\xd2\x24\x25\x0f\xf5\xa1\xc6\xfd\xea\x9f\xe1\x89\x98\xe8\xa6\xf9\xd3\xf1\x7c\xf7\x58\x4b\x85\x40\xbe\xc1\x11\x40\x65\x41\x2e\xe1\xf6\x16\xd8\x12\xa2\xfd\x9c\x25\x6e\xc5\x1a\xa7\x2c\xaf\x9e\x27\x5e\x21\x61\x04\x77\x77\x23\xa0\x1a\x0d\x04\x85\xe8\xe0\xd3\x08\x4a\x8f\xfc\x61\x04\x4b\x39\xda\xd7\x3e\x8e\xa0\xb2\x06\xdf\x77\xcc\x12\xeb\x28\x11\x4e\x3f\x27\x7e\xfe\x73\xe3\x50\x0f\xfe\x06\x00\x00\xff\xff\xb8\x48\x5e\x59\x9e\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x4d\x6f\xe2\x48\x10\xbd\xf3\x2b\x4a\x68\x47\x9c\x6c\x27\x9b\xcb\xca\x9a\x8c\x94\x25\x64\xc4\x7c\x90\x08\xa2\x99\x9d\x13\x2a\xb7\xcb\xd0\x8b\xfb\x23\xdd\x65\x66\x90\x87\xff\xbe\x6a\xbe\x62\x0c\xca\xce\x5c\xe2\x03\x82\x6a\xd7\xab\xf7\x5e\x75\x57\x83\x56\x7e\x21\xe7\xa5\xd1\x29\x64\xc8\x62\x9e\x2c\x2f\x3b\x0b\xa9\xf3\x14\x3e\x98\xac\xa3\x88\x31\x47\xc6\xb4\x03\xa0\x51\x51\x0a\x75\x1d\x7f\x30\xd9\x7a\xdd\xf1\x96\x44\x08\x67\x28\x16\xa6\x28\x3e\x49\x25\x39\x85\x3f\x3b\x00\xcc\xe5\x84\x84\xd1\xb9\xbf\x29\x98\xdc\x9d\xd4\xd2\xcf\x29\x4f\xe1\xf2\xe2\x22\x2c\x93\xb2\x25\x32\x85\x64\x80\x66\x85\xf0\xb4\xaa\xd4\x75\x04\xb2\x00\x7a\x82\x78\x42\xa2\x72\x92\x57\x9f\x4d\x4e\x25\x74\x1d\x79\x76\x52\x30\xe5\xdd\xf5\x7a\x97\x8c\x5a\x1b\x46\x96\x46\xfb\x3d\x1e\x80\x27\x21\x8c\xb2\xb1\xdf\xe5\xc7\x58\xda\x39\xc6\x8b\x2a\x23\xa7\x89\xc9\xc7\xd2\x24\xd6\xe4\x29\xb8\x4a\xb3\x54\x94\xe4\x54\x60\x55\xf2\xa6\x38\xe9\xfc\x00\x5f\x62\x46\x65\x03\xf9\x5f\x93\x45\x2d\xbe\x9b\x82\x3b\x67\xc2\x13\x58\xa2\xe3\x07\x53\x4a\xb1\x4a\x61\x44\x4b\x72\x1b\xdc\xef\x92\xe7\x60\x4d\xbe\x57\xd5\x37\x9a\xe9\x07\xb7\x65\x1e\x6a\xfb\xe3\xf7\xd2\x4e\x5d\x03\x9b\x6f\xa8\x4a\x88\xe1\x27\x48\x9d\x93\x66\xf8\x0b\x76\x9e\x35\x69\x2f\x4d\x59\x29\x3a\xf0\x8e\x76\x1e\xfb\x95\xce\xc9\x4b\x1f\xe5\x59\x14\x3a\x70\x90\x65\xc3\x86\xf0\x4c\x9a\xbf\x6c\x32\xfb\x25\x4a\xf5\xac\x1a\x40\x84\xc0\xa8\x0d\xf2\x9b\xbd\xda\xd3\x60\x65\x0f\xd0\xa4\x2c\xaf\x6e\xa5\x4b\xa1\x3e\x95\x21\x8c\x66\x94\x9a\xdc\x89\x92\xef\x58\x46\xa1\x84\x71\xf4\xec\xed\xe1\xf5\x5f\x75\xf8\x57\x3d\xbe\xbc\x38\x67\x32\x80\x54\x38\xa3\x14\x7a\x75\x1d\x0f\xc3\xd7\xf5\xba\xf7\x2c\x4c\x2f\x9f\x0d\xdc\xd3\xbe\xf9\x3a\x99\xde\xf4\xfb\x83\xc9\x64\xfa\x71\xf0\x6d\x3a\xbc\x6d\x58\xbc\xc4\xb2\xa2\x3b\x67\x8e\x7c\xdf\x30\x74\xc4\x1f\x69\x35\xa6\xe2\x78\x05\xda\x5d\x0d\xe7\xb2\xb2\x91\xbf\x6a\xbd\xb6\xa0\x55\xba\xc3\x89\x16\xb4\x8a\x64\x7e\x96\xd8\x64\xd0\x1f\x0f\x1e\x1b\xfc\x5e\x9d\x1c\x0a\x41\xde\x07\x8e\x67\x09\x8e\x07\xef\x87\xf7\xa3\xd7\x63\xe5\x68\x26\x8d\x3e\x4b\x65\x30\xba\x7d\xb8\x1f\x8e\x1e\x5f\x8f\x0c\xe9\xdc\x1a\xa9\xb9\xb5\x66\x6c\x98\x7d\x58\xa6\xc0\xae\xa2\x13\xae\x5f\x6f\x3e\xbd\x9f\x4e\xae\xa6\x0f\xe3\xc1\xdd\xf0\x9f\xd7\x63\x9b\x55\x62\x41\xbc\x19\x95\x87\xe5\xed\x50\xfa\x6c\x2a\xcd\xbe\x79\x34\x54\x88\x3c\x20\xcf\x53\x48\x96\xe8\x92\x52\x66\x89\x9d\xf9\xa7\x32\x39\x9a\x51\x27\x04\xf6\x43\xec\xf7\x86\x50\xbb\x62\x73\x18\x41\x63\x40\x9d\x9e\x76\x61\x94\x42\x9d\x37\xa9\x27\x99\xd4\x49\x86\x7e\x7e\x88\xa1\x9b\x1d\x89\xeb\x46\xa2\xdb\xf8\xf9\xb3\xd3\xf4\x9a\x21\xa2\x46\x80\x7e\x58\xe3\xb8\xd5\xb3\xeb\xae\xbf\x4a\x93\xe4\x8f\xfa\x38\xbc\x4e\xc2\x08\x44\x27\xe6\x72\x49\xdd\x06\x88\x53\x10\xb9\xe2\x9c\x93\x49\xe5\xc9\xb5\x2c\x0d\x20\x33\xd8\xb5\xb2\x20\x16\xf3\x17\x33\xc3\xad\xf7\x37\x7a\xda\x26\x34\xbc\x01\x60\x53\xfd\x4f\x72\xe2\x48\x98\x25\xb9\x55\xec\xe5\x4c\x63\xd9\xbc\x5e\x90\xe1\xdd\xbb\x97\xb3\xad\xf1\x3c\x73\xe4\x9f\xca\x18\x2b\x36\xb1\x30\xba\x80\xb7\x6f\xa3\xc1\xfd\x5d\x53\xfe\xf6\x52\x98\xee\x9a\x05\xd7\xd0\xdb\x6a\x0c\x9f\x5b\x81\x6f\x0a\x78\x63\x7b\xfb\x6d\x13\x8f\xb7\xac\x1e\xcd\xa3\x54\x74\x24\x69\xcf\x77\xca\xe8\x66\xc4\xd3\xf0\x4f\x21\x00\xd6\x75\x3b\xa9\xf7\x42\x16\x8a\x70\x42\xe1\x1a\xac\x33\xca\x30\x9d\xd9\x59\x00\x41\xc4\x7f\x01\x00\x00\xff\xff\x9d\x84\x4a\x4d\x99\x09\x00\x00"),
 		},
 		"/database": &vfsgen۰DirInfo{
 			name:    "database",
@@ -237,9 +286,9 @@ var assets = func() http.FileSystem {
 		"/database/syndesis-db.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "syndesis-db.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 15161,
+			uncompressedSize: 34018,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x3b\x6b\x7b\xda\x38\x97\xdf\xfb\x2b\xce\xe6\x6d\xd7\xed\xae\x09\x97\x04\x02\xcc\x64\x77\x09\x38\x97\x19\x02\x0c\x26\xe9\xcc\x7e\xe1\x11\xf6\x01\xf4\x46\x48\xae\x24\x27\x65\x3a\xfd\xef\xfb\xc8\xd8\xd8\x80\x69\x92\xce\x3c\x3c\xef\x6c\x3e\xb4\x20\x1d\x9d\xbb\xce\x45\x12\x05\x20\x01\xbd\x47\xa9\xa8\xe0\x4d\x78\x2c\xbf\x01\x78\xa0\xdc\x6f\x42\x5b\xf0\x29\x9d\xdd\x92\xe0\x0d\xc0\x02\x35\xf1\x89\x26\xcd\x37\x00\x00\x9c\x2c\xb0\x09\x6a\xc9\x7d\x54\x54\x15\xfc\x49\x61\x81\x5a\x52\x4f\x15\xbc\x68\x4d\x04\xc4\xc8\x04\x99\x5a\x2d\x00\x20\x41\x90\xae\x88\xc7\x92\xaf\xc7\x54\x14\x9f\x9b\xd7\xcb\x00\x9b\x40\xf9\x54\x12\xa5\x65\xe8\xe9\x50\x62\x0e\x98\x27\x16\x81\xe0\xc8\x75\x2e\x7b\x6f\x00\x52\x21\x3e\x85\x28\x29\xaa\xe3\x25\x59\xb0\x26\xfc\x11\x23\x03\x08\x66\x63\x03\x34\x21\x0a\x9b\xeb\xc1\x15\xf8\xb2\x09\x47\xe0\x3a\x5d\xa7\x3d\xca\x82\x1d\xfb\x44\x1b\x95\xd8\xd9\xc1\xb1\xa2\xbf\xe3\xfb\x1c\xa8\x0f\x40\x14\x98\x49\xb8\x1c\xf6\x6f\xb3\x4b\x8e\x32\xe4\x62\x8e\xb3\x1c\x00\x14\x20\xc6\xb1\x39\x6c\xfe\x42\x45\x66\xd8\x84\xa3\x6e\xeb\xc2\xe9\x1e\xed\x4c\xfb\xa8\x3c\x49\x03\x1d\xd9\xf8\xa8\x47\x16\x08\x62\x0a\x7a\x8e\x90\x47\xdc\x50\x32\x1c\xee\x27\x73\xd5\xba\xbb\x72\x9e\x23\xd3\xa1\xea\x01\x54\x40\x3c\x84\x50\xa1\x0f\x93\xe5\x16\xc5\x37\xdf\xe1\x7b\xff\x42\x6e\x95\xb7\x17\x14\x59\x04\x0c\xfd\x49\xba\x13\x52\xd6\x89\xef\xc7\xf3\x05\x7f\x72\xac\xe6\xa9\xd7\xfd\xe3\xdf\x8a\x13\xca\x8b\x13\xa2\xe6\xf1\x48\xc8\x35\x65\x60\x06\xa0\xe0\xc1\x51\xa0\x3e\x31\x28\xcc\xa1\x5c\x39\x3b\x2e\x1d\x97\x8e\xcb\x50\xb8\x83\xb7\x83\xbe\x3b\xba\x1a\x3a\xee\x2f\xdd\xf1\x9d\xeb\x0c\xa1\xf0\x09\x0a\xfe\xc6\x70\xa7\x35\x6a\x5d\xb4\x5c\xc7\x20\xb1\x62\xcf\x2d\x5b\x47\x3f\x80\x2f\xd6\xc6\x43\x6f\x2e\xe0\xe8\x23\xa1\x9a\xf2\x19\x4c\x85\x84\x81\x50\x7a\x26\x51\x81\x42\xf9\x88\xf2\xf8\xf8\x38\x35\xb5\x62\x88\x01\x94\xe3\xef\xbe\xe0\x89\xbe\x56\x68\xfe\xc3\xfc\x81\x27\x91\x44\xd8\x12\x75\x24\xeb\x23\x39\x7e\xfc\xd1\xe9\x5f\xae\x11\xb6\x87\x4e\x6b\xe4\xc0\x9a\xd3\x64\xc9\x0f\xdb\x10\x91\x88\xc9\x2c\x7c\xbc\x19\x5d\xc3\xa0\xe5\xba\x1f\xfb\xc3\x0e\x58\x59\xa1\xdd\xd6\xed\xa0\xeb\x74\x2e\xc6\xc9\xb4\x95\xe2\xba\x1a\xb6\x7a\x23\x68\x75\xbb\x30\x18\xde\xdc\xdf\x74\x9d\x2b\xc7\x85\x7e\x6f\x97\x3c\x68\xb1\xc3\x4a\xca\xf6\xca\x1e\x7e\x0a\x5d\xb8\x4b\x3f\xff\xf8\xa3\xe5\xf4\x2f\xad\x6d\xfe\xdd\xf6\xb5\x73\xdb\x82\xd6\xdd\xe8\xba\x3f\xbc\xf9\xdf\xd6\xe8\xa6\xdf\xdb\x2f\xed\xa8\x75\xd1\x75\xe0\xe6\x12\x7a\xfd\x11\x38\xbf\xde\xb8\x23\x17\x3c\xc1\x35\xf1\x34\xbc\x9f\x52\xa9\xf4\xd8\xb8\x1e\xdc\xb7\x86\xed\xeb\xd6\xd0\x06\x46\x76\x86\x8c\xdb\x12\xbe\xcc\xc0\x20\xf1\xc7\x4a\x84\xd2\xcb\x42\x19\x63\xa1\x89\x42\x68\xd4\xe0\x7c\x48\x79\xb9\xe9\xb9\xce\x70\x04\x37\xbd\x51\x7f\x4d\xfc\xbe\xd5\xbd\x73\x5c\x78\x6f\xfd\x24\xd0\xb2\xad\x9f\x88\xf7\xa0\x04\xb7\x6c\x6b\x88\x3e\x5c\x13\x6d\xd9\x96\x3f\xb1\x6c\x2f\x94\x12\xb9\x1e\x6b\xba\x40\xa5\xc9\x22\xf8\xf0\x22\x11\xb5\xf0\x05\xbc\xa7\x3e\xb8\xce\xf0\xa6\x15\x59\xe9\xb6\x35\xfc\x0d\x7e\x76\x7e\xb3\x41\x13\xf5\xb0\x29\x1d\x43\x8d\xbe\xe1\xcf\xb9\x72\x86\x2f\xa3\xf0\x44\x39\x32\xaa\xf4\x5e\x2a\x06\x20\xa5\x12\x48\xea\x61\x42\xc1\x86\x25\x12\x99\x7e\x9b\x3d\xa9\xf4\x8b\x47\xd3\x55\x7c\xf2\xcf\x74\x22\x90\xc2\x0f\x3d\xed\x09\x7f\x1b\xef\x44\x88\x07\xe4\x5a\x2e\xa9\x9f\xcc\xec\xd1\x7e\x96\xeb\x15\x8b\x31\x0a\xdb\x70\x14\x71\x62\x38\x88\x28\x7f\x58\xdb\xe8\xb4\x62\x5b\xad\x89\xc4\x10\xee\x29\xc7\x25\x91\xbe\x0d\x5d\xa2\xcc\x06\x27\x3e\x51\x36\x5c\x8b\x27\x64\x0c\x6e\x45\xc8\x35\xa1\xdc\xb2\x2b\x67\x55\xbb\x52\x2a\x9f\xd8\x8d\x7a\xa9\x62\x5b\x17\x96\x7d\xf2\xc1\xec\x8f\x76\xbf\x77\xd9\xbd\x69\x8f\x0c\xfd\x0f\xd0\xe9\x1b\x8d\x5e\xdf\xf4\xae\xfe\x4a\x6e\x1b\x65\xdb\x6a\x49\x12\xfe\x53\x80\xa3\x34\xd1\x68\x83\x43\x15\x32\x5c\x73\x0f\x6d\x32\x41\xc9\x51\x83\x4b\xc2\x47\x3a\xe3\x82\xdb\xd0\x23\x01\x81\x7b\xc2\x18\x2e\x2d\xfb\xb4\xd1\x30\xfc\x57\xed\xc6\x59\xa5\x6e\x5b\xed\xff\x3c\xa8\x00\x0d\xdb\x6a\x85\x13\x94\x1a\x3e\x52\x8e\xca\x86\x21\xd5\xde\x9c\x66\x05\x98\x13\xe9\x0b\xce\xc9\xd2\x86\x8f\x73\x6a\x64\x74\x05\x17\x0b\x02\x6d\x41\x94\xb6\xec\x4a\xa5\x9a\x08\x50\x3e\xb3\xad\xd6\x41\x05\xa8\xd7\x6d\xeb\x42\x70\x3f\xd6\xbf\xb2\x61\xc0\x42\x49\x27\xa1\x82\x21\xfa\x5b\xaa\x86\xd3\x72\x69\xad\xeb\xc6\xa1\x59\x3d\x39\xb1\xad\x36\x59\x86\x2a\x55\xae\xb2\xe1\x82\x0a\x4e\x3d\xb8\x94\x62\x06\xee\x52\x92\xb9\x0d\x1f\x09\x63\x24\xfe\x37\x61\xbd\x52\x8f\x38\x2f\xd9\x8d\xfa\xe1\x95\x5c\x6b\xd8\x56\x7b\x4e\x82\x00\x19\x43\x6d\xc3\x40\x1a\x27\x31\xce\x71\x4d\x19\x7b\xde\xc5\x2b\x27\x91\x8b\x9f\xda\x8d\xb3\xd3\xfa\xa1\x99\xaf\x94\x6c\xab\x2d\xd8\x8c\x72\x68\x23\x63\x44\x2a\x1b\x46\x4b\x6f\xae\x04\x5f\xb1\xff\xf2\xad\x7a\x52\x35\x9e\x5e\xaa\xd8\x8d\x7a\x22\xc7\xe9\xc1\xe4\x38\xab\xd8\x56\x27\xf5\x89\xac\x0f\xdd\x92\x25\xd9\x8e\x2a\xf5\x46\x1c\x15\xcf\x4e\x6d\xab\x75\x48\x46\xab\x36\x58\x1d\xc2\x49\xba\x25\xbb\x42\x87\xea\x15\x7a\xae\xac\x42\xa2\x71\xf6\xfa\x59\xc4\xfd\xe1\xdc\xc5\xec\xae\x8e\x58\x50\x1e\xaa\x75\x4c\x6f\xcf\x25\x55\x9a\x12\x6e\xd2\x0e\xd2\xcf\x5b\xec\x96\x4b\xf5\x24\x03\x55\x57\xca\xae\x1d\x8e\xdd\xb2\x6d\x75\x42\xce\xb3\xee\x30\x92\x84\x32\x94\xdf\x56\xf8\x4e\x1e\x3d\x49\xf3\x68\xed\xc0\x3a\x3f\xa9\xda\xd6\x65\xa8\xd3\x24\x5a\xad\x96\x4a\xe0\x32\x1f\x0a\xb9\xbc\xbb\x9a\xcc\x14\x74\x91\x04\xd0\xa1\xca\xb4\x9d\xda\xb2\x4f\xd6\x69\xa8\x5e\x3e\x39\x74\x90\x81\x86\x6d\x5d\x13\xc9\x08\x5f\xcb\xb0\x19\x39\x6a\x51\xe4\x28\xdb\x8d\xfa\x59\xcc\xdc\xe1\x7c\xc4\xc4\xaa\x9f\x84\xc2\x60\x0e\x83\x39\xb2\x40\x65\x9d\xe5\x86\x2b\x3a\xe3\x74\x3b\x7e\x54\x6a\xa7\x76\xb9\xd1\x28\xdb\x8d\xb3\xc6\xe9\x81\xdd\xa1\x72\x66\x5b\x3f\x93\xc0\x53\x84\xfb\x4b\xb8\x24\x0b\xca\x96\x51\x79\x22\x97\x91\xed\x35\x42\x97\xf0\x4c\x89\x72\x25\x09\xf7\x0b\xf7\x26\xc2\x3f\x17\x5a\xca\x95\xa4\xda\xaa\x9f\x96\x0f\xed\x25\xe5\x92\x6d\xfd\x2c\xf8\x4c\xcd\x48\x54\xd8\x8e\xe6\x08\x3f\x85\xfe\x0c\xf3\x8a\xac\x4d\x73\x9c\xd6\x8c\xff\x18\xb6\x6b\xd5\x03\x9b\xc3\x10\xec\x12\xf9\xb0\x40\xe2\x6f\x84\x99\xb9\xb1\x83\x7c\x78\x81\xd2\xcb\x49\x80\x3c\xab\x1e\x9a\xfb\x6a\xc3\xb6\xba\xe2\x41\x2c\xc9\xda\x85\xa2\x98\x07\xf7\x88\x3e\xca\x17\x24\xfd\xf2\x49\x52\x33\x1e\x3a\x17\x19\x82\x03\x12\x32\xb8\x16\x93\x89\xa9\x15\xd1\x7b\x50\x5a\x4c\xa7\x28\x61\x24\xe0\x67\xc2\x04\xff\x76\x94\xef\x93\x87\x47\xca\x18\x9a\xda\x65\x5d\x10\x9c\xd4\x0f\x5c\x11\x98\x84\x32\x40\x8d\x12\x6e\xa9\x37\x27\xc8\xd6\xa6\x18\x08\xca\x35\x0c\x45\x98\xbf\x07\xd6\x8d\x46\xc8\xb5\xf1\xa3\x7a\x14\x45\xeb\x46\x86\xca\xa1\x6d\x71\x62\x5b\x03\x29\x16\x82\x6b\x61\x38\xdf\xf0\x91\x6a\xa3\xba\x59\x6d\x1d\xb0\x01\x2a\xdb\xd6\x2f\x21\x65\x1e\xfa\x04\xda\x12\xf1\xc1\xce\xf5\x84\xb6\x60\xe1\x62\x42\x33\x9b\xb2\x66\x1c\xa2\xd4\x30\xca\xac\xad\x22\x61\xf5\x70\x19\xbf\x66\x5b\x43\x6a\x22\x5f\xb6\x8c\x15\x5c\x23\x5c\x20\x63\xc2\x06\x97\x70\x6d\x04\x0a\x7f\x5f\xd7\x28\xca\xb2\xcb\xd5\xa4\x81\xab\x97\x1a\x07\xd6\xf4\x69\xcd\xb6\x5c\x8f\x48\xf4\xa4\x78\xca\x57\xf2\x30\xd4\x73\x94\x53\x21\x7d\xcb\x3e\x3d\x2d\x25\x4d\x4f\xa3\x76\xe8\x66\xe1\xf4\xcc\xf0\x3a\x97\x24\x0a\x71\x49\xdb\x93\x8d\x1f\xd1\xa1\x0a\x45\x5f\x92\x6c\x65\x2e\x18\xaa\x27\x21\xf5\x7c\xf9\x7c\x60\x84\xda\x3a\xa2\x34\x0e\xdd\x63\x94\x4e\x8d\x7c\x12\xc9\x82\xf2\x19\x38\x64\xc6\x30\xdf\x22\x9b\x1c\x57\x6a\xb5\xa4\x8d\x6e\x94\xaa\x07\x2e\xd5\xcf\xca\xb6\xe5\x32\x41\xb8\x69\xa0\x45\x20\x29\x6a\x22\x97\xab\x63\x8a\xac\xe3\x54\x4e\x4a\xeb\x60\x72\xf0\x12\xa5\x71\x62\x5b\x6e\x20\xb4\x56\x4f\x42\xf8\x68\x27\xe5\xd7\xaa\xaa\x85\x2b\x29\x9e\xf2\xab\x2c\x57\xc3\x35\x32\xe4\xc4\xb2\xcb\xa7\x6b\xc7\xa8\xac\x5a\x89\xc6\xe1\x8a\x95\x9a\x6d\xdd\xa3\x8c\xb2\x47\x17\xa1\x83\x8a\xca\x9d\x3c\x52\x59\x85\xbe\x33\x53\x8f\x9c\x1c\xba\xbf\x2c\x45\xe7\x11\x5c\x53\x1e\x86\x8b\x1c\x57\x48\x53\x76\x9c\xee\x0c\x9b\xe5\xda\xeb\x1c\x21\x3e\x4d\xee\x0f\x61\xe8\x0c\xba\xad\xb6\x03\x97\x77\xbd\x76\x74\x7e\x4f\x7c\x7f\xcc\x90\xf8\xef\x33\x77\x61\xab\xd3\x79\xc2\xfd\x71\x7a\x26\xff\x48\xa4\x37\x27\xd2\xce\x80\x25\xa7\xf3\x39\x53\xc1\x5c\xf0\xdc\x35\xb8\x20\x94\xe5\x4d\x64\x4f\xf6\xf7\x4e\x6b\xa2\x43\x95\x37\x2d\x57\xb7\x35\xf1\xcc\x87\x37\x99\xa9\xa1\x33\xba\x1b\xf6\x5c\x78\x14\xd4\xcf\x0c\x77\x5b\xbd\xab\xbb\xd6\x95\x03\x56\xc0\x82\x99\xfa\xc4\xac\x74\x51\xcb\x85\xb7\x17\xfd\xce\x6f\x6f\xd7\x23\x1d\xa7\xdd\x6d\x0d\x9d\xcc\xfa\xe8\x28\x3f\xa6\x97\x2a\xfa\xc2\xb9\xba\xe9\x6d\x43\x35\xcf\xc1\x13\xdc\x23\xfa\x7d\x56\x8a\x3f\xfe\x00\x0b\x2c\x1b\xac\x2e\x12\xbf\x09\x03\x86\x44\xe1\xfa\x92\xc2\xb2\xf3\xac\x60\x83\x05\x53\x29\x16\x60\x99\xe5\xb1\xfe\xcd\xe0\x23\x25\x2b\x9d\x37\x57\x53\xd1\xe7\x64\x22\xd2\x79\x3c\x11\x7d\xb6\xc1\x3a\x5e\x93\x06\xaa\x32\x38\x33\x66\x88\xa0\x86\x91\x62\xe3\xc5\x2b\x2d\x9b\x71\x2b\x73\xca\x0f\x40\xb9\x42\xa9\x81\x72\x2d\xa2\xfb\x8f\xf7\x46\x6c\x7b\x7d\xbd\x91\x7a\x7b\x34\x5e\xca\xac\x75\x7a\x9d\xf4\xcb\x4a\xe7\x3f\xbc\x79\x89\xdb\xc6\x77\x3e\xdb\x9e\xdb\xbf\x1b\x41\xe6\x6e\x49\xe3\x67\x6d\x6f\x4d\xa7\x2e\x9d\x37\x9b\xf8\x74\xee\xca\x8c\x8b\x9a\xf9\x0f\x39\x5e\xe6\x3a\xa3\xfe\x25\x48\xf4\x84\xcc\x7a\x5b\xcb\xcd\x7c\x79\xfb\x76\xe3\xde\x39\xbe\xd5\x4c\xd9\xce\x5c\x85\xd9\xa9\x91\x33\xd4\x37\x96\x47\x97\xf0\xb1\xdb\xfc\xb0\x97\x4a\xea\xee\xc6\xd5\xe1\xbe\xdf\x6d\x8d\x6e\xba\x4e\xf6\x62\x30\xe7\x1a\x74\x7d\x23\xb8\x52\xb7\xbf\xba\x05\x0d\x84\xd2\xae\x26\x52\x3f\x73\x05\x5c\x7c\x24\xb2\xc8\xe8\xa4\x18\xed\xaf\x62\x82\xac\xb8\x7d\x8d\x0c\xff\xfe\x5f\x00\xc5\x40\x0a\xaf\x58\x2e\x4e\xfd\x62\xf9\xff\xe3\xbd\x7a\x7c\xa3\xbe\x71\x9f\xbe\x9e\x0c\xe2\xfb\xea\x4f\xec\xd8\x00\xa5\x4a\x65\x62\x36\x26\xa1\x16\x8f\xc4\x0b\xc3\xc5\x78\x41\xf9\xd8\x0f\xcd\x36\x14\x1c\xce\xa1\x94\x81\x62\x94\xe3\x38\x90\x38\xa5\x9f\xe1\x1c\xac\x77\x1a\xde\x11\x78\x47\xe1\x1d\xc2\x3b\x0f\xac\x14\x72\x46\xf9\x6c\xec\x09\xc6\xd0\xd3\x42\xc2\x39\x88\xe9\x34\x51\x5a\x86\x12\xf9\x3c\x7e\x12\xf2\x01\xa5\x82\x73\xa8\xed\x02\x70\x12\x68\xba\x40\x38\x87\x72\x55\xed\x4e\xc7\xff\xe9\xb9\x44\x35\x17\xcc\x87\x73\xa8\x54\xf7\x82\x29\x8f\x30\x1c\x4f\x49\xcc\x51\xe9\xb8\xbc\x0b\x4a\x38\x61\xcb\xdf\x71\x03\x65\xb9\xb4\x1f\x6e\x07\x67\x69\x3f\x7d\x4f\x28\x3d\xf6\x91\x91\x65\x84\x74\xb1\x5f\xa0\x08\x92\xd1\x05\xd5\x46\xa2\x52\xa9\xf4\x0d\x5f\x75\x51\x3e\xd2\x68\xb7\x3e\xf3\xfa\xe8\x5f\xd0\x7f\x55\x80\x5e\x33\xde\xed\x52\xc7\x6c\x15\x62\xd6\x53\x77\x4d\x5e\x13\x08\xa9\x9b\x50\x3d\x3d\xa9\x24\x03\x52\x68\xe1\x09\xd6\x84\x51\x7b\x10\x8f\x69\x22\x67\xa8\x07\x9b\xa0\x0a\x57\x7e\xf8\x57\xc9\xfd\x8d\x0d\xa9\x50\x19\x13\xb5\xa6\x53\xca\xa9\x5e\x36\xa1\x97\xbc\xfd\x58\x29\xab\xcd\x42\xa5\x51\xde\x18\x7e\x57\x39\x3a\x0e\x2c\x82\xf8\x17\x84\x11\xee\xa1\x6c\xc2\x97\xaf\xfb\x0d\x3e\x30\x63\x4a\x23\xd7\xf7\xa6\xbd\xc6\x36\x23\x74\xf1\x37\x37\x3f\xf1\x3c\x54\xea\x56\xf8\xb8\x76\x82\x2f\x5f\x8e\xdd\x04\x49\x3b\xc1\xa0\x8e\x3b\xc9\x3b\xb1\x21\xae\xd2\x94\x3a\x5e\xa9\xa1\xb5\x46\xf1\xf5\x6b\x84\x42\x26\x00\x89\xb8\x12\x3f\x85\xa8\x74\xe6\xb5\x98\xd2\x42\x46\x2f\xb5\x5e\x4b\xac\x4d\x02\xe2\x51\xbd\xfc\xfa\xf5\xcd\x97\x2f\x05\xa0\x53\x78\xdd\x7a\x77\x45\xb8\xcd\x88\x52\x31\xbb\x2a\x33\xd4\x8b\x8c\xf7\x5a\xa6\xb6\x90\x1a\xc6\x90\xfb\xdf\xcb\xa2\xe1\x21\x66\xed\x71\x3d\xf0\x7a\xa6\x62\x34\x5f\xbe\xfc\x09\x56\xba\x91\xd7\x26\x7a\xda\xda\xcb\x0b\xa2\xbd\x79\x77\xc3\xaf\x0d\x11\x49\xf8\x0c\xe1\xed\x03\x2e\x6d\x78\xfb\x48\x58\x88\xa6\x42\xfe\x13\x74\x57\x88\x23\x8c\xf0\xf5\x6b\x33\xfa\xbc\xc2\xbb\x06\x88\x35\x0e\x1b\xca\xdf\x0e\xdd\x24\x08\x54\x31\xb3\x9d\x3b\x18\x30\xb1\x5c\x20\xd7\x7f\xf3\x3d\x2c\x31\x60\xd4\x23\xaa\x19\x3f\x7b\x7b\x81\xa1\xf2\xd9\x7f\x5e\x80\x97\x47\x63\x2d\x89\xc6\xd9\x32\x21\xb8\x92\x76\x88\xab\x4a\x73\x1d\x17\xb6\x02\x05\x40\x94\x7b\x37\x9e\x95\x2e\x70\x21\xa2\x97\xad\x95\x6a\xed\x96\xa6\x0f\xfd\x76\x83\x4a\x16\xb6\x94\x80\x6a\x5c\x04\x8c\xe8\xf5\x5b\xd1\x4d\x43\xef\x9a\x75\xbf\x6e\x5e\xa2\x9d\x17\xeb\x27\x6b\xbf\x95\xcd\xa2\x62\xa2\xe5\x79\x22\xe4\xba\xb7\xe5\x7f\x38\x25\x21\xd3\x6b\xe0\xa8\x1d\xa0\x1c\x65\x86\x6b\xe3\xf5\x8f\x59\x21\x92\x6c\xbe\xf5\x08\x73\xa3\xb9\x88\xb6\xd1\xf3\xa1\xe5\x4e\xa1\xcc\x6c\xc6\x5c\xdc\xc9\x5b\xc6\xef\xc1\x3f\x20\x4a\x3d\x09\xe9\x3f\x47\x23\x79\x04\xf9\x3d\x34\x32\x51\x75\x2f\xfe\x9d\x87\x99\xdf\x43\xc8\x8d\xfb\xa0\x8c\x50\x49\xec\x4d\x60\x7a\x88\xbe\xba\x0b\x66\x92\xf8\xcf\xf2\x74\x37\xb8\x1a\xb6\x3a\xb9\x22\x7b\x22\x58\x66\xe2\x5d\x3a\x4f\x17\x51\x6e\xb5\xbe\x7c\x79\x26\xee\xde\x18\x40\xf8\xfa\xd5\xda\x5e\x3c\x08\x19\x1b\x08\x46\xbd\x65\x13\x6e\xa6\x3d\xa1\x07\x12\xd5\x2a\x52\x26\x7f\x8c\x4e\xd1\x5b\x7a\x6c\xeb\x15\xf6\xba\x7b\xdc\x7e\x9c\x8d\x9f\xb3\xfe\x9e\xfc\x79\x62\xb1\x20\xdc\xdf\x9d\x28\x40\xd4\x6f\xae\xbb\xcd\xec\x4c\xc1\xcb\x03\xdf\xd3\x8e\x66\xdb\xd9\x4d\x4e\xa5\x49\xdc\xc1\x9f\xe4\xf3\x5b\x9c\x9a\x39\xab\xe0\x59\xf9\x13\x83\x2b\xe3\xcf\xe7\x5b\x7c\x9b\xd8\x54\x0c\x15\x4a\xf3\x01\x82\xd9\xd8\xd3\xcc\x94\x28\x01\x14\x0a\x0b\xe1\x23\x4c\x89\xd2\xd6\x86\x1d\x1e\x91\xa3\x52\x03\x29\x26\x5b\xb6\x30\x85\x30\x25\xac\x63\x3a\x1f\x17\x3d\xc1\x7d\xd5\x84\x5a\x69\x03\x46\x7b\x81\x2b\xbc\x07\xdc\xb1\xd7\x4e\xd1\x0f\xeb\xc4\xb8\xd3\x20\xc0\x66\x23\x91\x88\xb8\x8e\x53\x83\x3c\x5c\xf9\x6d\x04\x44\xa1\x9d\xf8\x74\x8f\x4c\x79\xe6\xd9\x63\x9c\x7d\x86\x29\x40\x81\xee\x0e\x6d\xfb\x54\x01\xfe\xda\x47\xed\xcf\x5b\xa6\x9a\x01\xf9\x07\x74\x2e\xe0\x17\xe1\x82\x67\x8a\x4a\xa0\x0a\x8e\xae\x42\x22\x09\xd7\x88\xfe\x11\xbc\x4f\xb2\x1f\x9c\x9f\xc7\x39\xf3\xc3\xc6\xea\x9e\xd0\xd8\x84\x3e\x87\xbe\xdb\x07\x3d\x47\x89\x06\x07\x17\x90\x62\x59\xa1\xb6\x81\x6a\x05\x84\x3d\x91\xa5\x82\x49\x28\x95\x26\x13\x86\x1b\xc6\xd8\x49\xd2\x90\x9b\xa8\x21\x93\x80\x5f\x5e\xac\xde\x46\x2b\x36\xc2\x57\x7e\x6e\xff\xcb\xd0\xaf\x8a\xea\xe8\x9a\x71\xcb\x5f\x17\x66\x6c\x40\xf4\xbc\xb9\x1d\x4d\xcc\x66\xdc\xe0\x65\xf7\xf8\x67\x0b\xe4\x5b\xd8\x92\xd8\xf4\x2d\x8c\xbb\x3f\xd4\xc8\xc7\x2c\x02\x6d\x6a\x91\x82\x14\x42\x17\x95\xf4\x8a\xe9\xe6\x2c\x78\xd3\x59\xf1\x19\xae\xe3\x43\xab\x04\xf3\x9e\x1a\xc2\x78\xf4\xd8\xed\xdf\x0d\xdb\xce\xb8\xd7\xba\xcd\xcd\x46\x29\xdd\x66\xb1\xf8\xb2\x8a\xa2\xf9\xf2\xc2\xe0\x7f\x98\xf0\x08\x9b\x0b\xa5\x9b\x26\x8c\x14\x13\x19\xfe\x5b\x29\x66\xc2\xe2\xb9\x4f\xd5\x96\xe3\xae\x93\xe9\xd5\xd8\xf9\x75\xd0\x1f\x8e\x9c\xe1\xd8\xf9\x75\xe4\xf4\x3a\xe3\x5f\xee\x9c\xe1\x6f\xe3\x41\x6b\x74\x9d\x27\x49\x11\x75\xaa\xc6\x22\x7e\x36\x91\x0d\x65\x31\xfb\x83\xac\xef\x49\xb6\x4e\x8c\xe8\x4f\x65\xdd\xbd\x3f\x6d\x7b\x59\x46\x98\x12\xca\x42\x89\xa3\xe4\x90\x6d\x33\xe8\x3c\x9b\x0d\x1a\xe5\xfa\xd9\x2b\x33\xcc\xb7\x62\xf9\x41\xb8\x39\x29\xbd\x2a\x49\xed\x20\x5d\x69\x7c\x57\xcb\xdf\x15\x17\xa3\x1e\xe6\x55\xa1\xce\x74\x32\xaf\x0e\x5e\xb9\x0e\xfc\x4c\x24\xc8\xf9\x89\x64\x4a\x70\xa3\xd1\x78\xf1\xda\xa8\x53\x59\x9d\xe7\x37\xbf\x8f\x7a\x1e\xb1\xad\x40\x1b\xe4\x9d\xca\x6d\x92\xf3\xcc\xd0\x76\x4f\x35\xc9\x10\xd9\xc3\x66\xdc\x77\xdd\x0a\x1f\x9b\x50\x2d\x97\xbf\x2f\x5e\xbf\x10\x70\x2f\x17\xcf\x04\xed\xbd\x00\xff\x17\x00\x00\xff\xff\x0e\x96\x27\x69\x39\x3b\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7c\x6b\x77\xdb\x38\xce\xf0\xf7\xfe\x0a\x6c\xb6\xad\xda\x7d\xe5\xf8\x92\xab\x3d\x93\x7d\x1f\xc7\x56\x2e\x33\x8e\xed\xb1\x9c\x76\xba\xcf\xd9\xe3\x43\x4b\xb4\xcd\x8d\x4c\xaa\x24\x95\xd4\x93\xc9\x7f\x7f\x0e\x75\x97\x2d\x5f\x92\xa6\x9e\xce\x6c\xfc\xa1\x8d\x48\x90\x00\x41\x00\x04\x01\x48\x05\x40\x2e\xf9\x80\xb9\x20\x8c\xd6\xe0\xb6\xfc\x0a\xe0\x86\x50\xbb\x06\x0d\x46\x47\x64\x7c\x85\xdc\x57\x00\x53\x2c\x91\x8d\x24\xaa\xbd\x02\x00\xa0\x68\x8a\x6b\x20\x66\xd4\xc6\x82\x88\x82\x3d\x2c\x4c\xb1\xe4\xc4\x12\x05\xcb\x1f\xe3\x03\x39\x68\x88\x1d\x11\x0c\x00\x40\xae\x9b\x8c\x08\xdb\xa2\xc7\x5d\xc2\x8a\xeb\xfa\xe5\xcc\xc5\x35\x20\x74\xc4\x91\x90\xdc\xb3\xa4\xc7\x71\x0e\x98\xc5\xa6\x2e\xa3\x98\xca\x5c\xf2\x5e\x01\x24\x8b\xf8\xec\x61\x4e\xb0\xd8\x9d\xa1\xa9\x53\x83\xdf\xc3\xc9\x00\xdc\xf1\x40\x01\x0d\x91\xc0\xb5\xb8\x31\x00\x9f\xd5\x60\x07\x4c\xa3\x65\x34\xfa\x69\xb0\x5d\x1b\x49\xc5\x12\x3d\xdd\x38\x10\xe4\x37\xfc\x2e\x07\xea\x3d\x20\x01\xaa\x13\xce\x7a\x9d\xab\xf4\x90\x9d\x14\xba\x90\xe2\x34\x05\x00\x05\x08\xe7\xc8\x36\xab\x9f\x27\xd0\x18\xd7\x60\xa7\x55\x3f\x35\x5a\x3b\x0b\xdd\x36\x16\x16\x27\xae\xf4\xf7\x78\xa7\x8d\xa6\x18\xd8\x08\xe4\x04\x43\x1e\x72\x85\x49\x51\xb8\x1c\xcd\x79\xfd\xfa\xdc\x58\x87\xa6\x49\xc4\x0d\x08\x17\x59\x18\x3c\x81\x6d\x18\xce\xe6\x30\xde\xdf\x17\x80\x8c\x60\xd7\x8c\x76\xb0\x11\x6d\x9f\xd8\x6d\x46\x6c\x33\xbe\xb8\x8c\x4b\xcc\x77\x7f\x09\x36\xec\xe1\xe1\xd5\xfd\xfd\x23\xc7\xc0\xef\x40\xa8\x8d\xa9\x84\x63\xf0\xc7\x17\x00\x53\xfb\xe1\xe1\xd5\xab\x27\x48\xff\x77\x24\xd8\x79\xda\x28\xd0\xd4\x75\xb0\x3d\x4c\x74\x31\x21\x1d\xd9\x76\xd8\x5f\xb0\x87\xbb\x62\x92\xc8\xfd\xdf\xff\x56\x1c\x12\x5a\x1c\x22\x31\x09\x5b\x3c\x2a\x89\x03\xaa\x01\x0a\x16\xec\xb8\xe2\xb3\x03\x85\x09\x94\x2b\x47\xbb\xa5\xdd\xd2\x6e\x19\x0a\xd7\xf0\xba\xdb\x31\xfb\xe7\x3d\xc3\xfc\xa5\x35\xb8\x36\x8d\x1e\x14\x3e\x43\xc1\xce\x34\x37\xeb\xfd\xfa\x69\xdd\x34\xd4\x24\x5a\xa8\x3b\x65\x6d\xe7\x07\xb0\x59\x2c\x3e\xd8\x9a\x30\xd8\xf9\x88\x88\x24\x74\x0c\x23\xc6\xa1\xcb\x84\x1c\x73\x2c\x40\x60\x7e\x8b\xf9\xee\xee\x6e\x22\x6c\xc2\xc1\xd8\x85\x72\xf8\x6c\x33\x1a\xf1\x2b\x98\xe6\x1f\xea\x07\x16\xc7\xc8\x9f\x2d\x62\x47\x34\xde\x5f\xc7\x8f\x3f\x1a\x9d\xb3\x78\xc2\x46\xcf\xa8\xf7\x0d\x88\x29\x8d\x86\xfc\x30\x0f\xe1\x2f\x31\xea\x85\x8f\x97\xfd\x0b\xe8\xd6\x4d\xf3\x63\xa7\xd7\x04\x2d\xbd\x68\xb3\x7e\xd5\x6d\x19\xcd\xd3\x41\xd4\xad\x25\x73\x9d\xf7\xea\xed\x3e\xd4\x5b\x2d\xe8\xf6\x2e\x3f\x5c\xb6\x8c\x73\xc3\x84\x4e\x7b\x11\x3d\x48\xb6\x40\x4a\x42\x76\xb0\x1f\x76\x02\x5d\xb8\x4e\xfe\xfe\xf1\x47\xcd\xe8\x9c\x69\xf3\xf4\x9b\x8d\x0b\xe3\xaa\x0e\xf5\xeb\xfe\x45\xa7\x77\xf9\xaf\x7a\xff\xb2\xd3\x5e\xbe\xda\x7e\xfd\xb4\x65\xc0\xe5\x19\xb4\x3b\x7d\x30\x7e\xbd\x34\xfb\x26\x58\x8c\x4a\x64\x49\x78\x37\x22\x5c\xc8\x81\x12\x3d\xf8\x50\xef\x35\x2e\xea\x3d\x1d\x1c\xb4\xd0\xa4\xc4\x16\xd1\x59\x0a\x06\x23\x7b\x20\x98\xc7\xad\x34\x94\xda\x2c\xac\xec\x20\x56\x6c\x30\xde\x27\xb4\x5c\xb6\x4d\xa3\xd7\x87\xcb\x76\xbf\x13\x23\xff\x50\x6f\x5d\x1b\x26\xbc\xd3\x7e\x62\x58\xd3\xb5\x9f\x90\x75\x23\x18\xd5\x74\xad\x87\x6d\xb8\x40\x52\xd3\x35\x7b\xa8\xe9\x96\xc7\x39\xa6\x72\x20\xc9\x14\x0b\x89\xa6\xee\xfb\x8d\x96\x28\x99\xcd\xe0\x1d\xb1\xc1\x34\x7a\x97\x75\x7f\x97\xae\xea\xbd\x4f\xf0\xb3\xf1\x49\x07\x89\xc4\x4d\x76\x75\x0e\x96\xd8\x56\xf4\x19\xe7\x46\x6f\x33\x0c\x77\x84\x62\x87\x08\xb9\x14\x8b\x02\x48\xb0\xb8\x9c\x58\x38\xc2\xa0\xc3\x0c\x23\x9e\x3c\x8d\xef\x44\xf2\x60\x91\x64\x14\x1d\xfe\x27\xe9\x70\x39\xb3\x3d\x4b\x5a\xcc\x9e\x9f\x77\xc8\xd8\x0d\xa6\x92\xcf\x88\x1d\xf5\x2c\xe1\x7e\x9a\xea\x80\xc4\x70\x0a\x5d\x51\xe4\x53\xa2\x28\xf0\x31\xbf\x8f\xf7\x68\xbf\xa2\x6b\xf5\x21\xc7\x1e\x7c\x20\x14\xcf\x10\xb7\x75\x68\x21\xa1\x14\x1c\xd9\x48\xe8\x70\xc1\xee\xb0\xe3\xc0\x15\xf3\xa8\x44\x84\x6a\x7a\xe5\xe8\x40\xaf\x94\xca\x7b\x7a\xf5\xb8\x54\xd1\xb5\x53\x4d\xdf\x7b\xaf\xf4\xa3\xd1\x69\x9f\xb5\x2e\x1b\x7d\x85\xff\x3d\x34\x3b\x8a\xa3\x17\x97\xed\xf3\xe7\xa4\xb6\x5a\xd6\xb5\x3a\x47\xde\x7f\x18\x18\x42\x22\x89\x75\x30\x88\xc0\x0e\x8e\xa9\x87\x06\x1a\x62\x4e\xb1\x04\x13\x79\xb7\x64\x4c\x19\xd5\xa1\x8d\x5c\x04\x1f\x90\xe3\xe0\x99\xa6\xef\x57\xab\x8a\xfe\x03\xbd\x7a\x54\x39\xd6\xb5\xc6\xff\xdb\xea\x02\xaa\xba\x56\xf7\x86\x98\x4b\xf8\x48\x28\x16\x3a\xf4\x88\xb4\x26\x24\xbd\x80\x09\xe2\x36\xa3\x14\xcd\x74\xf8\x38\x21\x6a\x8d\x26\xa3\x6c\x8a\xa0\xc1\x90\x90\x9a\x5e\xa9\x1c\x44\x0b\x28\x1f\xe9\x5a\x7d\xab\x0b\x38\x3e\xd6\xb5\x53\x46\xed\x90\xff\x42\x87\xae\xe3\x71\x32\xf4\x04\xf4\xb0\x3d\xc7\x6a\xd8\x2f\x97\x62\x5e\x57\xb7\x4d\xea\xde\x9e\xae\x35\xd0\xcc\x13\x09\x73\x85\x0e\xa7\x84\x51\x62\xc1\x19\x67\x63\x30\x67\x1c\x4d\x74\xf8\x88\x1c\x07\x85\xff\x46\xa4\x57\x8e\x7d\xca\x4b\x7a\xf5\x78\xfb\x4c\x3e\xac\xea\x5a\x63\x82\x5c\x17\x3b\x0e\x96\x3a\x74\xb9\x12\x12\x25\x1c\x17\xc4\x71\xd6\x8b\x78\x65\xcf\x17\xf1\x7d\xbd\x7a\xb4\x7f\xbc\x6d\xe2\x2b\x25\x5d\x6b\x30\x67\x4c\x28\x34\xb0\xe3\x20\x2e\x74\xe8\xcf\xac\x89\x60\x34\x20\x7f\x73\x55\xdd\x3b\x50\x92\x5e\xaa\xe8\xd5\xe3\x68\x1d\xfb\x5b\x5b\xc7\x51\x45\xd7\x9a\x89\x4c\xa4\x65\xe8\x0a\xcd\xd0\xbc\x55\x39\xae\x86\x56\xf1\x68\x5f\xd7\xea\xdb\x24\xf4\x40\x07\xad\x89\x28\x4a\x54\xb2\xc5\xa4\x27\x1e\xc1\xe7\x4a\x60\x12\x95\xb0\x1f\x1f\xf9\xd4\x6f\x4f\x5c\x94\x76\x35\xd9\x94\x50\x4f\xc4\x36\xbd\x31\xe1\x44\x48\x82\xa8\x3a\x76\x30\xf9\x32\x47\x6e\xb9\x74\x1c\x9d\x40\x07\x01\xb3\x0f\xb7\x47\x6e\x59\xd7\x9a\x1e\xa5\x69\x71\xe8\x73\x44\x1c\xcc\x57\x33\x7c\xe1\x1c\xdd\x4b\xce\xd1\xc3\x2d\xf3\x7c\xef\x40\xd7\xce\x3c\x99\x1c\xa2\x07\x07\xa5\x12\x98\x8e\x0d\x85\x5c\xda\x4d\x89\xc6\x02\x5a\x18\xb9\xd0\x24\x42\x5d\x7c\xa5\xa6\xef\xc5\xc7\xd0\x71\x79\x6f\xdb\x46\x06\xaa\xba\x76\x81\xb8\x83\x68\xbc\x86\xac\xe5\x38\xf4\x2d\x47\x59\xaf\x1e\x1f\x85\xc4\x6d\x4f\x46\x94\xad\xfa\x89\x09\xec\x4e\xa0\x3b\xc1\x8e\x2b\xd2\xc2\x72\x49\x05\x19\x53\x32\x6f\x3f\x2a\x87\xfb\x7a\xb9\x5a\x2d\xeb\xd5\xa3\xea\xfe\x96\xc5\xa1\x72\xa4\x6b\x3f\x23\xd7\x12\x88\xda\x33\x38\x43\x53\xe2\xcc\x7c\xf7\x84\xcf\xfc\xbd\x97\x18\x5a\x88\xa6\x5c\x94\x73\x8e\xa8\x5d\xf8\xa0\x2c\xfc\x3a\xd3\x52\xae\x44\xde\xd6\xf1\x7e\x79\xdb\x52\x52\x2e\xe9\xda\xcf\x8c\x8e\xc5\x18\xf9\x8e\x6d\x7f\x82\xe1\x27\xcf\x1e\xe3\x3c\x27\x2b\xbb\x1d\xfb\x87\x4a\x7e\x14\xd9\x87\x07\x5b\xde\x0e\x85\xb0\x85\xf8\xcd\x14\x23\x3b\x63\x66\x26\x6a\x1f\xf8\xcd\x06\x4c\x2f\x47\x06\xf2\xe8\x60\xdb\xd4\x1f\x54\x75\xad\xc5\x6e\xd8\x0c\xc5\x22\xe4\xdb\x3c\xf8\x80\xb1\x8d\xf9\x06\x87\x7e\x79\x2f\xf2\x19\xb7\x7d\x16\x29\x84\x5d\xe4\x39\x70\xc1\x86\x43\xe5\x2b\x62\xeb\x46\x48\x36\x1a\x61\x0e\x7d\x06\x3f\x23\x87\xd1\xd5\x56\xbe\x83\x6e\x6e\x89\xe3\x60\xe5\xbb\xc4\x0e\xc1\xde\xf1\x96\x3d\x02\x75\xa0\x74\xb1\xc4\x1c\xae\x88\x35\x41\xd8\x89\xb7\xa2\xcb\x08\x95\xd0\x63\x5e\xbe\x0e\xc4\x17\x0d\x8f\x4a\x25\x47\xc7\xbe\x15\x3d\x56\x6b\xa8\x6c\x7b\x2f\xf6\x74\xad\xcb\xd9\x94\x51\xc9\x14\xe5\x19\x19\x39\xa8\x1e\x64\xbd\xad\x2d\x5e\x80\xca\xba\xf6\x8b\x47\x1c\x0b\xdb\x08\x1a\x1c\xe3\x1b\x3d\x57\x12\x1a\xcc\xf1\xa6\x43\x92\x52\xca\x43\x25\x10\xa5\xaa\x62\xe6\x61\x60\x09\x0f\xb6\x77\xe2\x1f\xea\x5a\x8f\x28\xcb\x97\x76\x63\x19\x95\x18\x4e\xb1\xe3\x30\x1d\x4c\x44\xa5\x5a\x90\xf7\x5b\xec\xa3\x08\x4d\x2f\x1f\x44\x17\xb8\xe3\x52\x75\xcb\x9c\xde\x3f\xd4\x35\xd3\x42\x1c\x5b\x9c\xdd\xe5\x33\xb9\xe7\xc9\x09\xe6\x23\xc6\x6d\x4d\xdf\xdf\x2f\x45\x97\x9e\xea\xe1\xb6\x2f\x0b\xfb\x47\x8a\xd6\x09\x47\xbe\x89\x8b\xae\x3d\x69\xfb\xe1\x07\x55\x08\xb6\x39\x4a\x7b\xe6\xcc\xc1\xe2\x8e\x71\x39\x99\xad\x37\x8c\x70\x18\x5b\x94\xea\xb6\xef\x18\xa5\x7d\xb5\x3e\x8e\xd1\x94\xd0\x31\x18\x68\xec\xe0\xfc\x1d\xc9\x52\x5c\x39\x3c\x8c\xae\xd1\xd5\xd2\xc1\x96\x5d\xf5\xa3\xb2\xae\x99\x0e\x43\x54\x5d\xa0\x99\xcb\x09\x96\x88\xcf\x82\x30\x45\x5a\x70\x2a\x7b\xa5\xd8\x98\x6c\xdd\x45\xa9\xee\xe9\x9a\xe9\x32\x29\xc5\x1d\x63\x36\xd6\x23\xf7\x2b\xf0\x6a\xe1\x9c\xb3\xbb\x7c\x2f\xcb\x94\x70\x81\x1d\x4c\x91\xa6\x97\xf7\x63\xc1\xa8\x04\x57\x89\xea\xf6\x9c\x95\x43\x5d\xfb\x80\xb9\x7f\x7a\xb4\x30\x34\xb1\x20\x7c\xe1\x1c\xa9\x04\xa6\xef\x48\xf9\x23\x7b\xdb\xbe\x5f\x96\xfc\x78\x04\x95\x84\x7a\xde\x34\x47\x14\x92\x23\x3b\x3c\xee\x14\x99\xe5\xc3\xc7\x09\x42\x18\x4d\xee\xf4\xa0\x67\x74\x5b\xf5\x86\x01\x67\xd7\xed\x86\x1f\xbf\x47\xb6\x3d\x70\x30\xb2\xdf\xa5\xb2\x71\x41\x74\x1e\x51\x7b\x90\xc4\xe4\x6f\x11\xb7\x26\x88\xeb\x29\xb0\x28\x3a\x9f\xd3\xe5\x4e\x18\xcd\x1d\x83\xa7\x88\x38\x79\x1d\xe9\xc8\xfe\xd2\x6e\x89\xa4\x27\xf2\xba\x79\x90\xad\x09\x7b\xde\xbf\x4a\x75\xf5\x8c\xfe\x75\xaf\x6d\xc2\x2d\x23\x76\xaa\xb9\x55\x6f\x9f\x5f\xd7\xcf\x0d\xd0\x5c\xc7\x1d\x8b\xcf\x8e\x96\x0c\xaa\x9b\xf0\xfa\xb4\xd3\xfc\xf4\x3a\x6e\x69\x1a\x8d\x56\xbd\x67\xa4\xc6\xfb\xa1\xfc\x10\x5f\xc2\xe8\x53\xe3\xfc\xb2\x3d\x0f\x55\x3b\x01\x8b\x51\x0b\xc9\x77\xe9\x55\xfc\xfe\x3b\x68\xa0\xe9\xa0\xb5\x30\xb2\x6b\xd0\x75\x30\x12\x38\x4e\x52\x68\x7a\xde\x2e\xe8\xa0\xc1\x88\xb3\x29\x68\x6a\x78\xc8\x7f\xd5\x78\x4b\x50\xc0\xf3\x5a\xd0\xe5\xff\x1d\x75\xf8\x3c\x0f\x3b\xfc\xbf\x75\xd0\x76\x63\xd4\x40\x44\x6a\xce\xd4\x36\xf8\x50\x3d\x9f\xb1\xe1\xe0\x80\xcb\xaa\x5d\x4b\x45\xf9\x01\x08\x15\x98\x4b\x20\x54\x32\x3f\xff\xf1\x4e\x2d\x5b\x8f\xd3\x1b\x89\xb4\xfb\xed\xa5\xd4\x58\xa3\xdd\x4c\x1e\x02\x9e\xff\xf0\x6a\x13\xb1\x0d\x73\x3e\xf3\x92\xdb\xb9\xee\x43\x2a\xb7\x24\xf1\x17\xa9\xcf\x75\x27\x22\x9d\xd7\x1b\xc9\x74\xee\xc8\x94\x88\xaa\xfe\xf7\x39\x52\x66\x1a\xfd\xce\x19\x70\x6c\x31\x9e\x96\xb6\xba\x99\x7a\x78\xfd\x3a\x93\xf9\x0e\xb3\x9a\x09\xd9\xa9\x54\x98\x9e\x6c\x72\x0a\x7b\x66\xb8\x5f\x06\x10\x8a\xcd\x0f\x4b\xb1\x24\xe2\xae\x44\x1d\x3e\x74\x5a\xf5\xfe\x65\xcb\x48\x27\x06\x73\xd2\xa0\x71\x46\x30\x60\xb7\x1d\x64\x41\x5d\x26\xa4\x29\x11\x97\x6b\x52\xc0\xc5\x5b\xc4\x8b\x0e\x19\x16\x7d\xfd\x2a\x46\x93\x15\xe7\xd3\xc8\xf0\xf6\x9f\x00\x45\x97\x33\xab\x58\x2e\x8e\xec\x62\xf9\x55\x94\xda\x67\x7c\x4d\xa6\xfe\x92\x12\x69\xfa\x35\x03\x62\x73\xc8\x38\x37\xff\xf0\xf0\x17\x4c\xe0\xdb\xc3\x02\xa1\x44\x16\x82\x52\x8a\x6c\xd1\x0a\xf7\x68\xa6\xf3\x2f\x97\xc3\x27\x23\xf8\x5f\x28\x8c\xe6\x45\x4f\x71\xa0\xb8\x1b\xb3\x28\xcd\x82\x02\x72\x5d\x87\x60\x1b\xfe\xfd\x03\xc8\x09\xa6\x09\x45\x5f\x88\x84\x52\xf8\x38\x22\x39\xda\xc1\x3d\x4a\x15\xb5\x9e\xc0\xbc\x20\xbc\x70\x9e\xa8\x3e\x05\x14\x16\x08\xb1\x44\xa4\xab\x85\x8d\x80\xd0\x79\x02\xd3\x14\x15\xff\xb1\xab\x18\xbd\x02\xa2\x80\xbf\x48\xcc\x29\x72\x02\xd0\x0c\x23\xfd\xe5\xef\xbc\x1e\xed\xc0\xbf\x83\x33\xc2\x77\x2d\xf0\x1c\xa3\x01\x0a\xff\x84\xd7\xa3\x84\xa3\xc1\xde\xde\x42\xa7\x3d\x30\x7a\xbd\x4e\x6f\x60\xf6\x3b\xdd\x93\x72\x34\xd9\x22\xa7\x25\xf3\xac\xc9\x13\xf8\x9c\xc3\xc7\x5c\x8e\xc5\xd9\xf1\x0d\xeb\x7c\x52\xfa\xfd\xf0\xe0\x23\x51\xb3\x29\xf6\x28\x09\x5f\x5b\xf2\x93\x36\x24\x71\xb5\xcf\x61\xb6\xda\xe7\x2f\x5d\xf7\x13\x56\xfc\x64\xcc\x45\xdc\xe9\x86\xba\xf8\xd9\xd9\x55\x40\x89\xcd\x70\xd8\x78\x80\x3c\xc9\x6e\x91\xe5\x79\xd3\xc1\x94\xd0\x81\xed\x29\x37\x81\x51\x38\x89\xb5\x47\x41\x39\x84\xe2\x81\xcb\xf1\x88\x7c\x81\x13\xd0\xde\x48\x78\x83\xe0\x0d\x81\x37\x18\xde\x58\xa0\x25\x90\x63\x42\xc7\x03\x8b\x39\x0e\xb6\x24\xe3\x70\x02\x6c\x34\x8a\x98\x96\xc2\x84\xbe\x0c\xee\x18\xbf\xc1\x5c\xc0\x09\x1c\x2e\x02\x50\xe4\x4a\x32\xc5\x70\x02\xe5\x03\xb1\xd8\x1d\xfe\x27\x27\x1c\x8b\x09\x73\x6c\x38\x81\xca\xc1\x52\x30\x61\x21\x07\x0f\x46\x28\xa4\xa8\xb4\x5b\x5e\x04\x45\x14\x39\xb3\xdf\x70\x66\xca\x72\x69\x39\xdc\xc2\x9c\xa5\xe5\xf8\x2d\x26\xe4\xc0\xc6\x0e\x9a\xf9\x93\x4e\x97\x2f\xc8\x87\x74\xc8\x94\x48\xb5\xa2\x52\xa9\xb4\x99\xf6\x7c\x44\x4e\x9d\x5b\x13\x72\x4b\xe8\x78\xd7\xa0\x68\xe8\x60\x3b\x54\x23\x80\x3b\xe4\x0c\x1c\x7c\x8b\x1d\x38\x01\x8e\x5d\x87\x58\x28\xc2\xef\x8f\xc1\x83\x29\xb3\x15\xa7\x19\x9d\x6b\xb7\xd8\x74\x8a\xa8\xe2\x84\x26\xb1\x90\xf0\xb7\x1c\xdb\x7c\x87\x9c\x42\x08\x5f\x7c\x33\x82\xb7\x6f\xc1\x72\xe1\x8d\xbb\x1a\x4c\x9b\x53\x4b\x8e\xe8\x18\xc3\x6b\x17\x71\x34\xd5\xe1\xf5\x2d\x72\x3c\xac\xfc\xee\xd5\xab\xee\x7b\xca\x7e\xc7\xeb\xbc\xbf\x0f\x67\x80\x87\x07\x38\xf1\x1f\x83\x89\x36\xd4\x7d\x13\xf3\x5b\xe2\x7b\x67\x6b\xea\x5d\x17\xf7\xa4\x6e\xdb\x44\x29\x0d\x72\xea\x94\x32\xe9\x2b\x90\x80\x90\x32\x94\x34\xd5\x94\x25\x93\xec\x13\x9a\x3a\x6b\x47\xe7\x9a\xb1\x68\xce\xef\xc9\x1a\xad\xe0\x46\xcb\x27\x33\xa0\x7f\xd5\xba\x43\xb8\x15\x4b\x16\x2e\xb6\x6a\xa1\xfb\xca\x65\xb8\xf2\x42\xb8\x37\x89\x7d\x8b\xca\xe3\x18\x97\x35\x38\xd8\xdf\xab\x44\x0d\x9c\x49\x66\x31\xa7\x06\xfd\x46\x37\x3a\x02\x11\x1f\x63\xd9\xcd\x82\x0a\x1c\x18\xae\xe7\x62\xed\xa6\x3c\xcb\x93\x6f\x3f\x39\x36\xf2\x1c\x13\xcb\x70\xdb\x2d\xc7\x13\x12\xf3\xcb\x6e\x0d\xda\xea\x2c\x4f\xc4\x3a\xa0\x5d\x28\xa1\xae\x8f\x46\xea\xec\x9c\x85\x30\xfe\x52\xfd\x0d\x6e\x44\xa3\x15\x3f\xfd\x5b\x6c\x78\xb4\x31\x64\x9f\x22\x07\x51\x0b\xf3\x1a\xdc\x3f\x44\xd4\x51\x26\x1f\x45\xe1\x52\xcd\xea\xaa\x36\x21\x31\x95\x1f\x98\xe3\x4d\x71\xc3\x41\x64\xfa\xa2\x67\xdf\xb7\x9e\x21\xcb\xc2\x42\x5c\x31\x1b\xc7\xda\x76\x7f\xbf\x5a\x1c\x7a\x38\xb8\xe0\x8a\xdd\x60\x9f\xeb\xf1\x14\x21\x3b\x79\x04\x10\x71\x94\xe3\xcf\x1e\x16\x32\x55\xe9\x2e\x24\xe3\x7e\x95\xf9\x63\x91\x35\x90\x8b\x2c\x22\x67\x0f\x0f\x9b\x69\xd7\xfc\x78\x33\x40\xdc\x70\x90\x88\x5c\x50\x91\x6a\x6a\xfb\xd2\xf9\x58\xa2\xe6\x26\xcd\x9e\x7a\x8f\x26\x51\xd1\x10\x92\x76\x1b\x37\x3c\x9e\xa8\x70\x9a\xfb\xfb\xaf\x20\x25\x90\xa4\xd8\xf0\x64\x8d\xe6\x14\x49\x6b\xd2\xca\xa8\x4e\xea\x94\xbf\xc1\xb3\xcd\xcf\xf8\x95\x78\xa3\x53\xff\x06\xcf\xe0\xe1\xa1\x96\x3d\xf2\x13\xbc\xa1\x70\xe7\xde\x04\xe6\x8c\x16\x72\x5d\x51\x4c\x59\xae\xfb\xfb\x47\x99\x69\x78\x78\x48\x3d\xdd\xdf\x03\x76\x84\x22\xa6\x89\x5d\x87\xcd\xa6\x98\xfa\x6d\x91\xae\xbd\x98\xbf\xef\xd8\xfc\x85\x9e\xb2\xa8\x41\xf9\xd1\xa7\x35\xc4\x7a\xe1\x7b\x94\xed\xdc\x9d\x4d\xb1\x79\x03\xfd\xc9\xe7\xfe\x7a\xfe\x7f\x13\x6f\x24\xa2\xdb\x73\x6d\x24\xb1\x29\x39\x92\x78\x3c\x8b\x48\x0d\xb6\xb9\xc7\x1c\x87\xd0\xf1\xb5\x0f\x12\x2c\x38\xd0\x85\xd0\xb2\xe6\x8e\xc1\x41\xa0\x32\x3e\x1c\xe6\x4e\x0b\x00\xff\x6a\x94\x79\x2f\x6a\x8a\xa7\xcc\x7f\x35\xab\x72\x70\x78\x45\x92\x88\xc8\xe2\xc9\x92\x86\x2d\x29\xd0\xb9\x5d\x90\x78\xea\x3a\x48\xc6\xef\x3d\x25\xea\x99\x17\xce\xcc\xd7\xa6\x77\xf8\x73\x0a\xc6\xc4\x96\xc7\x89\x9c\xa9\x13\xd0\x81\x1d\x8e\x83\xea\x36\x6c\xef\xbc\x4f\x59\xb0\x39\xc5\xf5\x51\x6d\x38\x4d\x6a\x16\x25\x45\x96\xda\xdf\x5d\x11\x82\xef\x22\xc7\x9d\xa0\xdd\x1b\x2f\x48\xef\x61\x5f\x02\x5c\x66\xd7\x80\x7b\x54\x5d\xae\x8b\x36\x1e\x21\xcf\x91\xab\x8e\xa5\xa5\x26\xe7\x09\x16\xa6\x5c\x9a\xd3\xb7\xb9\x0d\x58\xb4\x38\xcb\xe5\x7e\x13\xc9\xff\xa3\xcc\xca\xc2\x3a\x43\x62\x62\xdb\x92\xb2\x0d\x75\xcb\x62\x1e\x95\xf3\x26\x22\xb5\x31\x19\xe2\x4c\x6b\x82\x6d\x4f\x29\xd6\x6e\x9b\xd9\xd8\x0c\x0d\x47\x8a\x81\x34\xd5\x1c\x60\xdb\xe4\xe8\x5d\x3f\xef\x9a\x93\x36\xef\x70\x5d\x45\x7d\x9f\x39\x38\x08\x34\xa5\x0f\x73\x99\xb4\xe6\x9f\x61\xf9\x33\x2c\x7d\xc1\x6f\x15\x05\xd1\x35\x29\xad\x89\xd1\xcd\x69\x1d\xee\x68\xec\x92\x3d\x5f\x83\xb9\xcb\x09\x53\x0a\x1a\xfb\x95\x29\x12\xdc\xf9\x3e\x9f\xd7\x9b\x4d\xb3\x48\xc1\x1d\x91\x13\x70\x99\x1d\x59\x90\x06\xa3\x12\x7f\x91\x4b\x6d\x4b\x8a\x10\x91\x1d\x92\x61\xc9\xf2\x65\x47\xa3\xfd\x84\x16\xa1\x98\xa7\x54\x59\xc1\xdc\xa6\x35\x3b\xba\xbe\xcf\xa5\x20\x52\x10\x00\xbe\x90\xad\x77\x71\xaf\x05\xce\x0a\x6b\xce\xdc\xd1\xdb\x78\x4f\x99\xbf\x8b\x84\xb8\x63\xdc\x5e\x87\x23\xca\x95\x3c\x05\xc7\x9c\x24\xe4\xce\xbf\xf0\x6a\xe1\x53\x10\x99\x61\x26\x2f\xb5\xa8\x50\x5c\xc7\xeb\x2e\xfc\xbd\xd0\x2b\x82\x32\xac\x23\xf5\xaa\x6e\xf6\x8d\xde\x93\x77\x34\xc4\xa4\xf4\x7b\x93\xcd\x0d\xb1\x7d\x0d\x5b\x52\x18\xe7\x38\x93\x55\xe9\x68\x40\x1b\x63\x5b\x5c\xbb\x63\x8e\xec\xb5\xfb\x76\xdd\x3d\xef\xd5\x9b\xb9\x62\x61\x31\x77\x96\xa7\xb6\xb1\x0a\x3d\x41\x79\x37\x56\xdf\x4a\xbe\xfa\x02\x90\xa9\x7f\x09\xd7\xd6\x67\x5e\x14\x20\x3c\x3c\x68\xf3\x83\xbb\x9e\xe3\x74\x99\x43\xac\x59\x70\x8d\xca\xb6\x6d\x32\x6b\x2e\xf4\x42\x4f\x13\xdf\x9a\x9e\xeb\x32\x2e\xb3\x1c\x70\xc8\x08\x5b\x33\xcb\xc1\xf9\xfe\xdb\x2a\xc5\x88\x42\xe8\xdf\x3a\x7b\x9d\x96\x86\x38\x2b\x3f\xff\xda\x3d\xfe\x92\x76\x1b\xa2\x5f\x18\x9a\x5f\xec\x28\x80\x9f\x06\x8e\x93\xc0\xe9\x9e\x82\x95\xd3\xf8\xcf\xc2\x86\xfe\xff\x1c\x73\xe6\xe8\x0f\x7e\xcb\x6a\x06\xd2\x35\x07\x8b\x2a\xf5\xed\xeb\x04\x9e\x6f\x85\x6f\xdf\xe6\xea\xcb\x12\x06\x64\x12\xc3\x39\xe9\xfb\xdc\xa0\x44\x46\x2c\x38\x36\x25\x73\xbf\x52\x28\x56\x89\x85\xea\xd3\x0a\x96\x96\xdf\xd1\x3d\x57\x47\xda\x49\x5e\xa2\xd8\x13\x98\xab\x3f\xc0\x1d\x0f\x2c\xe9\x80\x90\xcc\x85\x42\xc1\x4f\x26\x8d\x90\x90\x5a\x46\x19\x6f\x31\xc5\x42\x74\x39\x1b\xce\x7d\x58\x42\x71\x84\x20\xa7\x89\x1d\x34\x33\xb1\xc5\xa8\x2d\x6a\x70\x58\xca\xc0\x48\xcb\x35\x99\x75\x83\x17\x94\x63\x21\xd0\x0f\x71\x24\x65\x21\x29\x00\xd9\xe4\x41\xb4\xc4\xd8\xce\x76\xf3\xe6\xca\x4f\x1d\x80\x7f\xc1\x44\x36\x59\xb2\xa6\xbc\xed\x59\xb2\x39\xcb\x36\xa6\x00\x05\xb2\xd8\x34\xaf\xc0\x05\x78\xde\xaa\x8e\xf5\x3b\x73\x90\x02\xf9\x3b\x34\x4f\xe1\x17\x66\x82\xa5\x5c\x50\x20\x02\x76\xce\x3d\xc4\x11\x95\x18\xdb\x3b\xf0\x2e\xba\x83\xc3\xc9\x49\x78\x73\x7f\x9f\x19\xdd\x66\x12\xd7\xa0\x43\xa1\x63\x76\x40\x4e\x30\xc7\x6a\x0e\xca\x20\x99\x25\x98\x5a\x07\x22\x05\x20\xe7\x0e\xcd\x04\x0c\x3d\x2e\xa4\x52\xd0\xcc\x66\x2c\x84\x0a\x20\x37\x5c\x00\xa9\x30\xc0\xe6\x71\xd3\x2b\x7f\xc4\x9c\x6a\xe6\x45\x18\x9e\x6d\xfa\x20\xbe\xeb\xd7\xca\xcf\xc9\xeb\x54\xb5\x75\x91\x9c\xd4\xf2\xca\x37\x32\xb4\x2c\xd6\x08\xcc\x81\xac\x9a\x2d\xb2\xdd\xab\x66\x5c\xfc\xda\x48\xfe\xcc\xcc\x95\xea\x8e\x5e\xe0\x8c\xc9\xa2\xe0\x56\x31\x51\xce\x82\x35\x1a\x17\xd7\x50\xed\x57\x36\x6c\xed\xac\xd8\x8c\x3d\x73\x45\x5a\xab\xc8\xcf\x80\x3e\x32\x05\xf0\x6c\x34\xc6\x45\x47\x8f\x20\x36\x19\xf3\x48\xaa\x57\xd6\x23\xac\xa3\x3a\x55\x2a\xb0\x86\xd6\x34\xe4\x02\x85\x4f\x09\xa1\x65\xc9\x92\x53\x37\x87\x00\xd5\xfa\xbc\xec\x88\xee\x0b\x6a\x39\xe3\xcc\x82\xfe\x4c\x17\x81\xcc\x22\xbf\xdb\x5b\xc1\x92\x10\x44\xfd\xa3\x39\xa8\x37\x1a\x86\x69\x0e\x7e\x36\x3e\x0d\x2e\x73\x2e\x90\x67\x9c\x4d\xe7\x4d\xbd\xc0\x16\xc7\xf2\x67\x3c\xeb\xe1\xd1\xa2\xd7\x35\x27\xb0\x43\x64\xdd\x78\x6e\x41\xec\x2d\x00\xde\xe0\x59\x2d\x9c\xab\x70\x83\x67\x85\x4c\xa5\x7b\x9a\x44\xd3\x68\xf4\x8c\x7e\x8a\xd2\x3f\x8c\xcc\x20\x3b\xac\xa8\x5d\x42\x6a\xcf\x38\xbf\xec\xb4\xb7\x4f\x1f\xc7\x63\xc2\xe8\x12\xa2\x8c\x76\xb3\xdb\xb9\x6c\xf7\xb7\x4f\x16\xa6\xb6\xcb\x08\x95\x0b\xbd\xcc\x0d\x82\xc8\x35\x90\xdc\xc3\x39\x74\x7f\xac\xb7\xce\x07\xe6\xde\xa0\xdb\x33\xce\x2e\x7f\xdd\x3e\xe5\x43\x4f\x39\xde\x05\x35\x28\x05\x90\xe3\xcd\x86\x9e\x6c\xaa\x00\x19\x16\x9d\xd6\x42\xea\x5b\x7b\xe0\x7b\xca\xbe\x8e\x66\x17\x79\xb2\x23\xf6\x6a\xc5\xe2\xeb\xfb\x6c\xf3\x43\xfa\x68\xc8\x7e\x7e\xee\x6e\x42\x1c\xec\x73\x30\x53\x4d\x1b\xfc\x96\x55\xed\xa6\x6b\xd2\xfe\x91\x33\xce\xaf\xc6\xc5\xab\xaa\x71\x63\xf4\xca\x72\xfb\xff\xba\x9e\x98\x04\x23\xde\xbe\x05\x3e\x9d\x2b\xc0\x8d\x7e\xa9\x42\xdc\xe8\x17\xd4\x44\x1f\xbc\x5a\x01\xf6\x14\x9f\xf0\x69\x87\x69\x02\xf7\x24\xc7\xba\x5c\x39\xbe\x22\x8f\xf2\x95\x0f\xf7\xaf\x48\xce\xf1\xb3\x34\x64\xac\x6e\x2f\x03\xb3\x73\xdd\x6b\x18\x83\x76\xfd\x2a\x37\xb0\x96\xf8\x98\xb5\x62\x71\xb3\x00\x72\x6d\xf3\x38\xf0\xff\x38\xcc\x42\xce\x84\x09\x59\x53\x57\xc6\x62\xc4\xca\xff\x2f\x84\xa3\xae\xc0\x27\x36\x11\x73\x97\x94\x38\x2e\x78\x3e\x30\x7e\xed\x76\x7a\x7d\xa3\x37\x30\x7e\xed\x1b\xed\xe6\xe0\x97\x6b\xa3\xf7\x69\xd0\xad\xf7\x2f\xf2\x56\x52\xc4\x32\x71\x99\x8b\x38\xfc\xbc\x61\x31\xfd\x0d\xcb\x3f\x9d\xbb\x10\x7f\xa4\xf1\xbb\x75\x15\x96\x7e\xe7\x74\xb3\xb8\xc6\x08\x11\xc7\xe3\xb8\x1f\xd5\x13\xd7\xe6\x94\x7b\x4d\x4c\xa3\x5a\x3e\x3e\x7a\x64\x9c\x64\x55\x44\x62\x2b\xd4\xec\x95\x1e\x15\x6a\x59\x98\x34\xe0\xf8\x22\x97\x9f\x64\x84\xfc\x7a\x80\x47\x19\xa1\x4a\x29\x33\x60\x33\x73\x9b\xab\x9a\x6b\x4c\x6d\xce\xf7\x72\x13\x84\x99\x8c\xd9\xc6\x63\xfd\x94\x5b\x70\x3d\xac\x3d\x0e\xfb\x13\x8b\x3d\x57\xd1\x38\x17\x65\x70\xf3\xca\x3f\xb3\x54\x5a\xaa\x69\x69\x9d\x4c\x06\xdd\x92\x75\x86\x29\x73\x65\xd2\x6a\x70\x50\x2e\x3f\x2d\x6c\xb1\x21\xe0\x52\x2a\x96\xbe\x95\xb1\x06\x60\xeb\xc1\x8d\xbc\x6d\x5b\x12\xd2\x78\x06\x86\x7f\xdb\x20\xc8\xba\xb5\xe4\x85\x3e\x56\xee\xdf\x3a\x2f\x60\x45\x92\xe1\xf9\xa3\x02\xeb\x7d\x34\x3c\x75\xe5\xac\x49\x92\xda\xed\xaf\x8c\x83\x14\x52\x91\x8e\x4d\xb1\x3c\xd6\x74\xdc\x26\x96\xa0\x1f\xd6\x5d\xc5\x65\xc6\xd9\xba\xc8\x7c\x71\x4a\x99\x98\x6c\x5d\xcd\x42\xd9\x32\x3c\x4f\xe9\x32\x2c\x3b\x87\xf2\x4f\x95\xef\xa9\x8c\x19\xbe\x7d\x29\x73\x46\x16\x1e\x59\x45\xf0\x0c\x6f\xe4\x14\x38\x7b\xa9\x96\xcd\x32\x24\x7c\xd1\xeb\xe5\xe5\x9c\x47\xbf\x9c\x53\x48\xbf\x24\xb7\xf9\x3b\x35\x6b\x2a\xc9\x53\x26\x70\x13\x79\x5e\xbf\x7d\x2f\x42\xbd\xfd\x52\xf0\xb5\xb7\x5a\x53\x22\x6a\x0f\x67\xb1\x81\x5b\x53\x08\xae\xec\x56\x9e\x60\x6f\xb9\x00\x3c\x23\xf1\x2f\x65\xd0\xff\x85\x65\xd0\xdf\x52\xb5\x5e\xca\xa1\x5f\xca\xa1\x5f\xca\xa1\x5f\xca\xa1\x1f\x89\x63\x93\x72\xe8\xb0\xea\xd7\x34\x7a\x1f\x2e\x57\x64\x02\xe6\xbf\x9d\xb1\x6e\xbe\xbf\x42\xcd\xf2\x9f\x29\x17\xf0\xdd\xa6\x00\x5e\xca\x16\xff\x8a\x65\x8b\x2f\xc5\x82\x9b\x15\x0b\x86\x3e\x61\x10\x6b\xfb\x23\xaa\xaa\x1e\x8f\x6c\x69\x0a\x65\xa3\x58\x26\x3c\x43\x60\x32\xc3\xb4\x00\xe8\x25\x40\xf9\xbd\x04\x28\x37\xa3\xb8\xcb\x94\x2f\x9d\x0a\xc9\x3f\x47\x84\xd2\xf5\x27\x7d\x09\xe8\x3c\x92\x29\x2f\x41\xca\xd5\xac\x83\xe7\x8c\x51\x26\x9f\xa9\x78\x11\xe8\x3f\x93\x40\xa7\xbf\x55\x91\x27\x82\xdb\x0e\x27\xa6\x64\xf3\x25\x9a\xf8\xdf\x18\x4d\xfc\x76\x6a\xf0\x12\x4c\x7c\x09\x26\xbe\x04\x13\x37\x0f\x26\x36\x4f\x07\x17\x1d\x33\xa7\xe0\x7d\x5d\x54\xac\x79\x3a\xe8\x76\x7a\xb9\x03\x77\x94\xaf\xb3\x93\x3f\xe6\xd9\x23\x96\x5f\xf9\xad\x83\x95\x91\xca\xe6\xe9\xd2\x90\xe1\x57\x44\x27\x3b\xad\xc1\x55\x27\xff\x5d\xff\xb5\xd4\x06\x37\x0f\xf5\xdf\xdc\xcd\x2e\x41\x70\x55\xff\x75\xd0\x68\x5d\x1a\xed\xfe\xa0\xd1\x69\xe7\xbc\x61\x51\x83\x9d\x0d\xf1\x5c\xa1\x2f\x0d\x87\x60\x2a\x1b\x8c\xd2\x87\x87\xdc\x2d\x35\xce\xea\xd7\xad\xfe\xc0\x5f\x96\x79\xf9\xaf\xdc\x65\x6d\x8a\xaf\x19\x98\x5e\xf5\x64\x92\xdf\x70\x3e\xc6\xfa\x75\xff\x62\xd0\xff\xd4\xcd\xc5\x34\xb5\x0f\xfe\x74\xd1\xcd\x70\xf1\x7f\x99\x20\x67\xf9\x51\x95\xc3\xf9\x41\xce\xf1\x90\x79\xd4\xca\x14\xca\x6e\x29\xc6\xb9\x36\x3a\xf8\xd8\x15\x7d\x93\x68\x62\x28\x33\xdf\x38\xa8\xb8\x1c\xcb\x57\x78\xcc\x9b\x05\x23\x97\x47\x01\xb3\xf3\x3c\x35\x94\x97\xfc\xf5\x7f\x01\x00\x00\xff\xff\x31\x16\x29\x1b\xe2\x84\x00\x00"),
 		},
 		"/infrastructure": &vfsgen۰DirInfo{
 			name:    "infrastructure",
@@ -255,65 +304,72 @@ var assets = func() http.FileSystem {
 		"/infrastructure/02-syndesis-secrets.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "02-syndesis-secrets.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 1763,
+			uncompressedSize: 2677,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x93\xc1\x8e\xda\x30\x10\x86\xef\x3c\xc5\xbc\x40\x52\xf5\x1a\x69\x0f\x69\x70\xbb\x11\x34\x4e\xb1\xdb\x8a\x53\x64\x92\x01\xac\x4d\x6c\xcb\x36\x5b\x21\xca\xbb\x57\xd0\xa4\x5b\x68\x20\xd0\x3d\xec\x11\xec\xf9\x66\xfe\xc9\xe7\x00\x84\x91\xdf\xd0\x3a\xa9\x55\x04\xcf\xef\x47\x00\x4f\x52\x55\x11\x30\x2c\x2d\xfa\x11\x40\x83\x5e\x54\xc2\x8b\x68\x04\x00\xa0\x44\x83\x11\xb8\xad\xaa\xd0\x49\x17\x38\xb4\xcf\x68\x03\xd7\x5d\x06\xa8\xc5\x02\x6b\xf7\xfb\x32\x80\x30\xe6\xe5\x76\xfb\x5f\xf7\x33\x94\xfa\xdd\xd0\xb9\xdf\x1a\x8c\x40\xaa\xa5\x15\xce\xdb\x4d\xe9\x37\x16\x47\x00\xce\x5b\xa9\x56\xe3\x3f\x53\x95\xb5\x44\xe5\x99\x17\x1e\xe3\x8d\x5f\xa3\xf2\xb2\x14\x5e\x6a\x35\xc1\x6d\x04\xbb\x5d\xc8\x3a\x66\xa2\x1b\xa3\x15\x2a\xef\x42\x76\x9c\x3d\x4c\xae\xd4\xee\xf7\xe7\x78\xa2\x4a\xbb\x35\xf7\xa3\x4f\xea\xf6\xfb\xd1\x2b\xf7\xbe\xaa\xf5\x42\xd4\x41\xa9\xd5\x52\xae\xde\x6e\xef\x34\x27\x19\x7b\x4c\x3f\xf2\x82\xc6\x5f\xf9\x63\x91\x4c\x53\x92\xf1\x82\x91\x64\x46\x78\x04\x3f\x83\x16\xbd\xdb\x85\xd4\xa0\x62\x6b\xb9\xf4\x54\x6c\xfc\xba\xdd\xcc\x31\x6b\xbb\xe4\x9c\x32\xfe\x69\x46\xd8\x97\x69\x91\xc7\x8c\x7d\xa7\xb3\xf1\x29\xa1\x6f\xd1\x87\x51\x16\xc2\x61\x98\x0b\xe7\x7e\x68\x5b\xfd\x0b\x63\xf1\xe7\x7c\x4a\xc6\x1f\xfe\x87\xca\x44\x63\x6a\xac\x16\x67\xf4\x36\x2c\xa5\x93\x94\xf4\x86\xed\x83\x1e\x73\x87\x89\xd6\x4f\x12\x4f\x82\xb3\x79\x36\x26\x2c\x65\x05\xc9\x92\xd9\x3c\xe7\xc5\x84\xcc\x87\x71\xad\x62\xdd\x51\xeb\xd7\x8b\xb3\xdd\xa7\xe0\x31\x27\xc5\x61\x5e\x92\xf1\x34\x89\x79\x4a\xb3\xbb\x1a\xdc\xf0\x3c\x4e\x5a\xb5\x21\x5e\xd1\xe6\xfc\xa9\x1c\x8a\x8d\xb0\xa2\x71\x7f\xd1\xae\x9a\xf7\x70\x8b\x6f\xbd\xc6\x3d\xdc\xe9\xd9\x75\xd3\x06\x69\x17\xfc\xea\x35\xec\x12\xec\xa2\x57\xfd\x66\x5d\xc2\x0c\xf9\x34\x68\xd4\x00\xf8\x06\x8f\xae\x9a\x74\x3b\xfe\xdc\x9f\x5f\x01\x00\x00\xff\xff\x78\x88\xa0\xf2\xe3\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\xcb\x72\x9b\x30\x14\x86\xf7\x7e\x8a\xf3\x02\xa6\x93\x4d\x17\xcc\x64\x41\xb1\xda\x30\x71\x0c\xb5\x68\x3b\x5e\x31\x32\x1c\x1c\x4d\x40\x62\x24\x39\xad\x87\xf8\xdd\x3b\x10\x11\xe7\x42\xc0\x71\x9a\x5d\x77\x36\x92\xfe\xff\xdc\xbe\x33\x05\x56\xf1\x9f\xa8\x34\x97\xc2\x85\xdb\xb3\x09\xc0\x0d\x17\x99\x0b\x14\x53\x85\x66\x02\x50\xa2\x61\x19\x33\xcc\x9d\x00\x00\x08\x56\xa2\x0b\x7a\x27\x32\xd4\x5c\x4f\x35\xaa\x5b\x54\x53\x7d\x7f\xb9\xae\xa7\xc0\x73\x70\xa8\x3d\x76\xbc\x2c\xe3\x86\x4b\xc1\x0a\x4f\x08\x69\x58\xf3\x5b\xc3\x7e\xdf\x4a\xb1\xc3\x27\x77\x52\xd7\x60\xe4\x8a\x95\xc5\xe8\xeb\x3b\xe0\x22\x43\x61\xe0\x73\x23\xd4\x58\xa2\xc8\x3a\xcd\x82\xad\xb1\xd0\xf7\xa1\x02\xb0\xaa\x3a\xc4\x6a\xbf\x75\x7f\x1d\x2e\x3f\x8d\x9d\x9b\x5d\x85\x2e\x70\x91\x2b\xa6\x8d\xda\xa6\x66\xab\x70\x28\xc9\x79\xeb\x7e\x1f\xd6\x50\x3a\xf6\xde\x40\x26\xda\x28\x2e\x36\xb3\x87\xb2\xa7\x05\x47\x61\xa8\x61\x06\xbd\xad\xb9\x46\x61\x78\xda\xd6\xe3\x12\x77\x2e\xd4\xf5\xc1\xc5\x97\x65\x25\x05\x0a\xa3\x1d\xda\x36\xc7\xf1\x07\xde\xda\xb2\x3d\x92\x27\x22\x55\xbb\xea\xed\xd2\x4f\xde\xed\xf7\x93\x77\x0e\xd6\xa6\x90\x6b\x56\x4c\x53\x29\x72\xbe\xf9\x3f\x58\x1f\x36\x58\x61\x44\x16\xf4\x22\xf8\x1a\x27\xa1\xf7\x23\xbe\x48\xfc\x79\x40\x16\x71\x42\x89\xbf\x24\xb1\x0b\x77\x53\x1b\x7d\x5d\x3b\x61\x85\x82\x5e\xf3\xdc\x84\x6c\x6b\xae\x6d\xeb\xdb\x66\x5a\x13\x9e\x83\x90\x06\x7a\x07\xa6\x71\x5c\x33\x8d\x0e\xf9\x63\x50\x09\x56\xcc\xd6\x81\x77\xd5\xcc\xa3\x2d\x70\x14\xd2\xf8\xdb\x92\xd0\xef\xf3\x24\xf2\x28\xfd\x15\x2e\x67\x4f\xed\x07\x55\x23\xa6\xf5\x6f\xa9\xb2\x43\xba\x2f\x65\xa9\x77\x15\xcd\xc9\xec\xcb\x29\xfa\x94\x95\x55\x81\xd9\xfa\x99\x0f\xcf\x61\x33\x96\xf0\x12\xab\x82\xa7\x4c\xc3\x19\xbc\x8c\x69\x49\xa2\x79\xe0\x7b\x71\x10\x2e\x4e\x09\xcb\x6a\x37\xcd\x7f\x59\x81\xce\xce\x36\x36\x0c\x2f\x03\xd2\xdb\xd8\x3e\x8b\xb6\xc7\x8e\x2f\xe5\x0d\xc7\x87\x26\x37\xf7\xe9\x6a\x31\x23\x34\xa0\x09\x59\xf8\xcb\x55\x14\x27\x97\x64\x35\x2e\x67\xf7\x45\x77\x64\x97\xc5\x61\x01\x75\x63\x17\x7b\x31\x49\x9a\x78\xc9\x22\xee\xea\xf2\x16\x83\x23\x76\xdd\x13\x2b\x9b\xc4\x3b\x6c\x9e\xef\xbd\xe6\x71\xc5\x14\x2b\xf5\x23\xb5\x41\xca\xce\x3f\x9c\xad\x5e\xba\xce\x4f\x66\x6a\x98\xaa\x51\xdd\x7f\xca\xd2\x28\x4d\xa3\xe1\x1c\xc5\x50\x2f\x45\xaf\x49\xbf\xca\x4e\x3f\x3d\xaf\xc9\x8c\x31\x33\x4a\xcd\x88\xf0\x11\xac\x0c\xd2\x72\xbc\xfc\x73\x46\xfe\x06\x00\x00\xff\xff\x21\x00\x68\xf9\x75\x0a\x00\x00"),
 		},
 		"/infrastructure/02-syndesis-service-accounts.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "02-syndesis-service-accounts.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 1354,
+			uncompressedSize: 1526,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x90\x31\x4b\x03\x41\x10\x85\xfb\xfd\x15\x43\xfa\x3b\xb1\xdd\xce\xd2\x4e\x08\xd8\x8f\x7b\x73\xe7\xe0\xdd\xec\x32\x3b\x7b\x10\x96\xfd\xef\x42\xbc\x60\x88\x82\x4d\x90\x08\x96\x33\xef\x7d\xf0\xf8\x3a\xc0\xc4\xcf\xa4\x99\xa3\x78\x58\xef\x1d\xc0\x1b\xcb\xe0\x61\x4f\xba\x72\xa0\x87\x10\x62\x11\x73\x00\x0b\x19\x0e\x68\xe8\x1d\x00\x80\xe0\x42\x1e\xf2\x41\x06\xca\x9c\xbb\x81\x46\x2c\xb3\x1d\xa3\x19\x5f\x68\xce\x1f\x35\x00\x4c\xe9\xb3\xb7\xfd\x4e\x67\xcf\xf1\xee\xa7\xdc\x0e\x89\x3c\xb0\x8c\x8a\xd9\xb4\x04\x2b\x4a\xdf\xd4\x42\x5c\x52\x14\x12\x3b\x1b\x75\x01\xd5\xca\x23\xf4\x8f\x0b\x4e\xf4\x54\xe6\x79\x4f\x41\xc9\x32\xb4\xe6\x00\xf8\xe2\xeb\x5d\xad\x24\x43\x6b\xb5\x2a\xca\x44\x5f\xb9\x23\xd6\x6d\x1e\x76\xb5\xf6\xad\xed\x4e\x90\xbb\x92\xd5\x4c\xba\x92\xde\x96\xd4\x6d\xd3\xdf\x93\xc9\x62\x34\x29\x1a\x47\xf9\x37\x7a\x15\xa3\x49\xe3\x42\xf6\x4a\x25\xdf\x96\xd0\xb3\x5d\xbf\x28\xf5\x3d\x00\x00\xff\xff\x17\xf2\xc1\xb1\x4a\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x92\x41\x4b\xfb\x40\x10\xc5\xef\xf9\x14\x43\xef\xd9\xf2\xbf\xee\xad\xfc\xeb\xa1\x07\x41\x2c\x78\x9f\x6e\xa6\x75\xe9\xee\x6c\x98\x9d\x54\xeb\x92\xef\x2e\xc4\x46\x6b\x15\x04\x29\x52\xc1\x63\x66\xe6\x91\xf7\x7e\x6f\x6b\xc0\xd6\xdf\x91\x64\x9f\xd8\xc2\xee\x5f\x05\xb0\xf5\xdc\x58\x58\x92\xec\xbc\xa3\x99\x73\xa9\x63\xad\x00\x22\x29\x36\xa8\x68\x2b\x00\x00\xc6\x48\x16\xf2\x9e\x1b\xca\x3e\xd7\x0d\xad\xb1\x0b\x3a\xac\x02\xae\x28\xe4\x97\x33\x00\x6c\xdb\xb7\xbb\xc3\x6c\xfc\x34\x3e\x4d\xbf\xda\xeb\xbe\x25\x0b\x9e\xd7\x82\x59\xa5\x73\xda\x09\x7d\x72\xe6\x52\x6c\x13\x13\xeb\x91\xa9\x13\x51\x29\x7e\x0d\x66\x11\x71\x43\x37\x5d\x08\x4b\x72\x42\x9a\xa1\xef\x2b\x00\x7f\x32\xb5\x55\x29\xc4\x4d\xdf\x97\x22\xc8\x1b\xfa\xa8\x1b\x64\xf5\x81\xc3\xa4\x14\xd3\xf7\x93\x51\x54\x9d\x89\x6a\x26\xd9\x91\x1c\x8c\x2f\xc7\xb4\xff\xc7\xa8\xd9\xcc\x51\x71\x85\x99\xcc\xd5\xa3\x92\x30\x86\xf9\x6a\x31\xbb\xbe\x4d\x81\x66\xc2\x83\x43\x00\x64\x4e\x8a\xea\x13\xbf\x76\x42\xdb\x6c\x30\xe2\x53\x62\x7c\xc8\xc6\xa5\x38\x95\x14\xa8\x46\x61\x0b\xa5\x7c\xeb\x4f\x63\xf2\x8b\x7a\x00\xef\xf8\xfd\xa6\xe2\x3d\x2b\x6d\x64\x28\xed\x8f\xe8\x59\x88\xb6\x92\x22\xe9\x3d\x75\xf9\xb2\x80\x1e\xf9\xfa\x41\xa8\xcf\x01\x00\x00\xff\xff\x82\x88\xf3\xd1\xf6\x05\x00\x00"),
 		},
 		"/infrastructure/03-syndesis-server-config.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "03-syndesis-server-config.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 3619,
+			uncompressedSize: 4204,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xdb\x6e\x1b\x37\x13\xbe\xd7\x53\x10\x41\x80\xfc\x3f\x9a\x5d\x59\x4e\x0a\x04\x04\x7a\x91\x4a\x69\xaa\xc6\xae\x5d\x2b\x2e\x72\x3b\x22\x47\x2b\x46\x5c\x92\x21\xb9\x8a\x37\xaa\xde\xbd\xe0\x1e\xa9\x53\xa4\xa2\xbd\xdb\x1d\xce\x7c\x73\x22\xbf\x99\x84\x80\x11\x7f\xa2\x75\x42\x2b\x4a\xd6\xa3\x01\x21\x2b\xa1\x38\x25\x63\xad\x16\x22\xbb\x05\x33\x20\x24\x47\x0f\x1c\x3c\xd0\x01\x21\x84\x80\x52\xda\x83\x17\x5a\xb9\x5a\x40\x88\xd0\xa9\x2b\x15\x47\x27\xdc\xb0\x30\x99\x05\x8e\x49\xae\x39\x52\xb2\x42\x34\x95\x92\x84\x39\xca\xce\x00\x8c\xa1\xa4\x35\x69\x64\xed\x6f\x2a\xf4\xf0\xdc\xb9\x2f\x0d\x52\x22\xd4\xc2\x82\xf3\xb6\x60\xbe\xb0\x78\x44\x8d\xe9\xdc\x68\x85\xca\xf7\x60\x89\x43\xbb\x46\x5b\x29\x2b\xc8\xf1\xe0\x24\x61\x55\xe6\x03\x42\xa2\x94\x8d\x91\x82\x55\x39\xa7\x65\x2e\x29\xf9\x2b\x69\xbc\x71\x34\x52\x97\x79\x70\xd1\x48\x08\x91\x1a\x78\xc2\x31\xd7\x49\x85\x40\x5e\x6c\x36\xe9\xac\x8d\x6a\x82\xb9\x9e\x80\x87\xed\xf6\x45\x63\xc0\xb4\x75\x74\xb0\xd9\x24\x44\x2c\xc8\xff\x94\xf6\x24\x7d\x2b\xa5\xfe\x7a\xa3\x19\xc8\x5f\xb5\xf3\xff\xdf\x6e\x3b\x6c\x08\x27\xc8\xef\xac\xc8\x84\x72\x94\x2c\xbd\x37\x8e\x0e\x87\xb1\x8b\x07\x5d\x78\x0c\x86\x21\xbf\xed\xb6\x82\x46\xe9\xf0\x0c\x0c\x1d\x0e\x65\x70\xb9\xd4\xce\xd3\xd7\xd7\x57\x57\x2f\x3b\xf4\x53\xf2\xb3\x5e\x15\xef\x9c\x32\x60\x4b\xec\x8b\xc4\x64\xe1\x3c\xda\x5e\xd0\xb6\xa3\x05\x1c\xd7\x0a\xdd\x79\x0e\x4f\xb1\x32\x2a\x6f\x05\x3a\x4a\x46\x57\x57\x83\x56\xc4\x6c\x69\xa2\x46\xac\xb0\xdc\xab\xfe\xb8\xbd\x10\x2e\x9d\x55\xdd\xee\x8e\xde\xd5\xc6\x1f\xb0\xec\x3b\xe3\x8c\x15\x2a\xeb\xf1\xbe\x09\xb3\x12\x6a\x37\x0a\x98\x4b\xe4\x94\x2c\x40\x3a\xec\x0e\x42\xdf\x9d\x2e\x2c\xc3\x58\xb9\xb0\x92\x92\x17\x9f\xf9\x9c\xd1\x13\x31\x85\x8b\x31\x07\x87\xe9\xe3\xc3\x4d\x1f\x46\x65\xeb\xd0\xd6\xf5\x39\x95\x4f\x6f\xeb\xd0\xee\x1a\x1b\x70\xee\xab\xb6\xfc\x02\xe3\xfb\x46\x75\x17\x80\x5b\x51\xbd\x0c\x09\xce\x25\x75\x18\xda\x66\xa9\xd1\xce\x67\x16\xdd\x17\x99\x4e\x2a\x8d\xb6\x6e\xc8\x0a\x2b\x7c\xd9\x27\x3f\x07\x27\xd8\xd9\xc2\xe5\xa0\x20\xc3\xdd\xc7\x64\xb4\xf5\x94\xbc\x19\xbd\x19\x75\xa2\x43\xf8\x08\xcf\xdb\x02\xbb\xfb\xc0\x8d\x16\xca\xbb\x5e\x71\x89\x20\xfd\x32\x36\x74\xa8\x9c\xf0\x62\x8d\xfb\x3d\xfc\xec\xb4\xe2\xf3\x73\x3e\x72\xad\x84\xd7\xbb\xd7\xa4\x26\x50\x8e\x0b\x28\xa4\x6f\xa4\x0b\x84\xc0\x51\x51\x28\xc7\x2c\x8f\xfb\x20\xc4\x14\x73\x29\x58\x02\x46\x9c\xd7\x5d\x29\xa8\xd2\x89\x14\x1b\x6e\xe9\x3b\xff\x96\x73\xad\x5c\xfa\xa1\x56\x4d\xdf\xd5\x40\x24\x22\x88\x53\xe8\x35\xd8\x1e\x9b\x9c\x7c\x07\x8d\x76\xc5\x02\xa7\x82\xf8\x0d\x30\x43\xdb\xc6\x10\xa1\xf2\xb9\xd4\x59\x76\xaa\x3e\x7b\xcd\xaa\x40\x12\x60\x5e\xac\x85\x2f\x13\x6f\x81\x5d\x50\xd9\xda\xac\xd5\x3a\x13\xe1\x1f\x05\xda\xf2\xf1\x61\x1a\x85\xf8\x25\x88\x52\x30\x22\xad\xde\x76\xfc\xb8\xce\xd8\x1e\xa9\xe2\x1e\x58\x43\xc9\x4a\x43\xe1\x97\x49\x37\xa4\x9a\x4c\x2b\x65\xfa\xfa\xf5\xab\x21\x18\x31\xd8\xaf\x75\x0b\x19\x26\x9a\x60\x98\x1e\x1d\x74\x83\xef\xf4\xe6\x90\x27\x7f\x69\x2e\x70\x7a\x0b\x6b\x54\x0f\x68\xb4\xab\xae\x2f\xba\xce\x5f\x1e\x4e\xfa\x92\xdb\x48\xa7\x96\x06\x37\x16\x54\x86\xe4\xb9\xe0\x2f\xc9\xf3\xc2\x4a\x42\x7f\xfa\xb7\x6e\x6b\xe4\x00\x49\xb6\x5b\x5a\x7d\x06\xe0\xe6\xbc\x49\x92\x1c\x99\x48\xda\xa0\x72\x4b\xb1\x88\xd8\x06\x8c\xf8\x19\x1c\x3e\x56\x4c\xfd\xfd\xc1\xd1\x45\x76\x67\x50\xcd\x02\xcc\x2d\x84\x81\xb5\xdd\x0e\x35\x18\x31\x5c\x8f\x7a\x06\x0d\xf5\x77\x06\x58\x43\xdf\x9d\xc5\xbd\xd5\x9f\x91\xf9\x98\x6c\x45\x0e\x19\xce\xbc\x45\xc8\x7f\x3f\x6f\xd5\x36\x6e\x82\xeb\x59\x61\x02\x57\x46\x65\x99\x17\x42\x72\xb4\xd3\x1e\xf1\x23\x64\xf1\x35\xb8\x16\x54\x82\x47\xe7\x8f\xad\x08\x97\x58\x6f\x36\xc4\x43\x76\x77\xea\xe6\x5c\x4f\xd3\xca\xfc\x58\xf1\xe3\xd5\xe9\x16\x73\x6d\xcb\x07\xfc\x52\xa0\xf3\xb7\x82\x92\xeb\x6e\xa6\x1f\xaa\xdd\x88\x5c\x54\x4a\x3f\x8e\xae\xa3\xe5\x60\x8d\xea\xce\xd4\x4b\x29\x79\x96\x7c\xfa\x44\x7f\x78\x74\xf8\x7e\xf4\x7e\x4c\xda\x9f\x99\x0f\x64\x3b\x41\x5e\x74\xcb\x1c\x49\x3e\xe5\x4f\xaf\x46\x57\xf9\xb3\xbe\xfe\xca\x63\x66\xab\xd3\x1b\xb1\x46\x85\xce\xdd\x5b\x3d\xc7\xa9\x12\x5e\x80\x9c\xa0\x84\x72\x86\x4c\x2b\x1e\x76\x8f\xeb\x36\x4e\x0e\x7a\x7f\x00\xd4\x03\xa4\x5b\xf2\x94\xb7\x5a\x4a\x8c\x76\xbd\x03\xb2\x18\x43\x8e\xf2\xc3\x11\x2a\x8c\x82\xa2\x84\x05\xad\x64\xd5\x6f\x52\xe1\x7f\x15\x53\x1d\x2b\x9c\xd7\xb9\xf8\x56\x39\xeb\xc5\x49\xb7\xc8\x5f\x30\x1b\x4e\xd2\x72\xc0\x69\xe8\xf5\xdc\x54\x48\x48\xc3\xe0\x47\x07\x42\xac\xd7\xad\xfa\x87\xc7\x39\x3c\x4d\xfb\xf4\xdd\x3d\xda\xb0\xe0\x5c\xfe\x3c\xa7\x71\x43\x73\xb1\xf3\xd8\x72\x78\x9a\x74\xd7\xeb\xbf\x85\x8e\x5a\x36\xf3\xe0\x71\xbc\x44\xb6\x0a\x06\x76\x0d\xff\x80\x5c\xa6\xdf\x83\x89\x19\xe0\xc2\xfe\x65\xa8\xd0\x82\xd7\xd1\xe2\xdd\x0e\xcd\x8f\xcd\xcc\xac\x07\x64\xdf\x88\xbf\x03\x00\x00\xff\xff\x94\xd1\xd4\x37\x23\x0e\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x5b\x6f\xdb\x36\x14\x7e\xf7\xaf\x20\x8a\x02\xdd\xb0\x4a\x8e\xdd\x0e\x68\x05\xec\x21\xb5\xbb\xce\x6b\xb2\x64\x71\x33\xf4\xf5\x98\x3a\x96\x59\x53\x24\x4b\x52\x6e\xd4\xcc\xff\x7d\x20\x75\xa3\x1d\xf9\x32\x6c\x2f\x86\x75\x2e\xdf\xb9\x92\xe7\x30\x22\xa0\xd8\x5f\xa8\x0d\x93\x22\x21\x9b\xd1\x80\x90\x35\x13\x69\x42\x26\x52\x2c\x59\x76\x0d\x6a\x40\x48\x8e\x16\x52\xb0\x90\x0c\x08\x21\x04\x84\x90\x16\x2c\x93\xc2\x54\x04\x42\x98\x8c\x4d\x29\x52\x34\xcc\x0c\x0b\x95\x69\x48\x31\xca\x65\x8a\x09\x59\x23\x2a\x2f\xc4\x61\x81\xbc\x55\x00\xa5\x12\xd2\xa8\xd4\xb4\xe6\x33\x66\x72\x78\x8a\x6f\x4b\x85\x09\x61\x62\xa9\xc1\x58\x5d\x50\x5b\x68\xec\x11\xa3\x32\x57\x52\xa0\xb0\x1d\x58\x64\x50\x6f\x50\x7b\x61\x01\x39\x3e\xe1\x44\xd4\x47\x3e\x20\x24\x08\x59\x29\xce\xa8\x8f\x39\x2e\x73\x9e\x90\xbf\xa3\xda\x5a\x8a\x8a\xcb\x32\x77\x26\x6a\x0a\x21\x5c\x42\x1a\xa5\x98\xcb\xc8\x23\x90\x17\x8f\x8f\xf1\xbc\xf1\x6a\x8a\xb9\x9c\x82\x85\xed\xf6\x45\xad\x40\xa5\x36\xc9\xe0\xf1\x31\x22\x6c\x49\x7e\x10\xd2\x92\xf8\x92\x73\xf9\xed\x4a\x52\xe0\xbf\x49\x63\x7f\xdc\x6e\x5b\x6c\x70\x1c\x4c\x6f\x34\xcb\x98\x30\x09\x59\x59\xab\x4c\x32\x1c\x86\x26\xee\x64\x61\xd1\x29\xba\xf8\xb6\x5b\x0f\x8d\xdc\xe0\x09\x98\x64\x38\xe4\xce\xe4\x4a\x1a\x9b\xbc\x1e\x5f\x5c\xbc\x6c\xd1\x0f\xd1\x4f\x5a\x15\x69\x6b\x94\x02\x5d\x61\x97\x24\xca\x0b\x63\x51\x77\x84\xa6\x1c\x0d\xe0\xa4\x12\x68\xf9\x39\x3c\x84\xc2\x28\xac\x66\x68\x12\x32\xba\xb8\x18\x34\x24\xaa\x4b\x15\x14\x62\x8d\xe5\x5e\xf6\x27\x4d\x43\x98\x78\xee\xab\xdd\xb2\xde\x57\xca\x1f\xb1\xec\x2a\x63\x94\x66\x22\xeb\xf0\xbe\x33\xb5\x66\x62\xd7\x0b\x58\x70\x4c\x13\xb2\x04\x6e\xb0\x65\xb8\xba\x1b\x59\x68\x8a\xa1\x70\xa1\x79\x42\x5e\x7c\x49\x17\x34\x39\xe0\x93\x6b\x8c\x05\x18\x8c\xef\xef\xae\x3a\x37\xbc\xae\x41\x5d\xe5\xe7\x50\x3c\x9d\xae\x41\xed\x94\xeb\x8e\xf2\x0d\x75\x54\xe1\xfd\x83\x75\xe0\x7c\xba\x98\x5d\x5e\x5f\x16\x76\x15\x34\x0a\x21\x0a\x8c\xf9\x26\x75\x7a\x86\xe5\xdb\x5a\xb4\xb1\x1e\x96\xdf\xa7\x45\x33\x7f\xc0\x38\x18\x13\x55\xd1\x48\x9d\xc5\x4a\x1a\x9b\x69\x34\x5f\x79\x3c\xf5\x12\x4d\xfa\x91\x16\x9a\xd9\xb2\xcb\xe1\x02\x0c\xa3\x27\xf3\x9f\x83\x80\x0c\x77\xcf\xa4\x92\xda\x26\xe4\xcd\xe8\xcd\xa8\x25\x3d\x85\x0f\xf0\xac\x2e\xb0\x6d\xab\x54\x49\x26\xac\xe9\x04\x57\x08\xdc\xae\x42\x45\x83\xc2\x30\xcb\x36\xb8\xdf\x0a\x5f\x8c\x14\xe9\xe2\x94\x8d\x5c\x0a\x66\xe5\x6e\xb7\x55\xf7\x70\x8a\x4b\x28\xb8\xad\xa9\x4b\x04\x77\xd5\x05\xae\xf4\x69\xf6\xdb\x20\x44\x15\x0b\xce\x68\x04\x8a\x9d\x96\x5d\x0b\xf0\xe1\x04\x82\x75\x43\x75\x3d\x70\x99\xa6\x52\x98\xf8\x63\x25\x1a\xbf\xaf\x80\xc8\x4e\xcd\xfb\xd1\x2b\xb0\xbd\x4b\xe9\xe0\x71\xaa\xa5\x7d\x37\x1d\x74\x02\x96\x6b\x68\x5c\x08\x40\xd7\x8e\x7e\x38\xdc\xd3\xc0\xbf\x03\x66\xa8\x7b\x90\xd3\x05\x97\x59\x76\x28\xf1\x7b\x5d\xe0\x41\x22\xa0\x96\x6d\x98\x2d\x23\xab\x81\x9e\x51\xb2\x4a\xad\x91\x3a\xe1\xe1\x9f\x05\xea\xf2\xfe\x6e\x16\xb8\xf8\xd5\x91\x62\x50\x2c\xf6\x77\x4f\x78\x7e\x4f\xe8\xf6\x94\x67\x0f\xac\x1e\x19\x42\x42\x61\x57\x51\x3b\x44\xeb\x48\xbd\x70\xf2\xfa\xf5\xab\x21\x28\x36\xd8\x2f\x62\x03\xe9\x26\x2e\xa3\x18\xf7\x0e\xe2\x33\x6a\xd3\x5f\xf4\x9d\x92\x1f\x57\xbd\xd5\x72\xc3\xdc\xee\x13\x38\xb5\x90\xd2\x1a\xab\x41\xd5\x7e\x98\xc0\x33\x0f\x5d\xff\xb6\x72\xc9\xdb\x8b\xb7\xe3\xc3\x79\xeb\xc1\xeb\x29\x44\xe5\xcf\xbb\x46\xb6\x9a\x4e\x26\x80\x39\x1e\xc8\x44\x63\x8a\xc2\x32\xe0\x73\xa4\x1a\x6d\xa0\x48\x5b\x96\xcb\xac\x63\x1e\x71\xe0\x08\xce\x7e\xf9\x76\xcb\x73\xa0\x50\x4f\x07\xee\xaf\xf5\x15\x16\x5f\xc3\x06\xc5\x1d\x2a\x69\xfc\x05\x86\x5d\xb0\xb9\xe3\x74\x67\x43\x07\x32\x15\xd5\x99\xd1\x20\x32\x24\xcf\x59\xfa\x92\x3c\x2f\x34\x27\xc9\x2f\xff\xd5\x6c\x85\xec\x20\xc9\x76\x9b\xf8\xbf\x0e\xb8\xe6\xd7\x41\x92\x9e\xd5\x46\x2a\x14\x66\xc5\x96\xc1\xbc\x01\xc5\xde\x81\xc1\x7b\x3f\xf2\x8f\x6f\x20\xad\x67\x37\x0a\xc5\xdc\xc1\x5c\x83\xdb\x7c\xb6\xdb\xa1\x04\xc5\x86\x9b\x51\xb7\x08\xb8\x83\x62\x14\xd0\x7a\x0f\x68\x35\x6e\xb5\xfc\x82\xd4\x86\x3b\x03\xcb\x21\xc3\xb9\xd5\x08\xf9\x1f\xa7\xb5\x9a\xc2\x4d\x71\x33\x2f\x94\x9b\x96\x61\x07\x17\x8c\xa7\xa8\x67\x1d\xe2\x27\xc8\xc2\xf3\x3a\x66\x09\x07\x8b\xc6\xf6\xed\x9a\xe7\x68\x3f\x3e\x12\x0b\xd9\xcd\xa1\xce\x19\xcf\x62\xaf\xde\x97\xfc\x70\x07\xbf\xc6\x5c\xea\xf2\x0e\xbf\x16\x68\xec\x35\x4b\xc8\xb8\x5d\x0e\x9f\x8a\x5d\xb1\x9c\x79\xa1\x9f\x47\xe3\x60\xcb\xdc\xa0\xb8\x51\xd5\xeb\x86\x3c\x8b\x3e\x7f\x4e\x7e\xba\x37\xf8\x61\xf4\x61\x42\x9a\x8f\xb9\x75\xe3\x76\x8a\x69\xd1\xbe\x0a\x48\xf4\x39\x7f\x78\x35\xba\xc8\x9f\x75\xf9\x17\x16\x33\xed\xb9\x57\x6c\x83\x02\x8d\xb9\xd5\x72\x81\x33\xc1\xdc\xf1\x9a\x22\x87\x72\x8e\x54\x8a\xd4\x2d\xb1\xe3\xc6\xcf\x14\xe4\xfe\x0a\x50\xad\x10\xed\x6b\x41\x58\x2d\x39\xc7\xe0\xd1\xf0\xe4\x2c\x4f\x20\x47\xfe\xb1\x67\x66\x05\x4e\x25\x84\x3a\xa9\x68\xdd\x5d\x14\xee\x7b\x1d\xce\x24\x5a\x18\x2b\x73\xf6\xdd\x1b\xeb\xc8\x51\xfb\x22\x3c\x63\x3b\x38\x38\x3f\x1d\x4e\x3d\x07\x4f\xed\x05\x11\xa9\x47\x6d\xef\x4a\x10\xca\xb5\x6f\xc6\xa7\xec\x1c\x1e\x66\x5d\xf8\xe6\x16\xb5\xdb\x94\xcf\x3f\x9e\xb3\xb0\xa0\x39\xdb\x39\x6c\x39\x3c\x4c\xdb\xf6\xfa\x7f\xa1\x83\x92\xcd\x2d\x58\x9c\xac\x90\xae\x9d\x82\xde\xc0\xbf\xb8\x5c\x66\xc7\x60\xc2\x1b\xe0\xcc\xfa\x65\x28\x50\x83\x95\xc1\x0b\xae\xd9\x6e\x3e\xd5\xcb\xcd\xfe\x86\xf5\x4f\x00\x00\x00\xff\xff\xfe\x98\x8b\xb0\x6c\x10\x00\x00"),
 		},
 		"/infrastructure/03-syndesis-ui.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "03-syndesis-ui.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 5053,
+			uncompressedSize: 7732,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\xeb\x6e\x1b\xbb\x11\xfe\xaf\xa7\x18\x30\x28\x9c\x00\xd6\xca\x17\xd8\x28\x16\x08\x5a\xc7\x4e\x5b\xa7\x91\x2d\x58\x76\x5a\x20\x09\x8a\xf1\xee\x68\xc5\x94\x4b\xb2\x24\x77\x65\x55\x47\xef\x7e\xc0\xbd\x48\x5c\x5d\x4e\x7c\x90\xe0\x20\xc9\x9f\x58\xe4\x5c\xbe\xf9\xe6\xc2\xd9\x3e\xa0\xe6\x1f\xc8\x58\xae\x64\x0c\xe5\x71\x0f\xe0\xbf\x5c\xa6\x31\x8c\xc9\x94\x3c\xa1\x1e\x40\x4e\x0e\x53\x74\x18\xf7\x00\x00\x24\xe6\x14\x83\x9d\xcb\x94\x2c\xb7\xfd\x82\x57\xa7\x02\x1f\x49\xd8\x5a\x02\x00\xb5\x5e\x8b\x34\x67\xed\xcf\x88\xab\xc1\xd7\xee\xdd\x5c\x53\x0c\x5c\x4e\x0c\x5a\x67\x8a\xc4\x15\x86\x76\x88\x25\x2a\xd7\x4a\x92\x74\x9b\x78\xac\xa6\xa4\xc6\xa2\x95\x71\x0d\xac\x7e\xf5\x23\x86\x3f\x1f\x35\xa6\xb4\x51\x4e\x25\x4a\xc4\x70\x7f\x39\x6a\xce\x1c\x9a\x8c\xdc\xa8\x11\x6c\x44\x2d\x09\x4a\x9c\x32\xdf\x2b\xbc\x3d\xb8\xbb\xa9\x40\xad\xed\x20\xc8\xc7\x15\x69\xa1\xe6\x39\x49\xb7\x95\x92\xc5\xa2\x0f\x7c\x02\x28\x53\x88\x2e\x34\xf7\x99\x23\x13\x5d\xe7\x98\xd1\xd8\x19\xc2\xdc\x42\x74\x45\xe5\xb8\xd0\x9e\x81\xe5\xb2\x52\x42\x29\x95\x43\xc7\x95\x5c\xa5\xed\x45\xfb\x3f\x8c\xc9\x59\x40\x70\x86\x67\x19\x19\x50\x12\xdc\x94\x5b\x48\x57\x18\xc0\x29\x50\x25\x99\x99\xe1\x8e\x56\x6a\x6e\x4a\xe0\x28\xd7\x02\x1d\x45\x3e\x09\x11\xf7\x20\x3c\xd3\x9a\x8c\x9b\x57\x10\xb5\xe2\x95\x7e\x47\x2b\xe0\x01\x6a\x1d\x5b\x21\x8f\x36\xa0\x55\x77\x91\xd2\x24\xed\x94\x4f\x5c\x55\x2c\x35\x48\x1b\xc3\xc1\xc7\x05\x9b\x18\x95\xb3\x78\xc1\x3c\x69\x2c\x66\x01\x09\xf7\x98\xb1\x43\xe6\xcb\x97\xc5\x2c\xf0\x17\x7b\xb4\xd6\xb1\xe5\x21\x9b\x70\x12\xe9\x08\xdd\xd4\x4b\x78\xf8\xdd\x60\x12\x25\x1d\x72\x49\xc6\x7e\xfc\xcb\xcb\xbf\x46\xde\xd4\xeb\xd7\x9f\x42\x5b\x9f\xd8\xab\xcf\x75\xcc\x6c\xf9\xf9\xa0\x49\x0e\xc9\xb4\xe1\xfc\xc7\xea\x92\xdd\xbd\xbc\xee\x1d\x43\x5a\xf0\x04\x6d\x0c\xc7\x3b\xdb\x20\x47\x97\x4c\xdf\x77\x42\xda\x1d\xd4\xd7\xc3\x7a\x36\x62\xeb\x0c\x3a\xca\xe6\xad\x43\xa3\x84\xe0\x32\x7b\xd0\x29\x3a\x5a\xa3\xc8\xf1\x69\x5c\x98\x8c\x62\x38\x39\xfb\x53\x78\xfa\x20\xb1\x44\x2e\xf0\x51\x74\xee\x0c\x59\x55\x98\x84\x82\x40\x04\xcf\xb9\x0b\x7e\xfb\xa6\xcb\x95\x99\xc7\xc0\x4e\xce\xce\x87\x9c\xad\x6e\x0c\xfd\xaf\x20\xbb\x4f\xf6\x68\x2d\x5a\x27\xec\x2e\x84\x5c\xdd\xb4\x55\xb6\x22\xb6\xd3\xdd\xdb\x85\xb3\x9f\xe7\xe7\x30\xfd\xec\x22\xfa\x1d\x69\x09\xcb\xa6\x2e\x95\xea\xf1\xb8\x48\x12\x55\x48\x77\xd3\x2d\xb3\x94\x26\x58\x08\xb7\x12\x5e\xb7\xd5\xda\x40\x7f\xcf\x3b\x53\xff\xab\x3a\x2c\x86\x83\xc5\x02\xa2\x71\x8b\xf0\xb2\x85\x67\xa3\x87\xeb\x7a\xfa\xc1\x72\x79\x10\xa8\x91\x2c\xe3\xde\x0b\xf8\x17\x81\x24\x4a\x01\xbd\xe7\x09\xcf\xa0\x44\x51\x90\x9f\x68\xc9\x14\x65\x56\xfd\xd5\x4e\x3e\x04\x49\xb3\x70\xee\xcd\xa6\x3c\x99\x82\x9d\x71\x97\x4c\xb9\xcc\xaa\xe1\xb5\x9e\xad\x30\x11\x98\x45\xbd\x17\xf0\xae\xb0\xae\x36\xd7\x0a\xd5\x53\xcd\x47\x05\xdc\x82\x54\xce\x7b\xb7\x3c\x25\x13\x42\xa9\x11\x44\x01\xe8\x96\x89\xab\xb7\x1f\xfe\x33\x7e\x18\x8d\x6e\xef\xee\x83\x5b\xa8\xc1\x57\x5c\x74\x66\xfc\x3a\xee\xc5\x82\x4f\x76\xcc\xff\x80\xc9\x51\x21\xc4\x48\x09\x9e\xcc\x63\xb8\x10\x33\x9c\xdb\x40\x99\x84\xa5\xdf\x56\xb9\x9e\xdc\x28\x37\x32\x64\xeb\xb7\x69\xa5\xb8\x1a\x7b\x4d\x0d\xf3\x92\x24\x59\x3b\x32\xea\x91\xe2\x4e\x0c\x53\xe7\xf4\xdf\xc9\x75\x0f\x01\x34\xba\x69\x0c\x6c\xc0\x36\xcf\xbb\xcf\xf3\x0a\x98\xe4\x8e\xa3\xb8\x22\x81\xf3\x31\x25\x4a\xa6\x36\x86\xd3\x50\xc6\x10\xa6\xfc\x8f\xc7\x70\xdc\xeb\xea\x75\x1a\xb9\xbf\xae\xff\xd1\x2e\x9b\xa5\x12\x45\x4e\x43\xdf\x47\x1b\x7a\xb9\x3f\x1b\x55\xf8\x06\x4a\x3b\xdf\xee\x7d\xa3\x94\x1b\x58\x93\x0c\xea\x7a\xea\x60\xab\xeb\xa8\xbe\xe8\xd7\x66\x83\xfb\xea\xd1\xf7\xa5\xff\x58\x18\xeb\xfc\x80\x84\x19\x77\x53\x40\x10\x6a\xd6\xcc\x33\x98\x28\xe5\xb4\xa9\xdf\x6f\xb0\x0e\x8d\x83\x97\x67\x47\x30\xe4\xaf\x3a\x2c\x6f\x0d\x53\xd8\x39\x50\x21\x18\x94\x27\x67\x67\x43\xde\xb9\xdb\x35\x56\x43\x8d\xb3\xa3\x40\xa1\x0e\xa7\x33\x40\xea\x40\x87\xa8\xe3\x1d\x2c\x04\x73\xa5\xbf\x45\xd5\x2e\xa2\x7a\xe1\x92\x15\x34\xfc\x9e\x85\x6b\xb9\xdc\x58\xe7\x76\x2c\x2e\xc1\x72\x17\xa8\xfe\xe4\x0b\xb7\xc3\x6c\xb5\x6f\xd7\xc8\x59\xb3\x5f\x35\xf6\xfc\x82\xb6\xce\xc8\x56\xf8\xf7\xb8\xce\x44\xad\x7f\xb0\xbd\xab\x1d\xf4\xda\xf1\xd2\xdb\xfb\xfd\x72\xd9\xa6\xff\xeb\x84\x86\x15\xf0\x63\xf1\xba\x06\x5d\x43\x8c\xbe\x58\x1f\xe6\x2f\x8d\x8d\xc5\x8a\x2a\x86\x9a\xbf\x41\x4b\x2c\x06\xe6\x27\x99\x8d\x07\x83\xc5\x62\xfd\x38\xde\xa9\xc2\xd1\x3f\x94\x75\x3e\xf8\xe5\x92\x1d\x76\x34\xdf\xca\xb4\xda\xca\xbd\xf6\x00\x35\x1f\x94\xc7\xa1\x84\x7f\xa8\x94\xa0\x07\x23\xbc\xc0\x62\x11\xdd\x6a\x92\x63\x5f\xc6\x97\xcd\xcd\xdd\xfb\xae\x4d\x6d\xd4\x17\x4a\xdc\xa6\xf8\xa8\x3e\xee\xca\xfa\x18\x73\xd4\x9a\x0c\x8b\x83\x88\x00\xd8\x23\x5a\x1a\xa2\xd6\x5c\x66\xcd\xd7\x68\x03\xe1\x19\x11\x36\x61\x0c\xd0\x09\xb4\x83\xc0\x5f\x63\xf7\x1d\x96\x78\x2d\x7d\xdd\xfa\x4f\xa0\x6f\x34\xff\x05\x4b\xdc\xe1\xe3\xdf\xc3\xf7\xdf\xcd\xc5\x53\x2e\x76\x45\x31\xbe\xbd\xf9\x7e\x51\x58\x25\x37\x7c\xa4\xdc\xfa\x97\xa0\x49\xc2\xc8\x50\xc9\x69\x36\x54\xa9\xaf\xb3\x09\x0a\xbb\x7e\x40\x96\x1b\x19\x2d\xb9\x71\x9b\xf9\x4c\xcb\x06\xda\x20\x2d\xbd\x5b\x76\xd8\x4e\xd5\x35\xc2\x8b\x34\x55\xd2\x46\x57\x1f\xa2\xb7\xd2\xbb\x4e\xa1\xb3\x44\x30\xaa\x4f\x59\xf8\xaa\x7a\x23\x7e\x4d\xd9\x2b\x7a\xd4\x15\x95\x1d\xa3\x21\xf2\x09\xa1\x6f\x51\xcb\xc0\x43\xdf\x07\xee\x12\x73\x12\xff\x6c\x01\x6e\x38\x7d\x72\x24\xfd\x30\xb2\x2d\x45\x21\xa1\x8d\xfb\xae\x8a\x50\x99\xdf\x12\x77\x51\xda\x22\x18\x19\x95\x16\x89\xe3\xff\xef\xb8\x63\x8f\x06\x65\x5a\xab\x76\x88\x46\xad\x6f\xaa\xef\x5c\x60\x7f\x2b\x2c\xc1\xad\x14\x5c\x52\x37\xb5\x13\x2c\x79\xa2\xe4\xe9\x49\x95\x90\xe6\x57\xff\xf4\xe4\xe9\xf4\x24\xd2\x32\xdb\x29\x7c\x7c\xde\x11\x3e\x3e\x7f\x3a\x3e\xdf\x16\x76\xaa\x48\xa6\xd7\x89\x92\xcd\x38\xd1\x82\xfa\xd5\x59\xdf\x6b\x6d\xcb\xeb\x3a\xb8\x37\x05\x17\x3e\x59\xce\x14\x2b\x0e\x96\x2b\xc2\x1a\x26\xfc\xde\xfc\x0d\x6c\xb4\x99\xfc\x49\xa8\xe8\xd4\xc3\x72\xb3\x78\x96\xbd\x5f\x03\x00\x00\xff\xff\xd2\xd3\x03\x27\xbd\x13\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x59\x6d\x6f\x1b\xb9\x11\xfe\xae\x5f\x31\xd8\x5c\xe1\x04\xb0\x56\xb6\x03\x1b\x87\x05\x82\xd6\x67\xa7\xad\xaf\x76\x2c\x58\x76\xda\xe2\xee\x50\xd0\xbb\x23\x89\x09\x97\xe4\x91\xb3\xb2\x55\xdd\xfe\xf7\x82\xfb\xa2\xe5\x4a\xab\x97\x26\x2e\x9a\xa2\xf9\x12\x2f\x39\x9c\x79\xe6\x99\xe1\x70\x48\xf5\x81\x69\xfe\x11\x8d\xe5\x4a\x46\x30\x3b\xee\x01\x7c\xe6\x32\x89\x60\x84\x66\xc6\x63\xec\x01\xa4\x48\x2c\x61\xc4\xa2\x1e\x00\x80\x64\x29\x46\x60\xe7\x32\x41\xcb\x6d\x3f\xe3\xbd\xc5\xa2\x0f\x7c\x0c\xe1\xa8\x1a\x0b\xcf\x93\x84\x13\x57\x92\x89\x73\x29\x15\x31\xf7\xb7\x85\x3c\x2f\xd6\xb3\x66\x28\xea\x2d\x16\x40\xea\xef\x2c\x15\x3b\x57\xff\x06\x5c\x26\x28\x09\xce\x9c\x22\x67\x12\x65\x52\xeb\x14\xec\x11\x85\x2d\xf1\x01\x30\xad\x1b\x80\xd5\x58\xfd\x19\x72\x35\xd8\x35\x4f\x73\x8d\x11\x70\x39\x36\xcc\x92\xc9\x62\xca\x0c\x76\x88\xc5\x2a\xd5\x4a\xa2\xa4\xbd\xd9\xb8\x2e\x60\x96\xf8\xb7\xf9\x5d\xc9\x6d\x71\xd9\x6a\x8c\x4b\x77\xb5\x32\x54\x79\xde\x2f\x3e\x22\xf8\xfe\xa8\x42\xab\x8d\x22\x15\x2b\x11\xc1\xfd\xc5\xb0\x1a\x23\x66\x26\x48\xc3\x4a\xb0\x12\xb5\x28\x30\x26\x65\x5e\x8a\xc1\x0d\xd4\xb4\x73\x8d\x69\x6d\x07\x5e\xc2\x5d\xa2\x16\x6a\x9e\xa2\xa4\xb5\x9c\xab\x38\x55\x06\x5e\x33\x99\x40\x78\xae\xb9\x4b\x4f\x34\xe1\x55\xca\x26\x38\x22\x83\x2c\xb5\x10\x5e\xe2\x6c\x94\x69\xc7\xc2\x9b\x5d\x09\xd5\x95\x8d\x9e\xa9\x3d\xcd\x54\x5a\x00\x5e\xd5\xff\xc3\x08\xc9\x02\x03\x32\x7c\x32\x41\x03\x4a\x02\x4d\xb9\x85\x64\xe9\x1d\x90\x02\x35\x43\xf3\x64\x38\xe1\x72\x19\x4d\x11\x08\x53\x2d\x18\x61\xe8\xc2\x1b\x72\x67\xd3\xc5\x50\xa3\xa1\x79\x81\x48\x2b\x5e\xac\x6f\xad\xf2\x18\x86\x72\x8d\x2d\x80\x86\x2b\xd0\x8a\xb9\x50\x69\x94\x76\xca\xc7\x54\x64\x7a\x09\xd2\x46\x70\xf0\xd3\x22\x18\x1b\x95\x06\xd1\x22\x70\xe1\x08\xa2\xc0\xf3\xf9\x9e\x4d\x82\xc3\xc0\xed\xfc\x20\x0a\x3c\x7b\x91\x43\x6b\x29\xc8\x0f\x83\x31\x47\x91\x0c\x19\x4d\x9d\x84\x83\xdf\x76\x26\x56\x92\x18\x97\x68\xec\x4f\xbf\x7f\xfd\x87\xd0\xa9\x7a\xf7\xee\x67\x5f\xd7\xcf\xc1\x9b\x5f\x4a\x9f\x83\xfc\x97\x83\x2a\x16\x28\x93\x8a\xe2\xfd\x8b\xcc\x97\xd6\x94\xda\x8a\x57\x57\x7c\x00\xff\x77\x35\xa6\xab\xd4\xfb\x95\xc7\xa0\x16\x3c\x66\x36\x82\xc5\xc2\xa1\x99\x90\x67\xe8\xa2\x06\x6e\xc3\x87\xab\xf0\xae\x12\x85\x23\xc8\xf3\xc5\x62\xb7\x5c\x21\x85\xc2\x22\xe4\xf9\xb1\xfb\xb3\x01\xb5\x5a\xab\x52\x46\xf1\xf4\xba\x15\x9c\xee\xf0\xec\x0e\xd0\x5e\xdc\x17\x62\x64\x18\xe1\x64\x5e\x1b\x34\x4a\x08\x2e\x27\x0f\x3a\x61\x84\x0d\x8a\x94\x3d\x8f\x32\x33\xc1\x08\x4e\x4e\x7f\xe7\x8f\x3e\x48\x36\x63\x5c\xb0\x47\xd1\x9a\x33\x68\x55\x66\x62\xf4\x1c\x11\x3c\xe5\xe4\x7d\xbb\xca\x98\x2a\x33\x8f\x20\x38\x39\x3d\xbb\xe1\xc1\x72\xc6\xe0\xaf\x19\xda\x4d\xb2\x47\x8d\x68\x99\x7a\x77\x3e\xe4\x62\xa6\xde\xb0\x4b\x62\x97\x25\xb8\x29\xbf\xbb\x76\xd4\x6b\xfc\xd5\x93\x19\x61\x9c\x19\x4e\xf3\x1b\x95\xa0\x80\xc0\xa0\x25\xc3\x63\xc2\x24\x78\xb3\x2c\x9c\x6b\x0d\x41\x61\x6a\x4f\x35\x9e\x16\x97\x18\xb1\x0b\x59\x68\x2b\xf1\x90\x09\x3d\x65\xe1\xe7\xec\x11\x8d\x44\xc2\x22\xa8\x5a\x25\x11\x98\x4c\x12\x4f\x71\x90\xe0\x98\x65\x82\xea\xb4\xaf\x36\xc0\x7f\xac\xca\x1c\x1f\xad\xec\xb1\x95\xed\xb6\x5e\x65\x36\xa7\xf2\x3e\xc9\xbc\x77\xc5\xf9\xef\x55\x9d\x35\x4a\x2a\x30\xcb\x22\x53\x06\xb6\xe8\x44\xcf\xe3\x58\x65\x92\x3e\xb4\x8b\x92\x1f\xc3\x16\xb8\x51\x3c\xc5\x24\x73\x39\x1e\x7e\x50\x09\x8e\xaa\xb2\xe1\x71\x2d\xbd\xe1\xe6\xf0\x37\x4c\x4e\x10\xbe\xfb\x8c\xf3\x43\xf8\x6e\xc6\x44\x86\x10\xbd\xfb\xb7\xf4\x3a\x3d\x85\x02\xc8\xf3\xa2\x38\x56\x6a\xda\x67\x4c\xe3\x77\x57\xde\x79\x56\xee\x95\x40\xd3\x6a\x5a\x8a\xdd\xda\x8c\x76\xb7\xd1\xdd\x1a\x1a\xea\xbf\x87\xbd\x11\x9c\x8f\xc7\x5c\x72\x9a\xfb\x9b\xb6\x1a\xda\x69\xbb\x5e\xbb\x21\xe6\x3b\x2c\x0f\x0d\x57\x6e\x2f\x5f\x08\x66\xad\x8b\xbc\x07\x41\xaf\xce\x15\x5c\xef\xa7\x66\x1d\xc1\x13\xa7\x29\x68\x95\xd4\xc5\xe6\x42\x49\xc2\x67\xda\x58\x86\x3c\x20\xb6\xbd\xa4\x45\xc9\x66\xb7\xeb\xd5\x4d\x67\xd4\x64\x7c\x7f\xc3\x2d\xab\x00\xb9\x5c\xf0\x05\x50\xf7\x06\x7b\xd2\x0d\xb6\xea\x22\x23\x38\xd8\x72\x8a\x17\x8d\x23\xe4\xf9\x81\xb7\x0c\xe5\x2c\xea\xbd\x82\xbf\x22\x48\xc4\x04\x98\xf3\x62\xcc\x27\x50\xee\x0c\x52\x10\x4f\x8b\x7d\x47\x6a\xd9\x39\x33\x90\xf8\xe4\xf7\xcd\x4f\x53\x1e\x4f\xc1\x3e\x71\x8a\xa7\x5c\x4e\x8a\xe6\xb7\x69\xc5\x61\x2c\xd8\x24\xec\xbd\x82\x1f\x33\x4b\xa5\xba\x5a\xa8\xec\x8a\x1d\xa5\xc0\x2d\x48\x45\xce\xba\xe5\x09\x1a\x1f\x4a\x89\x20\xf4\x40\xd7\x61\xb8\x7c\xff\xf1\x1f\xa3\x87\xe1\xf0\xf6\xee\xde\x9b\x85\x12\x7c\xc1\x45\xeb\x4a\x70\xb0\x4a\xd7\x30\x13\x62\xa8\x04\x8f\xe7\x65\xaf\xd4\x1e\xdb\xce\x63\xa7\xdc\xda\x8c\x47\x43\x2b\x5a\x82\xcf\x50\xa2\xb5\x43\xa3\x1e\x31\x6a\x81\x9f\x12\xe9\x3f\x21\xb5\x07\x01\x34\xa3\x69\x04\xc1\x20\x58\x1d\x6f\xdf\x18\x97\xfe\x49\x4e\x9c\x89\x4b\x14\x6c\x3e\xc2\x58\xc9\x64\x9f\x7e\xb0\x80\x63\xc3\xeb\x0a\xdd\xd5\xba\x92\x5d\x9d\xe2\x1e\x1a\xfc\x1e\xf2\xed\x51\xbb\x89\x2c\xff\x19\x64\x09\xff\x76\xe9\xb9\xab\xe1\x7d\x39\x3f\x5b\x55\x6c\x69\xb2\x1b\xa7\x5a\xbd\x48\xbf\x29\x3e\xc3\x2e\x87\x67\x4a\x64\x29\xde\xb8\x63\x7a\x65\x5d\xea\xc6\x86\x05\x79\x03\xa5\xc9\x75\x2c\x7d\xa3\x14\x0d\xac\x89\x07\xe5\x06\x6c\x11\x57\x6e\xbc\x72\xa2\x5f\xaa\xfd\x9a\xde\xb0\x0d\x80\x52\xdd\x61\xcc\x8d\x76\x55\xbc\xe2\x4a\xef\x0a\xd3\x63\x66\x2c\xb9\x9e\xbd\xac\xc3\x0c\x84\x7a\xaa\x5a\x6c\x18\x2b\x45\xda\x94\xb7\x73\xb0\xc4\x0c\xc1\xeb\xd3\x23\xb8\xe1\x6f\x5a\xf9\xb6\xd6\xdf\x43\x67\x8f\x0f\x5e\xef\x7e\x72\x7a\x7a\xc3\x5b\x73\x5d\x9d\xbe\xbf\xe2\xf4\xc8\x5b\x50\x72\xd7\x3a\x5b\x4a\x56\x6f\x98\x8e\x3a\x58\xf0\x8e\x9c\xfe\x5a\x5c\x5e\x34\x2a\x7d\x8f\xf7\xc6\x04\xa6\x9a\xe6\x97\xdc\x44\xb0\xf0\x0f\xa0\x9e\xff\x2e\xe3\x55\xbb\x0d\x6f\x34\x79\xbe\xf2\xd8\xd4\xf1\xf8\xe1\x3d\x3d\x79\x4b\xf7\x78\xef\x84\x6f\xec\x35\xc0\xef\x97\x89\x4d\x96\xaf\x81\x25\xf2\xa0\x7a\xa3\xa9\xf4\x8d\x8d\x4a\x9b\xb8\xaf\xb9\x7f\xcf\x9a\x78\x97\xeb\x0f\xd6\xdf\x7b\x0e\x7a\xf5\xc3\x48\x6f\xe3\xf3\xf1\x45\x9d\x64\xbb\x09\xf5\xf3\xec\xdb\xe2\xb5\x01\x5d\x42\x0c\x3f\x59\xe7\xe6\x6f\x95\x8e\xc5\x92\xaa\x80\x69\xfe\x03\xb3\x18\x44\x10\xb8\x93\xc3\x46\x83\xc1\x62\xd1\x6c\x88\x3b\x95\x11\xfe\x59\x59\x92\x45\x0f\x1b\x1c\xb6\x56\xbe\x97\x49\xf1\xb2\xe7\x56\x0f\x98\xe6\x83\xd9\xb1\x2f\xe1\x9a\x15\x25\xf0\xc1\x08\x27\xb0\x58\x84\xb7\x1a\xe5\xc8\xa5\xf1\x45\x35\x73\x77\xdd\xd6\xa9\x8d\xfa\x84\x31\xad\x8a\x0f\xcb\xe1\xb6\xac\xf3\x31\x65\x5a\xa3\x09\x22\xcf\x23\x80\xe0\x91\x59\xbc\x61\x5a\x73\x39\xa9\x7e\x0c\xa8\x20\xec\xe1\x61\xe5\xc6\x80\x91\x60\x76\xe0\xd9\xab\xf4\xfe\xc8\x66\xec\x4a\xba\xbc\x75\xd7\x92\xaf\x54\xff\x89\xcd\x58\x87\x8d\xbf\xdd\x5c\xbf\x98\x89\xe7\x54\x74\x79\x31\xba\xfd\xf0\x72\x5e\x58\x25\x57\x6c\x24\xdc\xba\xf3\xa6\x0a\xc2\xd0\xe0\x8c\xe3\x93\x2b\xaa\x41\x04\x63\x26\x6c\x73\x81\xcf\x57\x22\x3a\xe3\x86\x56\xe3\x99\xcc\x2a\x68\x83\x64\xe6\xcc\x06\x87\x9d\x57\x7a\x25\x6d\x78\xf9\x31\x7c\x2f\x9d\xe9\x95\x8e\x20\xc0\x72\x34\x88\xe0\x78\x39\x5c\x54\xe9\xb2\x8d\xe8\x16\x3d\x6a\x8b\xb6\xdb\x0c\x1f\xf9\x18\x99\xdb\xa2\x36\x00\x07\x7d\x13\xb8\x0b\x96\xa2\xf8\x4b\x0d\x70\xc5\xe8\x33\xa1\x74\xc5\xc8\xd6\x14\x1d\xb6\xee\xe5\xeb\xc7\x7b\x20\xd4\xc4\xdd\x14\xb6\x52\xca\x34\x2f\x4e\x34\x15\x6c\x03\x76\x5e\x4b\xed\x26\xaf\x15\xe6\xa5\xf6\xb5\xd4\xd9\x66\xa3\x95\x4c\x90\xe7\xc1\xcb\x05\xa4\x76\x70\x68\x54\x92\xc5\xc4\xff\xd9\xa2\x39\x78\x34\x4c\x26\x25\x65\x8b\xb6\x1f\xfa\x43\xf1\x1b\x01\x04\x7f\xcc\x2c\xc2\xad\x14\x5c\x62\x3b\xa5\xc7\x6c\xc6\x63\x25\xdf\x9e\x14\x89\x58\x7d\xf5\xdf\x9e\x3c\xbf\x3d\x09\xb5\x9c\x74\x0a\x1f\x9f\xb5\x84\x8f\xcf\x9e\x8f\xcf\xd6\x85\x49\x65\xf1\xf4\x2a\x56\xb2\x2a\xa3\x5a\x60\xbf\x18\xeb\xbb\x55\xeb\xf2\xba\x74\xee\x87\x8c\x0b\xc7\x09\x99\x6c\x19\xfb\x7c\x99\x28\x15\x13\xee\xce\xf8\x15\x6c\xd4\x41\xfc\x1f\xa1\xa2\xb5\x0f\xf2\xd5\x4d\x93\xf7\xfe\x15\x00\x00\xff\xff\x28\x88\x47\x8d\x34\x1e\x00\x00"),
 		},
 		"/infrastructure/04-amq-example.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "04-amq-example.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 2217,
+			uncompressedSize: 2459,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\xcf\x53\xeb\x46\x0c\xbe\xf3\x57\x68\xde\x85\x4b\x63\x30\x06\x27\xf8\xd4\x0c\x74\x3a\x9d\x29\x25\x0f\xd3\xbe\xe9\xa9\x23\xef\x2a\x64\x8b\xf7\x07\xbb\x0a\x90\xd2\xfc\xef\x1d\xdb\x71\xb0\x13\xe7\x3d\xe6\x4d\x73\x8b\xb4\x9f\xf4\xe9\x93\x2c\xbd\xbd\x8d\x40\xcd\x01\x8d\x84\x68\xe6\xad\x5c\x0a\x56\xff\x90\x84\x68\xea\x54\x4e\xfe\x99\x7c\xf4\x8b\xc6\x07\xca\xd9\x13\xea\xb0\x5e\x1f\x8d\x00\x9d\xfa\x83\x7c\x50\xd6\x64\xa0\x2a\x67\x64\x1d\x99\xb0\x50\x73\x8e\x94\x3d\x79\x8e\x8f\x00\x1e\x95\x91\x19\x74\xa0\x47\x00\x9a\x18\x25\x32\x66\x47\x00\x00\x06\x35\x65\xf0\x77\x61\x43\x18\xa1\x7e\x1a\xa5\x49\x6d\x2e\xb1\xa0\x32\x34\x4f\x00\xd0\xb9\x0c\xc2\xca\x48\x0a\x2a\x6c\x6c\xed\xdf\x2a\xd7\xb7\xfc\xc2\x6a\x67\x0d\x19\xce\x00\xf5\xd3\x11\x40\x70\x24\x9a\xe0\x8c\x0f\x9b\x34\x23\x98\x7b\xab\xdb\x94\x2d\xf7\x6b\x2b\x1e\xc9\xd7\x15\x6c\x3d\x0d\xe7\xe3\xb7\x37\x88\xf2\x36\xcb\x55\x9b\x22\x44\xd3\x9b\xcf\x8d\x5a\xb0\x5e\x1f\x6f\x40\x4a\x3b\xeb\x79\x66\x4b\x25\x56\xef\x29\x82\x58\x90\x5c\x96\x24\x33\x60\xbf\x6c\x13\x34\xe1\x3f\xc5\x51\xf2\x69\x47\xe6\x8e\xa6\x55\x57\x94\xa0\x3d\x3d\xd1\x18\xcb\xc8\xca\x9a\xad\x7a\x92\x82\xf0\xca\x71\x1d\xe2\x7e\x41\x50\x78\xfb\x48\xfe\x38\xc0\xad\x23\xf3\x45\x79\x82\x8a\x5c\x34\x2c\x7c\xa9\x04\x36\xd0\x06\xd6\xe9\x5a\x63\xa8\xdb\xc6\xc2\xf5\x64\xad\x02\x6e\x75\x6d\x5e\x57\xd3\xf1\xa2\x7c\x5b\x65\xf5\x22\x83\x34\x4e\xe3\x74\x63\x61\xf4\x0f\xc4\xb3\x1d\x7b\x0b\x0f\x6c\xb5\xdb\xc3\x26\x07\xb0\x8d\x3d\x50\x49\x82\xad\xcf\x06\x66\x82\x5e\x51\xbb\xb2\x57\xc5\x8e\xda\xe8\x5c\xe8\x8e\xf1\x35\xb9\xd2\xae\x34\x19\xde\x53\xfd\xff\x1d\xd7\x21\x6a\x43\xaa\xf7\x14\xf7\x54\xb7\x2a\x64\x10\x0f\xd6\xae\x91\xc5\xe2\xd7\x1e\xcf\x61\xa6\xdf\xe6\xfa\x31\xb6\x81\x3d\x32\x3d\x6c\xc7\x9d\x57\x8e\x32\xb8\x23\xe1\x09\xb9\x99\x02\x26\xed\x4a\x64\xda\x72\xec\x89\xba\x2f\xec\x61\xca\x1f\x21\xfd\x31\xda\x07\x84\xae\xe1\xb5\xd8\xd5\xae\x7c\x51\xbc\x00\x67\x65\x4e\x62\xe9\x15\xaf\xae\xac\x61\x7a\xe5\xce\x3e\x68\x3d\x37\x56\x52\x09\xeb\xf5\xbb\x74\x7d\x48\x15\x0f\xd8\xfe\x89\xba\x84\x08\xfe\x05\x65\x24\x19\x86\xf8\xb4\xc2\x54\xa9\xc8\xc8\x0e\x5a\x58\xc3\xa8\x0c\xf9\x8e\x26\xd5\x9b\xe7\xae\x44\xed\x27\x33\xbd\xf9\xfc\xd7\xef\xf9\x4f\x77\x1d\x17\xc0\x33\x96\x4b\x6a\xf7\xe0\x10\x62\x36\xcd\xf3\x2f\xb7\x77\xd7\x43\x28\xb6\x2e\xaf\x1a\xc8\x07\xb0\xf7\x77\xd3\xdf\xf2\xd9\xed\xdd\x7d\x3e\x84\x6e\x37\xc0\x0f\xcd\xb7\xbc\x15\x72\x5b\xd4\x77\xc8\xf9\x61\x41\xcf\x86\x05\x85\xe6\x76\x65\xe0\xe9\x41\x05\xf6\xab\x08\x85\xa0\x10\x22\x4f\x72\x81\x1c\x09\xab\x4f\x3a\xd7\xe9\x04\xf5\x53\x9a\x8c\xb6\x97\xee\xc7\xb0\xc0\xb3\x8b\x34\x4b\x2e\x27\x31\xa6\xe9\xf9\xd9\x24\x39\x1f\x17\x32\x99\x8c\xcf\x65\x1c\x9f\xa7\xc9\xe5\xa9\x2c\x92\x8b\xb3\xf1\x64\x3e\xc7\xe2\x22\x95\x13\x2a\xc6\x49\x2a\x88\xe4\x3c\xb9\x1c\xc7\x97\x17\xa7\xf2\x2c\x4d\xe2\x5d\x3e\xb3\x65\x59\x6e\xae\x05\x4c\xcb\x17\x5c\x75\xc7\xf8\xc0\x78\x42\x7f\xef\xb6\xdd\xd9\xaa\xdb\x6c\xc7\xc9\x78\x3c\xe9\x35\x67\x73\x81\x6d\x69\x1f\x15\xf6\x3c\xce\x5b\xb6\xc2\x96\x19\xdc\x5f\xcd\xbe\x16\xb3\xbb\xc5\xbb\x41\x9b\xab\xf0\x9d\x01\x93\x81\x80\xdd\x23\xf0\xf5\x90\x9e\x50\x2a\x43\x21\xcc\xbc\x2d\x28\xeb\x61\xe8\xb5\x5d\x9a\xef\x3f\x61\xb5\x46\x23\x77\xcd\x23\x38\x29\x94\x39\x29\x30\x2c\xf6\x3c\x23\xb1\xff\xd8\x3a\xae\x46\xa4\x06\xf5\x29\x44\x9d\x08\x4c\x5e\x2b\x53\x9f\xd5\x9f\x3d\x0a\x9a\x91\x57\xf5\x32\xb1\x46\x86\x0c\xd2\xd3\xce\xa0\xfe\x17\x00\x00\xff\xff\x3c\x10\x73\xa7\x9b\x09\x00\x00"),
+		},
+		"/infrastructure/04-syndesis-external-db-metrics.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "04-syndesis-external-db-metrics.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 4999,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4d\x73\xa3\x46\x10\xbd\xeb\x57\x74\xed\xc5\x97\x80\x8c\xb1\x91\xcc\x29\xaa\x75\x2a\x95\xaa\x6c\xcc\x0a\x27\x7b\x4c\x35\x33\x2d\x69\x62\xe6\xc3\x33\x23\xef\x2a\x2a\xfd\xf7\x14\x20\x08\x48\x28\x76\xa5\x96\x9b\xba\xe7\xbd\x7e\xf3\xba\x69\xb4\xdf\x07\x20\x56\x80\x8a\x43\x98\x59\xcd\xb7\xcc\x8b\xbf\x89\x43\xb8\x30\x22\x27\xfb\x4a\x36\xfc\x45\xe2\x9a\x72\x6f\x09\xa5\x3b\x1c\x26\x01\xa0\x11\x7f\x90\x75\x42\xab\x14\x44\x95\x0c\xb5\x21\xe5\x36\x62\xe5\x43\xa1\xa7\xaf\xd1\x04\xe0\x59\x28\x9e\x42\x0f\x3a\x01\x90\xe4\x91\xa3\xc7\x74\x02\x00\xa0\x50\x52\x0a\x7f\x15\xda\xb9\x00\xe5\x4b\x90\xc4\x75\xb8\xc4\x82\x4a\xd7\x1c\x01\x40\x63\x52\x70\x3b\xc5\xc9\x09\x77\x8c\xb5\x3f\xab\x5a\x6f\xe5\x99\x96\x46\x2b\x52\x3e\x05\x94\x2f\x13\x00\x67\x88\x35\xe4\x1e\xd7\xc7\x32\x01\xac\xac\x96\x6d\xc9\x56\xfb\x83\x66\xcf\x64\xeb\x1b\x74\x99\x46\xf3\xd5\x7e\x0f\x61\xde\x56\xf9\xd8\x96\x70\xe1\xe2\xd3\xe7\xc6\x2d\x38\x1c\xae\x8e\x20\x21\x8d\xb6\x3e\xd3\xa5\x60\xbb\x7f\x4b\x38\xb6\x21\xbe\x2d\x89\xa7\xe0\xed\xb6\x2d\xd0\xd0\x7f\x88\xc2\xf8\xc3\x89\xcd\x3d\x4f\xab\xae\x08\x46\x67\x7e\xa2\x52\xda\xa3\x17\x5a\x75\xee\x71\x72\xcc\x0a\xe3\x6b\x8a\xa7\x0d\x41\x61\xf5\x33\xd9\x2b\x07\x8f\x86\xd4\x17\x61\x09\x2a\x71\xe1\xb8\xf1\xa5\x60\xd8\x40\x1b\x58\xaf\x6b\x4d\xa0\x6e\x9b\x67\x66\x60\x6b\x45\xd8\xf9\xda\x9c\xae\xa6\xe3\xab\xb0\xed\x2d\xab\x13\x29\x24\x51\x12\x25\xc7\x88\x47\xbb\x26\x9f\x9d\xc4\x5b\xb8\xf3\x5a\x9a\x33\x6c\x7c\x01\xdb\xc4\x1d\x95\xc4\xbc\xb6\xe9\xc8\x4c\xd0\x37\x94\xa6\x1c\xdc\xe2\xc4\x6d\x34\xc6\xf5\xc7\xf8\x81\x4c\xa9\x77\x92\x94\x3f\x73\xfd\xfb\x8e\xeb\x98\xb4\x31\xd7\x07\x8e\x5b\xaa\x5b\xe5\x52\x88\x46\xef\x2e\xd1\xb3\xcd\xaf\x03\x9d\xe3\x4a\xdf\xd6\xfa\x3e\xb5\xce\x5b\xf4\xb4\xee\xc6\xdd\xef\x0c\xa5\xb0\x24\x66\x09\x7d\x33\x05\x9e\xa4\x29\xd1\x53\xa7\x71\x60\xea\xb9\xb1\x97\x25\xbf\x47\xf4\xfb\x64\x5f\x30\xba\x86\x77\x66\x57\x0f\xd3\xca\xa3\x50\x64\x7b\xf2\x02\x20\xf5\xda\x57\xdb\x4e\xef\xe2\xd3\xe7\x3f\x7f\xcf\x7f\x5a\xf6\x52\x00\xaf\x58\x6e\xa9\x5d\x49\x63\x88\x6c\x91\xe7\x5f\x1e\x97\x0f\x63\x28\xaf\x4d\x5e\x79\xe9\x2f\x60\x9f\x96\x8b\xdf\xf2\xec\x71\xf9\x94\x8f\xa1\xdb\x97\xf1\x87\xfe\x6b\x05\xf5\xa2\xc2\x35\xa5\x60\x69\x2d\x9c\xb7\xbb\x10\x19\x23\xe7\x42\x4b\x7c\x83\x3e\x64\x5a\x4e\x7b\xbb\x7a\x8a\xf2\x25\x89\x83\x6e\xef\xff\xe8\x36\x78\x73\x97\xa4\xf1\xfd\x3c\xc2\x24\xb9\xbd\x99\xc7\xb7\xb3\x82\xc7\xf3\xd9\x2d\x8f\xa2\xdb\x24\xbe\xbf\xe6\x45\x7c\x77\x33\x9b\xaf\x56\x58\xdc\x25\x7c\x4e\xc5\x2c\x4e\x18\x11\x5f\xc5\xf7\xb3\xe8\xfe\xee\x9a\xdf\x24\x71\x74\xaa\x27\xdb\x96\xe5\x71\x77\xc2\xa2\xfc\x8a\xbb\x7e\x53\x2f\x34\x0b\x86\x5b\xa8\x35\xa8\xeb\x5a\xb3\x2b\xe6\xb3\xd9\x7c\xe0\xcf\xf1\x7b\xa4\x4b\xfd\x2c\x70\x90\x31\x56\x7b\xcd\x74\x99\xc2\xd3\xc7\xec\xbf\x38\xfb\x3b\xad\x4f\xda\xec\xc8\xff\x49\x18\x8f\x10\x9e\xf6\xee\x32\xa5\x25\xe4\x42\x91\x73\x99\xd5\x05\xa5\x03\x0c\x7d\xeb\x4f\x75\xf3\x30\x2d\x25\x2a\x7e\x1a\x0e\x60\x5a\x08\x35\x2d\xd0\x6d\xce\x32\x01\x3b\x3f\xac\x8d\xaf\x46\xa4\x06\x0d\x25\x84\x3d\x06\x4f\x56\x0a\x55\x7f\x64\x7e\xb6\xc8\x28\x23\x2b\x34\xcf\x89\x69\xc5\x5d\x0a\xc9\xf5\xa4\xfa\x7b\x42\x8a\x1f\x0e\x93\x7f\x02\x00\x00\xff\xff\xbc\x63\x7e\xac\xa9\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5f\x93\xda\x36\x10\x7f\xe7\x53\xec\xf0\x92\x64\xa6\x98\x5c\x3a\x49\x53\xbf\x51\x70\xaf\x37\x03\x77\x04\xb8\x4e\xf2\xc4\x08\x79\x39\x34\x27\x4b\x3e\x69\x4d\x43\x09\xdf\xbd\x23\xdb\x80\x6d\xcc\x9f\x4b\x1e\xae\x9d\x29\x4f\x20\xed\x9f\xdf\xfe\x76\x57\x2b\xb1\x5e\xb7\x40\xcc\xc1\x1b\xaf\x54\x88\x56\x58\xaf\xab\xa3\x58\x2b\x54\x64\xbd\x1e\x23\x36\x63\x16\xbd\xe0\x2b\xa1\x51\x4c\xf6\x66\xf7\xa3\xfe\x66\xd3\x68\x01\x8b\xc5\x9f\x68\xac\xd0\xca\x87\xe5\x55\x03\xe0\x51\xa8\xd0\x87\xae\x56\x73\xf1\x30\x60\x71\x03\x20\x42\x62\x21\x23\xe6\x37\x00\x00\x24\x9b\xa1\xb4\xd9\x77\x00\x16\xc7\x3e\xd8\xdc\x65\xbe\xb6\xfd\xe9\x09\xdd\x3e\xb7\x4f\xab\x18\x7d\x10\x6a\x6e\x98\x25\x93\x70\x4a\x0c\xd6\x88\xf1\x6d\x2c\x7b\x63\xad\x70\xd6\x8a\x90\x8c\xe0\x99\x5d\xc5\x22\xac\xdd\x6d\xf1\x34\x96\x06\xc0\x3e\x88\xa7\x04\x8d\x40\xeb\xad\x58\x24\x7d\xf8\x96\x7b\x04\x88\x1f\xa6\x61\xce\x95\xbf\x5b\xcc\xc4\x57\x3e\x34\x61\x1c\xf4\x83\xee\xa4\x28\xe6\x85\x8c\x9c\xeb\x9f\x8a\x8b\x53\x2b\xfe\xc6\xd7\x35\x52\x6f\x80\x59\x70\x9b\xf0\xfb\xe8\x6e\x50\x54\x69\x16\xdc\xe5\xc0\x8b\x08\x00\x5a\x90\xdb\x28\x2f\xbb\x4f\x62\xd9\x03\xfa\xd0\xec\x77\x7e\x0b\xfa\xcd\x83\xed\x10\x2d\x37\x22\xa6\x34\xc7\xcd\x5b\x16\x21\xe8\x39\xd0\x02\xa1\xce\xb9\xf3\xe4\x10\x1e\x77\x73\xdd\xb9\xbf\x0e\xce\xb9\xe9\x09\xfb\x08\x36\x66\x1c\x21\xb1\x18\xc2\x6c\x55\xf1\x78\x61\xbd\xc6\xda\x10\x1a\xef\x53\x96\xb0\xcd\xa6\xb1\x5e\x3f\x53\x07\xbe\x81\x50\x21\x2a\x82\x8f\x90\xea\xb7\x00\x55\xb8\xd9\x34\x2a\xd5\xcf\xe2\xd8\xb6\x0b\x2d\xd0\xc3\x58\xea\x55\x84\x8a\x0e\x7a\xe0\x68\xad\x1d\x46\xd5\x09\x43\xe1\x28\x61\xb2\xa3\x94\x26\xe6\xbe\x5b\x87\x23\xed\x9e\xfd\x92\xef\x02\x23\xfd\x85\x45\xf2\xac\xf6\x2e\xa0\x0f\x85\x80\xb6\x36\xff\x8d\xfd\x79\x82\x95\x7e\x0a\x37\x8b\xe3\x54\xfc\xb9\xdc\x89\xd0\x6d\x8c\x3c\x0b\xdb\x60\x2c\x05\x67\xd6\x87\xab\xf4\xb7\x45\x89\x9c\xb4\xd9\x92\x12\x31\xe2\x8b\x7e\x89\xa7\x7a\xa6\xce\x73\xf5\xec\x63\x8a\x30\x8a\x25\xa3\x5d\x7b\xed\x0b\x2b\x27\x49\x9b\xb3\xf9\x7f\x8d\x4f\x05\x99\x31\xf2\xc4\x08\x5a\x0d\x74\x88\x12\x9a\x06\xad\xf3\x46\x18\x36\xdf\xe4\x25\x51\x53\x6a\xa9\xab\x0b\xcd\x14\xac\x38\x2e\xb9\x8b\xd2\xb3\xb9\xb8\xc7\x64\xbc\x60\xde\x63\x32\x43\xa3\x90\x30\xe5\x21\xd6\xa1\x0f\x26\x51\x24\x22\x6c\x87\x38\x67\x89\xa4\x42\xe3\x5d\xde\x24\xdf\xd1\x13\x57\x6f\x2b\x95\x51\xe9\x8f\xc3\x1e\x39\x9e\xfd\x4b\xf2\xff\xd2\x8d\x70\x10\x6f\x0e\x6a\xd7\x0d\x59\xd6\xcc\x52\x70\xec\x70\xae\x13\x45\xb7\x95\xe3\xab\x90\xa0\xbf\x04\x2d\x20\xd6\xe1\xb6\x1a\xba\x5a\x11\x7e\xa5\xa3\x75\x52\x20\xd5\x96\x55\x4a\x07\xda\x11\xb8\x05\x6d\xae\x15\x31\xa1\xd0\x14\xd2\xe2\x64\x96\xc5\x2c\xb5\xf2\x93\xb7\xd7\x99\x74\xa6\xe3\xbb\xfb\x51\x37\x98\xde\x76\x06\x41\x69\x16\x2d\x99\x4c\xd0\x87\x57\xeb\xf5\xe9\x39\xd1\x13\x06\x39\xa5\xf7\xa0\x57\x35\x3e\x86\xd7\xd3\xe0\xf3\xf0\x6e\x34\x09\x46\xd3\xe0\xf3\x24\xb8\xed\x4d\x3f\xdd\x07\xa3\x2f\xd3\x61\x67\xf2\x47\x9d\xc3\x36\x12\x6f\xc7\xda\xd2\x83\x41\xdb\xc6\x7c\x0a\xb5\x8b\x57\x8d\x3d\xc5\xbb\x70\xbf\x83\xe8\x8b\xa9\x7e\x57\x4f\x35\x80\x88\xd2\x49\xfe\xea\xf2\x59\x7a\xe3\x34\xa0\xcc\x54\x6a\x65\x98\x48\x39\xd4\x52\xf0\x95\x0f\xeb\x75\x75\xed\x8c\xf9\x9b\xa3\xd2\x07\x3b\x3d\x5c\x8e\x93\xd8\xa1\x29\x53\x71\x7c\x14\xef\x65\xa4\x58\xa2\x42\x6b\x87\x46\xcf\x2a\xf7\x9a\x39\x13\x32\x31\x38\x59\x18\xb4\x0b\x2d\x43\x1f\xde\x97\xf6\x89\xc7\x63\xcd\x1f\x91\xaa\xd7\x21\x87\xc4\x87\x5f\xaf\x3e\xfe\x52\xda\x10\x4a\x90\x60\xb2\x87\x92\xad\xc6\xc8\xb5\x0a\xad\x0f\x1f\xde\x16\x64\x0c\xb2\x50\xbc\x24\x9a\x9f\x8b\x68\x9c\xa2\x2d\xb7\xd8\xae\x34\x87\xf5\x46\x33\xc6\x0f\x59\x36\x68\x75\x62\x38\x56\xee\xad\x52\x44\x82\x6c\x15\x70\x84\x91\x76\x57\xea\x77\xef\x3f\x0c\x44\x69\xcf\xe0\x53\x82\xf6\x84\xc6\xdb\x92\xc2\x52\xcb\x24\xc2\x81\x3b\xd6\x2a\x71\x44\x6e\x6d\xc8\x68\x71\xac\x35\x6b\xa2\x3a\xf1\x7c\x78\xe6\xfb\xaa\x8b\x86\xc4\x5c\x70\x46\x38\x46\x6e\x90\x4a\x55\xbb\x3d\x64\x30\x97\x77\xfe\x48\xda\x12\xa2\x2a\xfe\x2d\xb6\xf6\x29\x25\x57\x5d\x77\x4a\xae\x7c\x20\x93\xe0\xa9\x99\x5b\x00\x68\xbd\x41\x42\x09\x93\x93\xfe\xb8\x16\xa5\x50\xb9\xc3\x8b\x21\x1e\xd5\x38\x8a\xaf\x9c\xcf\xd2\x10\xb8\xe0\x65\xb7\xfd\xf0\xed\xb3\xd5\x7f\xb1\xe4\x9e\x4f\xad\x4d\x55\xca\x08\xb3\xb5\x6c\x30\x9f\x1b\x5c\xa7\x71\xfc\x48\xca\xcf\x24\xfc\x02\xe0\x37\xb9\xa6\x73\x65\xbd\xde\x6c\x3b\x40\x6a\xe0\x1d\xff\xf3\x61\x9c\xdd\x56\xfe\x7f\x76\xfd\xb7\x9e\x5d\x85\x59\xd2\xaa\x9d\x12\x07\x53\x2a\x36\x9a\x34\xd7\xd2\x87\x49\x77\x98\xaf\x11\x33\x0f\x48\xd9\xec\x29\xaa\x57\x1f\x71\x3f\x4a\xf1\x05\x6f\x35\x8b\xd6\xd5\x66\x67\x3e\x77\x83\x74\xe5\xc3\xad\x56\x59\x1a\xb2\xfc\x74\x65\x62\x09\xcd\xcd\xb0\x50\xd5\xff\x04\x00\x00\xff\xff\xc1\xd0\xbd\x5c\x87\x13\x00\x00"),
 		},
 		"/infrastructure/04-syndesis-meta.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "04-syndesis-meta.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 6030,
+			uncompressedSize: 11479,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x5f\x6f\xe3\x36\x12\x7f\xcf\xa7\x18\x68\x1f\x72\x07\xac\xe5\x64\xaf\xed\xa6\x02\x16\x77\xbe\x38\xdd\x4d\xb1\x4e\x04\xdb\xdd\xe2\xd0\x16\x01\x4d\x8d\x6d\x6e\x28\x92\x47\x8e\x9c\x1a\xbe\x7c\xf7\x03\x45\x5b\x96\x64\x39\xc9\x16\xf7\xb0\xd7\xbc\xc4\x22\x67\x7e\x9c\x7f\x1c\xfe\xc8\x1e\x30\x23\x3e\xa1\x75\x42\xab\x04\x56\xe7\x27\x00\xf7\x42\x65\x09\x4c\xd0\xae\x04\xc7\x13\x80\x1c\x89\x65\x8c\x58\x72\x02\x00\x20\xd9\x0c\xa5\x0b\xbf\x01\x98\x31\x09\xb8\xb5\xca\xd0\x09\xb7\x1d\xdb\x7d\xc6\x42\xf7\x9f\x9b\xa7\xb5\xc1\x04\x84\x9a\x5b\xe6\xc8\x16\x9c\x0a\x8b\x1d\x62\x5c\xe7\x46\x2b\x54\xb4\x07\xeb\x79\xb3\x4a\x51\xc5\x72\x3c\x1c\x77\x06\x79\xb0\xd2\x68\x4b\x5b\x83\x7b\xe5\x47\x02\x17\x67\xdb\x45\x8c\xd5\xa4\xb9\x96\x09\x4c\x2f\xd3\xed\x18\x31\xbb\x40\x4a\xb7\x82\x95\x68\x58\x66\x49\x64\xca\x01\x87\x12\x39\x69\xfb\xbf\x8a\xc4\x51\x17\x8f\x66\x28\xf5\x63\x8e\x50\xd1\x27\x2d\x8b\x1c\x2f\x25\x13\xf9\x41\xbe\xba\xa3\xf3\xf5\xe5\x71\x9f\x2f\xc6\x39\x3a\x37\xd2\x19\x56\x59\x1b\x23\xcb\x7e\xb6\x82\xf0\x56\xf1\x80\x6b\xd1\xe9\xc2\x72\xac\x3c\xb0\xf8\xef\x02\x1d\x55\xdf\x00\x8e\xb4\x65\x0b\x4c\x60\xb3\x89\x27\x3b\x23\x2e\x77\x16\xb8\x78\x84\xc4\xe2\xf1\x0e\x27\xde\x06\x91\x19\xc6\x05\xad\x1f\x1f\x5b\x81\x67\xc6\xb8\x7e\x2d\xfa\x43\x34\x52\xaf\x73\x54\x74\x10\xf2\xcd\xa6\x07\x62\x0e\x4c\x65\x10\x0f\x8c\xf0\x3b\x09\x6d\x7c\x9d\xb3\x05\x4e\xc8\x22\xcb\x1d\xc4\x43\x5c\x4d\x0a\xe3\xab\xf1\xf1\x31\x78\xad\x94\x26\x46\x42\xab\xca\x85\x57\xbb\xff\x30\x41\x72\xc0\x80\xac\x58\x2c\xd0\x82\x56\x40\x4b\xe1\x20\xab\x6c\x00\xd2\xa0\x57\x68\x1f\x7c\x90\x2a\x35\x5a\x22\x10\xe6\x46\x32\xc2\xd8\x07\x38\x16\xde\x08\x5f\xf5\x06\x2d\xad\x4b\x13\x8d\x16\xa5\x7e\x43\xab\x91\x1d\x08\x5a\xae\xb4\x3d\x6e\x19\x57\xce\xc5\xda\xa0\x72\x4b\x31\xa7\xb2\x18\x82\x99\x2e\x81\xd3\x5f\x36\xd1\xdc\xea\x3c\x4a\x36\x91\x0f\x5b\x94\x44\xb5\x30\x4c\xd9\x22\x7a\x1d\xf9\x02\x8d\x92\xa8\xb1\x62\xe2\x2d\x76\x14\x3d\xbe\x8e\xe6\x02\x65\x96\x32\x5a\x7a\x19\xef\x42\xd3\x21\xae\x15\x31\xa1\xd0\xba\x5f\xfe\xfe\x97\x7f\xc4\x1e\xec\xdd\xbb\x5f\x9b\x68\xbf\x46\x7f\xfd\x2d\x78\x1e\x3d\xfe\x76\xba\x4d\x11\xaa\x6c\x1b\xf9\xaf\x6d\x27\x3c\xdf\xd1\x2c\x1a\x29\x38\x73\x09\x9c\x77\xf6\xa2\x9c\x11\x5f\x7e\x6c\xb8\xd5\xed\xd8\xf3\xae\x7d\x81\xd5\x8e\x2c\x23\x5c\xac\xf7\x3b\xb2\xb5\x45\x01\xa4\xc8\x45\x7d\x8b\xfa\x9d\x93\x6b\xbb\x4e\x20\x7a\xf3\xed\x77\x23\x11\x55\x33\x87\xdb\xb9\x2e\x7b\xb6\x17\x0d\xf1\x1e\x23\xb7\xc8\xb6\xa5\xbf\xab\x90\x2a\x1c\x8d\xdd\x79\x98\xf2\xe3\xd1\x79\x49\x7c\x5e\x9c\xfe\x2f\x0a\x66\x3d\xdd\x21\xc5\xe5\x71\x3c\xe0\x5c\x17\x8a\x6e\x9a\x05\xe2\xca\x0e\x53\xc9\xee\xf7\xc4\x5e\xbf\x77\xf4\x20\x08\x7f\xa8\x56\xf5\x70\xec\xc4\x7f\x1c\x7c\x1a\xdc\x0d\xd2\xf4\x6e\x78\x3d\xae\x4d\x03\xac\x98\x2c\x30\x81\xfe\xbe\x05\xb9\x0e\xf5\x8f\xb7\x83\xe1\xd5\xf8\xee\xc3\xed\xe8\xea\x39\xed\x3e\xfe\x4e\xc7\x0c\xb8\x4d\xa7\xd7\xb7\x37\x93\x2e\x88\xa8\x37\xfc\xcc\x56\x2c\x56\x48\xb1\xb1\x38\x47\x7b\x9d\xae\xbe\x99\x10\xe3\xf7\xef\xc8\x16\x08\xbd\x61\xe1\xd0\xc6\x4b\x9d\xe3\xbb\x3e\xe5\x26\xea\x58\xe4\x66\x30\xba\x9a\xa4\x83\xcb\x0e\x23\x7f\xb0\x3a\x4f\x1a\xc3\x00\x65\x4b\x1a\xe3\xbc\x3d\xbe\x9d\xf1\xcd\x2a\xa9\x8a\xae\x6c\x49\xce\x30\x8e\x27\x9b\x8d\x98\xc3\xfe\x28\x1a\x64\x99\x56\x2e\xfe\x91\xe1\x02\x6d\x7c\xa5\xd8\x4c\xe2\xae\x27\xb5\x83\x70\xf5\xfe\x6a\x7c\x77\x75\x33\x4c\x6f\xaf\x6f\xa6\x95\xc4\xf6\xa0\x39\x06\x79\xa9\x65\xe8\x0b\x3f\x8d\xaf\x1b\xb8\xfb\xe8\xd5\x8f\xc6\xa7\x94\xa3\xc6\x9a\x28\x1d\x1e\x01\xf4\xfc\x28\xe9\xf7\xab\x32\xfb\x5c\xa2\xf5\xf8\x0e\x2d\x39\xff\xe6\xcd\x77\x17\x7d\x66\x44\x9f\x2c\xe3\xe8\x5a\xc8\xea\xc9\x08\x4c\x06\xa3\xf4\xe3\xd5\xf8\x6e\xfa\xaf\xb4\xb3\xa0\x9e\xf0\x67\xc2\x72\x23\xd1\x4e\xd7\x06\xeb\xee\x1c\x5d\x22\x1d\x8c\x07\xa3\x3f\xb6\x46\xca\x2c\xcb\xfd\x22\xf5\x53\x26\x78\xe8\xb3\x75\x70\xf4\xb7\xed\xf8\x34\xb8\x1b\x5e\xfd\xf3\xa7\xf7\x9d\xab\xfb\xa2\xae\xc7\xac\x1d\xb1\x57\xf5\xdf\x50\x9e\xb6\xf0\x20\xa4\x04\x26\x1f\xd8\xda\x81\x23\x66\x09\x74\x41\x30\x43\xa1\x16\xe0\xb0\x24\x0f\xfe\xd0\xb7\x28\x91\x39\x84\x40\x55\x92\x78\xca\x16\x60\x98\xb0\x4d\x44\x05\xf7\xc5\x0c\xad\x42\x42\x17\x68\xc8\x83\x2e\x64\x06\x16\x73\x26\x54\x09\xc4\x3d\x0a\xae\x50\xc1\xc3\x12\x15\x64\xb8\x42\xa9\x8d\x50\x8b\x06\x12\xf4\x7a\x30\x0c\x53\x68\x3d\x27\x59\x89\x0c\x3d\xcd\xb1\xb8\x10\x8e\xec\xba\x1f\x78\x07\xd7\xf9\x4c\xa8\x92\x1a\xc1\x5c\xdb\xad\x79\x6d\xa3\x2a\x06\x52\xb7\x69\x86\x15\x2b\x22\x54\x30\x5b\x07\x4a\xb4\xa5\x51\x7b\xca\xf5\x32\xbb\x14\x3e\x6c\xa9\xd0\xd6\x4f\xe6\x9c\xe6\x82\x11\x66\x61\x3c\x30\xa4\x23\xc9\x28\x25\x12\x38\xdd\x6c\xe0\x38\x1d\x0d\xf9\x7a\x7c\x3c\x7d\x59\xcd\x94\x98\x69\x21\x65\xaa\xa5\xe0\xeb\x04\x06\x65\x92\xeb\xe5\xd1\xde\xaa\x07\x2a\xd7\xf3\x1b\x4d\xa9\x45\x17\xa8\xec\xb1\xba\xb2\xc8\x32\xa1\xd0\xb9\xd4\xea\x19\x36\x5b\x9f\xdf\xf4\xef\x91\xda\xfd\xd0\x94\x8d\xb0\xbf\x44\x26\x69\xd9\x9e\x0b\xd7\xab\xf3\x8b\xf3\xd6\x84\xe3\x4b\xf4\xdb\xe0\xc3\x74\x9a\x36\xa6\x84\x12\x24\x98\x1c\xa2\x64\xeb\x09\x72\xad\x32\xcf\x7f\xce\x6a\x32\x52\xac\xf0\xab\xb3\xf0\x6f\x67\x67\x0d\x21\x83\x56\xe8\xac\x9a\x7e\xd3\x9c\x9d\x33\x21\x0b\x8b\xd3\xa5\x45\xb7\xd4\x32\x4b\xe0\xdb\x93\xa6\x49\xae\x79\x4c\x57\x67\xfd\xc1\x7d\x35\xfc\xb5\x6e\xad\x15\x52\xc7\xbd\xb7\x1b\xb0\xed\x7f\x00\xcc\x91\xac\xe0\xee\x29\xcd\xef\xdf\xbe\xfd\xbe\x43\xd3\x58\x9d\x23\x2d\xb1\x70\x7f\xd0\xa0\xb7\x6f\x2f\x3a\x60\x3f\x6b\xa9\xef\x05\x7b\x11\x66\x07\x31\x85\x4e\x72\x0a\x35\xd2\xf9\xb2\x4b\xe4\xa8\x94\x6e\x9d\x8d\x5d\x5c\xb6\x0e\xfd\xe6\xe2\x6c\x24\x1a\x2d\xc8\x19\x2b\xd4\xa2\x37\xd3\x9a\x80\x15\xa4\x73\x46\x82\x33\x29\xd7\x60\x04\xbf\x77\x50\x18\x4f\x58\xfd\x1d\xc0\x77\xaf\x78\x9d\x4b\xf0\xd7\x2c\x88\xfb\x5c\xab\xb9\xa8\xf7\xd9\x07\x6d\xef\x85\x5a\x0c\x85\x3d\xca\xd6\x56\xe5\xcd\x77\xe4\x89\xa5\xeb\x22\x81\x01\xb3\x17\xc4\x1a\x3e\xe4\x5e\x27\xf0\x9d\x06\x97\x3b\xb0\x62\x07\x85\xbf\xd3\x97\xe0\xd4\x39\x61\x50\xeb\xa0\xb4\x9d\x98\xa6\xeb\x71\xa4\x99\x00\xee\x87\x6e\x9e\x60\xc5\xcf\xf9\x1f\xc6\x47\xcc\x24\x1d\x05\xd9\x80\xec\x6d\x03\x72\x52\x7f\x1a\xd8\xf7\xf4\x63\xcf\x04\x07\x8f\x10\x1d\x97\xed\xda\x93\x44\x4d\xf5\x4f\xf0\x0c\x44\x6c\x51\xbd\xff\x04\xeb\xa3\xed\xab\xc0\x16\x71\xde\xa0\xe6\x07\x21\x98\xb2\x7d\x01\x06\xfd\xd3\xae\x37\x86\xd3\x8a\xa5\x1d\x7f\x69\xbb\xdc\x25\xfa\xff\xf3\x35\xb4\x57\x6d\xc7\xbd\xe1\xad\xf6\x91\xc0\x7f\x7a\xbb\x95\xca\x3a\xdc\x07\xd6\xb4\x0f\x96\x57\xf0\x33\x82\x56\x72\x0d\x0f\x4c\x51\x78\x2e\x22\x46\x85\x7b\x0d\x4a\x87\xef\x79\x21\x65\xb9\x58\x0c\x1f\x50\x71\x04\x87\xbc\xb0\x82\xd6\xa0\xd5\x6b\x70\xa8\x9c\x20\xb1\x42\xd0\xf3\x79\xf5\x9e\x04\x13\xc4\xf2\xa0\x72\x49\xbf\x9f\x69\xee\xe2\xd0\x05\xbd\xc7\xb5\x7e\x58\x4e\xf5\x79\x61\x2d\x2a\xea\x97\x37\x3e\xbf\x42\x7f\x49\xb9\xec\x1b\xab\xb3\x82\x7b\xa7\x7a\x9e\xaf\xac\x7b\xb9\x56\x82\x74\x09\xe3\x05\xaa\xb5\x7e\xd0\x16\x32\x24\x26\xa4\xab\xde\x4c\x14\x5b\xa0\x6f\x3b\x07\xae\xd7\x8f\xc0\x9d\x23\x49\xe3\xee\x5c\x5e\xdd\x12\xf0\xdc\xfc\x64\x37\x96\x95\x0f\x6b\xb5\x7e\x15\x58\x46\x5d\xb1\x0a\x44\x02\x73\x26\x1d\x9e\xfc\x37\x00\x00\xff\xff\x76\x21\x41\x15\x8e\x17\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5a\x6b\x8f\xdb\xb6\xd2\xfe\xbe\xbf\x62\xe0\x16\x48\x0b\x44\xf2\x26\xbd\x24\x15\x10\xbc\xaf\x8f\xed\xa4\x5b\xec\xc5\xb0\xdd\xf6\x14\x6d\xb1\xa0\xa5\xb1\xcd\x2e\x45\xaa\xe4\xc8\x1b\xc3\xf5\x7f\x3f\xa0\x28\xeb\x62\x4b\xb6\xb3\x4d\x71\x7a\xd0\x7c\x89\x97\x97\x99\x67\x86\xc3\xb9\x89\x1e\xb0\x84\xff\x80\xda\x70\x25\x03\x58\xbd\xb8\x00\x78\xe0\x32\x0a\x60\x82\x7a\xc5\x43\xbc\x00\x88\x91\x58\xc4\x88\x05\x17\x9b\x8d\x07\x7c\x0e\x4a\x83\xdf\x4b\xb8\x5d\x81\xda\x1f\xab\x94\xd0\x80\x3f\x59\xcb\x08\x0d\x37\x7e\x2f\x8a\x38\x71\x25\x99\xe8\x49\xa9\x88\xd9\xdf\x06\xb6\xdb\x0b\x00\x00\x56\x0e\x15\xf4\x0e\x89\xe5\x8b\x01\x3e\xd9\xfd\x0f\x25\x2d\x98\x2b\x0d\x2c\x25\xe5\x2d\x50\xa2\x66\xc4\xe5\x02\x68\x89\x40\xc2\x40\x88\x9a\xf8\x9c\x87\x8c\x10\x52\x83\x51\xb6\x98\x4b\x42\x2d\x99\x78\x0e\xc6\x49\xe5\x91\xf2\xf2\x9f\x40\x9a\xcd\xe7\x3c\xdc\x63\x98\xcf\xfa\x4c\x24\x4b\xe6\xab\x04\xa5\x59\xf2\x39\xf9\x5c\x75\xb3\x29\xb9\xf0\x2c\x2b\xcf\x60\xa8\x91\x3c\xc9\x62\x0c\x60\xb3\xf1\xaf\x72\x56\x7d\xd4\x64\xfc\x1b\x24\x36\xc9\x56\x6c\xb7\x99\xb4\x28\x23\xc8\x7f\x5a\xc1\x4f\xeb\x6c\xb3\x01\x52\x3f\xb1\x58\x9c\x5c\xfc\x07\x70\x19\xa1\x24\xf8\x1a\x0e\x99\xe5\x3f\xad\x64\x82\xcd\x50\x98\x20\x97\x93\x25\x49\x00\x26\xa7\xbc\x93\x7d\xc7\x88\xab\xee\xa9\x79\x5a\x27\x18\x00\x97\x73\xcd\x0c\xe9\x34\xa4\x54\x63\xc3\xb2\x50\xc5\x89\x92\x28\xa9\x24\xe6\x59\xbb\x3a\xa6\x89\xeb\x0c\xe8\x69\x25\xe4\xeb\x5a\xe5\xb7\x58\xdc\xf9\xd4\x59\x03\x98\x04\x43\xa7\x88\x44\x69\xca\x75\xe2\x65\x7f\x04\xf0\xfa\x32\x97\x23\xd1\x8a\x54\xa8\x44\x00\xd3\xfe\x28\x1f\x23\xa6\x17\x48\xa3\x7c\x61\xb1\xd4\xb1\x59\x12\x25\x17\xce\x88\x04\x86\xa4\xf4\xc7\x52\x76\xab\x16\x5b\x6f\xf1\xc8\x8e\x19\x42\x49\x3f\x28\x91\xc6\xd8\x17\x8c\xc7\xb5\x3b\xdd\xa6\x9d\xf3\x4d\xb4\xe1\x5a\xff\x69\x9b\xfd\x87\x1a\x6a\x69\x90\x2c\x0c\xd1\x98\x1b\x15\x61\x61\x96\x63\x64\xd1\x8f\x9a\x13\xde\xc9\xd0\x41\xd7\x68\x54\xaa\x43\x2c\x94\xa4\xf1\xf7\x14\x0d\x15\x7f\x03\x18\x52\x9a\x2d\x9c\x6f\x2a\x20\xf5\x77\x42\x3a\x0f\xe5\x8f\x77\x74\xfc\xdc\x4a\x58\xc2\x42\x4e\xeb\xed\x76\xcf\xb2\x58\x92\x98\x6e\xc5\xbc\x06\x98\x08\xb5\x8e\x51\xd2\x81\x4d\x95\xb1\xe2\x33\x26\xa3\xaa\x8f\xbf\x8a\xd9\x02\x27\xa4\x91\xc5\x06\xfc\x01\xae\x26\x69\x62\xaf\xdc\xe7\xa7\xcc\xa5\xc9\xd6\x2a\xac\xce\x64\xd3\x10\x5b\x26\x48\x06\x18\x90\xe6\x8b\x05\x6a\x50\x12\x68\xc9\x0d\x44\x85\x74\x40\x0a\xd4\x0a\xf5\xa3\x55\x7f\xb1\x2d\x8b\x39\x18\x27\x82\x11\xfa\xf6\xe8\x7c\x6e\x79\x5a\x87\x91\xa0\xa6\x75\x86\x28\x51\x3c\xdb\x5f\xdb\x55\x33\x2d\x70\xbb\x4c\x06\xd5\xdf\x03\x97\xcd\xd5\xe3\x4f\x0e\xd3\x04\xf0\xec\xe7\x4d\x67\xae\x55\xdc\x09\x36\x1d\x7b\x20\x9d\xa0\x53\x91\x7a\xca\x16\x9d\xe7\x1d\x7b\xb7\x3b\x41\xa7\xc6\x31\xb0\x88\x0d\x75\xb6\xcf\x3b\x73\x8e\x22\x1a\x31\x5a\xda\x35\x56\x84\xba\x40\xa1\x92\xc4\xb8\x44\x6d\x7e\xfe\xbf\xcf\xfe\xdf\xb7\xc4\xde\xbc\xf9\xa5\x4e\xed\x97\xce\xe7\xbf\x3a\xc9\x3b\xdb\x5f\x9f\xe5\x27\x82\x32\xca\x15\xfd\xd7\xc7\xba\x1d\x97\x8a\xef\xa8\x02\xf8\x07\xfa\x91\xd3\x01\x4f\x63\x22\x78\xc8\x8c\x75\x0c\x16\xcf\x82\xe0\x98\x7f\x70\x8b\xe1\x12\xb6\xdb\xcd\xe6\x9c\x95\xd9\x3a\x14\x06\x61\xbb\x7d\x61\x7f\x96\xd0\xf6\xa3\x62\xcc\x28\x5c\x5e\xd7\x0e\xa9\xf9\x98\x4e\x1f\xd4\x99\x67\xe0\x1c\xa3\x66\x84\x8b\x75\xe9\x3a\xf7\x7c\x29\x80\xe0\x31\xaf\xfa\x52\xeb\xe2\x62\xa5\xd7\x01\x74\x5e\x7e\xf5\xf5\x0d\xef\x14\x33\x87\x7e\xb7\xba\xf6\xb2\x5c\xea\xac\x67\x6c\x53\x42\x96\x7b\x92\xdd\x85\x2b\xd4\xd1\x94\x6e\xd7\xd3\xca\x77\x79\xde\xab\xe4\xc9\x9b\xf2\x19\xfe\x5e\x59\x33\xc1\x30\xd5\x9c\xd6\x36\xae\x08\xe8\x68\x34\xa4\x79\x48\x18\x75\x3e\x2f\xdc\x62\x4b\x8e\xde\x8a\xa0\xb2\xd1\xba\xb7\xb7\xca\x2a\x11\x18\x68\x25\x84\xcd\xcc\x2d\x13\xa6\x09\x1e\x97\x28\x33\xe7\x67\x9d\x23\x23\xa5\x41\x5b\x2e\x68\xb2\xc1\x5d\x8a\x0e\x79\x76\x5d\x4b\xe4\x67\x28\xd4\x63\x85\x4b\xed\x90\x2d\x9e\xa2\x12\xb0\x41\xaa\x73\x90\x86\x97\x68\xb7\xdb\x4e\x43\x1e\x7e\xa6\x8e\x6a\x92\x1a\x0c\xad\x7d\xf9\x26\x5f\x9e\x57\x0a\x0f\xe9\x0c\xb5\x44\xc2\x0c\x5c\xa2\xa2\x00\x74\x2a\x89\xc7\xd8\x8d\x70\xce\x52\x41\x3b\xfe\x7f\x75\x19\xf0\xe2\xf2\x78\x1d\x70\xe8\x1a\xdb\xef\xdd\x39\x37\xef\x6c\x37\xf9\xdf\x74\x95\x07\x4a\xc9\xe1\x14\x7e\x11\xca\xda\xaf\x17\x86\x2a\x95\x74\x5b\xf7\xa4\x26\xcb\x2e\x0e\xb1\x4d\xc2\x25\x46\xa9\xb5\x78\xff\x56\x45\x38\xc9\x9d\x5c\x45\xd9\xb2\x32\x5c\x26\x2e\x9a\xc9\x05\xc2\xa7\x0f\xb8\x7e\x0e\x9f\xae\x98\x48\x11\x82\x37\x1f\x44\xd7\xd2\xc9\x08\xc0\x76\x9b\xb9\xf3\x9c\x4c\x3d\x32\x96\x62\x37\x19\x5e\x85\xcb\x54\x89\xfc\xb2\x98\x0a\x13\x2a\x47\x9b\x13\xfc\x66\x0a\xa5\xe6\x5f\xc3\xd9\x08\x7a\xf3\x39\x97\x2e\xfd\x2c\xcc\x32\x1f\x3a\xc9\x7b\xb7\xb7\xe5\xc8\x4f\x70\x1e\x69\xae\xec\x65\xee\x0b\x66\x8c\x3d\xf8\x0a\x84\x64\x7f\x2e\xd3\xf5\x79\x64\x0e\x11\x3c\x72\x5a\x42\xa2\xa2\x9d\xb7\xe9\x2b\x49\xf8\x9e\x5a\xfd\x50\x05\x88\xa9\x6f\xa9\xa9\xa4\x5d\xec\xdd\xee\x32\xa3\x2b\x0d\xde\x6b\xad\x00\x33\x98\xc5\x96\x27\x80\x3d\x1b\xee\xcb\x66\xb8\x00\x28\x57\x55\x07\xb5\xc3\xfa\x5d\xef\x87\xde\x7d\x6f\x34\xba\x1f\x5c\x8d\x2b\xd3\x00\x99\xf1\x07\xd0\x2d\xb3\x77\xd3\xb0\xfd\xfa\xae\x37\x18\x8e\xef\xbf\xbd\xbb\x19\x9e\xda\xdd\xc5\xf7\xd4\x06\xe0\x6e\x34\xbd\xba\xbb\x9d\x34\x91\xe8\x78\x83\xdf\xd8\x8a\xf9\x12\xc9\x4f\x34\xce\x51\x5f\x8d\x56\x5f\x4e\x88\x85\x0f\x6f\x48\xa7\x08\xde\x20\x35\xa8\xfd\xa5\x8a\xf1\x4d\x97\xe2\xa4\xd3\xc0\xe4\xb6\x77\x33\x9c\x8c\x7a\xfd\x06\x90\x6f\xb5\x8a\x83\xda\x30\x40\x96\xcd\x8f\x71\xbe\x3f\x9e\xcf\xd8\x3c\x3f\x28\x12\x8c\x2c\x9b\x37\x09\x0b\xf1\xf0\x4e\x54\xf2\xba\xbc\x9c\x2a\x7d\x6a\xbf\x57\x3b\x9f\x1d\xd4\xfe\xdd\xed\xb4\x77\x75\x3b\x1c\xdf\xf7\x7b\xf7\xfd\xe1\x78\x7a\xf5\xf6\xaa\xdf\x9b\x0e\x9b\x95\xb3\xd9\x40\xc8\x6c\x74\xb6\x98\x0c\x74\xba\x48\x61\x77\x67\x7f\x5d\x56\x30\xf3\x42\xd6\x39\x1b\x97\xa5\x37\x60\xc4\xa0\x12\xe6\x2b\x37\xfe\xdb\xe9\x74\x34\xd2\xea\xfd\xba\x11\xbf\x9d\xbd\x1f\x8d\xef\xfe\xfd\x53\x0b\xe0\xea\xfe\x36\xf2\x93\xe3\xf4\x27\xa7\x19\x4c\x8e\x70\xb8\x55\xed\xe4\x6f\xef\x8e\xd3\x2e\xf6\xd6\x09\xef\xc7\x57\x25\x8d\xff\x1d\xc3\x05\x6a\x7f\x28\xd9\x4c\x60\xd4\xc8\xed\xbb\xde\xf0\xdd\x70\x7c\x3f\xbc\x1d\x8c\xee\xae\x6e\xa7\xc5\x8a\xa6\x90\x5d\x21\xd9\x57\xc2\x85\xaf\xef\xc7\x57\x35\xba\x35\xa8\x67\x6d\xee\xd4\x78\xda\x4a\xa3\x85\xe0\x92\x28\x09\xba\x85\x6d\x79\xbf\x65\xd4\xbc\x70\x47\x2d\x78\xf1\xe5\xcb\xaf\x5f\x77\x59\xc2\xbb\xa4\x59\x88\x66\x8f\xb2\x3c\xaa\x81\x49\xef\x66\x74\x3d\x1c\xdf\x4f\x7f\x1a\x35\x3a\x92\x23\xf2\x4c\x58\x9c\x08\xd4\xd3\x75\x82\x55\x71\x5a\x59\x8c\x7a\xe3\xde\xcd\xd3\x78\x8c\x98\x66\xb1\x3b\xfa\xba\x3c\xce\x00\x1a\x9a\x23\xb0\xef\xe9\x06\xc3\x7f\x7d\xff\xae\x91\xbb\x75\x66\x55\x9d\xed\x6b\xec\x93\x5a\x7d\x90\x35\x28\xe0\x91\x0b\x01\x4c\x3c\xb2\xb5\x01\x57\x1e\xa8\x94\x60\x86\x36\xf3\x37\x98\xf5\x5b\x6c\x55\xa0\x51\x20\x33\x08\xae\x99\x13\xf8\x53\xb6\x80\x84\x71\x5d\xa7\x28\xa1\xcc\xba\x5d\xe7\xe6\x51\xa5\x22\x02\x8d\x31\xe3\xae\xe6\x08\x2d\x15\x5c\xa1\x74\x65\x48\x84\x2b\x14\x2a\xe1\x72\x51\xa3\x04\x9e\x07\x03\x37\x85\x1a\x12\xad\x56\x3c\x72\xb5\x0c\x2e\xb8\x21\xbd\xee\xba\x56\x4d\xa8\xe2\x19\x97\xe5\xc7\x08\x07\x6f\x1f\x54\xd1\xb4\xa9\x62\x9a\x61\xd1\x48\x22\x94\x30\x5b\xbb\x2e\x52\xde\x79\x2a\x2b\xaf\xf3\x70\x49\x7c\xcc\xbb\x47\xb9\x9c\xcc\x18\x15\x72\x46\x18\xb9\x71\xd7\x54\x6a\x39\x8c\x6c\x45\x00\xcf\x8e\x56\xf4\xee\xbc\xb6\xdb\x67\xfb\x1b\x47\xa9\x10\x23\x25\x78\xb8\x76\x3d\x84\xfa\xd8\x29\x8a\x8d\x2b\x0f\x66\x4a\xc3\xac\xe7\x14\x1a\x59\xc4\x25\x1a\x33\xd2\x6a\x86\xf5\x68\x67\xef\xfb\x3b\xa4\xfd\x10\x98\x64\xb1\xaf\xbb\x44\x26\x68\xb9\x3f\xe7\xfa\xf8\x2f\x5e\xbf\xd8\x9b\x30\xe1\x12\x77\xbe\xbb\x36\x65\x73\x4c\xce\xc4\x00\x05\x5b\x4f\x30\x54\x32\x3a\xaf\x93\x92\xe1\x35\xfe\x78\x87\xff\xea\x90\xce\xe9\x26\xcb\x39\x44\x6a\xfd\x97\xcb\x7a\x03\xc6\xfd\x13\x7c\x85\xff\xc3\x2a\xbc\xce\xe1\xff\x19\x0d\x1e\xa3\x51\x55\xe0\x17\x97\x8d\x1a\x04\x48\x50\xf3\x2c\x87\x7f\x2a\xfa\x51\x95\xc0\x07\xe3\xae\xef\xae\x22\x7e\xd9\x02\x78\xce\xb8\x48\x35\x4e\x97\x1a\xcd\x52\x89\xe8\x29\x98\xdf\xee\xd1\xf8\x60\xd8\x07\x04\xaa\xc8\xbf\x6a\x02\x5e\xf9\x3c\xe7\xfe\x79\x65\x51\x72\xf0\x11\xce\xfd\xdb\xfb\x14\x57\x50\x6a\xf8\x98\xd7\x4c\x70\xdf\x94\x1d\xc1\x18\x49\xf3\xd0\x1c\xdb\xf9\xcd\xab\x57\xdf\x34\xec\x4c\xb4\x8a\x91\x96\x98\x9a\x27\x02\x7a\xf5\xea\x75\x03\xd9\xdf\x94\x50\x0f\x9c\x9d\x45\xb3\xa1\xc7\x09\x8d\x7d\x4e\xa8\xf4\x2f\xcf\xfb\x70\x74\x93\xad\xde\xb3\xb6\xa6\xb6\x68\x95\xf4\xcb\xd7\x97\x37\xbc\x16\xe9\x4c\xa2\xb9\x5c\x78\x33\xa5\x28\xfb\xc4\x1f\x33\xe2\x21\x13\x62\x0d\x09\x0f\x1f\x0c\xa4\x09\xb0\x24\x6b\x31\xdb\x20\xe9\xaf\x63\x01\x73\xad\x62\xf0\xbb\xa1\x92\x73\x5e\x0d\xe7\x8f\x4a\x3f\x70\xb9\x18\x70\xdd\x5a\x0c\xae\xb2\xaf\x5d\x37\x2a\x95\xfb\xd6\xe5\x74\xeb\x68\x7a\x6e\x59\x4d\x86\xd8\xee\x71\xe5\x54\xad\x54\x3c\x40\xb1\x23\x85\xef\xe9\x43\xe8\xd8\x92\xb3\xf2\x05\x4d\x2a\x3a\x7c\x25\x51\xfd\x5e\xd6\x2f\xbb\xa5\xc6\xbf\x49\x29\x65\x62\x7a\x3d\x69\xcc\xe6\x76\x9d\x56\x8f\x84\x69\xc5\x52\xab\xc6\x5a\x77\xd8\x30\x7c\x27\xc5\x3a\x00\x9b\x03\x1e\xeb\xb1\x3c\xa1\x9e\xac\xd5\x80\xe7\x01\x6d\xdf\x72\x0a\xe9\x53\x3a\xc0\x3b\xa0\x14\x27\xad\xf0\xec\xdc\x79\x6a\xb1\x75\xeb\x8c\x19\xf4\x87\xef\x9d\xb6\x07\xb3\xac\x60\x6b\x28\xc3\x2a\x36\xe5\xce\x25\x9a\x79\x89\x5d\xfb\x01\xb9\x5d\x2b\xbb\x8f\x9a\xef\x15\x5c\x9e\x9c\xf3\x3d\x49\x65\x3d\xbd\x30\xb5\xa3\x62\x7a\xd1\xd2\xb6\x3c\x97\xdc\xe9\xc6\xda\x39\x51\xea\x78\xb0\x6d\x05\x60\x37\x1f\x8d\xb2\xc7\x77\xd6\xc2\xeb\x97\x5f\xbc\x6c\x4e\x0d\x9c\x4d\x15\xa6\x74\x28\xa0\x73\x5f\x0d\x6d\xc3\x46\xdf\x96\x34\xbd\x3c\xa9\x07\x82\xd0\x0e\xdd\x36\x7e\xa8\xac\x33\x68\xf3\xc3\x6e\xfc\x86\x25\x41\x83\x24\x35\x92\x5e\xee\x98\x9f\xee\x98\x4e\xb9\xa5\xa3\x58\x5a\x62\x68\x33\xc7\x03\x7f\xf1\x71\x22\xc0\x09\xff\xef\x1e\xb2\xd5\xc1\xbb\xb1\xdb\xf3\xdf\xb6\xfd\x19\x87\xda\xec\x4e\x31\x4e\x68\x9d\xc5\xf0\x4d\x95\xcb\x45\xf5\xcd\x47\xc5\x63\xb4\xbc\xff\x38\x78\xc8\xd2\xf0\xac\xa2\xf2\xac\xa5\xb2\xf5\xac\xb7\x52\xf0\xb7\x7b\x65\x50\xfd\xa4\x45\x6c\x51\xbc\x21\x72\xe8\x3b\xf9\xfb\x8f\x9c\xe2\xbc\xd6\x49\x3e\x50\xc1\x94\x95\x09\x8d\xdb\xff\xac\xe9\x35\xc9\xb3\xa2\xb9\xd4\xfe\x1c\xad\xbf\xbb\x24\x07\x6a\xfd\xbb\xa9\xef\xa8\x17\x01\x28\x81\xef\xa5\xa3\x01\xfc\xe1\xed\x38\x65\x76\x58\x2a\x36\xd9\x2f\x54\x3e\x81\x1f\x11\x94\x14\x6b\x78\x64\x92\xdc\xc3\x20\x62\x94\x9a\xe7\x60\xaf\xbb\xfd\x7b\x9e\x0a\x91\x31\xf3\xe1\x5b\x94\x21\x16\x5f\x53\x40\xc9\xe7\x60\x50\x1a\x4e\x7c\x85\xa0\xe6\xf3\xe2\xe5\x10\x4c\x10\xb3\xc2\xc7\x04\xdd\x6e\xa4\x42\xe3\xbb\xac\x3a\x7b\xbb\x5a\xe6\xd7\xd9\x54\x37\x4c\xb5\x46\x49\xdd\xec\x03\x85\xe5\xd0\x5d\x52\x2c\xba\x89\x56\x51\x1a\x5a\xa1\x3c\x9b\x3a\xad\xbd\x58\x49\x4e\x2a\x23\x63\x17\x14\xbc\xde\x2a\x0d\x11\x12\xe3\x85\x33\x89\x99\x64\x0b\xb4\x69\xec\x81\xe8\xd5\x92\x6a\x27\x48\xd5\xe3\xa0\x4b\x75\xf2\x24\x6d\x37\x16\x65\x4f\xa8\x2a\x71\xc7\x35\x20\x82\x9a\xf3\xca\x15\x11\xc0\x9c\x09\x83\x17\xff\x09\x00\x00\xff\xff\x93\xf2\xb0\xf5\xd7\x2c\x00\x00"),
 		},
 		"/infrastructure/04-syndesis-oauth-proxy-embedded.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "04-syndesis-oauth-proxy-embedded.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 4189,
+			uncompressedSize: 7127,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x57\x5f\x6f\xdb\x46\x0c\x7f\xcf\xa7\x38\xb8\x0f\x6d\xb7\x4a\x6a\xbb\x0e\x18\x04\xe4\x21\x48\xd2\x35\x68\x9b\x18\xb1\x37\x6c\xd8\x9f\x80\x3a\xd1\xd2\xd5\xa7\xbb\xdb\x1d\xa5\xc4\x73\xfc\xdd\x87\x93\x6c\xc7\xfa\x93\xc4\x19\x06\x0c\x9b\x1e\x12\x5b\x24\x8f\x3f\x92\x3f\xf2\xe8\xe5\x92\x89\x19\x0b\x8f\x8c\x98\xa0\xad\xd0\x86\xa7\x45\x82\x69\x8a\xe9\xd8\xea\x4a\xa4\x68\xd9\x6a\x75\x10\x30\x30\xe2\x47\xb4\x4e\x68\x15\xb3\xea\xcd\x01\x63\x73\xa1\xd2\x98\x79\x1b\xc1\xf1\x80\xb1\x02\x09\x52\x20\x88\x0f\x18\x63\x4c\x42\x82\xd2\x35\x9f\x19\x03\x63\x62\xe6\x16\x2a\x45\x27\xdc\xfa\xdd\xe6\x6b\x28\x74\xf4\x98\x9c\x16\x06\x63\x26\xd4\xcc\x82\x23\x5b\x72\x2a\x2d\x0e\xa8\x71\x5d\x18\xad\x50\xd1\xdd\x61\x81\x86\x92\x72\x63\xf5\xcd\xa2\x36\x00\xa5\x34\x01\x09\xad\xb6\xe0\x9e\x6d\xfe\xb3\xa3\xad\x90\xcd\xb4\x65\x50\x92\x0e\x32\x54\x68\x81\x84\xca\x18\xe5\xc8\x48\x3a\xe6\x50\xce\x02\x27\x32\x85\x29\xe3\x68\x49\xcc\x04\x07\xc2\xce\x71\xae\xc9\x4c\x08\xd2\xe4\x10\x6a\x83\xca\xe5\x62\x46\x1e\x67\x2d\x52\x59\xe0\x8d\x03\x87\xdc\x22\x05\x0a\x0a\x1c\x84\x1d\x90\x6c\x52\x72\xaf\xc6\x01\x63\xce\x20\x6f\xe2\x31\xda\xd2\x3a\xb4\xa0\xfe\x12\xb3\xef\xde\xbd\xfb\x66\x0d\xca\x58\x4d\x9a\x6b\x19\xb3\xe9\xf1\x78\xfd\x8e\xc0\x66\x48\xe3\xb6\xaa\x43\x89\x9c\xb4\xfd\xa7\x2a\xf8\x48\x69\xda\xfc\x02\x63\x5c\xb4\x43\xb2\x13\x34\x52\x2f\x0a\x54\xf4\x9f\xe1\xd9\x7e\xc5\xb2\x68\xa4\xe0\xe0\x62\xf6\x66\x30\xeb\x05\x10\xcf\x3f\xb5\x42\x1c\x0e\xf2\xf1\x30\x9f\x1c\x81\x23\x0b\x84\xd9\x62\xe3\xb8\xc9\xcd\xa5\x67\xeb\x86\xec\x84\x85\x91\x40\xb8\x85\xdb\x2a\x4d\xbf\x3c\xf7\xa3\xdf\x07\xff\xde\xa5\xfa\x1b\xc1\xee\x16\xc5\x3f\x5c\x2b\x02\xa1\xd0\xee\x60\x0f\xd6\x45\xed\x99\xfa\x47\x14\x90\x61\xcc\x9e\x2f\x97\x2c\x9c\x6c\x7c\x1f\x6f\x1c\xbb\xf0\xc2\x1b\x85\x67\x5e\x8b\xad\x56\xcf\x77\x13\x62\xb3\x56\x82\xbc\xa3\x20\x30\xeb\xe1\x7b\xb8\x1d\x1c\x3d\x15\xae\xf5\x5c\x60\x3d\x38\x0e\x6b\x4c\x57\x5d\x50\x8d\x5e\x69\x1c\x59\x84\xe2\x30\x27\x32\x71\x14\x6d\x73\xe0\xea\x79\x1f\x81\x11\xd1\x93\x8d\x0a\x30\x06\xed\x13\xec\xca\xa7\x38\x49\xab\x28\xad\xfa\xfa\x24\x5d\x3d\x33\x0f\x23\x24\x1e\x91\x74\x91\xb1\xa2\x02\x42\xff\x39\xe4\xb6\x9f\x23\x6f\x31\xc7\xc5\xb0\xc1\x1c\xfb\xc9\x32\xe0\x5c\x00\x9c\xa3\x73\x01\xe9\x39\xaa\x9e\x86\x9b\x0b\xb3\x2d\x4f\x90\x94\x44\xfa\x1e\x25\x5f\x93\xc0\x62\x86\x37\x87\x91\xd4\x99\x2e\xfb\xf8\x7a\x7a\xbf\xfc\x1e\xfd\xf6\xf5\xaf\xe1\x0b\xa3\xb2\xdb\x2f\x26\xbb\x45\x4d\xb7\xae\xca\x6e\x89\x66\xb7\xd7\x7a\xd6\xfc\x79\xfb\xf2\xf1\x83\x7c\x55\xab\x37\x91\xbb\x86\x2c\x43\x1b\x7e\xb5\xb7\x85\x50\x29\xde\x84\x39\x15\x72\x6f\x13\x6e\x31\x45\x45\x02\xa4\x8b\x38\x48\x99\x00\x9f\xef\x6d\x5c\x35\x53\xff\x71\x7d\xae\xd5\x4c\x64\xe1\x17\xf7\xa0\xb2\xb1\x38\x93\x22\xcb\xfb\xb9\xde\x76\x52\xc0\xa1\x21\x84\x99\x8b\x9a\x14\x9e\x53\x1e\x79\x90\x94\x2a\x95\x38\xc8\xa4\xb6\x75\x05\x36\xb2\xa5\x8a\x9a\xcb\xdb\x45\xf3\x32\x41\xab\x90\xd0\x6d\xef\x77\x8e\xc0\xb9\x2e\x15\x45\x1c\x3a\x27\x36\x2b\xd7\x0b\xa5\xe9\xa1\x61\x71\x22\x1c\x24\x12\x27\x60\x8f\x73\xe4\xf3\x97\x7e\x0f\xbb\x1f\x95\x03\x7b\xb8\x1c\xf9\x61\xe0\x0c\x70\x1c\xc5\xa3\xe5\xf2\x81\xc3\x27\x60\xcf\x37\xba\xab\xd5\xe8\xd5\xc8\xa2\xd3\xa5\xad\x0d\x8d\x4e\xdd\xe8\xd5\xa8\x42\x9b\x8c\xe2\x51\x86\x34\x5a\xb5\xc1\xa3\x4a\xbb\x60\x9e\xb1\x35\xdc\xb4\x5e\x9d\x94\xbe\x8e\x37\xdd\x54\x3a\xdf\x27\x08\x16\x6d\xd3\x52\x0c\x1c\xa3\x5c\x38\x66\xf1\x8f\x52\x58\x74\x0c\x6f\xc8\x02\x33\x68\x0b\xe1\x3c\x19\xd8\x75\x2e\x78\xce\xb4\x92\x8b\x8e\x17\x0e\x8a\x25\xc8\x32\x51\xa1\x62\xc9\x82\x01\xe3\xb2\x74\x84\x36\x80\xb4\x10\xbb\xc4\x40\x55\xed\x8e\xd5\xcd\xf4\xbe\x38\xfa\x61\xfa\xe1\xed\xd5\xf8\xf2\xe2\xa7\x9f\xaf\x8e\x2f\x2e\x3e\x9e\x9d\x5e\x4d\x4e\x8f\x2f\x4f\xa7\x2d\x4f\x15\xc8\x12\xdf\x5b\x5d\xb4\x47\xb3\xbf\xa2\x7d\xc5\x3f\xe2\xe2\x12\x67\x5d\x59\xef\xda\xcf\xa4\x4e\x40\x06\x0d\x73\x7b\xca\x73\x5c\xac\xf1\xdc\x0b\x64\x18\xf5\xa7\xb3\xd3\xf3\xe9\xd5\xd9\x49\x1f\xb1\xf7\xed\x08\x8b\xb8\x4d\xc1\x78\xb9\x0c\x2f\x0c\xaa\x89\xa7\xca\xd8\xea\x2f\xc8\x69\xb5\x8a\xdb\xb7\x61\xc0\xa5\x68\x56\xac\x7d\xbc\xff\xab\x39\x1b\x9f\x9e\x4f\x3e\x9c\xbd\x9f\x5e\xad\xb3\x77\x0f\xa4\x9d\x5d\x78\x13\xd0\xf6\x62\x1f\x77\x57\xe3\x5d\x30\xa6\x4c\xa4\xe0\x2d\xc1\xd0\xe6\xcc\xea\x15\x0e\x52\xa1\xd0\xb9\xb1\xd5\x09\xb6\xa3\xf3\xd7\xda\xf7\x48\xdd\x90\x7b\x5b\xf9\x56\x00\x94\xc7\x2c\xaa\xab\x11\xe5\x08\x92\xf2\x3f\xbb\xa9\xe4\x39\x7a\x84\x1f\xa6\xd3\xf1\xa4\x25\x13\x4a\xf8\x09\x7c\x82\x12\x16\x13\xe4\x5a\xa5\x7e\xad\xfc\xb6\xa5\x43\xa2\x40\x5d\xd2\x9d\xf8\xf5\x8e\x58\xfa\x9e\xfa\x3f\x04\x52\x69\x59\x16\xf8\xd9\x13\xbf\x53\xfd\xc2\xbf\x1b\x37\xe0\x3a\x1b\xc1\x00\x0b\x1e\xfa\x31\xd6\x3c\x9b\xa1\xd9\x59\xe0\xa4\x28\x04\xb9\x6e\xb6\x0a\x2c\xb4\x5d\xc4\xec\xed\xeb\xd7\x9f\x45\x4b\xe6\xe7\x20\xba\x87\x2c\x76\x0c\xd6\x9d\x7d\xd4\x74\xf6\xf9\x00\xd2\x6e\x23\x37\xf9\x18\xd8\x64\x1f\x0f\xb0\xe9\xdc\x36\xae\xe6\xdd\x90\xe3\xbb\x13\x96\x4b\x54\xe9\x6a\x75\xf0\x57\x00\x00\x00\xff\xff\x25\x6a\x7e\xe3\x5d\x10\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\xeb\x6f\xe3\xb8\x11\xff\x9e\xbf\x62\x90\x3b\xe0\x76\xdb\xca\x4a\xb6\xd7\xe2\x20\x20\x1f\x8c\x24\x7b\x1b\xdc\x6e\x62\xc4\xe9\xa1\x87\x3e\x02\x9a\x1a\x4b\x5c\x53\x24\x8f\x1c\x39\x71\x1d\xff\xef\x05\x25\xd9\xd6\xcb\x8f\x1c\xb6\x58\xa0\xf7\x25\x91\x39\x4f\xfe\xe6\xc1\x21\x97\x4b\x10\x53\x18\x0c\x8d\x18\xa3\x9d\xa3\x1d\x5c\x67\x13\x8c\x63\x8c\x47\x56\xcf\x45\x8c\x16\x56\xab\x93\x00\x98\x11\x3f\xa3\x75\x42\xab\x08\xe6\xe7\x27\x00\x33\xa1\xe2\x08\xbc\x8c\xe0\x78\x02\x90\x21\xb1\x98\x11\x8b\x4e\x00\x00\x24\x9b\xa0\x74\xe5\x37\x00\x33\x26\x02\xb7\x50\x31\x3a\xe1\xaa\xb5\xf5\xcf\x81\xd0\xe1\x21\x3a\x2d\x0c\x46\x20\xd4\xd4\x32\x47\x36\xe7\x94\x5b\xec\x61\xe3\x3a\x33\x5a\xa1\xa2\xad\xb2\x40\xb3\x9c\x52\x63\xf5\xf3\xe2\x64\xb9\x0c\x8a\xad\x8e\xd7\x22\xc3\x38\x16\x24\xb4\x62\xf2\x63\xe1\xae\xdf\xe9\x72\x09\xa4\x7f\x61\x99\xdc\xc7\xf7\x02\x42\xc5\xa8\x08\xfe\x5a\x8a\x04\x80\x2a\xf6\x9f\xc5\x66\x95\xd2\xc4\x3c\xff\x66\xff\xdf\xac\xff\xc3\x70\x43\x84\xa9\xb6\xc0\x72\xd2\x41\x82\x0a\x2d\x23\xa1\x12\xa0\x14\x81\xa4\x03\x87\x72\x1a\x38\x91\x28\x8c\x81\xa3\x25\x31\x15\x9c\x11\xb6\xd4\xb9\x12\xfc\x01\x93\x26\x65\x03\x6d\x50\xb9\x54\x4c\xc9\x43\x51\x90\x54\x12\x78\xe1\xc0\x21\xb7\x48\x81\x62\x19\xf6\x22\x13\x90\x74\xfb\xd0\xd9\x3a\x7d\x04\x44\x75\xe6\xbd\x38\xed\x74\xe7\x04\xc0\x19\xe4\x25\x78\x46\x5b\xaa\x70\x0c\x8a\x1f\x11\xfc\xf0\xfd\xf7\x7f\xae\x10\x30\x56\x93\xe6\x5a\x46\xf0\x70\x39\xaa\xd6\x88\xd9\x04\x69\xd4\x64\x75\x28\x91\x93\xb6\x5f\x2a\x23\x0f\xa4\x5a\xb3\x5e\x98\x31\x2e\xac\x15\xcd\x15\x1a\xa9\x17\x19\x2a\x6a\xd4\xcd\xf1\x11\xe8\xa4\xd9\x17\x0a\xc9\xef\xb8\x6a\x8f\xcb\x46\x8b\x46\x0a\xce\x5c\x04\x65\xdb\x4c\xa8\x66\xf0\x72\xbd\x11\x37\xb8\xf3\xe2\x83\xfb\x8a\x1b\xce\x60\xb5\x5a\x2e\x8f\x62\x2d\x18\x51\x3a\x84\xd5\xea\xdc\x7f\x6e\x5d\x6c\xe7\x70\xc6\x88\xa7\x1f\x1b\x21\xeb\x0f\xda\xe1\xb0\xbd\x2a\x22\x05\x3b\x59\x46\x98\x2c\xd6\x86\xcb\x58\xdf\xfb\x46\xb3\xee\x53\x84\x99\x91\x8c\x70\xe3\x6e\x3b\xd1\xb5\x3d\x98\xad\x6f\xf0\xd7\x1a\xcf\x18\x79\x6e\x05\x2d\x3e\xe9\x18\x25\x9c\x5a\x74\x64\x05\x27\x8c\x4f\xdf\x56\x10\xf5\x14\x46\x61\xea\x48\x35\x35\x2d\x1e\x6e\xee\x81\x18\xb8\x8a\xbd\x6a\xb3\xb3\x7c\x82\x56\x21\x61\x01\x95\xd1\x71\x04\x36\x57\x24\x32\x0c\x63\x9c\xb2\x5c\xd2\x3a\xb5\xaa\x24\xfb\x9f\x35\xd5\xf3\xb3\x56\x1e\xb7\x52\xba\x5b\xd1\xbb\x13\xe4\x98\x14\x39\xba\xba\xbf\x7e\x85\x77\xa0\xa9\x9c\x2a\x0a\xb9\x63\x6f\xcc\x53\x8c\x73\x29\x54\x32\xb8\xd5\x31\x8e\xab\x3a\xab\xc1\xa8\x6a\xcb\x25\x98\x5e\x89\x65\x2a\x41\xf8\x76\x86\x8b\x3f\xc1\xb7\x73\x26\x73\x84\xe8\xe2\x55\x7a\xbd\x9e\x42\x01\xac\x56\x45\x4f\xa9\xd4\x54\x2c\xcd\x44\xda\x91\x52\x35\x2b\x0f\x5a\x16\x93\x84\x56\xae\x66\x84\xb6\xab\xfd\x27\x45\xbf\x86\x2d\x9a\x3f\xc0\xd1\x1e\x0c\xa7\x53\xa1\x04\x2d\xea\xf5\x58\x2d\x1d\xb4\xbd\x96\xdd\x11\xc6\x03\x96\x47\x56\x68\x5f\xa6\x97\x92\x39\x77\xcb\x32\xac\xb9\x60\xda\xb4\x02\xeb\xe3\xd4\x74\x3d\x78\x12\x94\x82\xd1\xf1\xba\x8f\x5c\x6a\x45\xf8\x4c\x3b\x3b\x4c\xcd\x11\xd7\x14\x69\x40\xb2\x7b\xdb\x6b\x69\xae\x15\x31\xa1\xd0\xd6\x0a\x3a\xa8\x4e\xae\x56\x3d\x15\x3e\x6e\xf8\x7f\x83\xa7\x47\xfb\xfa\xae\xdf\x57\x00\x91\xb1\x04\x23\xf8\x6e\xff\xc1\x77\xe3\xb9\x60\xb5\xfa\xae\xde\xa2\x6c\xd2\x68\x59\x7e\x97\x41\x60\xaa\xfb\xc8\xc5\x66\xd0\xed\xb0\x70\xad\x67\x02\x8b\x41\xf7\xa2\x00\xe4\x71\x7b\x62\xd5\xf9\x72\xe3\xc8\x22\xcb\x2e\x52\x22\x13\x85\xe1\xa6\x2b\xb9\xe2\x0a\x14\x32\x23\xc2\x57\x0b\x65\xcc\x18\xb4\xaf\x90\xcb\x5f\x63\x24\x9e\x87\xf1\xbc\xcb\x4f\xd2\x15\x33\xfe\x45\x88\xc4\x43\x92\x2e\x34\x56\xcc\x19\xa1\xff\x1e\x70\xdb\xc5\xc8\x4b\xcc\x70\xd1\x2f\x30\xc3\x2e\x58\x86\x39\x17\x30\xce\xd1\xb9\x80\xf4\x0c\x55\x87\xc3\xcd\x84\xd9\x84\x27\x98\xe4\x44\x7a\x07\x93\x8f\x49\x60\x31\xc1\xe7\x8b\x50\xea\x44\xe7\x5d\xff\x3a\x7c\xff\xf8\x77\xf8\xaf\x3f\xfe\x73\xf0\xc6\xa8\xe4\xe5\xb3\x49\x5e\x50\xd3\x8b\x9b\x27\x2f\x44\xd3\x97\x27\x3d\x2d\xff\xbc\x7b\x7b\x58\x91\x8f\xea\xfc\x3c\x74\x4f\x2c\x49\xd0\x0e\xfe\x70\xb4\x84\x4f\xf5\xe7\x41\x4a\x99\x3c\x5a\x84\x5b\xf4\xe5\x21\x98\x74\x21\x67\x52\x4e\x18\x9f\x1d\x2d\x3c\x2f\x2f\x0e\x87\xf9\xb9\x56\x53\x91\x0c\x3e\x3b\xad\x4e\xb6\x07\xd2\x9e\x6a\x1b\xcf\x84\x19\xe6\x94\xde\x7b\xf9\x66\xb9\xf7\x9b\xf0\xc5\xbb\x63\xb4\xe8\x8a\x18\x8b\x53\x29\x92\xb4\x1b\xd4\x4d\xc9\x06\x9c\x95\x99\x67\x66\xa2\xc8\x3e\x9f\xbc\x1e\xa2\x60\x92\xab\x58\x62\x6f\xca\x36\xa5\xe7\xcc\x86\x36\x57\x61\x79\xab\x75\x61\x73\x20\xab\xee\xc4\x8c\x73\x9d\x2b\x0a\x39\x6b\x69\x2c\xe7\xf6\x37\x4a\xef\x9d\xdc\xaf\x84\x63\x13\x89\x63\x66\x2f\x53\xe4\xb3\xb7\x7d\xfb\xde\x7a\xe5\x98\xf5\x40\x39\x66\xdf\x4b\x96\xec\xc5\x9f\x59\x7f\xa6\x38\xc3\xf8\xfe\x38\x31\x7b\x8f\x4e\xe7\xf6\x20\xdb\xcf\x68\x27\x07\x58\x86\xa3\x9b\x1f\xad\xce\x0d\xb4\x07\x8f\xda\xd8\xd8\x38\x55\x3b\x4a\x2e\x8b\xbe\x7a\xfd\x6c\x84\xc5\x3e\x28\xaa\xbe\x8b\x05\xc3\xc5\xfe\x86\xdf\xd6\xd5\x9a\x5f\x8f\x71\xe4\x1e\xa7\x16\x5d\xba\xc7\x13\x5b\x72\x1c\xe5\x4a\x4d\x5b\xcb\x97\xc3\xe5\xc4\xb5\x41\xd7\x5b\x14\x9e\xd2\x57\x3d\x47\xe9\xbd\x7e\x26\xcb\x86\x36\xc9\x33\xbf\xd8\xd5\x7f\xa0\x2a\xbf\x81\x2a\x7f\xe3\xe2\x91\x49\xe9\xa7\x68\xdd\xc7\x73\xe7\x3b\x34\x32\x8b\xb6\x6c\xe6\xc0\x1c\x50\x2a\x1c\x58\xfc\x35\x17\x16\x1d\xa0\x37\x0e\x06\x6d\x26\x9c\x6f\x43\xf0\x94\x0a\x9e\x82\x56\x72\xd1\xb2\xc2\x99\x82\x09\x42\x22\xe6\xa8\x60\xb2\x00\x06\x5c\xe6\x8e\xd0\x06\x2c\xce\x44\xbd\x7f\xa1\x9a\x6f\xe7\xee\x0f\x0f\x0f\xa3\x91\x3f\x97\x1b\x6e\xaf\x07\x19\x4f\x7d\x1c\xdd\xdf\xfd\xfd\x97\x86\xb5\x62\x2e\x8e\xe0\x74\xb9\xac\xcb\x9f\xf6\x0c\x87\x9e\x3c\xde\xaf\x7f\x7c\xd8\xc0\x78\x8f\x85\x5b\xbd\x5b\xfd\xed\xdd\x7e\xdd\x1b\xd9\xd3\xde\xa1\x69\xad\xe6\x6e\xf8\xb7\x87\x0f\xef\x4a\x55\x8f\x97\x77\x77\x3f\xdd\x5c\x3f\x8e\xaf\x2f\xef\xaf\x1f\xba\x8a\xdf\x5b\x9d\x35\xc7\xa5\x62\x78\xb3\x48\x3f\xe1\xe2\x1e\xa7\x6d\x5a\xe7\xb1\x23\x91\x7a\xc2\x64\x50\x9e\x26\x1d\xe6\x19\x2e\x2a\x7f\x76\x3a\xd2\xef\xf5\xc7\x9b\xeb\xdb\x87\xc7\x9b\xab\x3e\x28\xdc\xc2\x11\x66\x51\xb3\x5b\x47\xcb\xe5\xe0\xce\xa0\x1a\xfb\xae\x3a\xb2\xfa\x33\x72\x5a\xad\xa2\xe6\x9d\x31\xe0\x52\x94\x2f\x67\xc7\x58\xff\xaa\x98\x8d\xae\x6f\xc7\x1f\x6e\xde\x3f\x3c\x56\xe8\xed\x70\xa9\xf6\xc4\xb9\xde\xd0\x66\x72\x1f\xb5\x5f\x3c\xeb\xce\x98\x7c\x22\x05\x6f\x10\xfa\x1e\x44\xa1\x78\xb8\x62\xb1\x50\xe8\xdc\xc8\xea\x09\x36\x77\xe7\x47\xcd\x1f\x91\xda\x5b\xee\x3c\xb6\x6e\x08\x8c\xd2\x08\xc2\x22\x1a\x61\x8a\x4c\x52\xfa\x9f\x36\x94\x3c\xc5\x4d\xb1\x35\x68\xfe\x86\x27\x98\xbc\x42\xc9\x16\x63\xe4\x5a\xc5\x2e\x82\xf3\xbf\x34\x78\x48\x64\xa8\x73\xda\x92\xcf\x6a\x64\xe9\xbb\xcd\xff\xc3\x46\xe6\x5a\xe6\x19\x7e\xf2\x89\xdf\x8a\x7e\xe6\xd7\x46\xa5\x73\xad\x29\xbd\x27\x0b\x0e\x3c\xe8\xff\x96\xa7\xaf\xa6\x0b\x94\x99\x1e\xb3\x7e\xb5\xaf\x83\xd9\x6a\x6e\x69\xdd\xe0\xa4\xc8\x04\xb9\x76\x68\x32\xcc\xb4\x5d\x44\xf0\xee\xec\xec\x93\x68\xd0\xfc\x71\x84\x6e\x9f\x44\x4d\xa0\x6a\x23\xc3\xb2\x8d\xdc\xf6\xc0\xd2\xee\x1a\x25\xf8\x3d\xf7\xe8\x5d\x68\xd6\x52\xa2\x68\x13\x4d\xbf\xca\xb5\x3e\xc3\x5f\x20\x1e\x41\x0d\xf1\xad\x49\xcc\x0c\x2d\xae\x84\x8d\x60\xd9\x7c\xa0\x28\xff\xff\x37\x00\x00\xff\xff\x6f\x30\x64\x40\xd7\x1b\x00\x00"),
 		},
 		"/infrastructure/04-syndesis-oauth-proxy-no-embedded.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "04-syndesis-oauth-proxy-no-embedded.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 4067,
+			uncompressedSize: 7121,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x57\xdf\x6f\xdb\xc6\x0f\x7f\xf7\x5f\x41\x20\x0f\xfd\x7e\x37\xc8\x6a\xbb\x16\x18\x04\xe4\x21\x48\xb2\xb5\xe8\xda\x18\x89\x57\x6c\xd8\x8f\x80\x3e\xd1\xd2\xd5\xf7\x6b\x77\x94\x12\xcd\xf1\xff\x3e\x9c\x65\x3b\x92\xed\xc4\xc9\xb0\x97\x4d\x0f\xc6\xe9\x8e\x3c\x7e\xf8\x21\x4d\x52\xf3\x39\xc8\x29\x18\xcb\x30\x3c\x71\xf2\x8a\x7c\x4d\x7e\x78\xae\x27\x94\xe7\x94\x8f\xbc\xad\x65\x4e\x1e\x16\x8b\x41\x02\xe8\xe4\x67\xf2\x41\x5a\x93\x41\xfd\x6a\x00\x30\x93\x26\xcf\x20\xea\x48\x41\x03\x00\x4d\x8c\x39\x32\x66\x03\x00\x00\x85\x13\x52\xa1\x5d\x03\xa0\x73\x19\x84\xc6\xe4\x14\x64\x58\xed\xad\x5f\x87\xd2\xa6\x87\xce\xb9\x71\x94\x81\x34\x53\x8f\x81\x7d\x25\xb8\xf2\xb4\x47\x4c\x58\xed\xac\x21\xc3\xf7\x97\x25\x16\x2b\x2e\x9d\xb7\xb7\xcd\x52\x01\x8d\xb1\x8c\x2c\xad\x59\x81\x33\xa8\xe9\x21\xf1\xe0\x48\xb4\x52\xce\x7a\x5e\x29\x24\xcb\x97\x0c\xbe\x7d\xf3\xe6\x9b\x15\x06\xe7\x2d\x5b\x61\x55\x06\xe3\xd3\xd1\x6a\x8f\xd1\x17\xc4\xa3\xbe\x68\x20\x45\x82\xad\xff\xa7\x78\x39\xe0\x70\x3f\x6a\xe8\x5c\x48\x3b\xa1\x3b\x23\xa7\x6c\xa3\xc9\xf0\xbf\x26\x7a\x4f\x0b\x96\x27\xa7\xa4\xc0\x90\xc1\xab\xbd\xac\x6b\x64\x51\xfe\xd0\x73\x71\xbf\x93\x87\xdd\x7c\xb6\x07\x81\x3d\x32\x15\xcd\xda\x70\xcb\xcd\x25\x09\x4f\xc8\x2d\x2b\x4c\xda\x29\x64\xda\xc0\xed\x85\x66\x37\x3c\x0f\xa3\x7f\x0a\xfe\x27\x87\xea\x6f\x38\xdb\x0d\x4a\x7c\x84\x35\x8c\xd2\x90\xef\x60\x4f\x56\x41\xdd\x51\x8d\x8f\xd4\x58\x50\x06\x2f\xe6\x73\x18\x5e\xad\x6d\x9f\xae\x0d\x87\xe1\x45\x54\x1a\x7e\xb2\x66\x5d\xb3\xde\x47\x05\x58\x2c\x5e\x74\xb9\xf1\x45\x8f\xab\x68\x33\x49\x84\xb5\x33\x49\x49\x34\x7e\xbc\xb4\x7d\xbd\x6d\xbc\x95\xab\x5c\x60\x4f\xa8\x8f\x4b\x66\x97\xa5\xe9\xc6\xd7\xb0\xac\x96\x29\x3a\x99\x3e\x5b\x49\xa3\x73\xe4\x9f\xa1\x57\x3d\xc7\x48\x5e\xa7\x79\xbd\x2b\xcf\x2a\x24\x82\x3c\x27\x53\xa9\xe8\x38\x25\x16\x29\xab\x90\x3a\x2f\x6b\x64\x8a\xeb\xa1\xf0\xbc\x57\x6d\x46\xcd\x23\x5a\x33\xda\xa5\xcd\x61\x08\x09\x0a\x41\x21\x24\x6c\x67\x64\x76\x24\xc2\x4c\xba\xc4\xad\x7a\x4c\x32\xa9\x98\xed\x03\x42\x31\x3a\x89\xa7\x82\x6e\x8f\x53\x65\x0b\x5b\xed\x82\xdc\x91\xfb\xe5\xf7\xf4\xb7\xaf\x7f\x1d\xfe\xcf\x99\xe2\xee\x8b\x2b\xee\xc8\xf2\x5d\xa8\x8b\x3b\xe6\xe9\xdd\x8d\x9d\xb6\x3f\xaf\xff\x7f\xf8\xa2\x18\xdf\xfa\x55\x1a\x6e\xb0\x28\xc8\x0f\xbf\x7a\xb2\x86\x34\x39\xdd\x0e\x4b\xd6\xea\xc9\x2a\xc2\x53\x4e\x86\x25\xaa\x90\x0a\x54\x6a\x82\x62\xf6\x64\xe5\xba\xad\xf3\x87\xe5\x85\x35\x53\x59\x0c\xbf\x84\x47\x85\x9d\xa7\xa9\x92\x45\xb9\x87\xeb\xa0\x12\x69\x02\x89\xca\x53\xab\x50\x93\x97\xd3\xdd\x1c\x20\x8d\x52\x25\xb9\xd5\x28\xcd\xf1\x2e\x71\x31\x6d\x43\x82\x79\xee\x29\x84\xe3\xac\xd3\x4f\xdb\xe7\x08\xce\x64\xc0\x89\xa2\x1c\xa6\xd6\x83\xb1\x37\xd9\x3a\xb1\xaa\x10\x53\x86\xd0\x93\x6f\xb3\x0b\x30\x00\x97\x32\x80\xa7\x3f\x2a\xe9\x29\x00\xdd\xb2\x47\x70\xe4\xb5\x0c\x91\x17\xb8\x29\xa5\x28\xc1\x1a\xd5\x6c\x59\x11\x68\x60\x42\x50\xc8\x9a\x0c\x4c\x1a\x40\x10\xaa\x0a\x4c\x3e\xc1\x5c\xcb\x2e\x47\x47\xdd\x35\x9c\x28\x05\x76\x0a\x5c\x52\x20\x20\x53\x43\x8d\x3e\x80\xae\x02\xc7\xeb\xa6\xc4\xa2\x8c\xd0\xbd\xd5\x51\xe6\xbe\x48\x16\xca\x4e\x50\x25\x6d\x18\x00\x4d\xde\xbb\x75\x3e\x7f\xa4\xd2\x9d\xde\x27\xc8\x55\x6c\x19\xbc\x58\x40\x58\x2e\x42\xef\x92\xd6\xd7\x50\xda\x4a\xe5\x11\x8c\xb2\x98\x53\x0e\xce\x4b\xeb\x81\xed\x12\x8f\x34\x81\x51\xa9\xe5\x2c\xb4\xf2\x03\xac\x23\x8f\x6c\xfd\x03\x3e\x93\xa9\xbb\x85\x74\x5d\xba\x2f\x4e\x7e\x1c\xbf\x7b\x7d\x3d\xba\xbc\xf8\xe9\xe7\xeb\xd3\x8b\x8b\x0f\xef\xcf\xaf\xaf\xce\x4f\x2f\xcf\xc7\x3d\xa6\x6b\x54\x15\x7d\xe7\xad\xee\x17\x63\x58\x79\xf0\x81\x9a\x4b\x9a\x6e\x9f\xed\xf4\xfc\x1e\x7b\x3b\xc2\x33\x6a\x56\x78\xb6\x80\x1c\x0d\x8e\x60\x1c\x13\xe4\x46\x2a\x15\xdb\x25\x99\x1c\x50\xa9\xa5\xdb\xb1\xbb\xde\x07\xaa\xf3\x2f\x5c\x41\x8b\xd9\xb5\x0e\xf0\xe0\x28\xe6\xc8\x54\xfa\xc0\xb1\x9f\xd5\xe4\x59\x9a\x02\x24\x47\x5e\xd1\x80\x9d\x7c\x21\xc1\xf1\x22\x13\x77\x1a\xd4\x6a\xf3\x12\xd8\x4b\x53\x0c\x61\x5c\xd2\xe0\x08\x62\x81\x30\x0c\x32\x80\xd4\x71\xa4\x44\xb3\xbc\xc3\xd9\x20\x97\x31\x89\x58\x36\x69\x15\xec\x3a\x89\x18\x6f\xa3\x8e\xb0\xde\x93\xe0\xe1\xe0\x68\x30\x9f\xaf\x70\x8e\xed\xb9\xa9\x3f\x47\xf1\xe7\xe4\xd0\xb3\x84\xcf\x22\x55\x6f\xe3\x27\xc1\x3d\xe9\x9d\xe1\x78\x9d\x18\x9b\x4e\x3f\xda\x9e\x95\xbb\x41\x75\xd5\x44\x49\xd1\x3b\xd8\x37\x4a\xc3\x72\xa6\xc3\x5c\x1a\x0a\x61\xe4\xed\x84\xfa\x59\x12\x0b\xc9\xf7\xc4\xdb\xa9\xb3\x33\xa6\x6f\x0e\x90\xcb\x0c\xd2\x65\xd7\x4f\x4b\x42\xc5\xe5\x9f\xdb\x29\x29\x4a\x8a\x08\xdf\x8d\xc7\xa3\xab\xde\x99\x34\x32\xd2\x71\x46\x0a\x9b\x2b\x12\xd6\xe4\x71\xce\x7c\xdb\x93\x61\xa9\xc9\x56\x7c\x7f\xfc\xb2\x73\xac\x62\x9d\xf9\x2f\x38\x52\x5b\x55\x69\xfa\x68\x2b\xb3\x1d\x7d\x1d\xf7\x46\x2d\xb8\xad\x81\x61\x4f\x16\xec\x99\x1e\xe3\xd0\xd1\x0b\x7e\xb0\x95\x17\xb4\x35\xc6\x29\xa9\x25\x87\x6d\xb6\x34\x69\xeb\x9b\x0c\x5e\xbf\x7c\xf9\x51\xf6\xce\x62\x6f\xa0\xf0\x98\x46\x47\x21\xb4\x1f\xb6\x27\x42\x44\x67\x3e\xed\x41\x9a\x08\x25\xdb\x8f\xa7\x2e\x1f\x7b\x46\xdb\xc3\x0e\xb6\x7f\xdf\x3e\xae\x76\xaf\x35\x7c\xa0\x29\x34\x8e\xed\xa9\xd5\x7a\xd3\x14\x62\x49\x88\x05\x6e\xb1\x18\xfc\x15\x00\x00\xff\xff\x22\xc5\xe2\xe4\xe3\x0f\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x59\xeb\x6f\xe3\xc6\x11\xff\xae\xbf\x62\x60\x07\x48\xd2\x96\x92\x7d\x4d\x8a\x80\x80\x3f\x18\xb6\xdb\x04\xb9\xb3\x05\xcb\x09\x1a\xf4\x71\x58\x2d\x87\xe4\x9e\x96\xbb\x9b\xdd\xa1\x6c\x56\xd6\xff\x5e\x2c\x1f\x12\x29\x52\x0f\x5f\x0b\x04\x68\xbf\x18\xd4\xee\xbc\x76\xe6\x37\xb3\x33\xeb\xd5\x0a\x44\x0c\x4a\x13\x8c\xaf\x8d\x98\xa1\x5d\xa2\x1d\xdf\x65\x73\x8c\x22\x8c\xa6\x56\x2f\x45\x84\x16\xd6\xeb\x51\x00\xcc\x88\x9f\xd1\x3a\xa1\x55\x08\xcb\xcb\x11\xc0\x42\xa8\x28\x04\xcf\x23\x38\x8e\x00\x32\x24\x16\x31\x62\xe1\x08\x00\x40\xb2\x39\x4a\x57\x7d\x03\x30\x63\x42\x70\x85\x8a\xd0\x09\x57\xaf\x35\x3f\xc7\x42\x4f\x8e\xed\x53\x61\x30\x04\xa1\x62\xcb\x1c\xd9\x9c\x53\x6e\x71\x80\x8c\xeb\xcc\x68\x85\x8a\xb6\xc2\x02\xcd\x72\x4a\x8d\xd5\x2f\xc5\x68\xb5\x0a\xfc\x71\xc7\xb3\x86\xe5\x3a\x8a\x04\x09\xad\x98\x7c\x5f\x9a\xeb\x4f\xba\x5a\x01\xe9\x5f\x58\x26\x0f\xd1\xbd\x82\x50\x11\x2a\x82\x3f\x55\x2c\x01\xa0\x8a\x9a\xcf\x3d\x2a\xae\x95\xd2\xc4\xfc\x77\xa9\xa7\x74\xcb\x76\x29\x3c\xa2\xb8\xcd\x3d\xa0\x5d\x3a\xdc\x23\x74\x63\x9a\xdf\x53\x2c\xc3\x61\xdf\x00\x38\x83\xbc\x8a\x97\xd1\x96\xea\xd0\x05\xe5\x8f\x10\xbe\xfb\xe6\x9b\x3f\xd6\x0e\x37\x56\x93\xe6\x5a\x86\xf0\x74\x33\xad\xd7\x88\xd9\x04\x69\xda\x25\x75\x28\x91\x93\xb6\xff\x2d\x10\x1c\x89\x6e\x17\xa2\xcc\x18\x37\x69\xe1\xf4\x16\x8d\xd4\x45\x86\x8a\x3a\x50\xfd\xad\xe2\xb5\x0d\xc9\xff\x69\xa2\x9c\x8e\x46\x8b\x46\x0a\xce\x5c\x08\x55\xb5\x4a\xa8\xa5\xf0\xa6\x39\x88\x1b\x3f\x78\xf6\xf1\x63\x4d\x0d\x17\xb0\x5e\xaf\x56\x27\x91\x96\x84\x75\x0a\x5d\xfa\xcf\xad\x89\xbb\x18\xce\x18\xf1\xf4\x7d\x27\x64\xc3\x41\x3b\x1e\xb6\x37\x45\xa4\x24\x27\xcb\x08\x93\xa2\x51\x5c\xc5\xfa\x11\xb9\x45\x46\x55\x94\x09\x33\x23\x19\xe1\xc6\xdc\x5d\xa0\x6b\x7b\x14\xad\x5f\xe1\xaf\x2d\x9a\x19\xf2\xdc\x0a\x2a\x3e\xe8\x08\x25\x9c\x59\x74\x64\x05\x27\x8c\xce\xbe\xae\x5d\x34\x58\x73\x44\x0c\x27\x8a\x69\x49\xf1\xee\xe6\xde\x11\x63\x57\x93\x8f\x99\x34\x29\x1b\x2f\xf2\x39\x5a\x85\x84\xa5\xab\x8c\x8e\x42\xb0\xb9\x22\x91\xe1\x24\xc2\x98\xe5\x92\x1a\x68\xbd\xa9\x04\x7f\x46\x06\x5f\x5e\x0c\x14\xfc\x16\x5e\xfa\x19\xbd\x1f\x20\xa7\x40\xe4\xe4\xec\xfe\xed\x33\xbc\xe7\x9a\xda\xa8\x32\x91\x7b\xfa\x66\x3c\xc5\x28\x97\x42\x25\xe3\x7b\x1d\xe1\xac\xce\xb3\x96\x1b\x55\x6b\xb9\x72\xa6\x17\x62\x99\x4a\x10\xbe\x58\x60\xf1\x07\xf8\x62\xc9\x64\x8e\x10\x5e\xbd\x49\xae\x97\x53\x0a\x80\xf5\xba\xac\x29\xb5\x98\x9a\xa4\x0b\xa4\x3d\x90\x6a\x69\x79\xd2\x12\x6d\x05\x93\x96\x12\xda\xae\x0e\xdf\x14\xc3\x12\xb6\xde\xfc\x0e\x4e\xb6\xe0\x3a\x8e\x85\x12\x54\xb4\xf3\xb1\x5e\x3a\xaa\xbb\xe1\xdd\x13\xc6\x23\x9a\xa7\x56\x68\x9f\xa6\x37\x92\x39\x77\xcf\x32\x6c\x99\x60\x76\xf7\x4a\x5f\x9f\x26\xa6\x6f\xc1\xb3\xa0\x14\x8c\x8e\x9a\x3a\x72\xa3\x15\xe1\x0b\xed\xad\x30\x2d\x43\x5c\x97\xa5\xe3\x92\xfd\xc7\x6e\xb8\xb9\x56\xc4\x84\x42\xdb\x4a\xe8\xa0\xbe\xb9\x76\xf2\xa9\xb4\x71\x43\xff\x19\x96\x9e\x6c\xeb\xbb\x61\x5b\x01\x44\xc6\x12\x0c\xe1\xcb\xc3\x17\xdf\xbd\x56\x4d\x8f\xff\x83\x67\x80\xf5\xfa\xcb\x76\xb5\xb2\x49\xa7\x7a\xf9\x03\x07\x01\xd7\x7a\x21\x30\xf0\x27\xbf\x2a\x0f\xfe\x71\x7b\x33\xb5\xe9\x72\xe3\xc8\x22\xcb\xae\x52\x22\x13\x4e\x26\x9b\xea\xe3\xca\xe9\x62\xc2\x8c\x98\xbc\x99\x29\x63\xc6\xa0\x7d\x03\x5f\xfe\x16\x25\xd1\x72\x12\x2d\xfb\xf4\x24\x5d\xc0\xd1\x52\x10\x0b\x89\x57\x13\x24\x3e\x21\xe9\x26\xc6\x8a\x25\x23\xf4\xdf\x63\x6e\x69\x90\x6d\x81\xc5\x01\xae\x05\xf6\xdd\x66\x98\x73\x01\xe3\x1c\x9d\x0b\x48\x2f\x50\xf5\x28\xdc\x42\x98\xc0\xd4\x33\x59\x30\xcf\x89\xf4\x1e\x22\x1f\x9d\xc0\x62\x82\x2f\x57\x13\xa9\x13\x9d\xf7\x8d\xec\xd1\xfd\xed\x9f\x93\x7f\xfc\xfe\xef\xe3\xaf\x8c\x4a\x5e\x3f\x99\xe4\x15\x35\xbd\xba\x65\xf2\x4a\x14\xbf\x3e\xeb\xb8\xfa\xf3\xee\xeb\xe3\x82\x7c\x7c\x97\x97\x13\xf7\xcc\x92\x04\xed\xf8\x77\x27\x73\x78\x70\xbf\x8c\x53\xca\xe4\xc9\x2c\xdc\xa2\x4f\x08\xc1\xa4\x9b\x70\x26\xe5\x9c\xf1\xc5\xc9\xcc\xcb\x6a\x54\x38\x4e\xcf\xb5\x8a\x45\x32\xfe\xe4\xb4\x1a\x6d\xaf\xa0\x03\xf9\x35\x5b\x08\x73\x9d\x53\xfa\xe8\xf9\xbb\x09\x3e\xac\xc2\xa7\xeb\x9e\x66\xa2\xcf\x62\x2c\xc6\x52\x24\xe9\x40\x50\x9d\x0c\x84\x2a\x6b\x08\x56\x0c\x4b\xb4\x22\xee\x83\x0d\x33\x26\x64\x10\xe9\x8c\x09\x75\xd5\x8f\x90\xcf\x0f\x17\xb0\x28\xb2\xe8\xdc\x55\x58\x0e\x74\xbd\x6b\xa0\x77\xea\x1f\x9c\xcb\xd1\xfe\xf4\xf8\x7e\xc8\xfc\x06\xb7\x57\x5a\x44\xbc\xb7\xeb\x17\x03\x51\xf2\x07\xb9\x95\x57\x87\xcb\x57\x47\xd1\xa1\xd9\xbb\xc7\x79\x53\xd6\xb0\xbb\x17\x23\x2c\x0e\x59\x59\xd7\x38\x2c\x09\x8e\x58\xb1\x2b\xeb\x33\x0c\x79\xc4\xd8\xa2\x4b\x0f\x58\x62\x2b\x8a\x93\x4c\x69\x49\xdb\xb1\xe5\x38\x60\xb9\x36\xe8\x06\x61\xe7\x77\x86\xf0\x79\x92\xdc\xbb\x17\xb2\xec\xda\x26\xb9\x9f\xbb\x07\xe4\x1f\xc1\xfd\x39\xdc\x0a\xc7\xe6\x12\x23\x88\xb5\x05\xa5\x9f\xc3\xa6\x52\xe6\xce\xd7\x40\x64\x16\x6d\x55\x2e\x81\x39\xa0\x54\x38\xb0\xf8\x6b\x2e\x2c\x3a\x40\xaf\x1c\x0c\xda\x4c\x38\x9f\xe8\xf0\x9c\x0a\x9e\x82\x56\xb2\xd8\xd1\xc2\x99\x82\x39\x42\x22\x96\xa8\x60\x5e\x00\x03\x2e\x73\x47\x68\x03\x16\x65\xa2\x5d\x21\xce\xdb\xdf\x70\x2d\x25\xe8\x18\x28\x45\x87\x80\x6a\x09\x4b\x66\x1d\x64\xb9\x23\x2f\x2e\x46\xf2\x2d\x0e\xc4\x56\x67\x9e\x66\xdb\x87\x27\x52\xcf\x99\x0c\xaa\xba\x02\x4c\x45\x1d\xa9\xab\xd5\xa1\x50\x6f\x2b\xde\xcc\x0f\x7e\xb4\x5e\xfb\xa6\xc1\x22\xb9\x8e\x90\xea\xac\x2e\xd5\xb9\x8c\xbc\x31\x52\xb3\x08\xa3\xaa\x29\x03\xd2\xa5\x3d\x42\x39\x62\x52\x96\x9d\x67\x7d\x0e\xd0\xc6\xb7\xa2\xda\xee\x39\x33\xaa\xe5\xb6\x97\xff\xfe\xe9\x69\x3a\xf5\x3d\x40\x27\x6c\x4d\x73\xe4\x77\x3f\x4e\x1f\x1f\xfe\xfa\x4b\xc7\xdb\x65\xaf\x1d\xc2\xd9\x6a\xd5\xe6\x3f\x1b\x68\x38\xfd\xf6\xec\xb0\xfc\xd9\x71\x05\xb3\x03\x1a\xee\xf5\x7e\xf1\xf7\x0f\x87\x65\x6f\x78\xcf\x06\x1b\xb1\x46\xcc\xc3\xf5\x4f\x4f\xdf\xbf\xab\x44\x7d\xbc\x79\x78\xf8\xf1\x87\xbb\x8f\xb3\xbb\x9b\xc7\xbb\xa7\xbe\xe0\x3f\x5b\x9d\x75\xfb\x2e\xa8\x63\xfb\x23\x16\x8f\x18\xef\xee\xf5\x1e\x50\x3a\xb8\xea\x11\x2f\xb0\xa8\xed\xd9\x31\xe4\x7c\x74\x0e\x4f\x3e\x75\x9e\x85\x94\x7e\x56\xf5\xa9\xc8\xa4\x2c\x01\x11\x31\x62\x5b\x08\xb7\x2e\xdc\xda\x34\x9f\x77\x0d\xf4\x47\xe7\x3e\x7b\x62\x61\x1d\xf9\x3e\x78\x89\x96\x84\x4a\x40\x90\x47\x1c\x53\xa0\xe7\x9f\x90\x93\x17\xa4\xfc\x4a\xe1\xdb\xda\xe6\x87\x23\xeb\x47\x01\x78\x4a\x71\x74\xde\x34\xba\xc2\x81\xc8\x8c\xb6\xc4\x54\x29\xc3\x68\x57\x0e\x9f\xa5\x2d\x9b\x84\x73\xba\x49\x2f\x62\x2f\x9e\x87\x6b\x6b\x91\xd3\x78\x74\xee\xfb\xe7\xca\xce\x27\x7d\xa7\x96\x3f\x7b\xf2\xb7\x64\xd7\x9b\x88\x6f\xbd\xab\xbe\xed\x16\xb1\xd6\x53\x6a\x03\x8c\xcd\x84\x30\xdd\x7d\x59\x6d\x07\xd5\xe4\x73\x29\xba\x37\xe5\xd0\xc3\x2b\x94\x0f\x64\x2c\x12\x0a\x9d\x9b\x5a\x3d\xc7\x2e\x4a\xfc\x55\xfe\x17\xa4\x5d\xe8\xf4\x1e\x75\x37\x1b\x8c\xd2\x10\x26\x65\x83\x3f\x49\x91\x49\x4a\xff\xb5\x0b\x49\x9e\xe2\x26\x01\x3b\x7b\x7e\x92\x14\x4c\xde\xa2\x64\xc5\x0c\xb9\x56\x91\x0b\xe1\xf2\xdb\x0e\x0d\x89\x0c\x75\x4e\xdb\xed\x8b\xd6\xb6\xf4\x15\xf8\x7f\xe1\x20\x4b\x2d\xf3\x0c\x3f\xe8\x5c\xed\x46\x3f\xf3\x6b\xd3\xca\xb8\x9d\xd9\x60\x00\x05\x03\x4f\x37\x7e\xbe\xf8\x4f\x9e\xd8\xba\x26\x50\x66\x06\xd4\xfa\xd5\xa1\xaa\x66\xd1\xe9\xdc\x72\xdc\x19\x0f\xa5\xc8\x04\xb9\xdd\xd0\x64\x98\x69\x5b\x84\xf0\xee\xe2\xe2\x83\xe8\xec\xf9\x2b\x1a\xdd\x21\x8e\x16\x83\xab\xfe\xc1\x74\xcd\xb9\x37\xfb\x7e\xc0\x2d\x01\x97\xa2\x7a\xd7\x6f\x3b\x7f\x60\x5e\xdf\xe7\xcd\x16\x24\xca\x44\xee\xda\x55\xad\x35\x2f\x18\x07\x0b\x42\x61\x48\xdf\xe8\x2c\xdb\xdc\xcd\x9f\x1f\xa8\xa0\x15\x8a\xad\x2d\x98\x19\x2a\x6e\x85\x0d\x61\xd5\x7d\x21\x69\xda\xa7\x7f\x07\x00\x00\xff\xff\x14\xa9\x66\x2b\xd1\x1b\x00\x00"),
 		},
 		"/infrastructure/04-syndesis-server.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "04-syndesis-server.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 8389,
+			uncompressedSize: 17237,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x59\x6d\x6f\xdb\x38\xf2\x7f\x9f\x4f\x41\xa8\xf8\x23\xed\x1f\xb1\xdc\xf4\x61\x9b\x15\x10\xdc\xa9\xb6\x9a\xb8\xf1\x83\x56\x52\x7a\x28\xba\x85\xc1\x48\x63\x9b\x35\x45\xea\x48\xca\x59\x5f\x2e\xdf\xfd\x40\x49\x96\x25\x5b\x72\x12\x5c\x17\xc8\x9e\xdf\x24\x26\x39\xcf\xf3\x1b\x72\xc6\x1d\x84\x13\xf2\x05\x84\x24\x9c\x59\x68\x75\x7a\x84\xd0\x92\xb0\xc8\x42\x3e\x88\x15\x09\xe1\x08\xa1\x18\x14\x8e\xb0\xc2\xd6\x11\x42\x08\x51\x7c\x03\x54\xe6\xff\x23\x84\x93\xc4\x42\x72\xcd\x22\x90\x44\x16\x6b\x9b\xaf\x26\xe1\xdd\x87\xf6\xd5\x3a\x01\x0b\x11\x36\x13\x58\x2a\x91\x86\x2a\x15\xd0\x70\x2c\xe4\x71\xc2\x19\x30\xb5\x65\xd6\x91\x20\x56\x20\xb2\xc3\x0c\xc7\xd0\xb4\x23\x13\x08\x73\x4d\x13\x2e\x54\xa1\x74\x27\xfb\x62\xa1\xb3\xd7\x85\xa0\x44\x70\xc5\x43\x4e\x2d\x14\xf4\xdc\x62\x4d\x61\x31\x07\xe5\x16\x07\xcb\xa3\xb9\xa0\x85\x52\x49\xb6\x20\x81\x42\xa8\xb8\xf8\x59\xde\x38\x60\x66\x3d\x4e\x38\x49\x64\xb7\x12\xac\x3e\x24\x94\xaf\x63\x60\x6a\x2f\x5e\x77\x77\x1d\x44\x66\x08\xb3\x08\x99\x76\x42\xfc\x8c\x9d\x39\x88\xf1\x1c\x7c\x25\x00\xc7\x12\x99\x7d\x58\xf9\x69\xa2\xdd\x72\x7f\x9f\x11\x61\xc6\xb8\xc2\x8a\x70\x56\x46\xfa\xc5\xe6\x2f\xf2\x41\x49\x84\x91\x12\x64\x3e\x07\x81\x38\x43\x6a\x41\x24\x8a\x4a\x1d\x90\xe2\x88\xaf\x40\xdc\x0a\xa2\xa0\x24\x53\x0b\x40\x0a\xe2\x84\x62\x05\xa6\x8e\x8c\x49\xb4\x12\xda\xfd\x09\x08\xb5\xce\x54\x4c\x38\xc9\xe8\x6b\x54\x3b\xbe\x40\x39\x9d\xcc\xb4\x37\x77\xd4\xcb\xf6\x4c\x9e\x00\x93\x0b\x32\x53\x59\x8e\xe5\x8a\x4a\x0b\x1d\x7f\xbb\x33\x66\x82\xc7\x86\x75\x67\x68\xc7\x19\x96\x51\x71\x44\x80\xe7\xc6\x89\xa1\x43\x6c\x58\xc6\x8e\x4c\x4b\x6b\x2d\x95\x71\x7f\x62\xcc\x08\xd0\xc8\xc5\x6a\xa1\x4f\x69\x33\xea\x46\x85\x9c\x29\x4c\x18\x08\xf9\xed\x6f\x2f\xff\x6e\x6a\x76\xe7\xe7\xbf\xef\xf2\xfb\xdd\x78\xf5\x3d\xb7\xdf\xb8\xff\x7e\x5c\x04\x0a\x58\x54\xf8\xff\xaf\x09\x32\x01\x09\x25\x21\x96\x16\x3a\x6d\x84\x47\x8c\x55\xb8\x18\xd6\x4c\x6b\x36\xee\x61\xf3\x9e\xa4\xb9\x54\x02\x2b\x98\xaf\x37\x42\x05\x48\x9e\x8a\x10\x2a\x5a\x50\x12\x13\x55\xf9\xae\x51\x14\x73\xb1\xb6\x90\xf1\xe6\xfd\x2f\x23\x62\x94\x3b\x02\xfe\x99\x82\x6c\x3b\xfb\x7a\x7b\x34\xf7\xba\x07\xa1\x00\x5c\xc0\x60\x93\x29\xa5\x43\x6a\x48\xdd\x0f\x7c\xbb\x7f\x1e\xe3\xa1\x47\x27\xc1\x13\xdd\x59\x0d\x79\x1e\xe6\xec\xa6\xb0\xc3\x90\xa7\x4c\x8d\x5b\xd2\x24\xff\x6c\xd1\xb1\xa5\xef\xb4\xa6\xd6\xe6\x03\x6c\x55\x75\xc9\x86\xe0\xb3\xfd\xc5\x9e\xda\xae\x3b\xed\x0f\xbc\xca\x36\x42\x2b\x4c\x53\xb0\x50\x77\x5b\x92\x64\x1b\xf9\xc4\x0d\x06\x93\xb1\xdf\x44\x6e\x74\xfa\x3f\xf0\x0a\x9b\x0c\x94\x99\x08\x98\x81\x18\xb8\xab\x77\xbe\xc2\xe1\xf2\x5c\x89\x14\x50\xa7\x9f\x4a\x10\xe6\x82\xc7\x70\xde\x55\x71\x62\x34\x08\x19\xdb\x23\xc7\x77\xed\x9e\xb3\x2f\xe1\x93\xe0\xb1\x55\x5b\x46\x28\x2b\x2e\x1e\xcc\x76\xd7\x8b\x1d\x5d\x76\xac\x32\x6d\xb2\xe2\x22\x13\x1c\x42\x83\x60\x67\xdc\x77\x27\x83\x71\xe0\x4f\x03\xc7\x0f\xa6\xfe\xb5\xeb\x4e\xbc\x60\xea\x8c\xed\x8f\x43\xa7\xdf\x64\xef\xf1\xdd\x1d\x32\xfd\x4d\x1e\xf4\x36\x49\x20\xcd\xe2\xce\xf8\x04\x58\x27\x8d\x34\x03\x90\xaa\xb8\x2f\xd0\xfd\xfd\x71\x83\xf0\xde\x64\x1c\x78\x93\xe1\xd0\xf1\xfc\xe9\x60\x1c\x38\x17\x9e\xad\xdd\xfc\x53\xa4\xe7\x77\xdd\x80\x29\x98\x8b\xfc\x8a\x6a\x51\xc2\x9d\xf8\xc1\x85\xe7\xf8\xbf\x0d\xa7\xbe\x3d\x72\x87\x4e\xff\xe3\xd4\xb5\x7d\xff\x1f\x13\xaf\x4d\x83\x46\x05\xfa\x58\xe1\x1b\x2c\xc1\xf4\x71\x9c\x50\x88\x6e\x5c\x2c\xe5\x2d\x17\x51\x8b\xed\xc3\x81\x33\x0e\xa6\x7e\x60\x07\xce\xd4\xbe\x0e\x2e\x9d\x71\x30\xe8\xe5\xf6\xdb\xc3\x8b\x89\x37\x08\x2e\x47\x8d\xf9\x76\x19\xe3\xd0\xbf\xb4\x4f\x9b\xf2\xe8\x10\xd7\x2b\xe7\xeb\xe3\xb2\x4b\xea\x62\xa4\xae\x60\xdd\x98\x61\x8d\x28\xec\xe4\x34\x7b\x87\x97\xb0\xb6\x50\x48\x09\x30\xe5\x2b\xac\xc0\x4e\xd5\x02\x98\x22\x61\x16\x92\x2b\x58\x3f\x64\x83\x33\xee\x79\x5f\xdd\x47\x78\xc5\x76\xfc\x6e\xef\x63\xaf\xeb\x5e\xf5\xfc\xf7\x2e\x8e\x22\xc2\xe6\x0f\x7a\xa8\xc2\xfd\x39\x78\xc7\x61\xa1\x58\x27\x8f\xf4\x4c\x30\x68\x4c\x4f\xa3\x31\x2f\xaa\xe8\xca\xc9\x7b\x97\x4e\xef\x2a\x43\x9d\xf7\xc5\x1e\xfe\x57\x50\xab\x80\x2c\x33\xa3\xb7\x80\x70\xa9\x17\xc5\x0a\xd3\x16\xd4\x4d\x5c\x67\xec\x5f\x0e\x3e\x05\xd3\x91\x3d\xb6\x2f\x9c\x91\x36\xec\xda\x1b\x4e\x3f\x4d\xbc\xb7\x7e\xcf\x1e\x36\x54\xc2\x27\xa8\x34\xc2\x0c\xcf\x41\x97\xf4\x6b\x6f\xf8\x89\x8b\xb7\x32\xc4\x14\x32\x5d\x8a\x97\xee\x96\x8d\x1d\x45\x9c\x49\xf3\x33\x86\x39\x08\xd3\x61\xf8\x86\xc2\xe6\x85\x55\x57\xfa\xb3\xed\x5c\x38\xde\x74\x53\x33\xcb\x13\x0f\xb0\xec\x71\x9a\xbf\x70\xae\xbd\x41\x8d\xef\x36\x68\xd5\x9a\x72\x88\xd8\xa8\xc9\x04\x2a\xa1\x85\xa1\x6e\x3e\xac\x6e\xb7\x4c\xc4\x1f\x19\xb7\x4e\xb8\xe1\x66\x9d\xbe\x7b\xf3\xcb\x59\x17\x27\xa4\xab\x04\x0e\x41\xee\x70\x66\x07\x3d\x90\x17\x4a\x6f\x1a\x7c\x75\x1b\xe3\x74\xc0\x9e\xbc\x3a\x8a\x60\x9d\x40\xd5\x9c\x56\x11\xae\xed\xd9\xcd\x88\x7f\x50\x86\x8b\x05\x8e\xb5\x90\xad\x45\x6d\x91\xea\xe1\x18\xe8\x55\x63\xf0\x5f\xa0\x11\x16\x4b\x10\x48\x2d\xb0\x42\x21\x4e\x25\xe8\xbe\x46\x40\xa5\x93\xe1\xb3\x5a\x07\x82\x72\xd8\x9f\x20\xc9\x73\x2a\xbd\xc9\xe0\x56\xbf\x6b\x66\x64\x9e\xe6\x58\x41\x44\xea\x67\x1b\x25\x10\x35\x61\xdd\x1e\x39\xc3\xe9\xd5\xa1\xbb\xd0\xd0\xcf\x8b\xc7\x58\x77\xc5\xb0\x22\x2b\x78\x4e\xe6\x5d\x8d\xed\x60\xf0\xc5\x79\xa2\x79\xda\xb8\xbd\x2e\x74\x37\x7d\xbe\xd8\xd3\xbe\xf3\xf1\xfa\xe2\x20\xcf\xdd\x04\xcf\x9a\xac\x87\x0b\x4c\xd6\x07\x16\x65\xe4\x80\x32\x19\x37\x37\xa5\xd4\xe5\x94\x84\x6b\x0b\xd9\xf4\x16\xaf\xa5\x96\xbb\x0b\xd9\xbd\xa3\x83\xd9\x98\x2b\x57\x80\xd4\x6d\xfa\xbe\xa2\x94\xac\x80\x81\x94\xae\xe0\x37\x50\xbf\x7f\x34\xe6\x2f\x40\xed\x5e\x4a\xc9\xee\x78\xa2\xdc\xc8\x9e\x89\x59\x0d\x58\x9d\x76\x57\xf9\xd4\x60\xe7\x8c\xe6\x79\x09\x38\xaa\x3d\xc5\xeb\x1e\xb7\xc3\x10\x92\xfd\xcb\x6d\x53\xb2\x15\xfc\xa1\xba\x09\xc5\x84\x1d\xd7\xce\x10\x46\x14\xc1\xb4\x0f\x14\xaf\x7d\x08\x39\x8b\xa4\x85\xde\xbe\xae\x2b\x99\x80\x20\x3c\x2a\xb7\xdf\xd4\x77\x67\x98\xd0\x54\x40\xb0\x10\x20\x17\x9c\x46\x16\x7a\x5f\xd9\x17\x80\x23\xf2\x44\x57\x65\x1e\x31\xba\x0b\xc0\x54\x2d\x8c\x66\x47\x9e\x9e\x9d\x3e\x6c\xc8\x69\x55\xd3\xca\x58\x69\xe3\xba\xb2\xc3\xd9\x1b\x1e\xe5\x9f\x9d\x11\x52\x1b\xd9\xae\x2e\x39\x59\x0c\x4a\x90\x50\x1e\xa2\xfc\xf5\xc3\x87\x5f\x1b\x28\x13\xc1\x63\x50\x0b\x48\x0f\x12\x9f\x7d\xf8\x70\xd6\x40\xfc\x83\x53\xbe\x24\xb8\xb2\x73\xcb\xc5\x92\xb0\x79\x9f\x88\xd6\x36\x6b\xc5\x69\x1a\xc3\x48\xf7\x84\xb2\xa9\x7b\xcb\x6b\x4a\x27\x3f\x56\x13\x1a\x6b\x9a\xbc\xd5\xa9\xf2\xee\xe6\x14\xb5\x12\xe7\x83\x42\xbf\x71\x1f\x85\x14\x4b\x89\x14\x47\xc6\x45\x8a\x05\x66\x0a\x20\x32\xd0\xcb\xbc\xad\x47\xe7\xe7\x65\xdb\xfe\xaa\x46\x1e\x64\x83\x2b\x0e\x92\x1d\xab\xcc\x26\xc4\x19\x9a\xf8\x13\x84\xa5\xae\x7d\x02\xb2\x5a\x87\x66\xe4\x0f\x88\x50\x56\xfd\x6a\xe4\x33\xc1\xe3\x7c\x74\xa0\x45\x6f\xc6\x0a\xe8\xe5\xd9\xeb\xff\x43\x61\x2a\x04\x30\x45\xd7\xaf\x4c\x74\xbc\x91\x7e\xac\xf9\x91\x39\xe3\x02\xa2\x5c\x40\x2d\xad\xf7\xc6\x12\xa8\x71\x34\x81\x2a\x23\x87\x87\xea\x9a\xb7\x61\x6a\x8e\x32\x8a\x9d\x57\x9b\xfe\x84\x49\x6a\xa1\x0f\xef\x5f\xc7\xb5\xf5\xa6\x39\x47\x55\x70\x36\x16\x69\xe2\xf4\xae\xca\x29\x8f\x6e\x43\xb3\xdf\x16\xfd\x7c\x7d\x84\x13\xab\x21\x0f\x77\x5f\xdf\x45\x42\x1c\x55\x67\x9c\xdb\xc2\xdd\x36\xef\xbc\xbf\xdf\x99\xa6\x36\xcc\x0c\x2b\xb3\xd5\x0a\xe9\xde\x70\xb5\x7d\x70\xf1\xfc\x26\x78\xdb\xa1\x8d\xc2\xf3\x72\x16\x9e\x5b\x60\x14\xe3\xcd\x82\xe7\xac\xd6\x1d\xed\xb9\x21\xc0\x5b\x10\xe6\xf4\xc7\xcd\xe3\xd2\xe3\xf2\x9a\x3b\x7a\x91\xc1\x0b\x0b\x9e\xb2\x08\x85\xfa\x51\xd6\x59\x96\xe5\xac\xf5\x47\x88\xde\x26\x1b\xf6\x3c\xdf\x30\xa1\x26\xdc\xdc\xa8\xd1\x4d\x93\xb9\xc0\x11\x74\x62\x1e\x81\x85\x96\x00\xc9\x33\x8d\xca\x5e\x0e\x6d\x0b\x75\x07\xcf\x81\xa9\x4e\x59\xf5\xb6\xc6\x57\xce\xe4\xbb\xe6\x3a\xa6\x16\xfa\x77\xe7\xa8\x65\x84\xe1\x96\x04\xa6\x97\x52\xd0\x10\x78\xe4\x8b\x19\xdd\xdf\x1f\xbd\x40\x7e\x60\x7b\x81\x95\xbd\x5c\x3b\x57\x47\x9d\x22\x3a\x1e\xa7\xda\xde\x6a\xec\xc4\x0d\x0e\x4d\x9c\xaa\x05\x17\xe4\x5f\x59\x78\xcc\xe5\x99\x2c\x11\xd5\x84\x9e\x22\x19\x9e\x69\x7c\x84\x76\x97\x56\x28\xcb\xd1\x0b\xc1\xd3\xa4\xc4\x4e\xa6\xb9\x89\x13\x1c\x2e\xc0\xe4\x22\x07\xc5\x4e\x15\xef\x20\xe3\xff\x73\x58\xad\x40\xdc\x48\x0b\x7d\x43\x73\x50\x27\x88\x12\xa9\x4e\x50\x3e\x1b\x3e\x41\x69\x12\x65\x7f\x23\xa0\xb0\xfd\x5b\xf4\x74\x84\xb3\x13\x74\x8b\x55\xb8\xf8\x5e\x73\xfd\x47\xc2\x22\xc2\xe6\xff\xeb\x11\x90\xe9\xcd\x0f\x08\x55\x11\x84\xda\x8f\x93\xc5\xc8\xf9\x60\x29\x16\x9c\x42\x39\xd0\xa9\xe5\x6d\x93\xf9\x9b\x18\x1f\xf0\xe3\x4f\x4e\xff\x52\xe3\x65\xde\xcb\x75\x44\xf6\x28\x7f\xa6\xc1\x68\x87\x83\x5e\xca\x7e\x09\x60\x73\xb3\x30\xc5\x8c\x60\xd5\x84\x89\xf2\x68\x08\xb2\x15\x1a\x45\xc2\xb7\x48\x82\x15\x30\xf5\x48\x51\xe1\x02\x33\x06\xf4\x41\x51\x7f\x0a\xb6\xfe\x5a\xe1\xfd\xd3\xb1\x76\xc8\x1d\x8f\xc1\xde\x0b\xe4\x8c\xfb\xe5\x45\xb4\x79\x5d\xfc\x27\x00\x00\xff\xff\x64\x95\x86\x86\xc5\x20\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\xfb\x73\x9b\x48\x9e\xff\xdd\x7f\x45\x17\xd9\x2b\x27\x57\x16\x4a\x32\x93\x49\x56\x55\xa9\x3b\x22\x11\x5b\x63\xbd\x16\x48\x6e\x53\xb3\x53\xaa\x36\x7c\x25\xf5\x18\xba\xd9\xee\x46\x8e\xce\xab\xff\xfd\xaa\x79\x09\x10\x20\xec\x71\x2a\xb9\xab\xcb\x2f\x56\xa0\xbf\xef\x47\x7f\xfa\x41\x0f\xe1\x90\x7c\x06\x2e\x08\xa3\x03\xb4\x7d\x75\x86\xd0\x2d\xa1\xde\x00\xd9\xc0\xb7\xc4\x85\x33\x84\x02\x90\xd8\xc3\x12\x0f\xce\xee\xef\x7b\x88\xac\x10\xe3\x48\x37\x42\xa2\x46\x00\xd7\x2d\x16\x49\x10\x48\xb7\x77\xd4\x03\x41\x84\x6e\x78\x1e\x91\x84\x51\xec\x1b\x94\x32\x89\xd5\x6f\x81\xf6\xfb\x33\x84\x10\xc2\x87\x47\x39\xbf\x63\x66\xe9\x60\x84\x9e\x65\x7f\xd1\x81\x17\x5a\x31\x8e\x70\x24\x59\x6f\x0d\x14\x38\x96\x84\xae\x91\xdc\x00\x92\xbe\x40\x2e\x70\x49\x56\xc4\xc5\x12\x50\x24\xc0\x8b\x07\x13\x2a\x81\x53\xec\x5f\x20\x91\x58\xd5\x93\xac\x97\xfe\x44\x92\xe3\xd5\x8a\xb8\x15\x81\xe9\x5b\x1d\xfb\xe1\x06\xeb\x2c\x04\x2a\x36\x64\x25\x75\xc2\xfa\xf1\x2b\xba\xee\x29\x51\x3d\x01\x2e\x07\xd9\xa3\x38\x80\x01\xba\xbf\xd7\xc7\xa9\xa8\x21\x70\x29\xf4\xc4\x2a\x3b\x1e\xb3\xdf\xc7\xf6\x02\xf5\x50\xfa\x53\x99\x7e\xda\x6b\xf7\xf7\x48\xb2\x2f\x38\xf0\x4f\x0e\xfe\x17\x22\xd4\x03\x2a\xd1\x2f\xe8\x58\x58\xfa\x53\xd9\xe6\xe3\x1b\xf0\xc5\x20\xb5\x14\x87\xe1\x00\x89\x94\x73\x66\x7d\x26\x88\xb0\xfe\xa9\xf7\x72\x17\xc2\x00\x11\xba\xe2\x58\x48\x1e\xb9\x32\xe2\x50\x33\xcc\x65\x41\xc8\x28\x50\x79\x60\x16\x87\x00\x78\x9b\x2f\x26\xb1\xaa\xa7\xdd\x90\x8e\x6b\xf4\x80\xd2\x26\x89\x51\x55\x38\x42\x22\x04\x37\x71\x46\xc8\xb8\x4c\xfd\xd2\x8b\xff\x33\x40\xef\x5e\xa6\xb6\x84\x9c\x49\xe6\x32\x7f\x80\x9c\xe1\x22\x7d\x26\x31\x5f\x83\x5c\xa4\x03\xf3\xa1\x89\xa0\x8d\x94\xe1\x59\x92\x4a\x3e\xb8\x92\xf1\xa7\x72\x78\x8b\x27\xcb\xd5\x8c\xc3\x50\xf4\x0b\x25\x3d\x82\xd0\x67\xbb\x00\xa8\x2c\x55\xb5\xe2\x7f\xa8\xec\xe7\x98\x7a\xc5\x8a\x1c\x07\x78\x0d\xb6\xe4\x80\x03\x81\xf4\x11\x6c\xed\x28\x54\xae\x79\x71\x2a\x1d\xeb\x0a\xbe\x20\xaa\xa3\x98\x9a\x4e\x60\x83\x14\x08\x23\xc9\xc9\x7a\x0d\x1c\x31\x8a\xe4\x86\x08\xe4\xe5\xd6\x21\xc9\x10\xdb\x02\xbf\xe3\x44\x42\x4e\x16\x77\x08\x08\x42\x1f\x4b\xd0\x55\xcc\x75\xa2\x64\xaa\xc0\x86\xc0\xe5\x2e\xd6\x28\x64\x24\xa6\x2f\x51\x55\xbc\x8c\x12\x3a\x11\x2b\xab\x57\xd4\x8b\xdf\x95\xfb\x45\xaa\xa8\x18\xa0\xf3\xdf\xee\xb5\x15\x67\x81\x36\xb8\xd7\x54\x48\xb4\x81\x56\xb0\xdb\xc1\x6b\xed\x42\x53\xc9\xa3\x0d\xb4\x8a\xcc\x81\xd2\x5a\x48\x6d\x7f\xa1\xad\x08\xf8\xde\x02\xcb\x8d\x1a\xa5\xcc\x28\x1b\xe5\x32\x2a\x31\xa1\xc0\xc5\x6f\xff\xf1\xfc\x3f\x75\xc5\xee\xfd\xfb\x7f\x54\xf9\xfd\x43\x7b\xf1\x7b\x62\xbf\xb6\xff\xfd\x3c\x8d\x0b\x50\x2f\x75\xf7\xb7\xef\x4e\x99\x94\x42\x7d\x16\x15\xf8\xff\x16\xd5\xd0\xa2\x38\x84\x3e\x71\xb1\x50\x93\x8d\xd2\x68\x2d\x0b\xc2\x86\x99\x01\xd9\xcc\xa3\x5b\xe9\x70\xf4\x12\xed\xf7\xf7\xf7\xdd\xc6\xc6\x23\xc1\x17\x80\xf6\xfb\x57\xea\xe7\x41\xc1\x6a\x2f\x0b\xb0\x74\x37\x93\x52\xb0\xea\xc3\x75\x3a\x60\x9d\x63\x11\x0f\x95\x1c\x4b\x58\xef\x32\xa1\x1c\x04\x8b\xb8\x0b\x05\x2d\x7c\x12\x10\x59\xf8\xbf\x6a\x79\x01\xe3\xbb\x01\xd2\x5e\xbf\xf9\x65\x4a\xb4\xfc\x0d\x87\x7f\x46\x20\x9a\xc6\xbe\x3c\x0c\x4d\xf2\xc8\x52\xd3\x39\x4e\x3b\x4b\x56\x7c\xb9\x43\xea\xc0\x52\x19\x14\x5c\xa6\xa8\x85\xd1\x93\x35\xf3\x1c\xfe\x59\x18\x63\x83\x1b\x71\x22\x77\x53\xe6\x81\x8f\x34\x0e\x42\x72\xe2\x4a\xf0\xb4\x17\x79\x9b\x6c\x40\x58\x8d\x1a\x14\x08\x55\xbb\xfb\xc8\x94\x13\x11\x46\x9c\xf9\xbe\xc2\x55\x4a\x08\xe6\x12\xdd\x6d\x80\xc6\xcd\x50\x35\x4b\x2c\x19\x47\x5c\x49\x01\x11\x3f\xcc\x00\x16\x4a\xb1\x51\x09\x86\xdd\x80\xcf\xee\x0a\x52\x4a\x61\x56\xfa\xe4\x38\x4e\x4d\x5a\xda\x11\x88\x3a\x68\xbb\xdf\x6b\x35\x18\xaa\xa3\x8f\x4a\x96\x0a\x70\x55\x86\xe9\x22\x1d\x9e\xe2\xbc\xdb\xe8\x06\x38\x05\x09\xb1\x72\x21\xf3\x06\x88\x47\x54\x92\x00\xfa\x1e\xac\x70\xe4\xcb\x4c\xfe\xb7\x86\x70\xaf\x5e\xb6\x63\xb8\xe3\x26\xd9\x5c\x79\x5d\x6a\xaf\x73\xc3\xfc\xbe\x4d\xf3\xc8\x2d\xa9\x42\x79\x7f\x44\x07\xec\x6e\xb8\x2e\x8b\xa8\x9c\xd5\xf6\xd4\x23\xdd\x6c\x77\x03\x5e\xa4\x72\x5e\x9f\x31\x0f\xec\xb4\xd1\x15\xdc\x4d\x0b\x8f\x0f\x50\x86\x63\xba\x06\xf4\x97\x5b\xd8\x5d\xa0\xbf\x6c\xb1\x1f\x01\x1a\xbc\x7f\x10\x5f\xc5\x27\x66\x80\xf6\xfb\xb8\xad\xa7\x6c\xca\xb3\xe4\xc1\xec\xba\xd4\x2b\x48\x71\x98\x9f\x96\x8b\x28\x08\x91\x87\xa7\x83\x5a\x9f\xd7\x73\x38\x78\xfe\x1d\xea\xac\x81\xb1\x5a\x11\x4a\xe4\xae\xd8\x94\xd2\x47\x27\x65\x67\xb4\x0d\x21\x3f\x21\x79\xc1\x09\x53\xe5\x3c\xf4\xb1\x10\x2a\xf0\x05\x15\xc2\xea\xbb\xd8\xd7\xdd\xd8\x1c\x6b\x70\x47\xe4\x06\x85\xcc\xcb\xfa\xcd\x90\x51\x09\x5f\x65\x63\x27\x2a\x28\x22\xca\x24\x25\x97\x34\x9b\x9d\x51\x1f\xf0\xdd\x21\xe1\x7b\x0d\xc0\x21\x57\x34\x27\x7a\x84\xba\x9d\x15\x7e\x5d\xaf\x30\x42\x40\xb7\xc5\x26\x95\x69\xfb\xab\xf1\xd9\x58\x1a\x8b\xc5\x72\x34\xb6\x0a\xaf\x11\x8a\xd3\x7f\x80\xfa\x07\x44\x2f\x9a\xc8\xe7\x0b\x67\x3c\x9f\xd9\x75\xe4\x5a\x6f\xf4\x07\xde\x62\x9d\x82\xd4\x43\x0e\x2b\xe0\xe3\xc5\xf6\x67\x5b\x62\xf7\xf6\xbd\xe4\x11\xa0\xde\x28\x12\xc0\xf5\x0d\x0b\xe0\x7d\x5f\x06\xa1\x56\x23\x64\x66\x4c\x4d\x7b\x61\x0c\xcd\x63\x09\x1f\x39\x0b\x06\xa5\xc7\x08\xc5\xd8\xdc\x82\x55\xf5\x79\xfa\x46\xa1\xf6\x41\x0e\x11\x62\x6c\x2e\x42\xec\xc2\x71\x4e\x1f\xa3\xb3\x43\x4f\x1c\x1a\x25\xef\x66\xaa\x0e\xe7\x33\xc7\x18\xcf\x4c\x6b\x39\x34\x96\x43\xd3\x72\xc6\x1f\xc7\x43\xc3\x31\xeb\x9d\x73\x7f\x8f\x5c\xac\xe6\x57\xa5\x93\x40\x5a\x1f\xa4\xdb\xcf\xf2\xa7\x8f\x73\x61\x3d\x17\x6b\x9d\xf5\x52\xfc\x46\x58\x62\x54\x98\xa8\x0b\x15\x7b\xe5\x38\x8b\x05\x67\x5f\x77\xb5\xfa\xab\xb7\xcb\x85\x35\xff\xfb\x97\x06\x85\x8b\xf4\x4d\xec\xed\x76\xfe\xf6\x69\x01\x76\x8b\x84\x19\x6b\x66\x3f\x9b\xb7\xf3\xce\x69\xb5\xda\x1a\xc9\xd8\x98\xb3\xd1\x62\x3e\x9e\x39\xf6\xd2\x31\x6d\x67\x69\x7f\x5a\x2c\xe6\x96\xb3\x34\x67\xc6\x87\x89\x39\xaa\x63\x7e\x7e\x02\xd6\x7f\x04\xac\xa6\x6f\xa1\x3b\x20\x64\xba\xaa\x46\xfb\xfd\x79\x43\x0a\x59\xf3\xc9\xc4\xb4\xec\xe5\x78\xe6\x98\x97\x96\xa1\xca\xeb\x49\xa4\x27\x9b\x0f\x0a\xd9\xad\x79\x8e\x8c\xea\x94\x58\xcc\x6d\xe7\xd2\x32\xed\xbf\x4d\x96\xb6\x31\x5d\x4c\xcc\xd1\x87\xe5\xc2\xb0\xed\xff\x9a\x5b\x4d\x1a\xd4\x2a\xa0\x12\xf1\x06\x0b\xd0\x6d\x1c\x84\x3e\x78\x37\x0b\x2c\xc4\x1d\xe3\x9e\x12\xdb\x56\x70\x39\xa1\xf9\x35\x81\xa1\xa3\x9b\xb1\x31\x35\x22\xb9\xa9\x8d\xd8\xc8\x70\x8c\x0f\x86\x6d\x2e\xc7\xc6\x74\x69\x7c\x72\xae\xda\xdc\xa5\xa9\xce\xd3\x9e\x01\xc3\xc9\xd8\x9c\x39\x4b\xdb\x31\x1c\x33\xe6\x67\xce\x1c\x55\xc8\x2a\x10\xc6\xe4\x72\x6e\x8d\x9d\xab\x69\x53\x4d\x9f\x68\x22\x43\x9f\x00\x95\xb6\x02\xee\xca\x1e\xa0\x52\x41\x74\xc2\xa8\xe1\xaf\xd5\x8c\xb7\x09\x4e\x2f\x13\xbb\xf2\x88\x17\x90\x25\x7d\x3e\x8e\x17\xb6\x9a\x5e\xd0\x7e\x7f\x15\x60\xd7\xbe\x32\x5e\xbf\xf9\xe5\xb0\xd4\x4c\x9f\x1d\x56\x9c\x75\x0d\xb9\xcd\x3b\xd7\x66\x4d\xf5\xd5\xb5\xe9\x64\xd3\xf6\x1a\x76\xb5\xad\xba\x76\x2e\x4d\x37\x7a\x8f\x06\xdf\xc2\x6e\x80\xdc\x26\x97\x5c\xc3\xee\x94\x0d\xe6\x6c\x68\x7d\x59\x3c\x75\x74\x4d\xea\xf2\x5d\xf8\xf8\xc8\x36\xd2\xa7\xb1\x32\x4c\xbb\x3f\xfc\x30\xec\x2f\xae\x87\xf6\x9b\x85\x9a\x2e\xe8\xba\x7b\xdc\x0a\x36\xff\x08\x31\x3b\x18\xdb\x21\x5e\xce\xb8\xbe\xb0\x5f\xd5\x59\x5d\x6c\xa2\x09\xf9\xf0\xca\x1c\x5e\xc7\xcd\xd5\xfa\x6c\x4c\xfe\x54\x47\x2d\xf4\xd2\xd8\x8c\xe1\x06\xdc\xdb\x78\xe9\xbc\xc5\x3e\xaa\xed\x72\x86\xe7\x31\x2a\x74\x67\xc3\x01\x6c\x17\xfb\xa0\x9b\x14\xdf\xf8\x50\xdf\x89\xe6\x0b\x73\x66\x5f\x8d\x3f\x3a\xcb\xa9\x31\x33\x2e\xcd\xa9\xf2\xc2\x27\x6b\xb2\xfc\x38\xb7\x7e\xb2\x87\xc6\xa4\x06\x15\x55\xf5\x3f\x96\x38\xc5\x14\xaf\x41\xa1\xba\x4f\xd6\xa4\x61\x0e\x70\xae\x2c\xd3\x8c\x25\xa8\x1a\x9f\x2f\x6d\xd3\xfa\x3c\x1e\x9a\xcb\xd1\xd8\x1e\xce\x3f\x9b\xd6\x97\xee\x53\xd2\xb1\x02\x46\x24\x59\x7a\xba\x35\x22\xc2\x65\x5b\xe0\xbb\xae\xee\x32\x5c\x17\x84\x70\xd8\x2d\xd0\xfc\x54\xa7\x5d\xfd\xe1\xd0\xb4\xed\xa5\x33\xbf\x36\x67\x4f\x97\xe8\xc5\x29\xef\xa1\x4a\x16\x6a\x00\xc7\x03\x7b\x52\x8d\x3c\xeb\xb4\xce\x4c\x85\xfd\x8a\x61\x0d\xbc\x35\x79\x7e\x35\xcc\x4b\xd3\x5a\x66\x78\x26\x1f\x71\x82\xe5\x90\xf9\xc9\x02\xf9\x93\x35\xae\xa8\x5d\x00\x53\x9d\x88\xb5\x92\x4c\xd5\xb8\x1a\x18\x6e\xa4\x0c\x07\xfd\x1c\xfd\xf6\xfe\x88\xb9\xf5\xdc\x8c\xdb\xe0\xd5\xcf\xaf\x7f\x79\xd7\xc7\x21\xe9\x4b\x8e\x5d\x10\x15\xce\x0d\x13\x79\xea\x81\x04\xc4\x58\x4b\xe7\xcb\xa2\xb6\x5e\x5a\xec\x49\x90\x0b\x77\x76\x21\xd4\x77\xd5\x8a\x88\x85\x61\x19\x4d\x93\xc7\x09\x19\x0b\xcc\x71\x90\x81\xd3\xea\xa6\x5a\x99\x42\x4d\x71\x4a\x25\xa4\xdd\x00\xe6\xc0\xb5\x36\xeb\x63\x40\xf4\xed\xb3\x3f\x4b\x00\x0e\x6a\x2d\x4a\xb0\x2f\xda\x33\xbf\x90\xf2\x29\x4c\xe9\x66\x30\x16\xc4\x6d\xb5\xf7\x93\x6d\xd6\x2c\x66\xbf\xa3\xa5\x6a\x8d\xab\x98\x35\xab\xdc\x0c\xae\xbf\xa3\xda\x61\x0a\xd6\xbb\x37\xa3\xe1\x61\xcb\xb9\xa5\x35\xa7\x36\x5b\xa6\x5a\x56\xa9\xaa\x9c\xd4\xaf\x8f\x13\x9c\xde\x89\x7e\x39\x34\x6a\x59\x94\x97\xd4\x69\x53\x91\xbe\xe8\xbb\x58\x77\xb9\xac\x2e\x30\xdb\x8d\x1c\xe2\x00\xfc\xeb\xda\x8e\xfb\x0c\x4d\x31\xbf\x05\x8e\xe4\x06\x4b\xe4\xe2\x48\x24\x3b\xf8\x50\x38\x11\x65\xab\xd2\x49\x26\x4a\x00\xd2\x05\x12\x2c\xa1\x52\x2f\x29\xdc\x21\x97\xd1\x15\x59\x47\xe9\xe1\x00\x11\x08\x87\xa1\x4f\xc0\xab\x43\x45\xc6\xd4\x9c\x2c\xaf\x1f\xb4\xda\x69\xb2\xee\x9a\x62\x49\xb6\xf5\x68\xe4\x3b\x99\x77\x3d\x33\x9c\xf1\x67\xf3\x81\xe6\x29\xe3\x6a\x0e\xaf\x51\x75\xdf\x6a\x64\x7e\xf8\x74\xd9\xca\xb3\x3a\xab\xc4\x87\xb5\xa7\xd1\x61\x7c\x9e\x5c\x41\x55\x31\xe9\x22\xf2\xfd\x05\xf3\x89\xbb\x4b\x8e\x0e\xcb\xcf\x4e\xf3\xac\x1d\x7b\xf4\xe6\x60\x7b\x79\x0b\xd1\x27\x5b\xa0\x20\xc4\x82\xb3\x1b\x28\xb7\x0d\x35\xfb\x5e\x82\xac\xf6\x92\xb0\x7a\xab\x22\x7f\x11\x6f\xa2\xc5\xb3\xf1\xf6\x55\x7f\x9b\x5c\x76\xa8\x8c\x51\x3c\xaf\x00\x7b\xa5\x5d\xd2\x72\x18\x14\x48\x0a\x8f\xd7\x06\x19\x86\x94\xf0\x55\xf6\x43\x1f\x13\x7a\x5e\x1a\x43\x28\x51\xdd\x6b\x04\x3e\xde\xd9\xe0\x32\xea\x75\x3d\x8a\x8d\x4d\x17\xfa\x24\xf5\xc4\xf8\x98\x51\x97\x43\xda\x0e\x5c\x8a\xeb\xb4\x9f\x5e\xbe\x2c\x1f\xe0\xe6\x5e\x04\x4e\xe2\xdd\xeb\xc7\x5b\xb0\x28\xb2\x78\x84\xee\x65\xfa\xa2\xd6\xaf\x1b\x94\x5e\x61\xe2\x47\x1c\x14\xd4\x15\x1b\xe6\x7b\x8f\xd3\xfb\x63\x85\xcb\x23\x54\x3f\x62\x51\xd4\xfe\x4d\x9d\xf2\x1c\xb0\x47\x1e\x58\x01\x71\xa2\x6b\xfd\x0d\x60\x5f\x6e\xb4\xfa\xfa\x78\xf5\xee\xd5\xd3\xe6\xa7\x95\x29\xfa\xe7\x12\xb4\x95\x4d\xe9\x82\x41\x6d\xac\x0b\x37\xb1\xb2\xb2\xcd\xcf\x30\x8e\xee\x5b\x25\xff\x2a\xb7\xae\x9a\xc8\xaa\x0e\x4b\xc8\x02\x90\x9c\xb8\xa2\x8d\xf2\xaf\x6f\xdf\xfe\xb5\x86\x32\xe4\x2c\x00\xb9\x81\xa8\x95\xf8\xdd\xdb\xb7\xef\x6a\x88\xff\x60\x3e\xbb\x25\xb8\xf0\xe6\x8e\xf1\x5b\x42\xd7\x23\xc2\x1b\x0f\x40\xb6\xcc\x8f\x02\x98\xb2\x88\x56\x5d\x94\x30\x4d\x26\xb9\x5e\x32\xac\x24\x34\x50\x34\xc9\x21\x44\x91\x77\x3f\xa1\x78\xe8\xa6\x68\x37\xc0\x05\xe9\xf8\x9e\x77\xa3\xc0\x4f\xa3\x3e\x25\xb0\xd4\x46\xa4\x2a\x69\x4e\x7d\x05\xe2\x79\x04\x4f\x8d\x0f\x0f\x30\xad\x9b\xa6\x0d\xe3\x4f\x29\xc9\x38\x7a\x4e\x99\x3c\xbe\xfa\x5a\xbc\x56\x57\xd0\x58\xe8\xd3\x48\x46\xd8\x77\x26\x76\xad\xda\xd9\x05\x8c\xee\x8a\x37\x52\x74\xf7\xef\x23\x0e\xa9\x4a\x07\x4b\xdd\x14\x6d\x26\x79\x70\x26\x4c\xd8\x7a\x4d\xe8\xba\x75\xdf\x22\xdd\x3a\xf4\xd9\xba\x51\xbd\x2d\xe6\x7d\x9f\xad\x73\x15\x8f\x25\x3f\xe6\x4a\x4a\xa6\x80\x0c\xc2\x46\xc9\xea\x5d\xdd\x96\x43\x7c\x29\x12\xfd\x8d\xd9\xc8\xf5\xb1\x10\x48\x32\xa4\x5d\x46\x98\x63\x2a\x01\x3c\x0d\x3d\x4f\x2e\x43\xa1\xf7\xef\xf3\xcb\x4e\x2f\x4a\xe4\x4e\x7c\x83\x92\x81\xa0\xe7\x32\xee\x41\x88\x51\x34\xb7\xe7\x08\xc7\x17\x7c\x38\xc4\x60\x19\xad\xc8\x57\xf0\x50\x0c\x9f\x4b\xe4\x2b\xce\x82\xe4\xc2\x95\x12\x9d\x5d\xc6\x42\xcf\xdf\xbd\xfc\x37\xe4\x46\x9c\x03\x95\xfe\xee\x85\x8e\xce\x33\xe9\xe7\x8a\x1f\x59\x53\xc6\xc1\x4b\x04\x94\xe6\xca\xa3\xcb\x5c\xa8\xf6\x42\x17\x2a\x5c\xd4\x3a\x05\x8c\xad\x8c\xa9\x3e\x8d\x29\x2a\x18\x59\xfd\x73\xc3\x68\x80\xde\xbe\x79\x19\x54\xb2\xec\xf8\x76\x58\x51\x70\x7c\x99\xac\x8e\xd3\xcf\x8a\xd3\x03\x7b\x6a\x7c\x46\x58\x93\x9f\x75\xdd\x34\x54\x63\x1f\xb0\x42\x68\x14\xf7\xa4\x6b\x86\x5c\xca\xa3\x57\x0d\x8f\x72\x99\xc1\xd7\xa2\x54\x12\x98\xaf\x1b\x6e\xba\x74\x65\x77\xfa\x2e\x46\x17\x9c\xd2\x8e\xbd\x1a\x15\x50\xc4\xad\x70\xab\x9d\xb2\x84\x47\x7f\xfe\xe9\x75\x3d\x9e\x4e\x72\x2a\x4f\xa5\x3f\xd3\x40\xb3\x04\xf5\xd9\xba\xb7\x62\xfc\x0e\x73\x2f\xbd\xaa\xd9\x92\x9c\x15\xae\x8f\xcd\x43\x4d\x7b\xcc\x92\xb4\xe1\x56\xca\x64\x7e\x79\x39\x9e\x5d\x36\x6e\xd7\xb6\x19\x10\x6f\x12\xd6\x36\xf5\x8c\xeb\xd1\x76\x78\x47\xce\x26\xf5\x92\xab\xe9\x1d\x82\x33\xa6\x1e\x7c\x6d\xd5\x62\x3c\x1b\x99\x7f\x7f\xa8\x0a\x31\xdb\xea\x45\xa8\x74\x3b\xd0\x61\x26\xdd\x7e\xc6\x5c\x34\x93\x1f\xed\xfc\x3d\x60\x68\x7c\xa7\xe4\x4d\xd9\xb3\xa7\x50\xf0\x63\xe6\xf1\xe2\xc0\x46\x70\x51\x56\xa0\xe6\x16\x56\x13\xfe\x4e\x9e\x4f\x71\x38\xa8\x29\xc2\xea\xd1\x65\x13\x24\xef\x5c\x88\xb5\xf6\x43\x10\xca\x5d\xbc\xb6\xb8\x6f\xdd\x74\x7c\x3c\xf0\x3f\x0d\xfb\x93\x8c\x29\xfb\x20\x79\x36\x3b\xda\x3c\x7e\xb8\x1e\x4f\xb2\x1c\x68\x5d\x0c\x3c\x44\xff\xd3\x22\x9f\x02\x5e\x9f\x02\xd7\xad\x89\xd7\xe0\xee\x7a\x89\xdf\x68\x1d\x73\x62\x15\xd3\xc1\xe3\x5d\x3e\xbb\xfb\x33\xf0\xbc\x1e\x9c\x37\x94\xd3\x59\xf1\x13\xa7\xc2\xf4\xd3\xf0\xb9\xd3\x7e\x5f\xf9\x6e\xab\xe6\x1b\xa2\xc2\x57\x5c\x05\xd2\xa3\xcf\xb8\x9a\xbe\x1f\xf9\xf1\x3e\xa8\x29\xde\xd9\x96\x78\x9d\x7f\x75\x97\x58\xa0\xa5\x9f\x3b\xa5\x3c\x57\xa5\x73\xa8\x23\x37\x38\xf8\xd0\xe3\x12\xfa\xf3\xfa\xcf\xa7\xce\xf3\x7d\xf5\xb3\x67\xf1\x2a\x07\x73\x16\x51\x0f\xb9\x38\x00\xbf\x77\x9b\xef\x02\x35\x7e\x14\x3b\xcc\x2a\xe9\xc8\xf3\x47\x9f\xb9\x21\x44\x98\x9e\x2f\x62\xa3\x70\xcd\xb1\x07\xbd\x80\x79\x30\x40\xb7\x00\xe1\x0f\x1a\x95\xa3\x1c\x3a\xec\x6f\xf5\xf0\x1a\xa8\xcc\x66\x26\x84\x0e\xc6\x17\xc6\x24\x6f\xf5\x5d\xe0\x0f\xd0\xbf\x7a\x67\x0d\xdd\x65\x91\x13\xe8\x56\xe4\x83\x28\x14\xcd\x89\x93\x2f\x05\x02\x9e\x21\xdb\x31\x2c\x67\x10\x9f\x40\xf5\xae\xcf\x7a\x69\x74\x2c\xe6\x2b\x7b\x8b\xb1\xe3\x37\xd8\xd5\x71\x24\x37\x8c\x93\xff\x8e\xc3\xa3\xdf\xbe\x13\x79\x45\xd5\x55\x4f\x9a\x0c\x3f\x68\x7c\xb8\x72\x97\x52\x28\xce\xd1\x4b\xce\xa2\x30\xaf\x9d\x58\x73\x1d\x87\xd8\xdd\x80\xce\x78\x52\x14\x95\xc5\x74\x0f\x69\xff\x9e\x94\xd5\x16\xf8\x8d\x18\xa0\xdf\xd0\x1a\xe4\x05\xf2\x89\x90\x17\x28\xf9\xb0\xe9\x02\x45\xa1\x17\xff\xf5\xc0\x87\xc3\xdf\xf4\x42\x04\x61\xf4\x02\xdd\x61\xe9\x6e\x7e\x2f\xb9\xfe\x03\xa1\x1e\xa1\xeb\xff\xeb\x11\x10\xd1\xcd\x1f\xe0\xca\x34\x08\xa5\x8f\xe5\xd3\x2f\x4e\x5a\x5b\x31\x67\x3e\xe4\x47\xe7\xa5\xbc\xad\x33\x3f\x8b\x71\x8b\x1f\x9f\x38\xfd\x73\x8d\x6f\x93\x33\xd9\x1e\x8f\xcf\xd1\x7e\xd0\x60\x34\x97\x83\x7a\x94\x7e\x8d\xa6\xa7\xa6\xe8\x1e\x6c\xeb\x6a\x22\x1f\xea\x82\x68\x2c\x8d\x34\xe1\x1b\x24\xc1\x56\x2d\x58\xba\x89\x72\x37\x98\x52\xf0\x4f\x8a\xfa\x26\xb5\xf5\xbf\x2b\xbc\xdf\xbc\xd6\xda\xdc\xd1\xa5\xf6\x9e\x21\x73\x36\xca\x27\xa2\x0c\x5d\xfc\x4f\x00\x00\x00\xff\xff\x63\x6c\x41\x50\x55\x43\x00\x00"),
 		},
 		"/infrastructure/05-syndesis-security.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "05-syndesis-security.yml.tmpl",
@@ -325,9 +381,16 @@ var assets = func() http.FileSystem {
 		"/infrastructure/06-syndesis-prometheus.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "06-syndesis-prometheus.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 6096,
+			uncompressedSize: 8990,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x6f\x6f\x13\x39\x13\x7f\x9f\x4f\x31\xea\x83\xd4\x56\x90\xb4\x80\x40\x0f\x7e\x54\x21\x68\x9f\x43\x48\x94\xe6\x08\xe2\x5e\x70\xdc\x6a\xe2\x9d\x26\x2e\x5e\xdb\x67\xcf\xe6\x1a\x95\x7c\xf7\x93\x77\xb3\xc9\x6e\xba\x69\xda\xaa\x91\x10\xbc\xa1\xb1\x67\x7e\x9e\xbf\xbf\xf1\xba\x0b\xe8\xd4\x17\xf2\x41\x59\x23\x60\xf2\xb4\x03\xf0\x5d\x99\x54\xc0\xb1\x35\xe7\x6a\x74\x8a\xae\x03\x90\x11\x63\x8a\x8c\xa2\x03\x00\xa0\x71\x48\x3a\x94\x7f\x03\xa0\x73\x02\xc2\xd4\xa4\x14\x54\x98\xaf\x55\x3f\x7b\xca\x1e\x6c\xda\xe7\xa9\x23\x01\xca\x9c\x7b\x0c\xec\x73\xc9\xb9\xa7\x16\x31\x69\x33\x67\x0d\x19\x5e\x82\x75\x9d\xb7\x19\xf1\x98\xf2\x12\xd7\x60\x46\xad\xbb\x5d\x59\xf8\xd2\x01\x58\x3a\xb1\xdc\xed\x4d\x33\x2d\xe0\x47\x77\x7e\xe8\x48\xdb\x21\xea\xca\x3b\x80\x20\x3d\x3a\x4a\x94\x61\xf2\x13\xd4\xa2\x58\x7c\x11\x16\xfb\x34\x41\x9d\x23\x2b\x6b\x6a\x32\x2f\x42\xa7\xd3\x50\x2f\x2d\x08\x4b\xd8\x2e\x5c\xd8\x61\x52\x9a\xbc\xe2\xc7\x5c\x91\x91\x95\xbc\xae\x58\x2a\x33\xfa\x11\xf1\xca\x72\xdc\xd0\x56\xa2\x1e\xdb\xc0\xe2\xd5\xe1\xab\xc3\x4e\x6d\x3f\x23\xf6\x4a\x26\x9e\x8a\xfc\xb5\x01\x77\x21\xd8\xdc\x4b\x4a\xe6\x19\x86\xaf\x49\x61\x61\x92\x7c\x6b\x9c\xe3\x69\x44\x97\x02\x46\x36\xd9\xeb\x3d\xde\x6f\x6c\xa1\xe4\xa2\x90\x52\x6f\xdd\xfd\x91\xc7\xcc\x6e\x5b\xd8\x86\x78\x5b\xd0\xce\x5b\x49\x21\x6c\x11\x7e\x5e\x26\xdb\x3a\x81\x43\x3a\xdc\x80\xdd\x5a\xc0\xb1\xf0\x47\xbe\x68\x82\xae\xb3\x69\xa8\x97\xdd\xf7\x7c\x48\xde\x10\x53\x48\x42\xda\x5e\x75\xde\xea\xd8\x05\x36\x6d\x1c\x1b\xa1\x83\x43\x49\xd7\xaa\xbc\xd8\x59\x5d\x8c\x40\x57\x57\xbd\x33\x47\x66\x30\x56\xe7\xdc\xf7\xf6\x82\x24\xcf\x66\x75\x63\xee\x58\xfc\x91\xf7\x92\x9a\x03\xce\xa6\x09\x1a\x63\xb9\xec\xf7\x5a\x42\x94\x4d\xca\x4e\xff\xd6\x1a\xba\xef\x44\xae\x35\xe0\x3e\xa7\x7b\xd8\x50\x3a\x51\x31\x5d\x3c\x3c\xb2\xe8\xdd\x8e\xae\xe5\xec\x21\xa3\xe0\x90\xc7\xed\x86\x78\x72\x1a\x25\x35\xf6\x4a\x1a\x2b\x0f\x13\x50\x1c\xe5\x95\x0c\x05\x4a\x92\xb4\x99\xbd\x52\x9d\x6d\xf6\x62\x9a\xfa\xd8\x86\xc9\x13\xb8\xab\xf1\xd6\xf3\xed\x8d\xaf\x2c\xfa\xfa\x97\xf8\xf6\x78\x7f\xef\xb5\x10\x7f\xa6\x8f\xf7\x5f\xff\x6f\x2f\xfe\xb7\x22\x59\x68\x67\xc5\xf8\x7a\xf4\x54\x3c\x7a\x76\x63\x14\x16\x0e\x34\x1c\xad\x4c\x29\xc4\x32\x6c\x4d\xea\x4d\xe5\x72\x2d\x72\xf7\x07\xac\x05\x70\x6f\x31\xa4\x37\xe6\x65\x15\x69\xd1\xe0\xf7\xad\x97\x36\xac\x7b\xd4\x72\xd4\x7d\x00\x13\x2a\xa8\x6d\x8e\xdc\x8b\xec\x72\x5b\xdc\x7f\x31\xc9\x7e\xde\xb9\x18\xe9\x2d\x76\x73\xdb\x21\x81\x1c\x7a\x64\xeb\x05\xec\x8a\xdd\xb6\xf3\xa5\x35\x4c\x97\x2c\xf6\xac\x1f\x25\xe8\x50\x8e\x29\x91\x98\x91\x4e\xfe\x7f\x29\xc7\x68\x46\x14\x3e\x5b\x46\xfd\x63\xfd\xfe\x6f\xa8\x34\xa5\x3f\x22\xcd\x57\xac\x5b\x4a\x0c\x18\x3d\x7f\x56\x19\x05\xc6\xcc\xb5\x08\x7c\xc0\xc0\x15\xcc\xb1\xcd\x9c\x26\xa6\xf4\xb6\x0a\xf1\xd8\xdc\xd3\x42\xbc\x3d\x7c\x05\xc5\x77\xd6\xde\xe4\x07\xe4\x27\xaa\xa8\xe2\xe6\x3d\x7e\xed\x7d\xf9\x27\xbe\xe5\x07\x47\x72\x7e\x81\xb7\xbe\xba\xff\x76\x61\xcd\x3d\x3a\xca\x08\xf8\xef\x61\xf5\xd3\x5b\xb6\xd2\x6a\x01\x9f\x8f\xfb\xf3\xb5\xb2\x9d\xfb\x85\x60\x71\x63\x2e\x4b\x4a\x93\x8c\x15\xf5\x40\xde\x6f\x76\x8b\x91\xf3\xb9\x37\xda\x62\xfa\x16\x35\x1a\x49\x5e\xc0\xd5\x6c\x7d\x62\xfb\x71\x2d\x30\x19\xfe\x62\x75\x9e\xd1\xb1\x46\x95\xfd\x62\x69\x46\x19\xa9\xe3\xd4\xa6\xb4\x48\xf6\x27\xc2\xf4\x0f\xaf\x98\xce\xcc\x9c\x9b\x3d\x95\x84\xb1\xf0\xc3\xd3\xdf\x39\x85\xfa\xf7\x51\x60\xeb\x71\x44\x22\xde\x11\x07\x95\x29\xc7\x95\x1d\xa1\xd7\x5f\x7e\x0f\x7e\xaa\xd0\x7a\xf3\xb0\x46\x4a\x50\x3c\x9d\xad\xa6\x02\x9d\x0b\x07\xb5\x7c\x9c\x90\xd3\x76\x1a\xa7\xfc\x2f\x96\x84\x38\x07\x95\xc4\x20\xe0\x69\x6b\x7f\x64\xc8\x72\xfc\xa1\xe1\x44\xbb\x1b\x9b\x1d\xb9\xf3\x57\x7f\x60\x8f\x4c\xa3\x69\x75\x70\xe9\xfd\x27\x92\x9e\x90\x69\x51\x0f\x2b\x05\x02\xa0\x55\xa6\x9a\x1f\xd0\x19\x65\xd6\x4f\x05\xec\x3c\x7b\xf1\xf2\x54\xed\x74\x96\x53\x64\xb5\x98\xea\xb2\x87\x95\x28\x53\xe6\x34\x32\x2d\x82\xd2\x28\x80\xeb\x69\x5e\x1f\xa3\xdb\x44\xe9\xd6\x29\xbf\x47\x48\xeb\xa9\x2f\xd3\x5d\x0c\x90\x37\x52\xda\xdc\xf0\xc7\x1b\x4b\x39\xfe\x8b\xf3\x16\x95\x21\xdf\x78\xef\xb8\xe1\xad\x43\x65\x45\x6b\xee\x5e\x5d\xc1\xa6\xe6\x7c\x1f\x45\x61\x36\x5b\x0e\xfa\xab\x2b\x75\x0e\xbd\x13\x9a\x0c\x72\x17\x09\x7f\x36\x5b\x45\xee\xe7\x5a\xf7\xad\x56\x72\x2a\xe0\x8d\xfe\x07\xa7\xa1\xa6\x4c\x3a\xd0\xcd\x2a\xef\xcf\x3f\x5a\xee\x7b\x0a\x65\x63\x2f\x14\x4d\xda\xd0\x43\x7f\xfd\x99\x66\xb7\x3b\x7f\x7b\xea\x9d\x2b\x4d\x47\x07\xc4\xf2\x60\x19\x81\x83\xe6\x23\xd4\xee\x75\xe5\x39\x6b\xf5\xe2\x87\x79\xcf\x53\xa4\x7a\x65\xcd\xd1\xf3\xc3\xb4\x2e\xac\xd5\x84\x0c\x85\xd0\xf7\x76\x48\x4d\x13\xc6\xcc\xee\x1d\xf1\xea\xc7\xb2\x6b\xce\xbb\x85\xeb\x46\xb1\x42\x7d\x42\x1a\xa7\x03\x92\xd6\xa4\x41\xc0\xcb\xba\x4c\x6d\xea\x56\x66\x2e\xb2\xdd\x6f\x03\xf5\x84\xa9\xda\x9e\x71\xcf\xeb\x32\xff\x81\x93\xb7\xf0\xbb\x1d\x80\xd4\x18\x02\xa8\x00\x3b\xef\x72\xf4\x68\x98\x28\xdd\x81\xbd\xaa\x91\xe1\xe8\x68\xde\xfe\xfb\x0d\xed\x8f\x96\x49\xc0\x99\x81\xb3\xc1\x19\xf0\x98\x3c\x45\x0c\x63\x61\x89\x52\x42\x3f\x01\xc5\x01\xb0\x28\x25\x18\xe6\x3e\x30\x0e\x35\x35\xbc\xbe\xc6\x37\xd0\xca\x39\x50\xe3\x92\x5b\x54\xff\x72\x34\x9d\x16\x4a\x8d\x02\x6c\x67\xaa\x87\x3c\x61\x52\xcc\xc3\xd3\xc8\x02\x2b\x45\xb0\xfe\xed\x35\x52\x60\xc3\x9c\x2c\xaa\xf7\x91\xc7\x02\x0e\x5a\xd9\xe0\x26\xb4\xc5\x4b\x6e\x3b\x5e\xb3\xbf\x3a\x4d\xbb\x5b\xd8\xe8\x16\x06\xbb\xb6\x4b\x56\x33\xc0\x32\x2e\x6d\xa6\xc5\x3b\xf9\x25\xab\xe7\xf7\xe6\x51\x1b\x11\xfe\x0d\x00\x00\xff\xff\xca\xc5\xfe\x34\xd0\x17\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x6d\x6f\x1b\xb9\x11\xfe\xae\x5f\x31\x70\x03\xd8\x46\xa2\xb5\x93\x43\x82\xbb\x2d\x82\x83\xcf\xe9\x05\x07\xc4\xb1\x1a\x19\x57\x14\xd7\x74\x31\xe2\x8e\x24\x26\x5c\x92\x47\xce\x3a\x11\x1c\xfd\xf7\x82\xfb\xa6\x5d\x69\x65\xc9\xae\x8d\x5e\x81\xfb\xe4\x15\x39\x7c\x66\x38\x9c\x19\x3e\x24\x3d\x04\xb4\xf2\x57\x72\x5e\x1a\x1d\xc3\xf5\xf3\x01\xc0\x67\xa9\xd3\x18\xce\x8d\x9e\xca\xd9\x05\xda\x01\x40\x46\x8c\x29\x32\xc6\x03\x00\x00\x85\x13\x52\xbe\xfc\x06\x40\x6b\x63\xf0\x0b\x9d\x92\x97\xbe\x6a\xab\x7f\x46\xd2\x9c\xec\xea\xe7\x85\xa5\x18\xa4\x9e\x3a\xf4\xec\x72\xc1\xb9\xa3\x1e\x31\x61\x32\x6b\x34\x69\x5e\x81\x0d\xad\x33\x19\xf1\x9c\xf2\x12\x57\x63\x46\xbd\xbd\x43\x51\xcc\x65\x00\xb0\x9a\xc4\xaa\x37\x5a\x64\x2a\x86\x6f\xc3\x4a\xe9\x4c\x99\x09\xaa\x7a\x76\x00\x5e\x38\xb4\x94\x48\xcd\xe4\xae\x51\xc5\x45\xe3\x4b\xdf\xf4\xd3\x35\xaa\x1c\x59\x1a\xdd\x92\x79\xe9\x07\x83\xce\xf0\xd2\x02\xbf\x82\x1d\xc2\x27\x33\x49\x4a\x93\xd7\xe6\x51\x0d\x64\x64\x29\x36\x07\x96\x83\x19\xdd\x8c\x78\xad\x39\x74\x28\x23\x50\xcd\x8d\xe7\xf8\x87\xd3\x1f\x4e\x07\xad\xfe\x8c\xd8\x49\x91\x38\x2a\xd6\xaf\x0f\x78\x08\xde\xe4\x4e\x50\x52\xad\x30\xfc\x96\x14\x16\x26\xc9\xc7\x8e\x1e\x47\x33\xfa\x1a\xc3\xcc\x24\x47\xd1\xd3\xe3\x4e\x17\x0a\x2e\x02\x29\x75\xc6\xde\x1f\x79\xce\x6c\x1f\x0b\x5b\x13\x3f\x16\xb4\x75\x46\x90\xf7\x8f\x08\x5f\x85\xc9\x63\x69\x60\x9f\x4e\x76\x60\xf7\x06\x70\x08\xfc\x99\x2b\x92\x60\x68\x4d\xea\xdb\x61\xf7\x39\x9f\x90\xd3\xc4\xe4\x13\x9f\xf6\x47\x9d\x33\x2a\x64\x81\x49\x3b\x6a\x03\xb4\xb7\x28\x68\x23\xca\x8b\x9e\xf5\xc6\x00\x74\x73\x13\x5d\x5a\xd2\xe3\xb9\x9c\xf2\xc8\x99\x4f\x24\x78\xb9\x6c\x1b\x73\xc7\xe0\x0f\x75\x2f\x69\x4d\xc0\x9a\x34\x41\xad\x0d\x97\xf9\xde\x5a\x10\x69\x92\x32\xd3\x3f\xf6\xba\xee\x33\x91\xed\x75\xb8\xcb\xe9\x1e\x36\x94\x93\xa8\x2b\x5d\x50\x1e\xaa\xe8\xdd\x54\xb7\xd6\xec\x21\xbd\x60\x91\xe7\xfd\x86\x38\xb2\x0a\x05\x75\xfa\xca\x32\x56\x2a\x8b\xa1\x50\xe5\xa4\xf0\x05\x4a\x92\xf4\x99\xbd\x16\x9d\x7d\xf6\x62\x9a\xba\x90\x86\xc9\x33\xb8\xab\xf1\xc6\xf1\xfe\xc6\xd7\x16\xfd\xf6\xef\xf8\xe3\xd3\xe3\xa3\x1f\xe3\xf8\x5f\xe9\xd3\xe3\x1f\xff\x7a\x14\xfe\xac\x49\x16\xa3\xb3\x62\xfb\x7a\xf2\x3c\x7e\xf2\xe2\x56\x2f\x34\x13\xe8\x4c\xb4\x36\xa5\x10\xcb\xb0\x77\x51\x6f\x0b\x97\x0d\xcf\xdd\x1f\xb0\xe5\xc0\xa3\x66\x93\xde\xb9\x2e\xeb\x48\x4d\x82\xdf\x37\x5e\xfa\xb0\xee\x11\xcb\x61\xec\x03\x98\x50\x43\x3d\xe6\x96\xfb\x29\xfb\xfa\x58\xb5\xff\xd3\x75\xf6\xc7\xdd\x17\x43\x79\x0b\xd9\xdc\xa7\xc4\x93\x45\x87\x6c\x5c\x0c\x87\xf1\x61\x9f\x7e\x61\x34\xd3\x57\x8e\x8f\x8c\x9b\x25\x68\x51\xcc\x29\x11\x98\x91\x4a\xfe\xf6\x55\xcc\x51\xcf\xc8\x5f\x19\x46\xf5\x6d\x7b\xff\xcf\x28\x15\xa5\xdf\x42\x99\xaf\xab\x6e\x29\x31\x66\x74\x7c\x25\x33\xf2\x8c\x99\xed\x11\x78\x87\x9e\x6b\x98\x73\x93\x59\x45\x4c\xe9\xbe\x03\x82\xda\xdc\x51\x23\xde\xef\xbe\xa2\xc4\x0f\xb6\x32\xf9\x31\xb9\x6b\x59\x44\x71\x97\xc7\x6f\xe5\xcb\x83\x9b\x9b\x21\xc8\x29\x18\x07\xd1\x99\x95\x61\x3c\xb9\xe8\x83\xc9\x99\x3c\x44\xe3\x3a\xe1\xcf\xd2\x54\x06\x03\x50\x9d\x35\xf5\xc0\xc3\x72\x59\x80\xaf\x4a\x84\x8f\x6b\xbc\x4d\xb0\x4a\x18\xe0\x2f\xf5\x5f\x58\x61\xc1\xd4\x38\xc0\x9c\xcd\x70\x46\x9a\xc2\x56\xa5\x67\xc0\x73\x02\x56\x1e\x04\x39\x96\x53\x29\x90\x09\x72\x4f\x69\x21\x5c\x10\x70\x8d\xea\x19\xf8\x72\xce\x43\x36\xc3\xea\x13\xd8\xe1\x74\x2a\xc5\x9a\xc2\xaa\x37\x42\x65\xe7\x18\x19\x4b\xda\x07\xf6\x10\x8e\x1c\x45\x97\x9e\x0d\x83\xaa\xa1\x27\xe1\x88\x87\xa5\xcf\x6e\x6e\xa2\x5f\x2a\x55\xe7\xe4\xd8\x47\xa3\xc6\x77\xe3\x42\x6e\xb9\x2c\xe6\x4c\x3a\x85\xea\x33\x4c\x7f\xb7\xe7\x6e\x6e\x80\xcd\x3f\x31\x53\x3b\x85\xbf\x81\xd4\x29\x69\x86\x57\xb0\xa9\xac\xfa\x84\x3f\xe8\x61\xed\x16\x7f\xbc\x2b\xcc\xdd\xed\x8a\x4a\x6e\xab\x17\x00\xbc\x25\x51\x1d\xf5\x8c\xab\x4f\x4a\x43\xd8\x72\xe2\x0a\x32\x31\x7c\x7f\x5a\xff\x74\x86\x8d\x30\x2a\x86\xab\xf3\x51\xd5\x56\x16\xfe\x51\x21\x58\x9c\xad\xca\xf0\x51\x24\x42\xed\x79\x20\x07\xef\x3c\xe6\x86\x63\x61\x5e\xcd\x46\x19\x4c\x7f\x42\x85\x5a\x90\x8b\xe1\x66\xb9\xbd\x04\x8c\x42\x9b\x67\xd2\xfc\xab\x51\x79\x46\xe7\x0a\x65\x76\xf7\x82\x70\xbf\xdc\xff\xaf\x43\xfa\xcf\x38\x2e\xdd\x2a\xc2\x2e\x7a\x61\x52\x6a\xa2\xf9\x03\x61\xfa\x0f\x27\x99\x2e\x75\x45\x53\x1c\x95\x7b\x67\xe3\x2a\x47\xbf\xe7\xe4\xdb\x57\x05\x9e\x8d\xc3\x59\x59\xc6\x1a\x93\xce\xeb\xa9\xb6\x8b\x59\xf4\xa1\x46\x8b\xaa\xb8\x09\xbb\xa3\xe4\xc5\x72\x3d\xd6\xd0\x5a\x7f\xd2\x0a\xb8\x37\x64\x95\x59\x04\xc2\xfb\x67\x94\xfd\x7f\x45\x59\xe0\xbc\x52\xa0\x8f\xe1\x79\x6f\x85\xcb\x90\xc5\xfc\x5d\xc7\x4f\xfd\x9e\xda\xed\xab\x3b\xdf\xf0\x79\x76\xc8\x34\x5b\xd4\x8a\x4b\x07\x7f\x08\x5b\x2e\x32\x35\x01\xbf\x96\x01\x00\x4a\x66\xb2\x7b\x59\x96\x51\x66\xdc\x22\x86\x83\x17\x2f\x5f\x5d\xc8\x83\xa6\x67\x33\x5b\xda\xb2\xa7\xb5\x28\x53\x66\x15\x32\x35\x4e\x69\x22\xbc\x45\x9d\xba\x14\xe1\x6d\xc5\x61\x8c\xde\x19\xa2\x47\xf4\x7b\x4b\x66\x4c\x22\x77\x92\x17\x21\xf1\x15\x1c\x38\xf2\xe1\x50\xc1\x94\x1e\x1c\x37\xf4\x69\x0b\xdf\xda\x6a\x41\x6b\x60\xe0\x5c\x3f\x9b\xe0\x2f\x40\x70\x46\xa9\xc0\xb2\x82\x12\x74\x0c\x5f\xe6\xa4\x0b\xca\x65\x2c\x15\x1c\x1b\x5c\xd0\x42\xbe\x68\xac\xe9\x16\x54\x4c\xa9\x43\xca\x26\xa4\xcc\x97\xf6\x7d\x66\x7b\xa9\x83\x3d\x0d\xab\x0b\xf5\xe3\x60\x83\x52\xad\xac\x5d\x2e\x0f\x7a\xd8\xd4\x9e\x3e\xea\xcc\xd4\x93\x08\x51\x16\xf9\x4a\xbc\x62\x7d\xab\x43\x5c\x30\xce\x9a\x34\x06\x97\x6b\x96\x19\x9d\xa4\x34\xc5\x5c\x71\xad\xff\xb1\xc9\xdc\xf3\xd3\xdb\xd9\xdc\x66\x8d\xda\x9e\x7d\xfb\xe4\xdf\xde\xf5\xea\x7f\x5f\xb3\x36\x5c\x53\x19\xd5\x14\x2e\x58\xb1\xf9\x33\x21\x4c\xae\xf9\xfd\xfe\x3b\xcd\x58\xcc\x29\xcd\x43\xec\x47\xef\x4d\x4a\xe3\xaa\xe8\xb5\xdc\xae\x5b\xcd\xa5\xc2\x00\xe2\xc2\x29\x0d\x9e\x7c\xa6\xc5\x33\x78\x72\x8d\x2a\x27\x88\x5f\xdf\x09\x37\xe0\x14\x00\xb0\x5c\xc6\xc5\x77\x09\x53\x89\x74\x03\x6f\x4b\x08\xb6\xb4\x5c\x19\x55\xa5\x8d\x6f\x29\xe1\x55\x6b\xff\x5e\xd9\x8f\xb0\xf2\xfe\xf7\xb0\xb7\x05\x67\xd3\xa9\xd4\x25\x47\x68\x02\xb4\x6a\xda\xa9\xbb\x1e\xbb\x65\xd9\x77\x68\x1e\x39\x69\x42\x5a\x9f\x2b\xf4\x3e\x2c\x7e\xcb\x04\xbb\xde\x57\xf8\x7a\x3f\x98\x4d\x0b\xbe\x48\x9e\x83\x35\x69\x5d\x77\xce\xcb\x1b\x86\xad\x15\xa9\x65\x88\xef\x0e\xe9\xb8\x64\xfb\xb4\xeb\xd1\xc2\x68\x46\xa9\xc9\x75\x9e\x92\x36\x0e\x35\x8d\x8d\x8d\xfc\x3d\x2c\xdd\xdb\xd6\x17\xfd\xb6\x02\xc8\xac\xe0\x99\x87\x1d\x4f\xf7\x33\xcd\x5f\x82\x28\x2c\x97\x87\xeb\xc3\x47\xb9\x52\x23\xa3\xa4\x58\x14\x2b\xb6\xd6\xb6\x1f\x6e\xaf\xfc\x46\xcf\x1b\xba\x1e\xe7\x36\x9c\x04\xbb\x5e\x40\xb7\xf9\xfe\x76\x38\xac\x1e\x15\xa3\xa9\x54\xf4\xfa\x84\x58\x9c\xac\xfc\x7f\xd2\x7d\x5d\x3c\xdc\x1c\x5c\x71\xf0\x88\x7d\x3a\x89\x1c\x85\x93\x99\x34\xfa\xf5\x77\xa7\x69\x5b\x58\xc9\x6b\xd2\xe4\xfd\xc8\x99\x09\x75\x4d\x98\x33\xdb\xb7\xc4\xeb\xaf\x20\xb6\x7b\x3c\x6d\x3c\xa9\x25\x4b\x54\x6f\x48\xe1\x62\x4c\xc2\xe8\xd4\xc7\xf0\xaa\x2d\xd3\x3a\x24\xd7\x66\x36\xb1\x33\xea\x03\x75\x84\xa9\x7c\x3c\xe3\xbe\x3b\xed\x30\x94\x37\x3f\xc1\xdf\xcd\x18\x44\x48\x49\x90\x1e\x0e\xde\xe6\xe8\x50\x33\x51\x7a\x00\x47\x35\x6b\x83\xd7\xaf\x2b\xae\x77\xdc\x19\xfd\xde\x30\xc5\x70\xa9\xe1\x72\x7c\x19\x98\x8b\xa3\x80\xa1\x0d\xac\x50\x4a\xe8\x67\x20\xd9\x03\xaa\x2f\xb8\xf0\x30\xc9\x9d\x67\x9c\x28\xea\xcc\x7a\x83\x5c\x42\x2f\xc1\x84\x16\x71\xdc\x23\xfc\x57\x07\xad\x8b\x62\x50\x27\x00\xfb\x69\xe9\x43\x6a\xb8\x2e\x4e\x77\x17\x61\xd3\x5c\x0b\x82\xed\x8f\xea\x81\xef\x76\xcc\xc9\xc2\xf0\x11\xf2\x3c\x86\x93\xde\x27\xed\xdb\xd0\x9a\x27\xfa\x7e\xbc\x6e\x7e\xb5\x58\xf6\x91\x36\xbc\x79\xb1\x78\xdc\x76\xc7\x8a\x94\xfa\xe8\x22\xe7\x1c\xd5\xd5\xbb\x71\x67\xfa\xb5\x61\x35\xa1\x1d\xb2\xf2\xb7\x9a\x52\xcf\xe0\x64\xeb\x88\x90\x1e\x97\x5a\x2d\xaa\x37\xbe\xcd\xea\x58\xba\xbc\xa7\x8c\xef\xe1\x6b\xdb\x77\x9d\xd3\x8d\x0d\x11\x9a\xb6\x13\xa0\x7b\x2d\x89\xa8\xff\x25\xa4\xab\x6a\x27\xc2\x83\xae\xd6\x8e\xb5\x2a\xef\x69\xe3\xb5\x17\x82\xd0\xf6\xfe\xae\x57\xb7\xcb\xe5\xe0\x3f\x01\x00\x00\xff\xff\x57\x96\x75\x7b\x1e\x23\x00\x00"),
+		},
+		"/infrastructure/07-syndesis-network-policies.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "07-syndesis-network-policies.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 7032,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x59\xc1\x6e\xdb\x30\x0c\xbd\xe7\x2b\x08\xf4\x5a\x3b\xed\x9a\x21\xa9\x07\x0c\x18\xb6\x1e\x06\x0c\x43\xb1\x16\xbb\x0c\x3b\x28\x16\xd3\x08\x75\x4c\x41\x92\xdb\x18\x41\xff\x7d\x94\xed\x38\x4e\xeb\xac\x4b\x77\xa8\xe7\xe5\x14\x8b\x94\xa8\xf7\x48\x3e\xc6\x48\x56\x2b\x50\x33\x48\xc9\x41\x78\x95\xa7\x12\xad\xb2\xe1\x27\x65\xc5\x34\xc1\xaf\xe8\xee\xc9\xdc\x5e\x52\xa2\x62\x85\x16\x1e\x1e\x06\x47\x83\x23\xf8\x86\xd6\x19\x15\x3b\x0b\x2a\xbd\x31\x68\x2d\x38\x02\x37\x47\x58\x9f\x87\x98\x16\x9a\x52\x4c\x5d\xed\x9a\x25\x74\x6f\xfd\x53\x0e\x22\x76\x99\x48\x92\x1c\x52\x44\x19\x71\x3c\x43\x99\x43\x03\xc1\x7b\x20\x91\xb9\xb9\x36\xb4\xcc\xfd\x2a\x53\x43\x8b\xe6\x0e\xcd\x31\x94\x9f\xde\xb8\x40\x27\xd6\xeb\xa1\x5f\x0c\x35\x51\x52\xfa\xe4\xf4\x98\xc3\xf1\x79\xb6\xcf\x31\xb3\xde\x86\x4b\x4d\x86\xc3\x5b\x10\xa9\x64\xc0\x0e\x6f\x8c\x70\x8a\xd2\xc2\x5b\xc6\x09\xe1\x43\x9a\x03\xf1\x19\xf3\x98\x92\x26\x69\x39\xa6\xc5\x04\x63\x87\x12\xa6\xc8\x44\x80\x29\x4a\x4c\x15\xca\x77\x80\xe5\x76\xb6\xf8\x14\x9a\x2a\x35\x28\x43\xb8\x42\xe7\x4f\x6a\x8c\x43\xd9\x9e\x4f\x7f\x87\xc9\x10\x28\x7d\x94\xbe\xcc\x3a\x5a\xf8\x60\x94\x99\x18\xfd\x3e\xd2\x0e\x38\x4d\x21\x17\x20\x00\xa1\xd5\x77\x66\xc4\x24\x22\x4e\x62\x11\x93\x61\x87\xb7\x13\x1b\x2a\x1a\xde\x9d\x0e\x00\xd8\x20\x23\x68\x5e\x98\xb3\xd5\xe7\x4b\x0a\x27\x22\x7e\x06\x48\x04\xb3\xb1\xe5\x33\x70\x50\x1d\x81\xad\x20\x54\xb6\xf5\xd2\x87\x7d\xce\xef\x72\x8d\x11\xa7\x6f\x66\x04\xe7\x20\xe3\x22\x1b\x6c\xd9\x56\xb7\xc6\x26\x58\xb0\x29\x7b\x71\x20\x15\x0b\xdc\xe5\xf5\xe9\x2c\x11\x73\x65\xae\x8a\xaa\x90\x59\x53\x58\x08\x17\xcf\xbf\x6c\xb1\x6a\xe7\xf5\x3c\xb3\xbd\x41\xeb\x22\xc7\xd7\x9c\x84\xea\xee\x00\x3e\x97\xad\x54\xac\xaa\xb6\x5a\xbb\x66\xdc\xa3\x11\xfc\xf8\x59\xdd\xe6\x5b\xb4\x86\x1c\xf8\x0e\x76\x14\x53\x12\xc1\xf5\xc7\xcb\x1a\x90\xdf\x14\xc1\x64\x34\x3a\x1b\xf4\xb0\x07\x32\xd5\x56\xfb\xc2\xda\x9d\x9a\x57\x20\x5f\x50\xeb\x4d\x6d\x9f\x52\xd8\x49\x63\x37\x95\x3f\xa3\xf3\x82\x36\xde\xaf\x17\x4f\x26\x27\x7d\xec\xc5\xf2\x5b\xa1\xad\x1f\x6b\x4f\x77\x7a\xb2\x01\xb6\xd7\x7d\xf9\x7b\x88\x17\x4b\xed\xb9\xf9\xef\xf5\xa6\x2f\x80\x5b\xcc\xa3\xad\xcb\x1a\xaf\x00\x5b\x80\x48\x23\x5b\x39\x34\x5c\x2c\x95\x75\xf6\x85\x72\xf8\x17\x72\xbb\x79\x43\xda\x9f\xe4\xf9\x78\x7c\xde\x47\xcd\x7b\x58\x6d\x8a\xaf\xec\xdd\xd1\x7b\x0d\xb4\x4f\x6a\x6f\x0c\xb1\x83\xe4\xfe\x17\xc9\xc9\x69\x9b\xe0\x0a\x6b\x77\xe4\x56\x81\xec\xad\xd8\xba\x07\xaf\x1e\x70\x5d\x04\x27\xa7\x41\xf9\x7b\xc3\xfe\x32\x7e\x3b\x3a\x7b\xd3\xff\x59\x75\x3a\x19\xf7\x73\x56\x05\x06\x35\x63\x14\xed\x33\xab\xe1\xed\xd2\xec\xda\x02\x7d\x98\x61\xaf\x31\xc3\xf6\x9d\x10\xfd\x14\x4f\x63\x68\x3e\xd5\x4e\xed\xec\x94\x74\x1a\x90\x0f\xca\x39\x28\xe7\xb5\x94\xc3\xc8\x8c\x8a\xed\x0e\xe9\x6c\xbc\x9d\xd2\x4e\x13\x74\x9f\xc4\xf3\xd7\xaf\x46\xab\x15\x60\x2a\xfd\x3f\x69\xbf\x00\x6a\x11\x17\x13\x78\x1b\x00\x00"),
 		},
 		"/install": &vfsgen۰DirInfo{
 			name:    "install",
@@ -368,9 +431,9 @@ var assets = func() http.FileSystem {
 		"/install/deployment.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "deployment.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 2204,
+			uncompressedSize: 2131,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x94\x3d\x73\xe2\x3c\x10\xc7\x7b\x3e\x85\x26\x4d\x2a\x13\x92\xe2\x29\xdc\xe9\xc1\xba\x24\x13\x1c\x3b\xb6\xe1\xae\x63\x14\x79\x13\x34\x67\xbd\x9c\x24\x7c\xc7\x30\x7c\xf7\x1b\xf3\x4e\xb0\x82\x53\x1c\x15\xda\x5d\x7e\xfb\xdf\x17\x96\x6a\x3e\x01\x63\xb9\x92\x21\xa2\x5a\xdb\x9b\xfa\xb6\xf7\x93\xcb\x32\x44\x11\xe8\x4a\x2d\x04\x48\xd7\x13\xe0\x68\x49\x1d\x0d\x7b\x08\x49\x2a\x20\x44\x76\x21\x4b\xb0\xdc\x06\x4a\x83\xa1\x4e\x99\x9e\xd5\xc0\x1a\xbf\x01\x5d\x71\x46\x6d\x88\x6e\x7b\x08\x59\xa8\x80\x39\x65\x1a\x0f\x42\x82\x3a\x36\x1b\xd1\x57\xa8\xec\xc6\xe0\xc7\x6d\xbc\x3b\x7b\x9f\xab\x1b\xaa\xf5\x21\xb0\xc5\xef\x16\x1a\x42\xf4\x09\x80\x29\xa1\x95\x04\xe9\xda\xf3\x39\x10\xba\xa2\x0e\xb6\x52\x8f\x4a\x6e\x3e\xd5\x89\xea\x4b\xba\x2f\x2b\xef\xa0\xfd\x4b\xea\x11\xda\x0d\x60\xfd\x1d\x4c\xcd\x19\x60\xc6\xd4\x5c\xba\xe7\x4f\xb5\x72\xc9\xdd\x50\x49\x47\xb9\x04\xb3\xaf\x30\x40\x4c\x09\x41\x65\x79\x28\x39\x40\xaf\xd4\xce\x8e\x9e\x01\x3b\x7a\x68\x65\xdd\xbb\x01\x8b\x82\xc9\xde\xca\x05\x7d\x87\x10\x5d\x2f\x97\xfd\x88\x3a\xfa\x4a\x2d\x3c\x36\xa6\xd5\xea\xfa\x43\x23\x77\xbf\x0e\xea\xcd\x32\x6e\xdd\xac\x45\xd7\xa5\xce\x1f\x25\x4d\xb6\xbe\xb3\xa4\xeb\x98\x74\x5e\x55\xa9\xaa\x38\x5b\x84\x08\x57\xbf\xe9\xe2\x30\x1b\xad\x8c\x3b\x1a\xf6\xa6\x1d\x5b\x29\xa9\x32\x2e\x44\xff\x0d\x06\x83\xc1\x51\xc0\xae\x10\x01\xce\x70\x76\x20\x81\xac\x4f\x39\x9b\xb0\xef\xb8\x18\x3e\x4c\x9f\x71\x4c\xf2\x14\x0f\xc9\x09\xa8\xa6\xd5\x1c\xbe\x19\x25\xc2\x13\x33\x42\x6f\x1c\xaa\x32\x83\xb7\x8f\xf6\xad\x27\xa5\x6e\x16\xee\x17\xb7\xdf\x24\xb2\x9a\x32\x68\x49\x9f\x26\xd1\x3a\xf9\xbf\xca\xdb\x5b\x2e\x03\xc4\xdf\x50\x3f\x83\xe6\x2f\x55\xae\x07\x60\xd1\x6a\xd5\xa2\x25\x49\x49\x86\x8b\x24\xf3\x08\x0a\xd1\xd5\xd9\xac\xaf\x5a\x30\x19\x19\xe1\x82\x44\xd3\xc7\x18\xdf\x93\x69\x34\x69\x23\xad\x17\xb1\x3e\xdb\x06\x1f\x64\x88\x63\x32\x7a\xf2\x81\x86\x54\x40\xf5\xd4\x19\x56\x24\x51\xe2\x43\x15\xaa\x54\x9d\x41\x09\x1e\x17\x0f\x3e\x52\x42\xe7\x6e\xd6\x19\x35\x7e\xf4\x71\xc6\xbc\x33\x24\xbf\xf3\x52\xf2\xbb\xee\x98\x34\x4b\x62\x52\x3c\x90\x71\xee\xa3\xa5\x46\x09\x70\x33\x98\xdb\xee\x05\xa6\xf7\x19\x8e\x5a\xb7\x6a\x5d\xa5\x7e\x37\xb4\x3c\xbf\x49\x3e\x5c\x4c\x0a\xec\x63\xc5\xe0\x68\x67\x50\x84\x0b\xfc\x3f\xce\xbd\xc2\xbc\xd7\xd2\xdb\xbd\xfc\x65\x34\x25\x3f\xd2\x24\x2b\x48\xe6\xa3\x92\x3f\xcd\x5d\x83\xf3\x73\xe8\x1d\x2d\xc9\x26\x7e\x5c\x0e\xa6\xfe\x02\x0c\xc7\x2f\x3e\x12\x16\xbf\xf6\x98\xe6\x72\x80\x2c\x9b\x5b\xf1\x37\x00\x00\xff\xff\x07\xc1\xee\x04\x9c\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x94\x3d\x73\xe2\x3c\x10\xc7\x7b\x3e\x85\x26\x4d\x2a\x13\x92\xe2\x29\xdc\xe9\xc1\xba\x24\x13\x1c\x3b\xb6\xe1\xae\x63\x14\x79\x13\x34\x67\xbd\x9c\x24\x7c\xc7\x30\x7c\xf7\x1b\xf3\x4e\xb0\x82\x53\x1c\x15\xda\x95\x7f\xfb\xdf\x17\x2d\xd5\x7c\x02\xc6\x72\x25\x43\x44\xb5\xb6\x37\xf5\x6d\xef\x27\x97\x65\x88\x22\xd0\x95\x5a\x08\x90\xae\x27\xc0\xd1\x92\x3a\x1a\xf6\x10\x92\x54\x40\x88\xec\x42\x96\x60\xb9\x0d\x94\x06\x43\x9d\x32\x3d\xab\x81\x35\x7e\x03\xba\xe2\x8c\xda\x10\xdd\xf6\x10\xb2\x50\x01\x73\xca\x34\x1e\x84\x04\x75\x6c\x36\xa2\xaf\x50\xd9\x8d\xc1\x8f\xdb\x78\x77\xf6\x3e\x57\x37\x54\xeb\xc3\xc5\x16\xbf\x5b\x68\x08\xd1\x27\x00\xa6\x84\x56\x12\xa4\x6b\x8f\xe7\x40\xe8\x8a\x3a\xd8\x4a\x3d\x4a\xb9\xf9\x55\x27\xaa\x2f\xe9\xbe\xac\xbc\x83\xf6\x2f\xa9\x47\x68\xd7\x80\xf5\x7f\x30\x35\x67\x80\x19\x53\x73\xe9\x9e\x3f\xd5\xca\x25\x77\x43\x25\x1d\xe5\x12\xcc\x3e\xc3\x00\x31\x25\x04\x95\xe5\x21\xe5\x00\xbd\x52\x3b\x3b\x3a\x06\xec\xe8\xa0\x95\x75\xef\x06\x2c\x0a\x26\x7b\x2b\x17\xf4\x1d\x42\x74\xbd\x5c\xf6\x23\xea\xe8\x2b\xb5\xf0\xd8\x98\x56\xab\xeb\x0f\x85\xdc\x7d\x1d\xd4\x9b\x61\xdc\xba\x59\x8b\xae\x4b\x95\x3f\x0a\x9a\x6c\x7d\x67\x41\xd7\x77\xd2\x79\x55\xa5\xaa\xe2\x6c\x11\x22\x5c\xfd\xa6\x8b\x43\x6f\xb4\x32\xee\xa8\xd9\x9b\x72\x6c\xa5\xa4\xca\xb8\x10\xfd\x37\x18\x0c\x06\x47\x17\x76\x89\x08\x70\x86\xb3\x03\x09\x64\x7d\xca\xd9\x5c\xfb\x8e\x8b\xe1\xc3\xf4\x19\xc7\x24\x4f\xf1\x90\x9c\x80\x6a\x5a\xcd\xe1\x9b\x51\x22\x3c\x31\x23\xf4\xc6\xa1\x2a\x33\x78\xfb\x68\xdf\x7a\x52\xea\x66\xe1\x7e\x70\xfb\x4d\x20\xab\x29\x83\x96\xf0\x69\x12\xad\x83\xff\xab\xb8\xbd\xe5\x32\x40\xfc\x0d\xf5\x33\x68\x9e\x54\xb9\x6e\x80\x45\xab\x55\x8b\x96\x24\x25\x19\x2e\x92\xcc\x23\x28\x44\x57\x67\xbd\xbe\x6a\xc1\x64\x64\x84\x0b\x12\x4d\x1f\x63\x7c\x4f\xa6\xd1\xa4\x8d\xb4\x1e\xc4\xfa\x6c\x1a\x7c\x90\x21\x8e\xc9\xe8\xc9\x07\x1a\x52\x01\xd5\x53\x67\x58\x82\xc7\xc5\x83\x8f\x95\xd0\xb9\x9b\x75\x46\x8d\x1f\x7d\x9c\x31\xef\x0c\xc9\xef\xbc\x94\xfc\xae\x3b\x26\xcd\x92\x98\x14\x0f\x64\x9c\xfb\x68\xa9\x51\x02\xdc\x0c\xe6\xb6\x7b\x82\xe9\x7d\x86\xa3\xd6\x61\x58\x67\xa9\xdf\x0d\x2d\xcf\x57\x89\x0f\x17\x93\x02\xfb\x58\x31\x38\xda\x19\x14\xe1\x02\xff\x8f\x73\xaf\x30\xef\x92\xf3\x56\x2f\x7f\x19\x4d\xc9\x8f\x34\xc9\x0a\x92\xf9\xa8\xe4\x4f\xb3\x8e\xe0\x7c\x8b\x79\x5b\x4b\xb2\x89\x1f\x97\x83\xa9\xbf\x00\xc3\xf1\x8b\x8f\x84\xc5\xaf\x3d\xa6\x79\xf0\x20\xcb\xe6\x89\xff\x0d\x00\x00\xff\xff\x53\x76\xba\xe3\x53\x08\x00\x00"),
 		},
 		"/install/grant": &vfsgen۰DirInfo{
 			name:    "grant",
@@ -428,9 +491,9 @@ var assets = func() http.FileSystem {
 		"/install/operator.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "operator.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 5084,
+			uncompressedSize: 5338,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x5b\x6f\xdb\x46\x13\x7d\xd7\xaf\x18\x30\x0f\x4e\x80\x90\x76\x5e\x3e\x7c\x20\xe0\xb6\xaa\xe5\xb6\x01\x12\x5b\x90\xdd\xf4\x21\x09\x82\x15\x39\x92\xb6\x5a\xee\x32\xbb\x43\xa9\x2a\xa1\xff\x5e\x2c\x97\x57\x91\xb6\x64\x34\x29\x52\xa0\x7e\x91\xb9\x97\xb9\x9d\x33\x67\xd6\x07\x96\xf2\x77\xa8\x0d\x57\x32\x84\xcd\xab\x11\xc0\x9a\xcb\x38\x84\x3b\xd4\x1b\x1e\xe1\x38\x8a\x54\x26\x69\x04\x90\x20\xb1\x98\x11\x0b\x47\x00\x00\x92\x25\x18\x82\xd9\xc9\x18\x0d\x37\xbe\x4a\x51\x33\x52\xba\xd8\x13\x6c\x8e\xc2\xb8\x73\x00\x2c\x4d\x9b\x83\xe5\x5a\xf5\x19\x70\x75\x7e\x6c\x9f\x76\x29\x86\xd0\x71\xd0\x3d\x10\xa9\x24\x55\x12\x25\x0d\xc5\xe3\x97\xe9\xcc\x94\xc0\x1f\xb9\x8c\xb9\x5c\x8e\xa0\x93\xb3\x9e\xb3\x28\x60\x19\xad\x94\xe6\x7f\x32\xe2\x4a\x06\xeb\xff\x17\x86\x8b\x6a\x9c\x94\x76\xc8\xa5\x21\x26\xc4\x37\x97\x3e\x80\xc9\xe6\xbf\x63\x44\x45\x3c\xfe\x43\xd8\x3e\x86\xa7\x56\x02\x67\xb8\x70\xf9\x34\xc5\x6c\xdd\xca\x73\x08\xec\x12\xec\xf7\x23\x97\x32\xff\x59\xab\x2c\x7d\xa4\xb6\xa3\x51\x9e\xfb\xc0\x17\x10\x8c\x53\x6e\xc3\x41\x1d\x5c\x29\x69\x94\xc0\x37\x5c\xae\xad\x25\xff\x29\x28\xb9\xb8\xae\x44\x66\x08\x75\x17\xeb\x2e\x80\x5f\x16\x9c\x23\xb5\x3f\x01\xcb\x23\x84\xf2\x23\x57\x14\xc1\xe5\xfa\x4b\x80\xe9\xf6\x4c\xca\xa2\x12\xb7\x9b\xea\xd3\x81\xd7\x01\xfb\x14\x1c\x1b\x52\xb4\x8a\x3f\xcc\x8d\x4e\x2e\x16\x7e\x94\xb1\xf5\x3a\x40\x85\x5b\x91\xdc\x65\x69\xaa\x34\xfd\xc7\x84\x8a\x09\x4a\x24\xff\x7a\x06\xd8\x1c\x1e\x47\xfe\x75\xc2\x96\x78\x47\x1a\x59\x62\x7a\xd0\x73\xbb\x19\xa8\x14\xa5\x59\xf1\x05\x1d\x60\xde\xba\xfa\x95\xc1\xfe\xbb\x9a\xfc\x18\x3e\x26\xc5\xc8\xc5\x49\x6c\x59\x46\x9c\xe7\xb6\x48\x13\xdc\x94\x4d\x51\xea\xac\x5f\x9a\xf1\x04\x23\x34\xe4\x95\x81\x2c\xb4\x4a\xaa\x4c\x1b\x10\x5c\x65\xf7\xfb\xd0\x1d\xae\xf7\x7b\xb5\xbb\x67\xcb\xd2\x29\x0a\x83\x87\xae\xf2\x3c\xb8\x67\xcb\xfd\xfe\x44\x67\xd5\xf1\x03\x77\x13\x15\xad\x51\x17\x87\xca\x1d\x9e\xd8\xc4\xa6\x4a\xf0\x68\xd7\xd8\x33\xd1\x0a\xe3\x4c\x60\x1c\x02\xe9\x0c\xab\xb8\x64\xbc\xdf\x8f\xaa\xdf\x03\x8e\xb0\x34\x35\x6d\x56\x4c\x30\x15\x6a\x97\xe0\xc0\x1b\xa6\x64\x1f\x93\x71\xbb\xb8\x0f\xb1\xb1\x9e\x6e\x52\x2a\x2a\x3a\xa0\xe6\xd3\xb3\xea\x17\xee\x90\x0c\x30\x20\xcd\x97\x4b\xd4\xa0\x24\xd0\x8a\x1b\x88\xeb\x20\x80\x14\xa8\x0d\xea\xad\xe6\x84\xf5\x35\x5a\x21\x10\x26\xa9\xc5\x26\xb0\x0c\x08\x0a\xaa\x43\xaa\x2d\x33\x68\x57\xc4\x98\x2a\x5e\xdc\xef\xdc\xea\x51\xc8\x35\x09\x98\x22\xe8\xe0\x20\xc0\x81\x06\x2a\x43\x35\x21\x9c\xbd\xcf\x3d\x0b\xa7\x17\xe6\x9e\xad\x9d\x17\x7a\x5d\x5a\x78\x2f\x3d\x0b\xb0\x17\x7a\x7d\x95\xaa\x79\xb1\x7f\xe9\x2d\x38\x8a\x78\xca\x68\x65\x4f\xda\x64\xba\xa9\x45\x4a\x12\xe3\x12\xb5\x79\xff\xfd\xf3\x1f\x02\x6b\xf2\xf2\xf2\x43\xdf\xe6\x07\xef\xc5\x47\x57\x07\x6f\xff\xf1\xac\x83\xfd\x31\x89\xfb\xb6\xda\xbd\x69\x69\x43\x9a\x11\x2e\x6b\x86\x3b\xcb\x33\x8c\x34\xb2\x92\x0e\x1a\x53\xc1\x23\x66\x42\x78\xe5\xae\xa0\xc0\xc8\x56\xb8\xbc\x92\x30\x8a\x56\x6f\x3a\xf9\x1d\xcf\xe6\x84\x7c\x9e\x28\x60\x15\xa4\x75\x58\x9d\xce\xea\x63\x70\x0c\xb1\x53\xf3\x38\x29\x93\xa7\x8f\xe6\x1a\x21\x57\xf2\xf6\x3c\xbd\x39\x12\x75\x43\xe7\xc6\x82\x7f\x42\xae\xcf\xda\xff\x3b\x01\x86\x2d\x17\x02\x98\xd8\xb2\x9d\x01\x43\x4c\x13\xa8\x8c\x60\x8e\x5c\x2e\xc1\x60\x21\x1e\xb6\xe9\x35\x0a\x64\x06\xc1\xe9\x53\x68\x3b\x0f\x52\xc6\x75\xd7\xa2\x84\x75\x36\x47\x2d\x91\xd0\x38\x19\xda\xaa\x4c\xc4\xa0\x31\x61\x5c\x16\x86\x22\x6b\x05\x37\x28\x61\xbb\x42\x09\x31\x6e\x50\xa8\xd4\xbd\x96\x1a\x4b\xe0\xfb\x30\x71\x5b\xa8\xad\x26\x6d\x78\x8c\x56\xe6\x34\x2e\xb9\x21\xbd\x3b\x77\x9a\x13\xa9\x64\xce\x65\x21\x8d\xb0\x50\xba\x0c\xef\x30\xa8\x5a\x7d\xda\x31\xcd\xb1\x56\x45\x42\x09\xf3\x9d\x93\xc4\x52\x46\x1b\xc9\xed\xc5\x35\xc3\xcf\x19\xd7\x68\xa0\x25\xe0\x97\xcd\xb0\x18\x3c\x5a\x44\x3b\xcd\x84\x70\xe3\x06\x2e\x61\xec\x4a\xfe\x5c\xd1\x0a\xf5\x96\x1b\x6c\xb9\x84\x58\xa1\x01\xa9\x68\xc5\xe5\xf2\xc5\x69\x85\x91\xb8\x2d\x75\xb8\x2c\x34\x33\x46\x45\x9c\x11\xc6\x6e\xdd\x54\xcf\x94\x21\x36\x14\x27\x1e\x1f\xa3\xd5\xc3\xa9\xf7\x26\x68\x59\x68\x32\x0c\xcb\x04\x5b\xd7\x5b\xb3\xfd\x81\x2b\xaf\x17\x37\x8a\xa6\x1a\x0d\x4a\x6a\x5f\xac\x05\xd8\xfd\x59\xdf\x9d\x2e\xf7\x9b\x8e\x98\x2a\x4d\x21\xfc\xef\xe2\xe2\xe2\xa2\x75\xa0\x12\x82\x04\x49\xf3\xa8\xdd\xda\x28\x37\x5d\x4b\xee\xe0\x6f\xe3\xfb\xab\x5f\x3e\xdd\x8c\xdf\x5e\xdf\x4d\xc7\x57\xd7\x1d\x53\x1b\x26\x32\xfc\xa9\xf3\x08\x71\x7f\xc5\x10\xaa\x1f\xb2\xbd\x1d\x3b\x9e\xc2\x5a\xb5\x82\xfa\x4d\x3c\xe0\x7e\x7a\x3b\x29\x9c\x7f\x2d\xbf\x03\x2e\x6f\xa7\xd7\xb3\xf1\xfd\xed\xec\x01\xbf\x21\xf4\xa7\xa5\x37\x60\x66\x72\xfd\xee\xd3\xdd\xaf\xd3\xe9\xed\xec\x7e\xd0\x48\x9e\x77\x08\xd4\x36\xb1\x51\x22\x4b\xf0\xad\x15\x40\x33\x84\x49\xcf\xbf\x6f\xf3\xe9\x78\x49\xec\x65\x97\xef\x79\x67\x93\x4b\x4e\x57\x83\xaa\x19\xa9\x24\x61\x32\xee\x3a\x9c\x33\xb3\xea\x2c\xf8\x51\xe7\xd3\x4b\x95\xa1\xa5\x6d\x6c\xff\x1d\x7c\xe7\x7c\x9d\x57\x6b\x9f\x45\x40\x7f\x90\xd7\xef\xae\x33\x9b\x3c\x23\x36\x67\x06\xcb\x36\x3b\xeb\xcd\xaa\xca\x8a\xbf\x71\xcf\xcb\x7f\xa8\x40\xce\xf6\x29\xf3\xe4\xd0\x28\x26\x29\xed\x26\x5c\x87\x90\xef\x47\x7f\x05\x00\x00\xff\xff\xe5\x92\x03\x73\xdc\x13\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\xdf\x6f\xdb\xb6\x16\x7e\xf7\x5f\x71\xa0\x16\x48\x02\x54\x4a\xfa\x72\x71\xa1\x8b\xdc\x7b\xb3\x24\xeb\x0a\xb4\x89\x67\x67\xd9\x43\x53\xb4\x94\x74\x6c\x73\xa6\x48\x95\x3c\xb2\xeb\x09\xfe\xdf\x07\x8a\x92\x2c\x59\x4e\xec\x62\xed\xd0\x01\xcb\x4b\x12\x91\xfc\xce\x8f\xef\x3b\x1f\xe9\x03\xcb\xf8\x3d\x6a\xc3\x95\x0c\x61\xf1\x72\x00\x30\xe7\x32\x09\x61\x8c\x7a\xc1\x63\xbc\x88\x63\x95\x4b\x1a\x00\xa4\x48\x2c\x61\xc4\xc2\x01\x00\x80\x64\x29\x86\x60\x56\x32\x41\xc3\x8d\xaf\x32\xd4\x8c\x94\x2e\xd7\x04\x8b\x50\x18\xb7\x0f\x80\x65\xd9\x66\x63\xf5\xad\xfe\x37\xe0\xea\x74\xdf\x3a\xad\x32\x0c\xa1\x13\xa0\xbb\x21\x56\x69\xa6\x24\x4a\xda\x95\x8f\x5f\x95\x33\x52\x02\x7f\xe0\x32\xe1\x72\x3a\x80\x4e\xcd\x3a\x62\x71\xc0\x72\x9a\x29\xcd\x7f\x67\xc4\x95\x0c\xe6\xff\x2e\x81\xcb\x6e\x1c\x54\x76\xc8\xa5\x21\x26\xc4\x77\x57\x3e\x80\xc9\xa3\xdf\x30\xa6\x32\x1f\xff\x31\x6e\x9f\xe2\x53\x2b\x81\x23\x9c\xb8\x7a\x36\xcd\x6c\x9d\x2a\x0a\x08\xec\x27\x58\xaf\x07\xae\x64\xfe\x4a\xab\x3c\x7b\xa2\xb7\x83\x41\x51\xf8\xc0\x27\x10\x5c\x64\xdc\xa6\x83\x3a\xb8\x54\xd2\x28\x81\x6f\xb8\x9c\x5b\x24\xff\x4b\x58\x72\x79\x5d\x8a\xdc\x10\xea\x2e\xd7\x5d\x02\xbf\x2e\x39\x7b\x7a\x7f\x00\x97\x7b\x04\xe5\xc7\xae\x29\x82\xcb\xf9\xd7\x20\xd3\xad\x99\x8c\xc5\x15\x6f\x37\xf5\xbf\x8e\xbc\x0e\xd9\x87\xf0\xb8\x11\x45\xab\xf9\xbb\xb5\xd1\xa9\xc5\xd2\x8f\x32\xb1\x51\x77\x48\xe1\x56\xa4\xe3\x3c\xcb\x94\xa6\x7f\x94\x50\x2b\x41\x89\xf4\x6f\xaf\x00\x5b\xc3\xd3\xcc\xbf\x4e\xd9\x14\xc7\xa4\x91\xa5\xa6\x47\x3d\xb7\x8b\x81\xca\x50\x9a\x19\x9f\xd0\x16\xe7\xad\xa3\xdf\x98\xec\x3f\xeb\xc9\x4f\xf1\x63\x32\x8c\x5d\x9e\xc4\xa6\x55\xc6\x45\x61\x9b\x74\x85\x8b\x6a\x28\x2a\x9f\xf5\x2b\x18\x4f\x30\x42\x43\x5e\x95\xc8\x44\xab\xb4\xae\x74\x43\x82\xeb\xec\x7a\x1d\xba\xcd\xcd\x7a\xaf\x77\x77\x6c\x5a\x05\x45\x61\x70\x3b\x54\x51\x04\x77\x6c\xba\x5e\x1f\x18\xac\xde\xbe\x15\xee\x4a\xc5\x73\xd4\xe5\xa6\x6a\x85\xa7\xb6\xb0\xa1\x12\x3c\x5e\x6d\xf0\x4c\x3c\xc3\x24\x17\x98\x84\x40\x3a\xc7\x3a\x2f\x99\xac\xd7\x83\xfa\xf7\x96\x46\x58\x96\x99\xb6\x2a\xae\x30\x13\x6a\x95\xe2\x8e\x37\x4c\xa5\x3e\x26\x93\x76\x73\x1f\x53\x63\x73\xbb\x49\xa9\xa8\x9c\x80\x46\x4f\xcf\xea\xdf\x30\x46\x32\xc0\x80\x34\x9f\x4e\x51\x83\x92\x40\x33\x6e\x20\x69\x92\x00\x52\xa0\x16\xa8\x97\x9a\x13\x36\xc7\x68\x86\x40\x98\x66\x96\x9b\xc0\x2a\x20\x28\xa5\x0e\x99\xb6\xca\xa0\x55\x99\x63\xa6\x78\x79\xbe\x73\xaa\x27\x21\x37\x24\x60\xca\xa4\x83\xad\x04\x77\x0c\x50\x95\xaa\x09\xe1\xe8\x5d\xe1\x59\x3a\xbd\xb0\xf0\x6c\xef\xbc\xd0\xeb\xca\xc2\x7b\xe1\x59\x82\xbd\xd0\xeb\xbb\x54\xa3\x8b\xf5\x0b\x6f\xc2\x51\x24\x43\x46\x33\xbb\xd3\x16\xd3\x2d\x2d\x56\x92\x18\x97\xa8\xcd\xbb\xff\x1d\xff\x3f\xb0\x90\xe7\xe7\x0f\x7d\xcc\x07\xef\xe4\xbd\xeb\x83\xb7\x7e\x7f\xd4\xe1\x7e\x9f\xc5\x7d\x5f\xe3\xbe\x19\x69\x43\x9a\x11\x4e\x1b\x85\x3b\xe4\x11\xc6\x1a\x59\x25\x07\x8d\x99\xe0\x31\x33\x21\xbc\x74\x47\x50\x60\x6c\x3b\x5c\x1d\x49\x19\xc5\xb3\x37\x9d\xfa\xf6\x57\x73\x40\x3d\x5f\x68\x60\x35\xa5\x4d\x5a\x9d\xc9\xea\x73\xb0\x8f\xb1\x43\xeb\x38\xa8\x92\x2f\xbf\x9a\x1b\x86\x5c\xcb\xdb\xf7\xe9\xcd\x9e\xac\x37\x72\xde\x20\xf8\x07\xd4\xfa\xac\xfd\xb7\x33\x60\x58\x72\x21\x80\x89\x25\x5b\x19\x30\xc4\x34\x81\xca\x09\x22\xe4\x72\x0a\x06\x4b\xf3\xb0\x43\xaf\x51\x20\x33\x08\xce\x9f\x42\x3b\x79\x90\x31\xae\xbb\x88\x12\xe6\x79\x84\x5a\x22\xa1\x71\x36\xb4\x54\xb9\x48\x40\x63\xca\xb8\x2c\x81\x62\x8b\x82\x0b\x94\xb0\x9c\xa1\x84\x04\x17\x28\x54\xe6\x5e\x4b\x1b\x24\xf0\x7d\xb8\x72\x4b\xa8\xad\x27\x2d\x78\x82\xd6\xe6\x34\x4e\xb9\x21\xbd\x3a\x75\x9e\x13\xab\x34\xe2\xb2\xb4\x46\x98\x28\x5d\xa5\xb7\x9d\x54\xe3\x3e\xed\x9c\x22\x6c\x5c\x91\x50\x42\xb4\x72\x96\x58\xd9\xe8\xc6\x72\x7b\x79\x8d\xf0\x53\xce\x35\x1a\x68\x19\xf8\xf9\xe6\xb2\xd8\xb9\xb5\xcc\x76\x98\x0b\xe1\xae\x1b\x38\x87\x0b\xd7\xf2\x63\x45\x33\xd4\x4b\x6e\xb0\x15\x12\x12\x85\x06\xa4\xa2\x19\x97\xd3\x93\xc3\x1a\x23\x71\x59\xf9\x70\xd5\x68\x66\x8c\x8a\x39\x23\x4c\xdc\x77\x53\x3f\x53\x76\xa9\xa1\xdc\xf1\xf4\x35\x5a\x3f\x9c\x7a\x6f\x82\x16\xc2\xa6\xc2\xb0\x2a\xb0\x75\xbc\x75\xb7\x3f\x72\xe4\xf5\xe4\x46\xd1\x50\xa3\x41\x49\xed\x83\x8d\x01\xbb\x1f\x1b\xbb\x33\xe5\xfe\x66\x22\x86\x4a\x53\x08\xff\x3a\x3b\x3b\x3b\x6b\x6d\xa8\x8d\x20\x45\xd2\x3c\x6e\x8f\x36\xca\x45\x17\xc9\x6d\xfc\xf5\xe2\xee\xf2\xa7\x0f\x37\x17\x6f\xaf\xc7\xc3\x8b\xcb\xeb\x0e\xd4\x82\x89\x1c\x7f\xec\x3c\x42\xdc\x4f\x79\x09\x35\x0f\xd9\xde\x8a\xbd\x9e\xc2\xc6\xb5\x82\xe6\x4d\xbc\x23\xfc\xf0\xf6\xaa\x0c\xfe\xad\xe2\xee\x08\x79\x3b\xbc\x1e\x5d\xdc\xdd\x8e\x1e\x89\x1b\x42\xff\xb6\xf4\x76\xc0\x5c\x5d\xdf\x7f\x18\xff\x32\x1c\xde\x8e\xee\x76\x82\x14\x45\x47\x40\x6d\x88\x85\x12\x79\x8a\x6f\xad\x01\x9a\x5d\x9c\xf4\xe2\xfb\xb6\x9e\x4e\x94\xd4\x1e\x76\xf5\x9e\x76\x16\xb9\xe4\x74\xb9\xd3\x35\x63\x95\xa6\x4c\x26\xdd\x80\x11\x33\xb3\xce\x07\x3f\xee\x4c\xe1\x50\xe3\x04\x75\x39\x66\x0b\xf7\x0a\x84\x88\xcd\xed\xa4\xc9\xca\x2f\xdd\x28\x46\x2b\xe0\x64\xc0\x9a\x5f\x15\x1a\x8e\xf1\x33\x8b\xc9\xbe\xae\x3a\x88\x42\xc5\x4c\xd8\xd9\x4d\x30\x43\x99\xa0\xa4\x93\xff\xc0\x84\x09\x01\x11\x8b\xe7\xd6\x85\x33\xa6\x8d\x35\x65\x8b\xfe\x31\x53\x86\xa6\xd6\x58\xfc\xfb\x8f\x10\x31\x29\x51\x5b\xff\xeb\x40\xba\xa9\x07\x9a\x31\x82\x44\xc9\x23\x2a\xfd\x7c\x78\x3b\xbe\x7b\x35\xba\x1e\xff\xfc\xe6\xc3\xfd\xf5\x68\xfc\xfa\xf6\x26\xe8\x54\xea\x61\x3c\x53\xf0\xe0\x3d\x2f\xfa\x3b\x43\xff\xf9\x71\x2b\xf2\xc9\xfa\xc1\x83\xff\xba\x4e\x9f\xd6\xdf\x3f\x89\x80\x3e\x93\xd7\xf7\x96\x23\x4b\x3d\x23\x16\x31\x83\x95\xc9\x1c\xf5\x6e\xea\x1a\xc5\xaf\xda\xfa\x17\xc9\xc3\x61\x1f\x72\x9b\x6e\x83\x62\x9a\xd1\xea\x8a\xeb\x10\x8a\xf5\xe0\x8f\x00\x00\x00\xff\xff\x9b\xb9\x5c\xba\xda\x14\x00\x00"),
 		},
 		"/install/role.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "role.yml.tmpl",
@@ -450,19 +513,26 @@ var assets = func() http.FileSystem {
 			name:    "route",
 			modTime: time.Time{},
 		},
+		"/route/certmanager-certificate.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "certmanager-certificate.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 1674,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\xc1\x6a\xdb\x40\x10\xbd\xeb\x2b\x1e\xf8\xd2\x42\xa4\xd0\x4b\x0f\xee\xc9\x98\x42\x43\xd2\x10\xe2\x52\xe8\x71\xad\x7d\xae\x87\x48\x2b\x75\x77\xe4\x60\x54\xff\x7b\xd9\x5d\x3b\x76\x49\x70\xda\x6b\xe9\x6d\xb4\xf3\x66\xe7\xed\x9b\x37\x1a\x47\xc8\x0a\xc6\x59\x54\x8b\xad\xb3\x0c\x12\xaa\x39\xbd\x7e\x36\xce\x7c\xa7\xaf\xae\x42\x18\xe8\x6f\x4d\x4b\x54\xb3\x5e\x16\xf4\x1b\xfa\x53\x04\x76\xbb\x62\x52\x4c\x70\xcf\x1f\x03\x83\x86\x0b\xac\x7c\xd7\xa2\xa6\xd7\xb2\xcd\x90\x0b\xe8\x9a\xf8\x72\xb3\x48\xa7\xb2\x92\xda\x28\xc1\x76\x49\x6b\x69\x21\x4e\xbb\x84\x38\x10\x80\xef\x06\x25\xde\x18\x67\x2f\xf0\xb8\xa6\x2b\x26\xa0\x33\xcb\x86\x36\x5f\xd5\x0f\xcb\x46\x6a\xcc\xee\xae\x32\xf4\x2d\x96\xdb\x98\x10\x0f\xcf\xd0\xb3\x56\xd9\x10\xca\xb6\x6f\x8c\x32\x54\xb8\x66\xaf\xf0\x74\x7c\xa4\x8d\xd8\x53\x76\x10\x0d\x6c\x56\x1f\x8a\x09\xe6\x6b\xd6\x0f\xf7\xf1\xc6\xf9\x91\xe8\x82\xb5\xa7\x5e\xa6\xdc\x5d\x6a\x3c\xbb\xbb\x7a\x96\x87\x67\xe9\x69\x6c\xe2\x27\x51\x34\x8b\x90\x33\x9d\x03\x37\xf4\xdb\x62\x02\xcf\xba\x73\xb5\x34\x44\xc8\x4f\x4e\xf4\x03\x82\x9a\x2d\xc4\x21\x6c\x5d\x8d\x47\xd1\x35\x44\xab\x62\x52\x94\x30\xbd\x7c\xa5\x0f\xd2\xb9\xe9\x6f\xac\x2b\xe9\x2e\x37\xef\x0a\xe0\x41\x9c\x9d\xe2\x84\x4f\x01\xb4\x54\x63\x8d\x9a\x69\x01\x00\x8d\x59\xb2\x09\x39\x06\x4c\xdf\x4f\x63\x9f\xa4\xf4\xfe\xec\xf0\x19\x2f\x7d\x2d\xaf\xdb\x9e\x53\x88\x5b\x79\x13\xd4\x0f\xb5\x0e\x9e\xc5\x38\x96\xd1\x46\x47\x0b\xcd\xac\x15\x95\xce\x99\xe6\x26\x75\x8f\x2e\x19\x47\x68\xf7\xcd\xb4\xcd\x39\xdc\x4f\x88\xb3\x74\x8a\xf7\xb9\xa4\x04\x9d\x8d\x61\xe4\xe1\x4c\xcb\x23\xb9\x32\x89\x57\x00\x71\xe2\xf9\x79\x59\xf1\xdb\x17\x60\xa5\x36\xa1\x8c\x02\xee\xf5\x4b\x70\xeb\x42\xc4\xee\xb5\x29\x31\x8e\x47\x66\xc9\x06\x9f\xba\xa0\xb1\xe9\xbe\x7f\x9a\xab\xbf\xe7\xea\x20\x66\x26\x74\x5a\xf6\xf2\xee\xec\xeb\x0f\xd3\xca\x4b\x77\xae\xe8\x5a\xd2\xab\xc7\xf1\x0f\x51\x6c\x02\xb1\xdb\xcd\x9b\x21\x28\x7d\xce\xc6\xe3\xac\xdd\x4b\xe3\xe9\x5c\xa8\x9e\xec\x5c\x7d\xcc\xeb\x15\xc1\xff\x4d\x77\xd6\x74\xf9\xdf\x53\x9a\x5e\x5e\x77\xde\x11\xfb\xd7\xf6\x7b\x36\xa1\x7f\xcd\x8f\x4f\xe1\x21\xfa\x15\x00\x00\xff\xff\x63\x81\x8b\x40\x8a\x06\x00\x00"),
+		},
 		"/route/ingress.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "ingress.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 786,
+			uncompressedSize: 994,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\xcd\x6e\xdb\x4a\x0c\x85\xf7\x7a\x8a\x03\x7b\x2d\x05\xc1\xcd\x22\xd0\xee\xc2\x9b\x74\xd3\x06\x75\xd0\xfd\x58\xa2\xed\x81\x24\x72\x40\x52\x6e\x0c\xc1\xef\x5e\xe8\xc7\x71\x0d\x04\x6d\x77\xc3\x43\xf2\xcc\x47\xce\x0c\x03\xe2\x1e\x2c\x8e\xe2\xff\x14\xb7\xa4\x27\xd2\xe2\xbb\xf4\x4e\x86\xcb\x25\x5b\x67\x6b\x7c\xe1\x83\x92\x19\x1e\x30\xe9\xe8\x8d\x6a\xec\x45\x91\xda\x10\x19\x4d\xbf\x23\x65\x72\xb2\x6c\x8d\x1c\xdb\x33\xd7\x64\xd1\x66\x93\x17\x31\xe7\xd0\x11\xba\xde\x1c\x3b\x82\x91\xc3\x05\x01\xa7\xd0\xf6\x84\xa4\x51\x14\x4a\x5c\x93\x46\x3e\x64\xeb\x2c\x47\x48\xf1\x07\xa9\x45\xe1\x12\x4c\xfe\x53\xb4\x89\x7c\x28\x9a\x67\x2b\xa2\x3c\x9c\x1e\x77\xe4\xe1\x31\x03\x9a\xc8\x75\x79\xa5\xcb\x80\x8e\x3c\xd4\xc1\x43\x99\x01\x40\x1b\x76\xd4\xda\x7c\x06\x42\x4a\x25\x6c\x41\x5b\xb4\x6b\x38\xba\xfe\x2d\xef\xe7\x44\x25\x22\xef\x35\x98\x6b\x5f\x79\xaf\x34\x95\x05\x66\xf1\xe0\x51\xf8\xe3\x2e\x3e\x44\x7e\x2f\xe2\xcc\x55\xdc\xd6\x33\xfa\x5c\xd5\xaa\x0d\x66\x25\x56\x53\xed\xea\x1f\x1a\x77\xa1\x6a\x88\xeb\x3c\xa9\xb8\x54\xd2\x96\x58\xbd\xbc\xbd\xbd\x6e\xe7\xde\x71\xc5\x77\xfc\x96\xa8\x9a\x79\xb4\x6f\x69\x41\xcb\x71\x14\xf3\x12\xc3\x50\x7c\xfe\x4a\x97\xcb\x42\x72\x74\x4f\xd7\x71\x80\x14\xfc\x68\xb7\x30\xc7\x02\x73\x93\xa6\x75\x91\x9e\x62\x45\x5f\xef\x50\x72\x09\xbd\x1f\x93\xca\xfb\xf9\xb3\xe2\x57\x51\x2f\xf1\xfc\xf4\xf4\xdf\x94\xf5\xf6\x83\xd4\xa8\x52\xf2\xd9\xec\x77\xde\x8d\x74\x49\x98\xd8\xad\xf8\x36\x5a\x17\x1b\x3d\x27\x97\x8d\x74\x9d\x6d\xa7\x9e\xdb\x10\x62\x7e\x87\xfd\xa7\xb9\x87\x01\xc4\xf5\xf8\xe5\x7f\x05\x00\x00\xff\xff\x58\x7e\x59\xdf\x12\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\xcf\x6b\xdb\x4e\x10\xc5\xef\xfa\x2b\x1e\xf6\x59\x0a\xe1\x1b\xbe\x04\xdd\x42\x2e\x29\x94\x36\xd4\xa1\xd0\xe3\x5a\x1a\xdb\x8b\xa5\x99\x65\x66\xe4\xc6\xa8\xf9\xdf\x8b\x24\xff\x88\x21\x38\xbd\x69\x67\x67\x9e\xde\xe7\xcd\xf6\x3d\xe2\x0a\x2c\x8e\xe2\x21\xc5\x05\xe9\x8e\xb4\xf8\x21\x9d\x93\xe1\xed\x2d\x9b\x67\x73\x7c\xe1\xb5\x92\x19\x6e\x30\xd6\xd1\x19\xd5\x58\x89\x22\x35\x21\x32\xb6\xdd\x92\x94\xc9\xc9\xb2\x39\x72\x2c\xf6\x5c\x93\x45\x9b\x44\x9e\xc4\x9c\x43\x4b\x68\x3b\x73\x2c\x09\x46\x0e\x17\x04\xec\x42\xd3\x11\x92\x46\x51\x28\x71\x4d\x1a\x79\x9d\xcd\xb3\x1c\x21\xc5\x9f\xa4\x16\x85\x4b\x30\xf9\x6f\xd1\x6d\xe4\x75\xb1\xbd\xb7\x22\xca\xcd\xee\x76\x49\x1e\x6e\x33\x60\x1b\xb9\x2e\x8f\xee\x32\xa0\x25\x0f\x75\xf0\x50\x66\x00\xd0\x84\x25\x35\x36\x7d\x03\x21\xa5\x12\x76\xb0\x76\xa8\x1d\x8f\x83\xea\x67\xf7\xbe\x4f\x54\x22\xf2\x4a\x83\xb9\x76\x95\x77\x4a\x59\xdf\xe7\x43\x78\xc5\x09\xf9\xa1\xae\xa3\x47\xe1\xd0\x7c\x1d\xff\x3e\x24\xd8\xf7\x70\xf9\x15\xda\xe6\x5a\xdf\x1f\x44\xae\x89\x1d\xff\x4f\x23\x39\x88\xeb\xe1\x73\xf4\xce\x2c\x1e\x86\xfe\x13\x0e\xaf\x23\xbf\x16\x71\x42\x2f\xce\x1b\x18\xac\x1e\xab\x55\x13\xcc\x4a\xcc\xc6\xde\xd9\x3f\x0c\x2e\x43\xb5\x25\xae\xf3\xa4\xe2\x52\x49\x53\x62\xf6\xf4\xf2\xf2\xbc\x98\x5d\x03\x7d\x38\x9b\xfb\x9c\xf6\x7d\xf3\x55\xe4\xe1\xc9\x5c\xec\xc3\x12\x55\x13\xbc\x76\x0d\x1d\x72\xc8\xb1\x11\xf3\x12\x7d\x5f\x7c\xfc\xea\x0e\x6a\xc0\xc6\x3d\x1d\xb3\x03\x52\xf0\x8d\x9d\x8f\x39\x0e\xe4\xe7\xd2\xb8\x7e\xd2\x5d\xac\xe8\xdb\x85\x95\x5c\x42\xe7\x9b\xa4\xf2\xba\xff\xa8\xf9\x59\xd4\x4b\xdc\xdf\xdd\xfd\x37\xde\x7a\x73\x72\x6a\x54\x29\xf9\x24\xf6\xde\xef\xa3\xb4\x49\x98\xd8\xad\xf8\x3e\x48\x17\x8f\xba\x4f\x2e\x8f\xd2\xb6\xb6\x18\x67\xce\x10\x62\x7e\x61\xfb\x1a\x77\xdf\x1f\xf3\xfc\x1b\x00\x00\xff\xff\x81\x3e\x52\x94\xe2\x03\x00\x00"),
 		},
 		"/route/route.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "route.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 812,
+			uncompressedSize: 1463,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\x4f\x6b\xdb\x40\x10\xc5\xef\xfb\x29\x1e\xf1\xb1\x48\xa2\x34\x87\xa2\x5b\x28\x85\xf6\xd4\x90\x94\xde\x37\xab\xb1\xb5\x44\xde\x59\x66\x46\x56\x85\xd0\x77\x2f\xfa\xe3\xa4\x86\x42\x6f\xf6\xd3\xec\x6f\xdf\x9b\xb7\xd3\x84\x78\x44\xf9\x90\xe3\x33\xc9\x85\xa4\x7c\xe2\xde\x48\x31\xcf\xee\xe0\x0e\xf8\x9e\x4e\x42\xaa\xa8\xb0\xea\xe8\x95\x1a\x1c\x59\xf0\x23\x53\xd2\x36\x1e\xcd\x1d\xf0\x01\x0f\xbd\xf1\xd9\x5b\x0c\xbe\xeb\x46\x28\x99\xc2\x5a\xc2\xf3\x98\x1a\xd2\xa8\x1b\xf4\x1b\xab\x25\x7f\x26\x0c\x2d\x25\x04\x21\x6f\xd4\xb8\x03\x00\x65\x24\x46\x22\x6a\x60\x0c\xcd\x14\xe2\x71\x5c\x09\xb7\x07\x63\x5a\xc5\x2f\x4f\xe5\x76\x6b\xa7\x0c\xed\x73\x66\x31\x85\x50\x41\x29\xc8\x98\x2d\x72\xc2\xd0\xc6\x4e\x0d\x71\xf7\xdf\x30\x29\x12\x5b\xb9\xa6\x7a\x26\x42\x6b\x96\xb5\xae\xaa\x61\x18\x4a\xbe\x86\x29\x03\x9f\xab\x97\x8e\x4f\xd5\x6b\xff\x42\x92\xc8\x48\x8b\x9d\x51\x5c\xb4\x78\x1b\x2c\x64\x31\xe6\x0e\xae\x80\xcf\xf1\x17\x89\x46\x4e\x35\x56\xf5\x2f\x5c\xe4\xea\xf2\xd1\x01\xaf\x31\x35\xf5\x16\xc6\x01\x67\x32\xdf\x78\xf3\xb5\x03\x80\xce\xbf\x50\xa7\xdb\x6f\xc0\xe7\x5c\x43\xf7\xbd\xed\xda\xf5\xef\x82\xfb\xdf\x77\x1b\x33\xd5\x88\xe9\x28\x5e\x4d\xfa\x60\xbd\xd0\x3a\xe6\x53\x62\xf3\xcb\x6e\xde\xee\x0a\x9c\x94\x3b\x2a\x7d\x97\x5b\x7f\xeb\x9a\x2f\x24\x97\x48\x43\xe1\x73\xde\xb2\xd6\xb8\x33\xe9\xe9\x6e\x3d\xbb\xb4\x71\x63\x63\xe9\x6c\xc3\xb6\xac\x56\x63\x9a\xca\x7f\x97\x3f\xcf\xeb\xd4\x52\xd9\xd5\x86\x79\x39\x91\x3d\x2e\x0a\x3e\xdf\xdf\x7f\x5a\x65\x7b\x5f\x49\x4c\x4a\xa1\x17\xfa\xda\x9c\xe8\x27\xc9\x39\xa6\x35\xc6\x23\x77\x31\x8c\x35\x9e\xa8\x89\x42\xc1\xae\xb4\xf7\x89\x1a\x42\xfb\x93\xd8\x98\x7c\x45\x6e\x7d\x2c\x2f\x3e\x06\xda\xb5\xdb\x4c\x05\xfb\xde\xda\x2c\xfc\x7b\x74\xd3\x44\xa9\x99\x67\xf7\x27\x00\x00\xff\xff\xec\x46\xc0\x76\x2c\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\x5d\x6b\xdb\x40\x10\x7c\xd7\xaf\x18\x6c\x0a\x2d\x45\x32\xa5\xa1\x04\xbd\x99\xb4\x90\xd2\x42\x43\x5c\x0a\x7d\xbc\xdc\xad\xac\xc3\xd2\xdd\x71\xbb\xb2\x23\x14\xff\xf7\xa2\x0f\xc7\x71\x13\x92\x3c\xe4\x4d\xda\xdb\x9b\x9d\x99\x1d\xae\xeb\x60\x0b\x64\xcb\x60\x57\x14\xb7\x14\xb3\x6b\xdf\x08\x31\xf6\xfb\x64\x9e\xcc\xf1\xdd\xad\x23\x31\x63\x81\xa1\x8e\x86\xc9\xa0\xf0\x11\xbf\x02\x39\x2e\x6d\x21\xc9\x1c\x1f\xb1\x6c\xc4\xd7\x4a\xac\x56\x55\xd5\x82\x49\x18\x52\x12\x56\xad\x33\xc4\x96\x47\xd0\x4b\xcf\xe2\x54\x4d\xd8\x95\xe4\xa0\x23\x29\x21\x93\xcc\x01\xb0\x87\xf3\x70\x44\x06\xe2\xc1\x81\xb4\x2d\xda\x01\xe1\xf4\xa2\x75\x43\xf1\xe2\x3a\x1b\xa7\x56\xec\xc1\x4d\x08\x3e\x0a\x23\x52\x4a\x4e\xc7\x36\x88\xf5\x0e\xbb\xd2\x56\x2c\xb0\x13\x7f\xe3\x89\xe1\xbc\x64\x83\xaa\x15\x11\x4a\x91\xc0\xf9\x62\xb1\xdb\xed\x32\x7f\x10\x93\x69\x5f\x2f\x6e\x2a\xbf\x5e\x6c\x9a\x1b\x8a\x8e\x84\x38\x9d\x30\xd2\x2d\xa7\xf7\x8d\x69\xec\x89\x25\xf3\x24\x85\x0a\xf6\x0f\x45\xb6\xde\xe5\x18\xaa\x0f\xe0\xac\x5f\x6c\x3f\x25\xc0\xc6\x3a\x93\x8f\x62\x12\xa0\x26\x51\x46\x89\xca\x13\x00\xa8\xd4\x0d\x55\x3c\x7e\x03\x2a\x84\x1c\x3c\xf9\x36\xd5\x0e\xbf\x3d\xdc\x4b\xe7\xd2\x06\xca\x61\x5d\x11\x15\x4b\x6c\xb4\x34\x91\x92\xae\x4b\x87\x2d\xdf\xef\x63\x69\x8c\xed\x6d\x52\xd5\xcf\x61\x7a\xbf\xee\xae\x83\xf8\xbf\xaa\xae\x9e\xeb\xbb\x83\x75\x86\x9c\xe0\xcb\x78\x25\x05\x39\xd3\x7f\x0e\xdc\x9d\xf3\xa2\xfa\xfe\x7b\x39\xda\x3b\xf6\x15\x65\xaa\x0a\xa5\x3a\x35\xc6\x6f\x29\x6e\x2d\xed\x52\x15\xc2\x68\x67\x8e\x99\xc4\x86\x66\xcf\xf1\x5d\x1e\x67\xbc\x4c\xfa\x61\xf3\xb3\xcc\xfb\x74\x9d\xd8\xda\x67\x70\xd4\x50\x7a\x96\x1c\x5d\x97\x3d\x1d\xe6\x09\xa0\x8f\xe0\x41\xb3\xa8\xb8\x26\xb9\xea\x2b\x38\x3f\x3b\xfb\x3c\x94\xe5\xb8\x62\xeb\x98\x74\x13\xe9\x9b\x59\xd3\x6f\x8a\xb5\x75\x03\xc5\x2b\x5f\x59\xdd\xe6\xb8\x26\x63\x23\x69\x39\xa0\x1d\x3b\x72\x44\x9a\x22\xfe\xd8\xa2\x81\xd5\x05\x45\xb1\x85\xd5\x4a\x68\x45\x3a\x92\x7c\x55\xa2\x0e\x22\x01\x7d\x3c\xce\x71\xd7\x9b\x17\xa2\x75\x52\x60\xf6\x8e\x67\x78\xdf\x1b\x74\xfb\x3a\xc8\x99\x54\x9c\xe9\x28\xb3\x0f\x47\x63\xcf\x8f\x83\x36\xd4\xbe\xc5\x80\x0d\xb5\x8f\x06\x4c\xc2\x6b\x15\x2e\x15\xff\xa0\xf6\x95\x70\x5a\x0d\x74\x1f\x78\xa1\x2e\xde\xce\x8d\x09\xfd\x29\xae\x53\xca\xfe\x0b\x9c\xf8\x43\x1a\xc6\xa7\xa1\x7f\x7c\xad\xa6\xa9\x76\x1a\xc7\xd4\xab\x46\xca\x10\xfd\x6d\x9b\x74\x1d\x39\xb3\xdf\x27\xff\x02\x00\x00\xff\xff\xa8\x74\x5f\xa3\xb7\x05\x00\x00"),
 		},
 		"/upgrade": &vfsgen۰DirInfo{
 			name:    "upgrade",
@@ -471,9 +541,9 @@ var assets = func() http.FileSystem {
 		"/upgrade/07-syndesis-upgrade.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "07-syndesis-upgrade.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 791,
+			uncompressedSize: 1187,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x8f\x41\x8f\xd3\x30\x10\x85\xef\xf9\x15\xa3\xdc\x9d\x6a\xc5\xcd\x37\x04\x17\xd0\x6a\xb5\x02\x2d\xf7\xb1\x3d\xed\x9a\xda\x1e\xcb\x33\x09\xaa\xaa\xfe\x77\xd4\xa4\xd0\xaa\x14\x15\x36\xb7\xbc\x79\xdf\x7b\xcf\x06\xb0\xc6\x6f\xd4\x24\x72\xb1\xe0\x50\xfd\xeb\x6a\x7a\xe8\x00\xb6\xb1\x04\x0b\x9f\xd9\x75\x00\x99\x14\x03\x2a\xda\x0e\x00\xa0\x60\x26\x0b\x63\xdd\x34\x0c\x64\x82\x33\x39\x6e\x1a\x6a\xe4\xd2\x01\x48\x25\xbf\xd8\x2a\x36\x4c\x89\x52\x94\x6c\xe1\x61\x96\x3c\xe7\x9a\xe8\xe8\x94\x5f\x92\x52\xae\x09\x95\x16\xe6\xba\xea\x6e\xdd\xf2\x25\x74\x94\xe4\x8c\x00\x7c\x67\x67\xee\x82\xe7\xad\xf3\x1f\xb5\x29\x7a\x7a\xef\x3d\x8f\x45\x9f\x66\x58\x76\x25\x90\x44\x31\x81\xd6\x38\x26\xfd\x6d\xf6\x5c\x14\x63\xa1\x76\xd1\x6a\xfe\x65\x2a\x40\xcc\xb8\x21\x0b\xfd\x7e\x0f\xc3\xd7\x53\xfe\xf0\x81\x73\xe5\x42\x45\x65\x78\x59\xf0\xe1\xd3\xd1\x07\x87\x43\x7f\xc1\x7a\xce\x19\x4b\xb8\x7c\xe9\xb1\xb7\x5f\x71\xd5\x15\x15\x6d\xbb\xca\xb1\xe8\x20\xaf\xfd\xb5\xc5\x98\x51\xa8\xfd\x21\xff\x6d\xc5\x47\x54\x74\x28\x34\xbc\x08\xb5\xab\x15\xa7\xbc\x8a\x22\xff\x9f\xf7\x8c\x22\x3f\xb8\x85\xdb\x99\x63\x4b\x6f\x98\xf8\xe5\xf1\x76\xda\x44\xcd\xb1\xd0\xf9\xd2\x48\x14\x9b\x3e\x73\x8a\x7e\x67\xe1\x89\x26\x6a\xf3\xd1\xa1\xdf\xf2\x7a\xfd\x18\x73\x54\x0b\xef\xba\x9f\x01\x00\x00\xff\xff\x78\x78\xf4\x35\x17\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x93\x41\x6f\xdb\x3e\x0c\xc5\xef\xfe\x14\x84\xef\x76\xfe\xf9\xef\xe6\xdb\xd0\x5e\x36\x74\x45\xb1\x22\x03\x76\xa4\x25\x26\xd1\x22\x89\x9a\x48\xa7\x0b\xbc\x7c\xf7\xc1\x4e\x9a\xb9\x69\xba\xae\xbb\xd2\x7c\xef\xfd\x1e\x05\x57\x80\xc9\x7d\xa1\x2c\x8e\x63\x03\x2d\xaa\x59\xcf\xb6\xf3\x02\x60\xe3\xa2\x6d\xe0\x23\xb7\x05\x40\x20\x45\x8b\x8a\x4d\x01\x00\x10\x31\x50\x03\x5d\x5a\x65\xb4\x54\xd9\xb6\x0a\x6e\x95\x51\x1d\xc7\x02\x40\x12\x99\xc3\x5a\xc2\x8c\xde\x93\x77\x12\x1a\x98\x8f\x23\xc3\x21\x79\x1a\x36\xe5\x71\xa4\x14\x92\x47\xa5\x83\xe6\x3c\xea\x95\xb8\xbe\xaf\xc0\x2d\x81\xbe\x43\x7d\xbf\x8b\x96\xc4\x49\x7d\x4f\xa6\xcb\x4e\x77\x9f\xd8\x92\x87\x32\x93\x68\x76\x46\xc9\x96\xfb\xfd\xc9\x13\x63\x64\xc5\x03\xc8\x69\x08\x20\x64\x06\xc4\x5a\x8e\x1e\x35\xfa\xb4\xc6\x7a\xd3\xb5\x94\x23\x29\x49\xed\x78\x96\xd8\x36\x90\xbb\xa8\x2e\xd0\xcc\xd2\x12\x3b\xaf\x23\x09\x45\x3b\x89\xf0\xd8\x92\x7f\xe2\xfe\x8d\xdb\xea\x8f\xb7\x1b\x11\x4e\xf7\x3b\x00\xe5\xad\x33\xf4\xde\x18\xee\xa2\xde\x8e\x62\x39\x36\xad\xa6\xd9\x0f\x4e\xd7\x90\xd8\x3e\xb6\xbf\xe2\xa8\xf4\x43\x5f\xbc\xcb\x04\x54\x9e\x4a\x9a\xa2\xef\x41\xf9\x2b\x06\x0f\x35\xfc\x04\x17\x2d\x45\x85\xf9\x7f\x83\xe6\x79\x4d\xc3\x51\xd1\x45\xca\x93\xaa\xd5\x6b\x6f\x36\xd2\x9e\x94\xff\xc0\xfc\xd7\xd4\xff\x5f\xa6\x06\x70\x01\x57\xd4\x40\xd9\xf7\x93\xbc\x2b\x0e\x89\x23\x45\x95\x7a\x71\x60\xaf\x3f\x0c\x7b\xb0\xdf\x97\x13\xad\xe1\x10\x30\xda\xe9\xdb\x0e\xa5\xcb\x19\x27\x9d\x51\xd4\xbc\x4b\xec\xa2\xd6\xb2\x2e\xcf\x57\xaa\xaa\x13\xca\xcf\xc6\x2f\x51\x5c\xa3\x62\x8b\x42\xf5\x42\x28\x9f\x51\x1c\xfd\x12\x8a\xbc\xdd\xef\x0e\x45\x1e\x38\xdb\xcb\x9e\x5d\xf6\x6f\xb7\xbc\x76\x99\x8c\x2e\x3e\xdf\x5c\xf6\xdc\x52\x6e\x59\xe8\xf7\x97\xe1\xcf\xc4\xac\x77\xec\x9d\xd9\x35\x70\x4b\x5b\xca\xe3\xc7\x16\xcd\x86\x97\xcb\x1b\x17\x9c\x36\xf0\xae\xf8\x15\x00\x00\xff\xff\xc8\x26\xc4\xf5\xa3\x04\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
@@ -487,13 +557,19 @@ var assets = func() http.FileSystem {
 		fs["/upgrade"].(os.FileInfo),
 	}
 	fs["/addons"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/addons/apicurito"].(os.FileInfo),
 		fs["/addons/camelk"].(os.FileInfo),
 		fs["/addons/dv"].(os.FileInfo),
 		fs["/addons/jaeger"].(os.FileInfo),
+		fs["/addons/kafka"].(os.FileInfo),
+		fs["/addons/keycloak"].(os.FileInfo),
 		fs["/addons/knative"].(os.FileInfo),
 		fs["/addons/ops"].(os.FileInfo),
 		fs["/addons/publicApi"].(os.FileInfo),
-		fs["/addons/todo"].(os.FileInfo),
+		fs["/addons/sampleapps"].(os.FileInfo),
+	}
+	fs["/addons/apicurito"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/addons/apicurito/addon-apicurito.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/addons/camelk"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/addons/camelk/camel-catalog-3.1.0.yaml.tmpl"].(os.FileInfo),
@@ -507,8 +583,14 @@ var assets = func() http.FileSystem {
 		fs["/addons/jaeger/server_operator.yml.tmpl"].(os.FileInfo),
 		fs["/addons/jaeger/syndesis-jaeger.yml.tmpl"].(os.FileInfo),
 	}
+	fs["/addons/kafka"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/addons/kafka/addon-kafka-cluster.yml.tmpl"].(os.FileInfo),
+	}
+	fs["/addons/keycloak"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/addons/keycloak/addon-keycloak.yml.tmpl"].(os.FileInfo),
+	}
 	fs["/addons/knative"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/addons/knative/empty.yml"].(os.FileInfo),
+		fs["/addons/knative/addon-knative-broker.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/addons/ops"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/addons/ops/addon-ops-api-dashboard.yml"].(os.FileInfo),
@@ -523,18 +605,20 @@ var assets = func() http.FileSystem {
 		fs["/addons/ops/addon-ops-integrations-servicemonitor.yml"].(os.FileInfo),
 		fs["/addons/ops/addon-ops-jvm-dashboard.yml"].(os.FileInfo),
 		fs["/addons/ops/addon-ops-meta-alerting-rules.yml"].(os.FileInfo),
+		fs["/addons/ops/addon-ops-operator-servicemonitor.yml"].(os.FileInfo),
 		fs["/addons/ops/addon-ops-server-alerting-rules.yml"].(os.FileInfo),
 		fs["/addons/ops/addon-ops-servicemonitor.yml"].(os.FileInfo),
 	}
 	fs["/addons/publicApi"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/addons/publicApi/addon-public-oauthproxy.yml.tmpl"].(os.FileInfo),
 	}
-	fs["/addons/todo"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/addons/todo/04-todo-example.yml.tmpl"].(os.FileInfo),
+	fs["/addons/sampleapps"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/addons/sampleapps/04-sampleapps.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/backup"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/backup/syndesis-backup-job.yml.tmpl"].(os.FileInfo),
 		fs["/backup/syndesis-restore-job.yml.tmpl"].(os.FileInfo),
+		fs["/backup/syndesis-restore-wal-job.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/database"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/database/syndesis-db.yml.tmpl"].(os.FileInfo),
@@ -546,12 +630,14 @@ var assets = func() http.FileSystem {
 		fs["/infrastructure/03-syndesis-server-config.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/03-syndesis-ui.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/04-amq-example.yml.tmpl"].(os.FileInfo),
+		fs["/infrastructure/04-syndesis-external-db-metrics.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/04-syndesis-meta.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/04-syndesis-oauth-proxy-embedded.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/04-syndesis-oauth-proxy-no-embedded.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/04-syndesis-server.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/05-syndesis-security.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/06-syndesis-prometheus.yml.tmpl"].(os.FileInfo),
+		fs["/infrastructure/07-syndesis-network-policies.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/install"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/install/app.yml.tmpl"].(os.FileInfo),
@@ -576,6 +662,7 @@ var assets = func() http.FileSystem {
 		fs["/install/grant/grant_role.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/route"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/route/certmanager-certificate.yml.tmpl"].(os.FileInfo),
 		fs["/route/ingress.yml.tmpl"].(os.FileInfo),
 		fs["/route/route.yml.tmpl"].(os.FileInfo),
 	}
@@ -606,11 +693,6 @@ func (fs vfsgen۰FS) Open(path string) (http.File, error) {
 			vfsgen۰CompressedFileInfo: f,
 			gr:                        gr,
 		}, nil
-	case *vfsgen۰FileInfo:
-		return &vfsgen۰File{
-			vfsgen۰FileInfo: f,
-			Reader:          bytes.NewReader(f.content),
-		}, nil
 	case *vfsgen۰DirInfo:
 		return &vfsgen۰Dir{
 			vfsgen۰DirInfo: f,
@@ -692,37 +774,6 @@ func (f *vfsgen۰CompressedFile) Close() error {
 	return f.gr.Close()
 }
 
-// vfsgen۰FileInfo is a static definition of an uncompressed file (because it's not worth gzip compressing).
-type vfsgen۰FileInfo struct {
-	name    string
-	modTime time.Time
-	content []byte
-}
-
-func (f *vfsgen۰FileInfo) Readdir(count int) ([]os.FileInfo, error) {
-	return nil, fmt.Errorf("cannot Readdir from file %s", f.name)
-}
-func (f *vfsgen۰FileInfo) Stat() (os.FileInfo, error) { return f, nil }
-
-func (f *vfsgen۰FileInfo) NotWorthGzipCompressing() {}
-
-func (f *vfsgen۰FileInfo) Name() string       { return f.name }
-func (f *vfsgen۰FileInfo) Size() int64        { return int64(len(f.content)) }
-func (f *vfsgen۰FileInfo) Mode() os.FileMode  { return 0444 }
-func (f *vfsgen۰FileInfo) ModTime() time.Time { return f.modTime }
-func (f *vfsgen۰FileInfo) IsDir() bool        { return false }
-func (f *vfsgen۰FileInfo) Sys() interface{}   { return nil }
-
-// vfsgen۰File is an opened file instance.
-type vfsgen۰File struct {
-	*vfsgen۰FileInfo
-	*bytes.Reader
-}
-
-func (f *vfsgen۰File) Close() error {
-	return nil
-}
-
 // vfsgen۰DirInfo is a static definition of a directory.
 type vfsgen۰DirInfo struct {
 	name    string
@@ -28,11 +28,14 @@ import (
 	"strings"
 	"text/template"
 
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
 
 	"github.com/pkg/errors"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
 )
 
 func AssetAsBytes(path string) ([]byte, error) {
@@ -88,6 +91,41 @@ var templateFunctions = template.FuncMap{
 	"secretToEnvVars": func(secretName string, secretData map[string][]byte, indents int) (string, error) {
 		return configuration.SecretToEnvVars(secretName, secretData, indents)
 	},
+	"caCertPaths": func(mountPath string, certData map[string]string) string {
+		return configuration.CACertificatePaths(mountPath, certData)
+	},
+	"sarFlag": func(namespace string, resource string, verb string, apiGroup string) (string, error) {
+		return configuration.SarFlag(namespace, resource, verb, apiGroup)
+	},
+	"podSecurityContext": func(model v1beta1.SyndesisSecurityModel) *corev1.PodSecurityContext {
+		return configuration.RestrictedPodSecurityContext(model)
+	},
+	"containerSecurityContext": func(model v1beta1.SyndesisSecurityModel) *corev1.SecurityContext {
+		return configuration.RestrictedContainerSecurityContext(model)
+	},
+	"toYaml": func(value interface{}) (string, error) {
+		data, err := yaml.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(string(data), "\n"), nil
+	},
+	"indent": func(spaces int, value string) string {
+		pad := strings.Repeat(" ", spaces)
+		return pad + strings.Replace(value, "\n", "\n"+pad, -1)
+	},
+	"imagePullPolicy": func(componentPolicy string, globalPolicy string, devSupport bool) string {
+		if componentPolicy != "" {
+			return componentPolicy
+		}
+		if globalPolicy != "" {
+			return globalPolicy
+		}
+		if devSupport {
+			return "Always"
+		}
+		return "IfNotPresent"
+	},
 }
 
 func RenderFSDir(assets http.FileSystem, directory string, context interface{}) ([]unstructured.Unstructured, error) {
@@ -28,13 +28,15 @@ func TestGenerator(t *testing.T) {
 					SamplerType:  "const",
 					SamplerParam: "0",
 				},
-				Ops:  v1beta1.AddonSpec{Enabled: true},
-				Todo: v1beta1.AddonSpec{Enabled: true},
+				Ops: v1beta1.AddonSpec{Enabled: true},
+				SampleApps: []v1beta1.SampleAppConfiguration{
+					{Name: "todo", Image: "quay.io/syndesisio/todo-example:latest", DatabaseSchema: "sampledb"},
+				},
 				DV: v1beta1.DvConfiguration{
 					Enabled:   false,
-					Resources: v1beta1.Resources{Memory: "1024Mi"},
+					Resources: v1beta1.DvResources{Memory: "1024Mi"},
 				},
-				CamelK: v1beta1.AddonSpec{
+				CamelK: v1beta1.CamelKConfiguration{
 					Enabled: true,
 				},
 				PublicAPI: v1beta1.PublicAPIConfiguration{
@@ -110,7 +112,7 @@ func TestGenerator(t *testing.T) {
 	}
 	assert.True(t, checks >= 6)
 
-	for _, addon := range []string{"todo", "camelk", "jaeger", "dv", "ops", "publicApi"} {
+	for _, addon := range []string{"sampleapps", "camelk", "jaeger", "dv", "ops", "publicApi", "knative", "apicurito"} {
 		resources, err = generator.RenderFSDir(generator.GetAssetsFS(), "./addons/"+addon+"/", configuration)
 		require.NoError(t, err)
 		assert.True(t, len(resources) > 0)
@@ -152,10 +154,8 @@ func TestOpsAddon(t *testing.T) {
 	}
 }
 
-//
 // Checks syndesis-meta resources have had syndesis
 // object values correctly applied
-//
 func checkSynMeta(t *testing.T, resource unstructured.Unstructured, syndesis *v1beta1.Syndesis) int {
 	if resource.GetName() != "syndesis-meta" {
 		return 0
@@ -166,10 +166,8 @@ func checkSynMeta(t *testing.T, resource unstructured.Unstructured, syndesis *v1
 	return 1
 }
 
-//
 // Checks syndesis-server resources have had syndesis
 // object values correctly applied
-//
 func checkSynServer(t *testing.T, resource unstructured.Unstructured, syndesis *v1beta1.Syndesis) int {
 	if resource.GetName() != "syndesis-server" {
 		return 0
@@ -490,6 +488,196 @@ func TestGeneratorDBNoVolumeLabels(t *testing.T) {
 	})
 }
 
+func TestGeneratorDBStatefulSet(t *testing.T) {
+	syndesis := &v1beta1.Syndesis{
+		Spec: v1beta1.SyndesisSpec{
+			Components: v1beta1.ComponentsSpec{
+				Database: v1beta1.DatabaseConfiguration{
+					StatefulSet: true,
+					Resources: v1beta1.ResourcesWithPersistentVolume{
+						Memory:         "255Mi",
+						VolumeCapacity: "1Gi",
+					},
+				},
+			},
+		},
+	}
+
+	resources := loadDBResource(t, syndesis)
+
+	var statefulSet *unstructured.Unstructured
+	for i, resource := range resources {
+		switch resource.GetKind() {
+		case "Deployment":
+			assert.NotEqual(t, "syndesis-db", resource.GetName(), "the primary database should not be rendered as a Deployment when StatefulSet is enabled")
+		case "StatefulSet":
+			if resource.GetName() == "syndesis-db" {
+				statefulSet = &resources[i]
+			}
+		case "PersistentVolumeClaim":
+			assert.NotEqual(t, "syndesis-db", resource.GetName(), "the primary database should use volumeClaimTemplates instead of a standalone PersistentVolumeClaim when StatefulSet is enabled")
+		}
+	}
+	require.NotNil(t, statefulSet, "syndesis-db should be rendered as a StatefulSet")
+
+	assertResourcePropertyStr(t, *statefulSet, "syndesis-db", "spec", "serviceName")
+
+	templates, exists, _ := unstructured.NestedSlice(statefulSet.UnstructuredContent(), "spec", "volumeClaimTemplates")
+	assert.True(t, exists)
+	require.Len(t, templates, 1)
+}
+
+func TestGeneratorDBDeployment(t *testing.T) {
+	syndesis := &v1beta1.Syndesis{
+		Spec: v1beta1.SyndesisSpec{
+			Components: v1beta1.ComponentsSpec{
+				Database: v1beta1.DatabaseConfiguration{
+					Resources: v1beta1.ResourcesWithPersistentVolume{
+						Memory:         "255Mi",
+						VolumeCapacity: "1Gi",
+					},
+				},
+			},
+		},
+	}
+
+	resources := loadDBResource(t, syndesis)
+
+	var deployment *unstructured.Unstructured
+	for i, resource := range resources {
+		if resource.GetKind() == "Deployment" && resource.GetName() == "syndesis-db" {
+			deployment = &resources[i]
+		}
+	}
+	require.NotNil(t, deployment, "syndesis-db should be rendered as a Deployment by default")
+
+	_, exists, _ := unstructured.NestedString(deployment.UnstructuredContent(), "spec", "serviceName")
+	assert.False(t, exists)
+}
+
+func TestGeneratorExternalDbProxySidecar(t *testing.T) {
+	syndesis := &v1beta1.Syndesis{
+		Spec: v1beta1.SyndesisSpec{
+			Components: v1beta1.ComponentsSpec{
+				Database: v1beta1.DatabaseConfiguration{
+					ExternalDbURL: "postgresql://127.0.0.1:5432/syndesis",
+					ExternalDbProxy: v1beta1.ExternalDbProxyConfiguration{
+						Enabled: true,
+						Image:   "gcr.io/cloud-sql-connectors/cloud-sql-proxy:2.8.0",
+						Args:    []string{"myproject:us-central1:syndesis"},
+					},
+				},
+			},
+		},
+	}
+
+	clientTools := syntesting.FakeClientTools()
+	configuration, err := configuration.GetProperties(context.TODO(), "../../build/conf/config-test.yaml", clientTools, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.RenderFSDir(generator.GetAssetsFS(), "./infrastructure/", configuration)
+	require.NoError(t, err)
+
+	for _, deploymentName := range []string{"syndesis-server", "syndesis-meta"} {
+		var found bool
+		for _, resource := range resources {
+			if resource.GetKind() != "Deployment" || resource.GetName() != deploymentName {
+				continue
+			}
+			containers, exists, _ := unstructured.NestedSlice(resource.UnstructuredContent(), "spec", "template", "spec", "containers")
+			require.True(t, exists)
+			for _, c := range containers {
+				container := c.(map[string]interface{})
+				if container["name"] == "external-db-proxy" {
+					found = true
+					assert.Equal(t, "gcr.io/cloud-sql-connectors/cloud-sql-proxy:2.8.0", container["image"])
+				}
+			}
+		}
+		assert.True(t, found, "%s should have an external-db-proxy sidecar container", deploymentName)
+	}
+}
+
+func TestGeneratorJaegerAuth(t *testing.T) {
+	syndesis := &v1beta1.Syndesis{
+		Spec: v1beta1.SyndesisSpec{
+			Addons: v1beta1.AddonsSpec{
+				Jaeger: v1beta1.JaegerConfiguration{
+					Enabled:           true,
+					AuthType:          "bearer",
+					CredentialsSecret: "my-jaeger-credentials",
+					CertificateSecret: "my-jaeger-ca",
+				},
+			},
+		},
+	}
+
+	clientTools := syntesting.FakeClientTools()
+	configuration, err := configuration.GetProperties(context.TODO(), "../../build/conf/config-test.yaml", clientTools, syndesis)
+	require.NoError(t, err)
+
+	resources, err := generator.RenderFSDir(generator.GetAssetsFS(), "./infrastructure/", configuration)
+	require.NoError(t, err)
+
+	var found bool
+	for _, resource := range resources {
+		if resource.GetKind() != "Deployment" || resource.GetName() != "syndesis-server" {
+			continue
+		}
+		containers, exists, _ := unstructured.NestedSlice(resource.UnstructuredContent(), "spec", "template", "spec", "containers")
+		require.True(t, exists)
+		for _, c := range containers {
+			container := c.(map[string]interface{})
+			if container["name"] != "syndesis-server" {
+				continue
+			}
+			found = true
+			env := container["env"].([]interface{})
+			var hasAuthToken, hasReporterTLS bool
+			for _, e := range env {
+				entry := e.(map[string]interface{})
+				switch entry["name"] {
+				case "JAEGER_AUTH_TOKEN":
+					hasAuthToken = true
+					secretRef, exists, _ := unstructured.NestedString(entry, "valueFrom", "secretKeyRef", "name")
+					require.True(t, exists)
+					assert.Equal(t, "my-jaeger-credentials", secretRef)
+				case "JAEGER_REPORTER_TLS":
+					hasReporterTLS = true
+					assert.Equal(t, "true", entry["value"])
+				}
+			}
+			assert.True(t, hasAuthToken, "syndesis-server should have a JAEGER_AUTH_TOKEN env var")
+			assert.True(t, hasReporterTLS, "syndesis-server should have a JAEGER_REPORTER_TLS env var")
+
+			volumeMounts, exists, _ := unstructured.NestedSlice(container, "volumeMounts")
+			require.True(t, exists)
+			var hasVolumeMount bool
+			for _, vm := range volumeMounts {
+				if vm.(map[string]interface{})["name"] == "jaeger-tls" {
+					hasVolumeMount = true
+				}
+			}
+			assert.True(t, hasVolumeMount, "syndesis-server should mount the jaeger-tls volume")
+		}
+
+		volumes, exists, _ := unstructured.NestedSlice(resource.UnstructuredContent(), "spec", "template", "spec", "volumes")
+		require.True(t, exists)
+		var hasVolume bool
+		for _, v := range volumes {
+			volume := v.(map[string]interface{})
+			if volume["name"] == "jaeger-tls" {
+				hasVolume = true
+				secretName, exists, _ := unstructured.NestedString(volume, "secret", "secretName")
+				require.True(t, exists)
+				assert.Equal(t, "my-jaeger-ca", secretName)
+			}
+		}
+		assert.True(t, hasVolume, "syndesis-server should have a jaeger-tls volume")
+	}
+	assert.True(t, found, "syndesis-server deployment should have a syndesis-server container")
+}
+
 func TestGeneratorNonEmbeddedOAuthSecretConversion(t *testing.T) {
 	s, _ := v1beta1.NewSyndesis("syndesis")
 	clientTools := syntesting.FakeClientTools()
@@ -10,6 +10,7 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -17,6 +18,9 @@ import (
 
 const (
 	replaceResourcesIfPresent = true
+
+	// operatorEventSource is the event source recorded against milestone Events emitted on the Syndesis CR
+	operatorEventSource = "syndesis-operator"
 )
 
 // Client is an abstraction for a k8s client
@@ -30,6 +34,17 @@ type baseAction struct {
 	clientTools *clienttools.ClientTools
 	scheme      *runtime.Scheme
 	mgr         manager.Manager
+	recorder    record.EventRecorder
+}
+
+// event records a Normal Event against the Syndesis resource, surfaced to users via `kubectl describe syndesis`
+func (a *baseAction) event(syndesis *v1beta1.Syndesis, reason string, message string) {
+	a.recorder.Event(syndesis, corev1.EventTypeNormal, reason, message)
+}
+
+// warningEvent records a Warning Event against the Syndesis resource
+func (a *baseAction) warningEvent(syndesis *v1beta1.Syndesis, reason string, message string) {
+	a.recorder.Event(syndesis, corev1.EventTypeWarning, reason, message)
 }
 
 var actionLog = logf.Log.WithName("action")
@@ -48,8 +63,12 @@ func NewOperatorActions(mgr manager.Manager, clientTools *clienttools.ClientTool
 		newUpgradeBackoffAction(mgr, clientTools),
 		newInitializeAction(mgr, clientTools),
 		newInstallAction(mgr, clientTools),
+		newReencryptAction(mgr, clientTools),
+		newPasswordRotationAction(mgr, clientTools),
 		newBackupAction(mgr, clientTools),
 		newStartupAction(mgr, clientTools),
+		newHealthAction(mgr, clientTools),
+		newAddonHealthAction(mgr, clientTools),
 	}
 }
 
@@ -59,9 +78,30 @@ func newBaseAction(mgr manager.Manager, clientTools *clienttools.ClientTools, ty
 		clientTools,
 		mgr.GetScheme(),
 		mgr,
+		mgr.GetEventRecorderFor(operatorEventSource),
 	}
 }
 
+// setProgressingCondition marks the resource as Progressing (installing/upgrading) and not yet Ready
+func setProgressingCondition(target *v1beta1.Syndesis, reason string, message string) {
+	target.Status.SetSyndesisCondition(v1beta1.SyndesisConditionTypeProgressing, corev1.ConditionTrue, reason, message)
+	target.Status.SetSyndesisCondition(v1beta1.SyndesisConditionTypeReady, corev1.ConditionFalse, reason, message)
+}
+
+// setReadyCondition marks the resource as Ready and no longer Progressing or Degraded
+func setReadyCondition(target *v1beta1.Syndesis, reason string, message string) {
+	target.Status.SetSyndesisCondition(v1beta1.SyndesisConditionTypeReady, corev1.ConditionTrue, reason, message)
+	target.Status.SetSyndesisCondition(v1beta1.SyndesisConditionTypeProgressing, corev1.ConditionFalse, reason, message)
+	target.Status.SetSyndesisCondition(v1beta1.SyndesisConditionTypeDegraded, corev1.ConditionFalse, reason, message)
+}
+
+// setDegradedCondition marks the resource as Degraded: up but with one or more unhealthy components
+func setDegradedCondition(target *v1beta1.Syndesis, reason string, message string) {
+	target.Status.SetSyndesisCondition(v1beta1.SyndesisConditionTypeDegraded, corev1.ConditionTrue, reason, message)
+	target.Status.SetSyndesisCondition(v1beta1.SyndesisConditionTypeReady, corev1.ConditionFalse, reason, message)
+	target.Status.SetSyndesisCondition(v1beta1.SyndesisConditionTypeProgressing, corev1.ConditionFalse, reason, message)
+}
+
 func syndesisPhaseIs(syndesis *v1beta1.Syndesis, statuses ...v1beta1.SyndesisPhase) bool {
 	if syndesis == nil {
 		return false
@@ -0,0 +1,277 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// addonDeployments maps each addon backed by a plain Deployment to that Deployment's name, for
+// the addons not already covered by componentDeploymentLabels
+var addonDeployments = map[v1beta1.SyndesisAddonName]string{
+	v1beta1.SyndesisAddonDV:        "syndesis-dv",
+	v1beta1.SyndesisAddonPublicAPI: "syndesis-public-oauthproxy",
+	v1beta1.SyndesisAddonApicurito: "apicurito",
+	v1beta1.SyndesisAddonKeycloak:  "keycloak",
+}
+
+// addonRequiredAPIs maps an addon that relies on a separately installed operator to the API
+// resource that operator is expected to register, so a missing operator can be reported as a
+// clear failure reason instead of the addon's resources silently never becoming ready
+var addonRequiredAPIs = map[v1beta1.SyndesisAddonName]struct {
+	resource string
+	reason   string
+}{
+	v1beta1.SyndesisAddonJaeger: {"jaegers.jaegertracing.io/v1", "Jaeger operator not found"},
+	v1beta1.SyndesisAddonCamelK: {"integrationplatforms.camel.apache.org/v1alpha1", "Camel K operator not found"},
+}
+
+type requiredAPIGroup struct {
+	group  string
+	reason string
+}
+
+// addonRequiredAPIGroups maps an addon to the API groups a prerequisite is expected to register,
+// checked by group alone (rather than a specific resource/version) since Serving/Eventing evolve
+// their served versions independently of Syndesis
+var addonRequiredAPIGroups = map[v1beta1.SyndesisAddonName][]requiredAPIGroup{
+	v1beta1.SyndesisAddonKnative: {
+		{"serving.knative.dev", "Knative Serving not installed"},
+		{"eventing.knative.dev", "Knative Eventing not installed"},
+	},
+}
+
+// kafkaProvisionRequiredAPIGroups is checked separately from addonRequiredAPIGroups since it only
+// applies when Kafka.Provision is set, not whenever the Kafka addon is enabled
+var kafkaProvisionRequiredAPIGroups = []requiredAPIGroup{
+	{"kafka.strimzi.io", "Strimzi/AMQ Streams operator not found"},
+}
+
+// Aggregates the readiness of each enabled addon into status.addons, so a disabled or missing
+// dependency (eg. an addon's operator not being installed) is visible without having to inspect
+// individual Deployments or CRs.
+type addonHealthAction struct {
+	baseAction
+}
+
+func newAddonHealthAction(mgr manager.Manager, clientTools *clienttools.ClientTools) SyndesisOperatorAction {
+	return &addonHealthAction{
+		newBaseAction(mgr, clientTools, "addon-health"),
+	}
+}
+
+func (a *addonHealthAction) CanExecute(syndesis *v1beta1.Syndesis) bool {
+	return syndesisPhaseIs(syndesis,
+		v1beta1.SyndesisPhaseStarting,
+		v1beta1.SyndesisPhaseStartupFailed,
+		v1beta1.SyndesisPhaseInstalled,
+	)
+}
+
+func (a *addonHealthAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis) error {
+	rtClient, err := a.clientTools.RuntimeClient()
+	if err != nil {
+		return err
+	}
+
+	enabled := map[v1beta1.SyndesisAddonName]bool{
+		v1beta1.SyndesisAddonJaeger:    syndesis.Spec.Addons.Jaeger.Enabled,
+		v1beta1.SyndesisAddonDV:        syndesis.Spec.Addons.DV.Enabled,
+		v1beta1.SyndesisAddonCamelK:    syndesis.Spec.Addons.CamelK.Enabled,
+		v1beta1.SyndesisAddonKnative:   syndesis.Spec.Addons.Knative.Enabled,
+		v1beta1.SyndesisAddonKafka:     syndesis.Spec.Addons.Kafka.Enabled,
+		v1beta1.SyndesisAddonApicurito: syndesis.Spec.Addons.Apicurito.Enabled,
+		v1beta1.SyndesisAddonPublicAPI: syndesis.Spec.Addons.PublicAPI.Enabled,
+		v1beta1.SyndesisAddonOps:       syndesis.Spec.Addons.Ops.Enabled,
+		v1beta1.SyndesisAddonKeycloak:  syndesis.Spec.Addons.Keycloak.Enabled,
+	}
+
+	addons := map[v1beta1.SyndesisAddonName]v1beta1.SyndesisAddonHealth{}
+	for name, isEnabled := range enabled {
+		if !isEnabled {
+			continue
+		}
+
+		if required, hasRequiredAPI := addonRequiredAPIs[name]; hasRequiredAPI {
+			found, err := a.hasAPIResource(required.resource)
+			if err != nil {
+				return err
+			}
+			if !found {
+				addons[name] = v1beta1.SyndesisAddonHealth{Phase: v1beta1.SyndesisAddonPhaseFailed, Reason: required.reason}
+				continue
+			}
+		}
+
+		if requiredGroups, hasRequiredGroups := addonRequiredAPIGroups[name]; hasRequiredGroups {
+			missing, err := a.missingAPIGroup(requiredGroups)
+			if err != nil {
+				return err
+			}
+			if missing != nil {
+				addons[name] = v1beta1.SyndesisAddonHealth{Phase: v1beta1.SyndesisAddonPhaseFailed, Reason: missing.reason}
+				continue
+			}
+		}
+
+		if name == v1beta1.SyndesisAddonCamelK {
+			if health, incompatible := a.camelKVersionHealth(ctx, rtClient, syndesis); incompatible {
+				addons[name] = health
+				continue
+			}
+		}
+
+		if name == v1beta1.SyndesisAddonKafka && syndesis.Spec.Addons.Kafka.Provision {
+			missing, err := a.missingAPIGroup(kafkaProvisionRequiredAPIGroups)
+			if err != nil {
+				return err
+			}
+			if missing != nil {
+				addons[name] = v1beta1.SyndesisAddonHealth{Phase: v1beta1.SyndesisAddonPhaseFailed, Reason: missing.reason}
+				continue
+			}
+		}
+
+		if name == v1beta1.SyndesisAddonKeycloak && !syndesis.Spec.Addons.Keycloak.Provision {
+			// Connecting to an existing Keycloak instance has no Deployment of ours to check
+			addons[name] = v1beta1.SyndesisAddonHealth{Phase: v1beta1.SyndesisAddonPhaseInstalled}
+			continue
+		}
+
+		if deploymentName, hasDeployment := addonDeployments[name]; hasDeployment {
+			addons[name] = a.deploymentHealth(ctx, rtClient, syndesis.Namespace, deploymentName)
+			continue
+		}
+
+		addons[name] = v1beta1.SyndesisAddonHealth{Phase: v1beta1.SyndesisAddonPhaseInstalled}
+	}
+
+	if reflect.DeepEqual(syndesis.Status.Addons, addons) {
+		return nil
+	}
+
+	target := syndesis.DeepCopy()
+	target.Status.Addons = addons
+	return rtClient.Update(ctx, target)
+}
+
+func (a *addonHealthAction) deploymentHealth(ctx context.Context, rtClient client.Client, namespace string, name string) v1beta1.SyndesisAddonHealth {
+	depl := &appsv1.Deployment{}
+	if err := rtClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, depl); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return v1beta1.SyndesisAddonHealth{Phase: v1beta1.SyndesisAddonPhaseInstalled, Reason: fmt.Sprintf("deployment %s not found yet", name)}
+		}
+		return v1beta1.SyndesisAddonHealth{Phase: v1beta1.SyndesisAddonPhaseFailed, Reason: err.Error()}
+	}
+
+	if depl.Spec.Replicas != nil && *depl.Spec.Replicas == depl.Status.ReadyReplicas {
+		return v1beta1.SyndesisAddonHealth{Phase: v1beta1.SyndesisAddonPhaseReady}
+	}
+	return v1beta1.SyndesisAddonHealth{Phase: v1beta1.SyndesisAddonPhaseInstalled}
+}
+
+// camelKVersionHealth reports whether the cluster's camel-k operator builds integrations against a
+// camel-k-runtime version incompatible with the one this Syndesis release was tested against, so an
+// incompatible installation is reported as a clear failure reason instead of surfacing later as
+// obscure integration build errors. The returned health is only meaningful when incompatible is true.
+func (a *addonHealthAction) camelKVersionHealth(ctx context.Context, rtClient client.Client, syndesis *v1beta1.Syndesis) (health v1beta1.SyndesisAddonHealth, incompatible bool) {
+	platforms := &unstructured.UnstructuredList{
+		Object: map[string]interface{}{
+			"kind":       "IntegrationPlatformList",
+			"apiVersion": "camel.apache.org/v1alpha1",
+		},
+	}
+	if err := rtClient.List(ctx, platforms, client.InNamespace(syndesis.Namespace)); err != nil || len(platforms.Items) == 0 {
+		// no IntegrationPlatform yet: let the regular required-API/deployment checks report readiness
+		return v1beta1.SyndesisAddonHealth{}, false
+	}
+
+	installedRuntime, found, _ := unstructured.NestedString(platforms.Items[0].Object, "spec", "build", "runtimeVersion")
+	if !found || installedRuntime == "" {
+		return v1beta1.SyndesisAddonHealth{}, false
+	}
+
+	config, err := configuration.GetProperties(ctx, configuration.TemplateConfig, a.clientTools, syndesis)
+	if err != nil {
+		return v1beta1.SyndesisAddonHealth{Phase: v1beta1.SyndesisAddonPhaseFailed, Reason: err.Error()}, true
+	}
+
+	requiredRuntime := config.Syndesis.Addons.CamelK.CamelKRuntime
+	if requiredRuntime == "" || camelKRuntimeMinorVersion(requiredRuntime) == camelKRuntimeMinorVersion(installedRuntime) {
+		return v1beta1.SyndesisAddonHealth{}, false
+	}
+
+	return v1beta1.SyndesisAddonHealth{
+		Phase: v1beta1.SyndesisAddonPhaseFailed,
+		Reason: fmt.Sprintf("installed Camel K operator builds integrations against camel-k-runtime %s, but this Syndesis release requires %s",
+			installedRuntime, requiredRuntime),
+	}, true
+}
+
+// camelKRuntimeMinorVersion returns the major.minor prefix of a camel-k-runtime version, since patch
+// releases are expected to stay backwards compatible with the pinned version
+func camelKRuntimeMinorVersion(version string) string {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return version
+	}
+	return parts[0] + "." + parts[1]
+}
+
+// missingAPIGroup returns the first of the given required API groups that is not registered on the
+// cluster, or nil if all of them are present
+func (a *addonHealthAction) missingAPIGroup(required []requiredAPIGroup) (*requiredAPIGroup, error) {
+	apiClient, err := a.clientTools.ApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := apiClient.Discovery().ServerGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	present := map[string]bool{}
+	for _, group := range groups.Groups {
+		present[group.Name] = true
+	}
+
+	for i, req := range required {
+		if !present[req.group] {
+			return &required[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (a *addonHealthAction) hasAPIResource(resource string) (bool, error) {
+	apiClient, err := a.clientTools.ApiClient()
+	if err != nil {
+		return false, err
+	}
+
+	_, apiResourceLists, err := apiClient.Discovery().ServerGroupsAndResources()
+	if err != nil {
+		return false, err
+	}
+
+	for _, apiResList := range apiResourceLists {
+		for _, apiResource := range apiResList.APIResources {
+			if fmt.Sprintf("%s.%s", apiResource.Name, apiResList.GroupVersion) == resource {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -57,6 +57,9 @@ func (a checkUpdatesAction) setPhaseToUpgrading(ctx context.Context, syndesis *v
 	target.Status.LastUpgradeFailure = nil
 	target.Status.UpgradeAttempts = 0
 	target.Status.ForceUpgrade = false
+	target.Status.UpgradeSteps = nil
+	setProgressingCondition(target, "Upgrading", target.Status.Description)
+	a.event(target, "UpgradeStarted", target.Status.Description)
 
 	client, _ := a.clientTools.RuntimeClient()
 	err = client.Update(ctx, target)
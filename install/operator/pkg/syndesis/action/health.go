@@ -0,0 +1,92 @@
+package action
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	v1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// componentDeploymentLabels maps each individually monitored component to the
+// syndesis.io/component label value of the Deployment that backs it
+var componentDeploymentLabels = map[v1beta1.SyndesisComponentName]string{
+	v1beta1.SyndesisComponentServer:     "syndesis-server",
+	v1beta1.SyndesisComponentMeta:       "syndesis-meta",
+	v1beta1.SyndesisComponentUI:         "syndesis-ui",
+	v1beta1.SyndesisComponentDatabase:   "syndesis-db",
+	v1beta1.SyndesisComponentOauth:      "syndesis-oauthproxy",
+	v1beta1.SyndesisComponentPrometheus: "syndesis-prometheus",
+}
+
+// Aggregates the readiness of the individual Syndesis component deployments into
+// status.components, so monitoring can alert on a single component going unhealthy
+// without having to know about the underlying Deployments.
+type healthAction struct {
+	baseAction
+}
+
+func newHealthAction(mgr manager.Manager, clientTools *clienttools.ClientTools) SyndesisOperatorAction {
+	return &healthAction{
+		newBaseAction(mgr, clientTools, "health"),
+	}
+}
+
+func (a *healthAction) CanExecute(syndesis *v1beta1.Syndesis) bool {
+	return syndesisPhaseIs(syndesis,
+		v1beta1.SyndesisPhaseStarting,
+		v1beta1.SyndesisPhaseStartupFailed,
+		v1beta1.SyndesisPhaseInstalled,
+	)
+}
+
+func (a *healthAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis) error {
+	rtClient, _ := a.clientTools.RuntimeClient()
+
+	list := v1.DeploymentList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+	}
+	selector, err := labels.Parse("syndesis.io/app=syndesis,syndesis.io/type=infrastructure")
+	if err != nil {
+		return err
+	}
+	if err := rtClient.List(ctx, &list, &client.ListOptions{Namespace: syndesis.Namespace, LabelSelector: selector}); err != nil {
+		return err
+	}
+
+	deploymentsByComponent := map[string]*v1.Deployment{}
+	for i := range list.Items {
+		depl := &list.Items[i]
+		deploymentsByComponent[depl.Labels["syndesis.io/component"]] = depl
+	}
+
+	components := map[v1beta1.SyndesisComponentName]v1beta1.SyndesisComponentHealth{}
+	for name, label := range componentDeploymentLabels {
+		depl, found := deploymentsByComponent[label]
+		if !found {
+			components[name] = v1beta1.SyndesisComponentHealthMissing
+			continue
+		}
+		if depl.Spec.Replicas != nil && *depl.Spec.Replicas == depl.Status.ReadyReplicas {
+			components[name] = v1beta1.SyndesisComponentHealthReady
+		} else {
+			components[name] = v1beta1.SyndesisComponentHealthNotReady
+		}
+	}
+
+	if reflect.DeepEqual(syndesis.Status.Components, components) {
+		return nil
+	}
+
+	target := syndesis.DeepCopy()
+	target.Status.Components = components
+	return rtClient.Update(ctx, target)
+}
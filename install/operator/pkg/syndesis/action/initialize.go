@@ -44,6 +44,7 @@ func (a *initializeAction) Execute(ctx context.Context, syndesis *v1beta1.Syndes
 		target.Status.Reason = v1beta1.SyndesisStatusReasonDuplicate
 		target.Status.Description = "Cannot install two Syndesis resources in the same namespace"
 		a.log.Error(nil, "Cannot initialize Syndesis resource because its a duplicate", "name", syndesis.Name)
+		setDegradedCondition(target, string(v1beta1.SyndesisStatusReasonDuplicate), target.Status.Description)
 	} else {
 		syndesisVersion := pkg.DefaultOperatorTag
 		target.Status.Phase = v1beta1.SyndesisPhaseInstalling
@@ -51,6 +52,8 @@ func (a *initializeAction) Execute(ctx context.Context, syndesis *v1beta1.Syndes
 		target.Status.Description = ""
 		target.Status.Version = syndesisVersion
 		a.log.Info("Syndesis resource initialized", "name", syndesis.Name, "version", syndesisVersion)
+		setProgressingCondition(target, "Installing", "Installation of Syndesis has started")
+		a.event(target, "Initializing", "Starting installation of Syndesis "+syndesisVersion)
 	}
 
 	return rtClient.Update(ctx, target)
@@ -3,14 +3,16 @@ package action
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
-
 	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
 	"github.com/syndesisio/syndesis/install/operator/pkg/openshift/serviceaccount"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -23,10 +25,13 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/audit"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/metrics"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/olm"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/operation"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/tls"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
@@ -35,6 +40,13 @@ const (
 	SyndesisRouteName = "syndesis"
 	// SyndesisPullSecret name of the Secret used to pull images from image registries that require authentication
 	SyndesisPullSecret = "syndesis-pull-secret"
+	// dryRunAnnotation, when set to "true" on the Syndesis resource, makes the install action render
+	// the infrastructure/database/addon manifests it would otherwise apply into a ConfigMap instead of
+	// applying them, so an admin can review a pending install or upgrade before it touches the cluster
+	dryRunAnnotation = "syndesis.io/dry-run"
+	// dryRunConfigMapSuffix is appended to the Syndesis resource name to name the ConfigMap that
+	// dry-run mode writes the rendered manifests to
+	dryRunConfigMapSuffix = "-dry-run"
 )
 
 // Install syndesis into the namespace, taking resources from the bundled template.
@@ -48,7 +60,18 @@ func newInstallAction(mgr manager.Manager, clientTools *clienttools.ClientTools)
 	}
 }
 
-func (a *installAction) installResource(ctx context.Context, rtClient client.Client, syndesis *v1beta1.Syndesis, res unstructured.Unstructured) (*unstructured.Unstructured, error) {
+func (a *installAction) installResource(ctx context.Context, rtClient client.Client, syndesis *v1beta1.Syndesis, res unstructured.Unstructured) (*unstructured.Unstructured, controllerutil.OperationResult, error) {
+	adopt, err := shouldAdopt(ctx, rtClient, syndesis, res.GetName(), &res)
+	if err != nil {
+		return nil, controllerutil.OperationResultNone, err
+	}
+	if !adopt {
+		a.log.Info("resource already exists and is not owned by this Syndesis resource, leaving it alone",
+			"kind", res.GetKind(), "name", res.GetName(), "namespace", syndesis.Namespace,
+			"hint", "set spec.adoptExistingResources to true to adopt it")
+		return &res, controllerutil.OperationResultNone, nil
+	}
+
 	operation.SetNamespaceAndOwnerReference(res, syndesis)
 	o, modificationType, err := util.CreateOrUpdate(ctx, rtClient, &res)
 	if err != nil {
@@ -59,16 +82,94 @@ func (a *installAction) installResource(ctx context.Context, rtClient client.Cli
 				a.log.Info("optional custom resource definition is not installed.", "group", gvk.Group, "version", gvk.Version, "kind", gvk.Kind)
 			}
 		} else {
+			metrics.ResourceApplyTotal.WithLabelValues(res.GetKind(), "failure").Inc()
 			a.log.Info("failed to create or replace resource", "kind", res.GetKind(), "name", res.GetName(), "namespace", res.GetNamespace())
-			return nil, err
+			return nil, controllerutil.OperationResultNone, err
 		}
 	} else {
+		metrics.ResourceApplyTotal.WithLabelValues(res.GetKind(), string(modificationType)).Inc()
 		if modificationType != controllerutil.OperationResultNone {
 			a.log.Info("resource "+string(modificationType), "kind", res.GetKind(), "name", res.GetName(), "namespace", res.GetNamespace())
 		}
 	}
 
-	return o, nil
+	return o, modificationType, nil
+}
+
+// injectKnativeNamespaceLabel labels the Syndesis namespace with eventing.knative.dev/injection=enabled,
+// which tells Knative Eventing's namespace controller to provision a default Broker automatically
+func (a *installAction) injectKnativeNamespaceLabel(ctx context.Context, rtClient client.Client, namespace string) error {
+	ns := &corev1.Namespace{}
+	if err := rtClient.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return err
+	}
+
+	if ns.Labels["eventing.knative.dev/injection"] == "enabled" {
+		return nil
+	}
+
+	if ns.Labels == nil {
+		ns.Labels = map[string]string{}
+	}
+	ns.Labels["eventing.knative.dev/injection"] = "enabled"
+	return rtClient.Update(ctx, ns)
+}
+
+// writeDryRunManifests concatenates the resources that dry-run mode collected instead of applying
+// and stores them, as YAML, in a ConfigMap owned by syndesis. It never touches any of the resources
+// the real install/upgrade would have created or updated.
+func (a *installAction) writeDryRunManifests(ctx context.Context, rtClient client.Client, syndesis *v1beta1.Syndesis, resources []unstructured.Unstructured) error {
+	docs := make([]string, 0, len(resources))
+	for _, res := range resources {
+		docs = append(docs, util.Dump(res.Object))
+	}
+
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: syndesis.Name + dryRunConfigMapSuffix,
+			Labels: map[string]string{
+				"app": "syndesis",
+			},
+		},
+		Data: map[string]string{
+			"manifests.yaml": strings.Join(docs, "---\n"),
+		},
+	}
+	operation.SetNamespaceAndOwnerReference(cm, syndesis)
+
+	_, _, err := util.CreateOrUpdate(ctx, rtClient, cm)
+	if err != nil {
+		return err
+	}
+	a.log.Info("dry-run manifests written", "configmap", cm.Name, "resources", len(resources))
+	return nil
+}
+
+// markStageStarted records that the given named install stage has begun and returns the
+// updated resource so callers keep working off a fresh resourceVersion
+func (a *installAction) markStageStarted(ctx context.Context, syndesis *v1beta1.Syndesis, stage v1beta1.SyndesisInstallStage) (*v1beta1.Syndesis, error) {
+	target := syndesis.DeepCopy()
+	target.Status.SetInstallStageStarted(stage)
+	rtClient, _ := a.clientTools.RuntimeClient()
+	if err := rtClient.Update(ctx, target); err != nil {
+		return syndesis, err
+	}
+	return target, nil
+}
+
+// markStageFinished records the outcome of the given named install stage
+func (a *installAction) markStageFinished(ctx context.Context, syndesis *v1beta1.Syndesis, stage v1beta1.SyndesisInstallStage, state v1beta1.SyndesisInstallStageState, message string) (*v1beta1.Syndesis, error) {
+	target := syndesis.DeepCopy()
+	target.Status.SetInstallStageFinished(stage, state, message)
+	rtClient, _ := a.clientTools.RuntimeClient()
+	if err := rtClient.Update(ctx, target); err != nil {
+		return syndesis, err
+	}
+	return target, nil
 }
 
 func (a *installAction) CanExecute(syndesis *v1beta1.Syndesis) bool {
@@ -84,7 +185,32 @@ func (a *installAction) CanExecute(syndesis *v1beta1.Syndesis) bool {
 var kindsReportedNotAvailable = map[schema.GroupVersionKind]time.Time{}
 
 func (a *installAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis) error {
-	if syndesisPhaseIs(syndesis, v1beta1.SyndesisPhaseInstalling) {
+	// Milestone Events are only emitted while a (re)install is actually in progress, not on every
+	// reconcile of an already Installed resource.
+	isProvisioning := syndesisPhaseIs(syndesis, v1beta1.SyndesisPhaseInstalling, v1beta1.SyndesisPhasePostUpgradeRun)
+
+	// Dry-run mode skips applying the templated infrastructure/database/addon resources (the actual
+	// install/upgrade payload) and collects them into renderedManifests instead. It still resolves the
+	// prerequisites above (OAuth secret, service account, route) since every render needs their values
+	// and, unlike the payload, those are typically already in place and stable across an upgrade.
+	dryRun := syndesis.Annotations[dryRunAnnotation] == "true"
+	var renderedManifests []unstructured.Unstructured
+	var auditChanges []audit.Change
+	applyResource := func(ctx context.Context, rtClient client.Client, syndesis *v1beta1.Syndesis, res unstructured.Unstructured) (*unstructured.Unstructured, error) {
+		if dryRun {
+			renderedManifests = append(renderedManifests, res)
+			return &res, nil
+		}
+		o, modificationType, err := a.installResource(ctx, rtClient, syndesis, res)
+		if err == nil && syndesis.Spec.EnableAuditTrail && modificationType != controllerutil.OperationResultNone {
+			auditChanges = append(auditChanges, audit.Change{Kind: res.GetKind(), Name: res.GetName(), ModificationType: string(modificationType)})
+		}
+		return o, err
+	}
+
+	if dryRun {
+		a.log.Info("dry-run enabled, rendered manifests will be written to a ConfigMap instead of applied", "name", syndesis.Name)
+	} else if syndesisPhaseIs(syndesis, v1beta1.SyndesisPhaseInstalling) {
 		a.log.Info("installing Syndesis resource", "name", syndesis.Name)
 	} else if syndesisPhaseIs(syndesis, v1beta1.SyndesisPhasePostUpgradeRun) {
 		a.log.Info("installing Syndesis resource for the first time after upgrading", "name", syndesis.Name)
@@ -92,25 +218,117 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 
 	resourcesThatShouldExist := map[types.UID]bool{}
 
+	// Stage tracking is only persisted while a (re)install is actually in progress, for the same
+	// reason milestone Events are gated above: it lets status.phases pinpoint where a stuck install
+	// is blocked, without rewriting the resource on every steady-state reconcile.
+	markStageStarted := func(stage v1beta1.SyndesisInstallStage) {
+		if !isProvisioning {
+			return
+		}
+		updated, err := a.markStageStarted(ctx, syndesis, stage)
+		if err != nil {
+			a.log.Error(err, "failed to record install stage as started", "stage", stage)
+			return
+		}
+		syndesis = updated
+	}
+	markStageFinished := func(stage v1beta1.SyndesisInstallStage, state v1beta1.SyndesisInstallStageState, message string) {
+		if !isProvisioning {
+			return
+		}
+		updated, err := a.markStageFinished(ctx, syndesis, stage, state, message)
+		if err != nil {
+			a.log.Error(err, "failed to record install stage outcome", "stage", stage)
+			return
+		}
+		syndesis = updated
+	}
+
 	rtClient, _ := a.clientTools.RuntimeClient()
+
+	markStageStarted(v1beta1.SyndesisInstallStagePrerequisites)
+
 	// Load configuration to to use as context for generate pkg
 	config, err := configuration.GetProperties(ctx, configuration.TemplateConfig, a.clientTools, syndesis)
 	if err != nil {
 		a.log.Error(err, "Error occurred while initialising configuration")
+		markStageFinished(v1beta1.SyndesisInstallStagePrerequisites, v1beta1.SyndesisInstallStageStateFailed, err.Error())
+		return err
+	}
+	if isProvisioning {
+		a.event(syndesis, "ConfigLoaded", "Syndesis configuration loaded")
+	}
+
+	configHash, err := config.Hash()
+	if err != nil {
+		markStageFinished(v1beta1.SyndesisInstallStagePrerequisites, v1beta1.SyndesisInstallStageStateFailed, err.Error())
 		return err
 	}
+	if !isProvisioning && !dryRun && syndesis.Status.ConfigHash == configHash {
+		// Nothing that affects rendered resources has changed since the last successful install,
+		// so there's nothing to re-render, re-apply or prune.
+		return nil
+	}
 
 	//
 	// Check for presence of route hostname as required for k8
 	//
 	if err := config.CheckRouteHostname(); err != nil {
+		markStageFinished(v1beta1.SyndesisInstallStagePrerequisites, v1beta1.SyndesisInstallStageStateFailed, err.Error())
 		return err
 	}
+	markStageFinished(v1beta1.SyndesisInstallStagePrerequisites, v1beta1.SyndesisInstallStageStateCompleted, "")
+
+	markStageStarted(v1beta1.SyndesisInstallStageSecrets)
 
 	//
 	// Check for oauth secrets as required for k8
 	//
 	if err := config.CheckOAuthCredentialSecret(ctx, rtClient, syndesis); err != nil {
+		markStageFinished(v1beta1.SyndesisInstallStageSecrets, v1beta1.SyndesisInstallStageStateFailed, err.Error())
+		return err
+	}
+	if isProvisioning {
+		a.event(syndesis, "SecretsGenerated", "OAuth credential secret is in place")
+	}
+
+	//
+	// Check for the public API's custom TLS certificate secret, when configured
+	//
+	if err := config.CheckPublicAPICertificateSecret(ctx, rtClient, syndesis); err != nil {
+		markStageFinished(v1beta1.SyndesisInstallStageSecrets, v1beta1.SyndesisInstallStageStateFailed, err.Error())
+		return err
+	}
+
+	//
+	// Check for the logging addon's credentials secret, when configured
+	//
+	if err := config.CheckLoggingCredentialsSecret(ctx, rtClient, syndesis); err != nil {
+		markStageFinished(v1beta1.SyndesisInstallStageSecrets, v1beta1.SyndesisInstallStageStateFailed, err.Error())
+		return err
+	}
+
+	//
+	// Check for the server's additional CA certificates ConfigMap, when configured
+	//
+	if err := config.CheckAdditionalCAConfigMap(ctx, rtClient, syndesis); err != nil {
+		markStageFinished(v1beta1.SyndesisInstallStageSecrets, v1beta1.SyndesisInstallStageStateFailed, err.Error())
+		return err
+	}
+
+	//
+	// Check for the cert-manager-issued certificate secret for the Syndesis route, when configured
+	//
+	if err := config.CheckRouteCertificateSecret(ctx, rtClient, syndesis); err != nil {
+		markStageFinished(v1beta1.SyndesisInstallStageSecrets, v1beta1.SyndesisInstallStageStateFailed, err.Error())
+		return err
+	}
+
+	//
+	// Provision/rotate the internal serving certificates used for service-to-service traffic
+	//
+	if err := tls.EnsureCertificates(ctx, rtClient, syndesis, config); err != nil {
+		markStageFinished(v1beta1.SyndesisInstallStageSecrets, v1beta1.SyndesisInstallStageStateFailed, err.Error())
 		return err
 	}
 
@@ -121,6 +339,7 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 		if k8serrors.IsNotFound(err) {
 			secret = nil
 		} else {
+			markStageFinished(v1beta1.SyndesisInstallStageSecrets, v1beta1.SyndesisInstallStageStateFailed, err.Error())
 			return err
 		}
 	}
@@ -131,6 +350,7 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 
 	serviceAccount, err := installServiceAccount(ctx, rtClient, syndesis, secret)
 	if err != nil {
+		markStageFinished(v1beta1.SyndesisInstallStageSecrets, v1beta1.SyndesisInstallStageStateFailed, err.Error())
 		return err
 	}
 	resourcesThatShouldExist[serviceAccount.GetUID()] = true
@@ -138,13 +358,18 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 	token, err := serviceaccount.GetServiceAccountToken(ctx, rtClient, serviceAccount.Name, syndesis.Namespace)
 	if err != nil {
 		a.log.Info("Unable to get service account token", "error message", err.Error())
+		markStageFinished(v1beta1.SyndesisInstallStageSecrets, v1beta1.SyndesisInstallStageStateFailed, err.Error())
 		return nil
 	}
 	config.OpenShiftOauthClientSecret = token
+	markStageFinished(v1beta1.SyndesisInstallStageSecrets, v1beta1.SyndesisInstallStageStateCompleted, "")
+
+	markStageStarted(v1beta1.SyndesisInstallStageExposure)
 
 	// Render the route resource...
 	all, err := generator.RenderDir("./route/", config)
 	if err != nil {
+		markStageFinished(v1beta1.SyndesisInstallStageExposure, v1beta1.SyndesisInstallStageStateFailed, err.Error())
 		return err
 	}
 
@@ -152,29 +377,42 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 	syndesisRoute, err := installSyndesisRoute(ctx, rtClient, syndesis, routes)
 	if err != nil {
 		a.log.Info("Unable to set route syndesis", "error message", err.Error())
+		markStageFinished(v1beta1.SyndesisInstallStageExposure, v1beta1.SyndesisInstallStageStateFailed, err.Error())
 		return nil
 	}
 
 	if err := config.SetRoute(ctx, syndesisRoute.Host()); err != nil {
+		markStageFinished(v1beta1.SyndesisInstallStageExposure, v1beta1.SyndesisInstallStageStateFailed, err.Error())
 		return err
 	}
+	if isProvisioning {
+		a.event(syndesis, "RouteResolved", "Syndesis route resolved to "+syndesisRoute.Host())
+	}
 
 	resourcesThatShouldExist[syndesisRoute.Meta().GetUID()] = true
 
 	if err := config.SetConsoleLink(ctx, rtClient, syndesis, syndesisRoute.Host()); err != nil {
-			return err
+		markStageFinished(v1beta1.SyndesisInstallStageExposure, v1beta1.SyndesisInstallStageStateFailed, err.Error())
+		return err
 	}
+	markStageFinished(v1beta1.SyndesisInstallStageExposure, v1beta1.SyndesisInstallStageStateCompleted, "")
+
+	markStageStarted(v1beta1.SyndesisInstallStageBackend)
 
 	// Render the remaining syndesis resources...
 	all, err = generator.RenderDir("./infrastructure/", config)
 	if err != nil {
+		markStageFinished(v1beta1.SyndesisInstallStageBackend, v1beta1.SyndesisInstallStageStateFailed, err.Error())
 		return err
 	}
 
 	// Render the database resource if needed...
 	if syndesis.Spec.Components.Database.ExternalDbURL == "" {
+		markStageStarted(v1beta1.SyndesisInstallStageDatabase)
+
 		dbResources, err := generator.RenderDir("./database/", config)
 		if err != nil {
+			markStageFinished(v1beta1.SyndesisInstallStageDatabase, v1beta1.SyndesisInstallStageStateFailed, err.Error())
 			return err
 		}
 
@@ -189,26 +427,39 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 		}
 
 		all = append(all, dbResources...)
+		markStageFinished(v1beta1.SyndesisInstallStageDatabase, v1beta1.SyndesisInstallStageStateCompleted, "")
+	} else {
+		markStageFinished(v1beta1.SyndesisInstallStageDatabase, v1beta1.SyndesisInstallStageStateCompleted, "using an external database, nothing to provision")
 	}
 
 	// Link the image secret to service accounts
 	if secret != nil {
 		err = linkImageSecretToServiceAccounts(ctx, rtClient, syndesis, secret)
 		if err != nil {
+			markStageFinished(v1beta1.SyndesisInstallStageBackend, v1beta1.SyndesisInstallStageStateFailed, err.Error())
 			return err
 		}
 	}
 
 	// Install the resources..
 	for _, res := range all {
-		o, err := a.installResource(ctx, rtClient, syndesis, res)
+		o, err := applyResource(ctx, rtClient, syndesis, res)
 		if err != nil {
+			markStageFinished(v1beta1.SyndesisInstallStageBackend, v1beta1.SyndesisInstallStageStateFailed, err.Error())
 			return err // Fail-fast for core components
 		}
 		resourcesThatShouldExist[o.GetUID()] = true
 	}
+	markStageFinished(v1beta1.SyndesisInstallStageBackend, v1beta1.SyndesisInstallStageStateCompleted, "")
+
+	markStageStarted(v1beta1.SyndesisInstallStageAddons)
 
 	addonsInfo := configuration.GetAddonsInfo(*config)
+	if err := configuration.ValidateAddonDependencies(addonsInfo); err != nil {
+		markStageFinished(v1beta1.SyndesisInstallStageAddons, v1beta1.SyndesisInstallStageStateFailed, err.Error())
+		return err
+	}
+
 	for _, addonInfo := range addonsInfo {
 		if !addonInfo.IsEnabled() {
 			continue
@@ -216,6 +467,11 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 
 		a.log.Info("Installing addon", "Name", addonInfo.Name())
 
+		if err := addonInfo.Validate(); err != nil {
+			a.log.Info("addon configuration is invalid", "addon", addonInfo.Name(), "error", err.Error())
+			continue
+		}
+
 		if config.ApiServer.OlmSupport && addonInfo.GetOlmSpec() != nil {
 			//
 			// Using the operator hub is not mutally exclusive to loading the addon
@@ -231,6 +487,13 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 			}
 		}
 
+		if addonInfo.Name() == "knative" && config.Syndesis.Addons.Knative.InjectNamespaceLabel {
+			if err := a.injectKnativeNamespaceLabel(ctx, rtClient, syndesis.Namespace); err != nil {
+				a.log.Error(err, "Failed to label namespace for Knative eventing injection", "Addon Name", addonInfo.Name())
+				continue
+			}
+		}
+
 		addonDir := "./addons/" + addonInfo.Name() + "/"
 		f, err := generator.GetAssetsFS().Open(addonDir)
 		if err != nil {
@@ -251,7 +514,7 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 		// try and continue to install the other addons
 		//
 		for _, res := range resources {
-			o, err := a.installResource(ctx, rtClient, syndesis, res)
+			o, err := applyResource(ctx, rtClient, syndesis, res)
 			if err != nil {
 				a.log.Info("Install of addon failed", "addon", addonInfo.Name(), "error message", err.Error())
 				break
@@ -259,6 +522,11 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 			resourcesThatShouldExist[o.GetUID()] = true
 		}
 	}
+	markStageFinished(v1beta1.SyndesisInstallStageAddons, v1beta1.SyndesisInstallStageStateCompleted, "")
+
+	if dryRun {
+		return a.writeDryRunManifests(ctx, rtClient, syndesis, renderedManifests)
+	}
 
 	// Find resources which need to be deleted.
 	labelSelector, err := labels.Parse("owner=" + string(syndesis.GetUID()))
@@ -270,6 +538,7 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 		LabelSelector: labelSelector,
 	}
 
+	pruned := 0
 	api, _ := a.clientTools.ApiClient()
 	err = ListAllTypesInChunks(ctx, api, rtClient, options, func(list []unstructured.Unstructured) error {
 		for _, res := range list {
@@ -290,7 +559,12 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 					a.log.Error(err, "could not deleted", "kind", res.GetKind(), "name", res.GetName(), "namespace", res.GetNamespace())
 				}
 			} else {
+				metrics.ResourcePruneTotal.WithLabelValues(res.GetKind()).Inc()
+				pruned++
 				a.log.Info("resource deleted", "kind", res.GetKind(), "name", res.GetName(), "namespace", res.GetNamespace())
+				if syndesis.Spec.EnableAuditTrail {
+					auditChanges = append(auditChanges, audit.Change{Kind: res.GetKind(), Name: res.GetName(), ModificationType: "pruned"})
+				}
 			}
 		}
 		return nil
@@ -298,9 +572,23 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 	if err != nil {
 		return err
 	}
+	if pruned > 0 {
+		a.event(syndesis, "ResourcesPruned", fmt.Sprintf("removed %d resource(s) no longer produced by rendering (e.g. a disabled addon)", pruned))
+	}
+
+	if syndesis.Spec.EnableAuditTrail && len(auditChanges) > 0 {
+		entry := audit.NewEntry(time.Now(), syndesis, configHash, auditChanges)
+		if err := audit.Record(ctx, rtClient, syndesis, entry); err != nil {
+			a.log.Error(err, "failed to record audit trail entry")
+		}
+	}
 
 	addRouteAnnotation(syndesis, syndesisRoute)
 	target := syndesis.DeepCopy()
+	target.Status.ConfigHash = configHash
+	if syndesis.Spec.SHA {
+		target.Status.ResolvedImageDigests = config.ResolvedImageDigests
+	}
 	if syndesis.Status.Phase == v1beta1.SyndesisPhaseInstalling {
 		// Installation completed, set the next state
 		target.Status.Phase = v1beta1.SyndesisPhaseStarting
@@ -323,6 +611,10 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 		}
 
 		a.log.Info("Syndesis resource installed after upgrading", "name", target.Name)
+	} else if syndesis.Status.ConfigHash != configHash {
+		if err := rtClient.Update(ctx, target); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -382,6 +674,34 @@ func getTypes(api kubernetes.Interface) ([]metav1.TypeMeta, error) {
 	return types, nil
 }
 
+// shouldAdopt reports whether the operator is allowed to create or take ownership of the named
+// resource. It always allows it when the resource does not exist yet, or when it already belongs to
+// this Syndesis resource. Otherwise it only allows it when spec.adoptExistingResources opts in,
+// so a pre-existing, foreign resource (e.g. left over from a template-based install) that happens to
+// share a name is not silently adopted - and later deleted along with the Syndesis resource.
+func shouldAdopt(ctx context.Context, cl client.Client, syndesis *v1beta1.Syndesis, name string, obj runtime.Object) (bool, error) {
+	existing := obj.DeepCopyObject()
+	err := cl.Get(ctx, client.ObjectKey{Namespace: syndesis.Namespace, Name: name}, existing)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	accessor, err := meta.Accessor(existing)
+	if err != nil {
+		return false, err
+	}
+	for _, ref := range accessor.GetOwnerReferences() {
+		if ref.UID == syndesis.GetUID() {
+			return true, nil
+		}
+	}
+
+	return syndesis.Spec.AdoptExistingResources, nil
+}
+
 func installServiceAccount(ctx context.Context, cl client.Client, syndesis *v1beta1.Syndesis, secret *corev1.Secret) (*corev1.ServiceAccount, error) {
 	sa := newSyndesisServiceAccount()
 	if secret != nil {
@@ -438,6 +758,22 @@ func installSyndesisRoute(ctx context.Context, cl client.Client, syndesis *v1bet
 	ct := conduit.Target()
 	cm := conduit.Meta()
 
+	adopt, err := shouldAdopt(ctx, cl, syndesis, cm.GetName(), ct)
+	if err != nil {
+		return nil, err
+	}
+	if !adopt {
+		// The route already exists and belongs to something else; leave it alone and just read its
+		// host, the same way we do below once our own route has been created.
+		if err := cl.Get(ctx, client.ObjectKey{Namespace: syndesis.Namespace, Name: cm.GetName()}, ct); err != nil {
+			return nil, err
+		}
+		if len(conduit.Host()) == 0 {
+			return nil, errors.New("hostname still not present on syndesis route")
+		}
+		return conduit, nil
+	}
+
 	operation.SetNamespaceAndOwnerReference(ct, syndesis)
 
 	// We don't replace the route if already present, to let OpenShift generate its host
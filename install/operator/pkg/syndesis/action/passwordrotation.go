@@ -0,0 +1,147 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/remotecommand"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// dbLabelSelector finds the single syndesis-db pod a rotated password needs to be applied to
+const dbLabelSelector = "syndesis.io/app=syndesis,syndesis.io/component=syndesis-db"
+
+// Periodically rotates the internal database password: generates a new one, applies it to the live
+// role with ALTER ROLE, then persists it to syndesis-global-config so the next reconcile re-renders
+// every dependent Deployment with the new value, letting the normal install action roll them
+// (syndesis-server before syndesis-db-pooler before syndesis-db, the order resources are rendered
+// and applied in) rather than restarting anything itself.
+type passwordRotationAction struct {
+	baseAction
+}
+
+func newPasswordRotationAction(mgr manager.Manager, clientTools *clienttools.ClientTools) SyndesisOperatorAction {
+	return &passwordRotationAction{newBaseAction(mgr, clientTools, "password-rotation")}
+}
+
+func (a *passwordRotationAction) CanExecute(syndesis *v1beta1.Syndesis) bool {
+	return syndesisPhaseIs(syndesis, v1beta1.SyndesisPhaseInstalled) &&
+		syndesis.Spec.Components.Database.PasswordRotation != "" &&
+		syndesis.Spec.Components.Database.ExternalDbURL == ""
+}
+
+func (a *passwordRotationAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis) error {
+	interval, err := v1beta1.ParseRotationInterval(syndesis.Spec.Components.Database.PasswordRotation)
+	if err != nil {
+		// Already rejected by Validate() on the way in, so this should not happen in practice
+		return fmt.Errorf("components.database.passwordRotation: %w", err)
+	}
+
+	if syndesis.Status.LastPasswordRotation == nil {
+		// First time this action runs against this installation: baseline the clock instead of
+		// rotating immediately, since the password the installer generated is already fresh
+		return a.recordRotation(ctx, syndesis)
+	}
+
+	if time.Since(syndesis.Status.LastPasswordRotation.Time) < interval {
+		return nil
+	}
+
+	rtClient, err := a.clientTools.RuntimeClient()
+	if err != nil {
+		return err
+	}
+	apiClient, err := a.clientTools.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{}
+	if err := rtClient.Get(ctx, util.NewObjectKey(configuration.SyndesisGlobalConfigSecret, syndesis.Namespace), secret); err != nil {
+		return fmt.Errorf("failed to load %s: %w", configuration.SyndesisGlobalConfigSecret, err)
+	}
+
+	user := syndesis.Spec.Components.Database.User
+	if user == "" {
+		user = "syndesis"
+	}
+	newPassword, err := configuration.GenerateFIPSPassword(16, syndesis.Spec.FIPSMode)
+	if err != nil {
+		return fmt.Errorf("failed to generate rotated password: %w", err)
+	}
+
+	dbPod, err := util.GetPodWithLabelSelector(ctx, apiClient, syndesis.Namespace, dbLabelSelector)
+	if err != nil {
+		return fmt.Errorf("failed to find the syndesis-db pod to rotate its password: %w", err)
+	}
+
+	// Run psql directly rather than via "bash -c" with an interpolated command string, and pass user
+	// and newPassword as psql variables (:"user" quotes as an identifier, :'newpassword' as a string
+	// literal) rather than interpolating them into the SQL text, so neither can break out of the
+	// command or the query regardless of what characters they contain. The statement is fed on stdin
+	// because psql does not interpolate variables in a -c command string.
+	if err := util.Exec(util.ExecOptions{
+		Config:    a.clientTools.RestConfig(),
+		API:       apiClient,
+		Namespace: syndesis.Namespace,
+		Pod:       dbPod.Name,
+		Container: "postgresql",
+		Command: []string{
+			"psql", "-U", user, "-d", "postgres", "-v", "ON_ERROR_STOP=1",
+			"-v", "user=" + user,
+			"-v", "newpassword=" + newPassword,
+		},
+		StreamOptions: remotecommand.StreamOptions{
+			Stdin:  strings.NewReader(`ALTER ROLE :"user" WITH PASSWORD :'newpassword';` + "\n"),
+			Stdout: os.Stdout,
+			Stderr: os.Stderr,
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to rotate the syndesis-db password: %w", err)
+	}
+
+	target := secret.DeepCopy()
+	target.Data["POSTGRESQL_PASSWORD"] = []byte(newPassword)
+	if err := rtClient.Update(ctx, target); err != nil {
+		return fmt.Errorf("rotated the syndesis-db password but failed to persist it to %s: %w", configuration.SyndesisGlobalConfigSecret, err)
+	}
+
+	a.event(syndesis, "PasswordRotated", "Rotated the internal database password. Dependent components will pick it up and restart on the next reconcile")
+	return a.recordRotation(ctx, syndesis)
+}
+
+func (a *passwordRotationAction) recordRotation(ctx context.Context, syndesis *v1beta1.Syndesis) error {
+	target := syndesis.DeepCopy()
+	now := metav1.Now()
+	target.Status.LastPasswordRotation = &now
+	client, err := a.clientTools.RuntimeClient()
+	if err != nil {
+		return err
+	}
+	return client.Update(ctx, target)
+}
@@ -0,0 +1,313 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// reencryptJobName is the Job the re-encryption CLI command runs in, and the label it's found by
+const reencryptJobName = "syndesis-reencrypt"
+
+// serverDeploymentName is the Deployment scaled to 0 while the re-encryption Job runs, so no writer
+// observes secrets encrypted with a mix of the old and new keys, and scaled back up once it's safe
+const serverDeploymentName = "syndesis-server"
+
+// Coordinates rotating SYNDESIS_ENCRYPT_KEY: when Spec.Components.Server.RequestedEncryptKey names a
+// key different to the one already active in the syndesis-global-config secret, this action moves
+// Syndesis into the EncryptionKeyRotating phase (blocking the install action from rolling the new key
+// out to syndesis-server before it's safe to), scales syndesis-server down, runs the syndesis-server
+// image's `reencrypt` CLI command as a Job against the database with both keys, then persists the new
+// key and scales back up. A Job failure leaves the previous key in place and scales syndesis-server
+// back up with it, so nothing is bricked; no further rotation is started while the failure is
+// recorded in the status.
+type reencryptAction struct {
+	baseAction
+}
+
+func newReencryptAction(mgr manager.Manager, clientTools *clienttools.ClientTools) SyndesisOperatorAction {
+	return &reencryptAction{newBaseAction(mgr, clientTools, "reencrypt")}
+}
+
+func (a *reencryptAction) CanExecute(syndesis *v1beta1.Syndesis) bool {
+	return syndesisPhaseIs(syndesis,
+		v1beta1.SyndesisPhaseInstalled,
+		v1beta1.SyndesisPhaseEncryptionKeyRotating,
+	)
+}
+
+func (a *reencryptAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis) error {
+	if syndesis.Status.Phase == v1beta1.SyndesisPhaseEncryptionKeyRotating {
+		// An operator restart landed here mid-rotation: the Job it started is still owned by the
+		// Syndesis resource and keeps running, so just resume waiting on it
+		return a.rotate(ctx, syndesis, syndesis.Status.EncryptionKeyRotation.StartTime != nil)
+	}
+
+	requested := syndesis.Spec.Components.Server.RequestedEncryptKey
+	if requested == "" {
+		return nil
+	}
+
+	if status := syndesis.Status.EncryptionKeyRotation; status != nil && status.Phase == v1beta1.EncryptionKeyRotationPhaseFailed {
+		// A previous rotation failed: start no further rotation while that failure is recorded,
+		// whatever key is requested now
+		return nil
+	}
+
+	rtClient, err := a.clientTools.RuntimeClient()
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{}
+	if err := rtClient.Get(ctx, util.NewObjectKey(configuration.SyndesisGlobalConfigSecret, syndesis.Namespace), secret); err != nil {
+		return fmt.Errorf("failed to load %s: %w", configuration.SyndesisGlobalConfigSecret, err)
+	}
+
+	if requested == string(secret.Data["SYNDESIS_ENCRYPT_KEY"]) {
+		return nil
+	}
+
+	a.event(syndesis, "EncryptionKeyRotationStarted", "Rotating SYNDESIS_ENCRYPT_KEY: scaling syndesis-server down and re-encrypting stored secrets")
+
+	target := syndesis.DeepCopy()
+	now := metav1.Now()
+	target.Status.Phase = v1beta1.SyndesisPhaseEncryptionKeyRotating
+	target.Status.EncryptionKeyRotation = &v1beta1.EncryptionKeyRotationStatus{
+		Phase:     v1beta1.EncryptionKeyRotationPhaseRunning,
+		StartTime: &now,
+	}
+	if err := rtClient.Update(ctx, target); err != nil {
+		return fmt.Errorf("failed to record the start of encryption key rotation: %w", err)
+	}
+
+	return a.rotate(ctx, target, false)
+}
+
+// rotate scales syndesis-server down, runs the re-encryption Job (creating it first unless resuming
+// after an operator restart), and on success persists the new key and scales back up
+func (a *reencryptAction) rotate(ctx context.Context, syndesis *v1beta1.Syndesis, resuming bool) error {
+	rtClient, err := a.clientTools.RuntimeClient()
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{}
+	if err := rtClient.Get(ctx, util.NewObjectKey(configuration.SyndesisGlobalConfigSecret, syndesis.Namespace), secret); err != nil {
+		return a.failRotation(ctx, syndesis, fmt.Errorf("failed to load %s: %w", configuration.SyndesisGlobalConfigSecret, err))
+	}
+	oldKey := string(secret.Data["SYNDESIS_ENCRYPT_KEY"])
+	newKey := syndesis.Spec.Components.Server.RequestedEncryptKey
+
+	if err := a.scaleServer(ctx, syndesis, 0); err != nil {
+		return a.failRotation(ctx, syndesis, fmt.Errorf("failed to scale syndesis-server down: %w", err))
+	}
+
+	if !resuming {
+		if err := a.deployReencryptJob(ctx, syndesis, oldKey, newKey); err != nil {
+			return a.failRotation(ctx, syndesis, fmt.Errorf("failed to start the re-encryption job: %w", err))
+		}
+	}
+
+	if err := a.awaitJobCompletion(ctx, syndesis.Namespace); err != nil {
+		return a.failRotation(ctx, syndesis, fmt.Errorf("re-encryption job did not complete successfully: %w", err))
+	}
+
+	target := secret.DeepCopy()
+	target.Data["SYNDESIS_ENCRYPT_KEY"] = []byte(newKey)
+	if err := rtClient.Update(ctx, target); err != nil {
+		return a.failRotation(ctx, syndesis, fmt.Errorf("re-encrypted stored secrets but failed to persist the new key to %s: %w", configuration.SyndesisGlobalConfigSecret, err))
+	}
+
+	if err := a.scaleServer(ctx, syndesis, 1); err != nil {
+		return a.failRotation(ctx, syndesis, fmt.Errorf("re-encrypted stored secrets but failed to scale syndesis-server back up: %w", err))
+	}
+
+	if err := rtClient.Delete(ctx, &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: reencryptJobName, Namespace: syndesis.Namespace}}); err != nil && !k8serrors.IsNotFound(err) {
+		a.log.Error(err, "failed to delete the completed re-encryption job", "job", reencryptJobName)
+	}
+
+	a.event(syndesis, "EncryptionKeyRotationCompleted", "SYNDESIS_ENCRYPT_KEY rotated successfully. syndesis-server is scaling back up")
+
+	completed := syndesis.DeepCopy()
+	completed.Status.Phase = v1beta1.SyndesisPhaseInstalled
+	completed.Status.EncryptionKeyRotation.Phase = v1beta1.EncryptionKeyRotationPhaseCompleted
+	return rtClient.Update(ctx, completed)
+}
+
+// failRotation records the rotation as failed, scales syndesis-server back up with the unchanged
+// (previous) key still active, and returns the original error
+func (a *reencryptAction) failRotation(ctx context.Context, syndesis *v1beta1.Syndesis, cause error) error {
+	a.warningEvent(syndesis, "EncryptionKeyRotationFailed", cause.Error())
+
+	if err := a.scaleServer(ctx, syndesis, 1); err != nil {
+		a.log.Error(err, "failed to scale syndesis-server back up after a failed encryption key rotation")
+	}
+
+	rtClient, err := a.clientTools.RuntimeClient()
+	if err == nil {
+		failed := syndesis.DeepCopy()
+		failed.Status.Phase = v1beta1.SyndesisPhaseInstalled
+		failed.Status.EncryptionKeyRotation.Phase = v1beta1.EncryptionKeyRotationPhaseFailed
+		failed.Status.EncryptionKeyRotation.Reason = cause.Error()
+		if err := rtClient.Update(ctx, failed); err != nil {
+			a.log.Error(err, "failed to record the failure of encryption key rotation")
+		}
+	}
+
+	return cause
+}
+
+func (a *reencryptAction) scaleServer(ctx context.Context, syndesis *v1beta1.Syndesis, replicas int32) error {
+	rtClient, err := a.clientTools.RuntimeClient()
+	if err != nil {
+		return err
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := rtClient.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: serverDeploymentName}, deployment); err != nil {
+		return err
+	}
+
+	target := deployment.DeepCopy()
+	target.Spec.Replicas = &replicas
+	if err := rtClient.Update(ctx, target); err != nil {
+		return err
+	}
+
+	return wait.PollImmediate(time.Second*3, time.Minute*5, func() (done bool, err error) {
+		current := &appsv1.Deployment{}
+		if err := rtClient.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: serverDeploymentName}, current); err != nil {
+			return false, err
+		}
+		return current.Status.Replicas == replicas && current.Status.ReadyReplicas == replicas, nil
+	})
+}
+
+// deployReencryptJob runs the syndesis-server image's `reencrypt` CLI command against the database,
+// decrypting every stored secret with oldKey and re-encrypting it with newKey. The Job is owned by
+// the Syndesis resource so it is cleaned up automatically if the resource itself is deleted mid-rotation
+func (a *reencryptAction) deployReencryptJob(ctx context.Context, syndesis *v1beta1.Syndesis, oldKey string, newKey string) error {
+	rtClient, err := a.clientTools.RuntimeClient()
+	if err != nil {
+		return err
+	}
+
+	config, err := configuration.GetProperties(ctx, configuration.TemplateConfig, a.clientTools, syndesis)
+	if err != nil {
+		return err
+	}
+
+	backoffLimit := int32(0)
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      reencryptJobName,
+			Namespace: syndesis.Namespace,
+			Labels: map[string]string{
+				"syndesis.io/app":       "syndesis",
+				"syndesis.io/component": reencryptJobName,
+				pkg.ControllerUIDLabel:  string(syndesis.GetUID()),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(syndesis, v1beta1.SchemeGroupVersion.WithKind(syndesis.Kind)),
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        reencryptJobName,
+					Labels:      map[string]string{"job-name": reencryptJobName},
+					Annotations: configuration.RestrictedPodAnnotations(config.Syndesis.SecurityModel),
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:   corev1.RestartPolicyNever,
+					SecurityContext: configuration.RestrictedPodSecurityContext(config.Syndesis.SecurityModel),
+					Containers: []corev1.Container{
+						{
+							Name:  "reencrypt",
+							Image: config.Syndesis.Components.Server.Image,
+							Args: []string{
+								"reencrypt",
+								// DirectURL always resolves straight to the syndesis-db Service,
+								// bypassing Database.Pooler, matching the convention backup and
+								// the db upgrade job already use for direct database access
+								"--url", "jdbc:" + config.Syndesis.Components.Database.DirectURL,
+								"--user", config.Syndesis.Components.Database.User,
+							},
+							// The DB password and both encryption keys are secrets: pass them as env
+							// vars rather than Args, which land verbatim in the Job/Pod spec and are
+							// readable by anyone with get/describe on Pods/Jobs in the namespace,
+							// matching the convention database_upgrade.go's Job already uses
+							Env: []corev1.EnvVar{
+								{Name: "SYNDESIS_DATABASE_PASSWORD", Value: config.Syndesis.Components.Database.Password},
+								{Name: "SYNDESIS_ENCRYPT_KEY_OLD", Value: oldKey},
+								{Name: "SYNDESIS_ENCRYPT_KEY_NEW", Value: newKey},
+							},
+							SecurityContext: configuration.RestrictedContainerSecurityContext(config.Syndesis.SecurityModel),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return rtClient.Create(ctx, job)
+}
+
+// Waits at most 15min for the syndesis-reencrypt Job to run to completion
+func (a *reencryptAction) awaitJobCompletion(ctx context.Context, namespace string) error {
+	rtClient, err := a.clientTools.RuntimeClient()
+	if err != nil {
+		return err
+	}
+
+	job := &batchv1.Job{}
+	return wait.PollImmediate(time.Second*3, time.Minute*15, func() (done bool, err error) {
+		if err = rtClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: reencryptJobName}, job); err != nil {
+			return false, err
+		}
+
+		if job.Status.Failed != 0 {
+			return false, fmt.Errorf("job %s failed", reencryptJobName)
+		}
+
+		if job.Status.Succeeded != 0 {
+			return true, nil
+		}
+
+		a.log.Info("Waiting for the re-encryption job to complete", "job", reencryptJobName)
+		return false, nil
+	})
+}
@@ -74,6 +74,7 @@ func (a *startupAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 		target.Status.Phase = v1beta1.SyndesisPhaseInstalled
 		target.Status.Reason = v1beta1.SyndesisStatusReasonMissing
 		target.Status.Description = ""
+		setReadyCondition(target, "Installed", "All Syndesis deployments are ready")
 		a.log.Info("Syndesis resource installed successfully", "name", syndesis.Name)
 		return rtClient.Update(ctx, target)
 	} else if failedDeployment != nil {
@@ -81,6 +82,7 @@ func (a *startupAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 		target.Status.Phase = v1beta1.SyndesisPhaseStartupFailed
 		target.Status.Reason = v1beta1.SyndesisStatusReasonDeploymentNotReady
 		target.Status.Description = "Some Syndesis deployments failed to startup within the allowed time frame"
+		setDegradedCondition(target, string(v1beta1.SyndesisStatusReasonDeploymentNotReady), target.Status.Description)
 		a.log.V(2).Info("Startup failed for Syndesis resource. Deployment not ready", "name", syndesis.Name, "deployment", *failedDeployment)
 		return rtClient.Update(ctx, target)
 	} else {
@@ -88,6 +90,7 @@ func (a *startupAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 		target.Status.Phase = v1beta1.SyndesisPhaseStarting
 		target.Status.Reason = v1beta1.SyndesisStatusReasonMissing
 		target.Status.Description = ""
+		setProgressingCondition(target, "Starting", "Waiting for Syndesis deployments to become ready")
 		a.log.V(2).Info("Waiting for Syndesis resource to startup", "name", syndesis.Name)
 		return rtClient.Update(ctx, target)
 	}
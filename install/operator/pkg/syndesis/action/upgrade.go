@@ -2,9 +2,12 @@ package action
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/syndesisio/syndesis/install/operator/pkg/openshift/serviceaccount"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/integration"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/upgrade"
 
 	"github.com/syndesisio/syndesis/install/operator/pkg"
@@ -77,6 +80,9 @@ func (a *upgradeAction) Execute(ctx context.Context, syndesis *v1beta1.Syndesis)
 	} else if syndesis.Status.Phase == v1beta1.SyndesisPhasePostUpgradeRunSucceed {
 		// We land here only if the install phase after upgrading finished correctly
 		a.log.Info("syndesis resource post upgrade ran successfully", "name", syndesis.Name, "previous version", syndesis.Status.Version, "target version", targetVersion)
+		if err := a.redeployIntegrations(ctx, syndesis); err != nil {
+			a.log.Error(err, "failure while redeploying integrations after upgrade, they will need to be redeployed manually", "name", syndesis.Name)
+		}
 		return a.completeUpgrade(ctx, syndesis, targetVersion)
 	} else if syndesis.Status.Phase == v1beta1.SyndesisPhasePostUpgradeRun {
 		// If the first run of the install action failed, we land here. We need to retry
@@ -115,18 +121,72 @@ func (a *upgradeAction) completeUpgrade(ctx context.Context, syndesis *v1beta1.S
 	target.Status.LastUpgradeFailure = nil
 	target.Status.UpgradeAttempts = 0
 	target.Status.ForceUpgrade = false
+	target.Status.UpgradeSteps = nil
+	setReadyCondition(target, "Installed", "Upgrade to "+newVersion+" completed successfully")
+	a.event(target, "UpgradeFinished", "Upgrade to "+newVersion+" completed successfully")
 
 	rtClient, _ := a.clientTools.RuntimeClient()
 	err = rtClient.Update(ctx, target)
 	time.Sleep(3 * time.Second)
+
+	// This upgrade attempt is done, forget it so the next one starts from a fresh Upgrader
+	// built against the (now cleared) upgrade step status of the custom resource
+	u = nil
 	return
 }
 
+// redeployIntegrations asks syndesis-server to redeploy every integration it knows about, since
+// an upgraded server may require integrations to be rebuilt against it. Progress is only
+// persisted once, as a final summary, to avoid a status update per integration on installations
+// that have many of them.
+func (a *upgradeAction) redeployIntegrations(ctx context.Context, syndesis *v1beta1.Syndesis) error {
+	rtClient, err := a.clientTools.RuntimeClient()
+	if err != nil {
+		return err
+	}
+
+	token, err := serviceaccount.GetServiceAccountToken(ctx, rtClient, "syndesis-oauth-client", syndesis.Namespace)
+	if err != nil {
+		return fmt.Errorf("unable to get service account token to redeploy integrations: %w", err)
+	}
+
+	client, err := integration.NewClient(ctx, rtClient, syndesis.Namespace, token)
+	if err != nil {
+		return err
+	}
+
+	concurrency := syndesis.Spec.Components.Server.Features.IntegrationRedeployConcurrency
+	redeployed, failed, err := integration.RedeployAll(ctx, client, concurrency)
+	if err != nil {
+		return err
+	}
+
+	target := syndesis.DeepCopy()
+	target.Status.IntegrationRedeploy = &v1beta1.IntegrationRedeployStatus{
+		Total:      len(redeployed) + len(failed),
+		Redeployed: len(redeployed),
+		Failed:     failed,
+	}
+	if err := rtClient.Update(ctx, target); err != nil {
+		return err
+	}
+	*syndesis = *target
+
+	if len(failed) > 0 {
+		a.warningEvent(syndesis, "IntegrationRedeployFailed", fmt.Sprintf("%d of %d integration(s) failed to redeploy after the upgrade and will need to be redeployed manually", len(failed), len(redeployed)+len(failed)))
+	} else if len(redeployed) > 0 {
+		a.event(syndesis, "IntegrationsRedeployed", fmt.Sprintf("redeployed %d integration(s) after the upgrade", len(redeployed)))
+	}
+
+	return nil
+}
+
 func (a *upgradeAction) setPhaseToRun(ctx context.Context, syndesis *v1beta1.Syndesis) (err error) {
 	target := syndesis.DeepCopy()
 	target.Status.Phase = v1beta1.SyndesisPhasePostUpgradeRun
 	target.Status.Reason = v1beta1.SyndesisStatusReasonPostUpgradeRun
 	target.Status.Description = "Perform the first install run after syndesis resource was upgraded"
+	setProgressingCondition(target, string(v1beta1.SyndesisStatusReasonPostUpgradeRun), target.Status.Description)
 
 	rtClient, _ := a.clientTools.RuntimeClient()
 	err = rtClient.Update(ctx, target)
@@ -139,6 +199,8 @@ func (a *upgradeAction) setPhaseToFailureBackoff(ctx context.Context, syndesis *
 	target.Status.Phase = v1beta1.SyndesisPhaseUpgradeFailureBackoff
 	target.Status.Reason = v1beta1.SyndesisStatusReasonUpgradeFailed
 	target.Status.Description = "Syndesis upgrade from " + syndesis.Status.Version + " to " + targetVersion + " failed (it will be retried again)"
+	setDegradedCondition(target, string(v1beta1.SyndesisStatusReasonUpgradeFailed), target.Status.Description)
+	a.warningEvent(target, "UpgradeFailed", target.Status.Description)
 	target.Status.LastUpgradeFailure = &metav1.Time{
 		Time: time.Now(),
 	}
@@ -0,0 +1,134 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package audit records what the operator changed on a cluster while reconciling a Syndesis resource,
+// so a regulated environment can review it later. The operator has no access to the identity of whoever
+// edited the Syndesis custom resource (that lives in the API server's own audit log, if enabled) - what
+// it can attest to is which resourceVersion/generation of the CR it was reconciling and which resources
+// it created, updated or pruned as a result.
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/operation"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("audit")
+
+// ConfigMapSuffix is appended to the Syndesis resource name to name the ConfigMap the audit trail is
+// recorded into.
+const ConfigMapSuffix = "-audit-trail"
+
+// webhookTimeout bounds how long Record waits for AuditWebhook to respond, so a slow or unreachable
+// endpoint never holds up a reconcile.
+const webhookTimeout = 10 * time.Second
+
+// Change describes a single resource the operator created, updated or removed while applying an Entry.
+type Change struct {
+	Kind             string `json:"kind"`
+	Name             string `json:"name"`
+	ModificationType string `json:"modificationType"`
+}
+
+// Entry is one reconcile pass' worth of effective configuration change, ready to be appended to the
+// audit trail.
+type Entry struct {
+	// Time the entry was recorded, RFC3339. Also used as the ConfigMap key, so entries sort chronologically.
+	Time string `json:"time"`
+	// SyndesisGeneration is metadata.generation of the Syndesis resource that was reconciled.
+	SyndesisGeneration int64 `json:"syndesisGeneration"`
+	// SyndesisResourceVersion is metadata.resourceVersion of the Syndesis resource that was reconciled.
+	SyndesisResourceVersion string `json:"syndesisResourceVersion"`
+	// ConfigHash is the effective configuration hash rendered for this reconcile, i.e. status.configHash.
+	ConfigHash string `json:"configHash"`
+	// Changes lists every resource created, updated or pruned while applying this configuration.
+	Changes []Change `json:"changes"`
+}
+
+// NewEntry builds an Entry for the given syndesis resource, stamped with the given time and changes.
+func NewEntry(now time.Time, syndesis *v1beta1.Syndesis, configHash string, changes []Change) Entry {
+	return Entry{
+		Time:                    now.UTC().Format(time.RFC3339),
+		SyndesisGeneration:      syndesis.Generation,
+		SyndesisResourceVersion: syndesis.ResourceVersion,
+		ConfigHash:              configHash,
+		Changes:                 changes,
+	}
+}
+
+// Record appends entry to the "<name>-audit-trail" ConfigMap owned by syndesis, keyed by entry.Time,
+// never touching any previously recorded entry. When syndesis.Spec.AuditWebhook is set, it also POSTs
+// entry to it as JSON, logging but otherwise ignoring a failed delivery: the ConfigMap is the audit
+// trail of record, the webhook is a best-effort notification on top of it.
+func Record(ctx context.Context, cl client.Client, syndesis *v1beta1.Syndesis, entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: syndesis.Name + ConfigMapSuffix,
+			Labels: map[string]string{
+				"app": "syndesis",
+			},
+		},
+		Data: map[string]string{
+			entry.Time: string(data),
+		},
+	}
+	operation.SetNamespaceAndOwnerReference(cm, syndesis)
+
+	// util.CreateOrUpdate only merges in the keys present in cm.Data, leaving any key already on the
+	// live ConfigMap untouched, which is exactly what makes this append-only.
+	if _, _, err := util.CreateOrUpdate(ctx, cl, cm); err != nil {
+		return err
+	}
+
+	if syndesis.Spec.AuditWebhook != "" {
+		postWebhook(syndesis.Spec.AuditWebhook, data)
+	}
+
+	return nil
+}
+
+func postWebhook(url string, data []byte) {
+	httpClient := &http.Client{Timeout: webhookTimeout}
+	resp, err := httpClient.Post(url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		log.Info("failed to deliver audit trail entry to webhook", "url", url, "error", err.Error())
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		log.Info("audit trail webhook returned an error status", "url", url, "status", resp.Status)
+	}
+}
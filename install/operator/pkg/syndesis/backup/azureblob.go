@@ -0,0 +1,256 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AzureBlob uploads the backup archive to an Azure Blob Storage container, authenticating with a
+// storage account shared key. There's no Azure SDK vendored into this project, so requests are
+// signed and issued directly against the Blob service REST API.
+const (
+	azureSecret          = "syndesis-backup-azure"
+	azureAccountName     = "account-name"
+	azureAccountKey      = "account-key"
+	azureContainerName   = "container-name"
+	azureBlobAPIVersion  = "2020-04-08"
+	azureBlobServiceHost = "blob.core.windows.net"
+)
+
+type AzureBlob struct {
+	*Backup
+	account   string
+	accessKey string
+	container string
+	file      string
+}
+
+func (a *AzureBlob) Enabled() (result bool) {
+	api, err := a.Backup.clientTools.ApiClient()
+	if err != nil {
+		return false
+	}
+
+	_, err = api.CoreV1().
+		Secrets(a.syndesis.Namespace).
+		Get(a.context, azureSecret, metav1.GetOptions{
+			TypeMeta: metav1.TypeMeta{},
+		})
+
+	result = err == nil
+	return
+}
+
+func (a *AzureBlob) Status() (err error) {
+	return nil
+}
+
+func (a *AzureBlob) Upload(dir string) (err error) {
+	if err = a.credentials(); err != nil {
+		return
+	}
+
+	data, err := ioutil.ReadFile(a.file)
+	if err != nil {
+		return
+	}
+
+	blobURL := fmt.Sprintf("https://%s.%s/%s/%s", a.account, azureBlobServiceHost, a.container, filepath.Base(a.file))
+	req, err := http.NewRequest(http.MethodPut, blobURL, bytes.NewReader(data))
+	if err != nil {
+		return
+	}
+	req.Header.Set("x-ms-blob-type", "BlockBlob")
+	req.Header.Set("Content-Length", strconv.Itoa(len(data)))
+	req.ContentLength = int64(len(data))
+
+	_, err = a.do(req, fmt.Sprintf("/%s/%s/%s", a.account, a.container, filepath.Base(a.file)), strconv.Itoa(len(data)))
+	if err != nil {
+		return fmt.Errorf("failed to upload backup to Azure Blob storage: %w", err)
+	}
+
+	return nil
+}
+
+// Download fetches the blob named by a.file, the object key of a backup archive previously uploaded by
+// Upload, into dir.
+func (a *AzureBlob) Download(dir string) (err error) {
+	if err = a.credentials(); err != nil {
+		return
+	}
+
+	name := filepath.Base(a.file)
+	blobURL := fmt.Sprintf("https://%s.%s/%s/%s", a.account, azureBlobServiceHost, a.container, name)
+	req, err := http.NewRequest(http.MethodGet, blobURL, nil)
+	if err != nil {
+		return
+	}
+
+	body, err := a.do(req, fmt.Sprintf("/%s/%s/%s", a.account, a.container, name), "")
+	if err != nil {
+		return fmt.Errorf("failed to download backup blob from Azure Blob storage: %w", err)
+	}
+
+	return ioutil.WriteFile(filepath.Join(dir, name), body, 0644)
+}
+
+// azureListBlobsResult is the body of a List Blobs (restype=container&comp=list) response
+type azureListBlobsResult struct {
+	Blobs struct {
+		Blob []struct {
+			Name       string `xml:"Name"`
+			Properties struct {
+				LastModified string `xml:"Last-Modified"`
+			} `xml:"Properties"`
+		} `xml:"Blob"`
+	} `xml:"Blobs"`
+}
+
+// Prune keeps the retention most recently modified blobs in the container and deletes the rest.
+// A retention of zero or less is a no-op, since it means backups are never pruned.
+func (a *AzureBlob) Prune(retention int) (err error) {
+	if retention <= 0 {
+		return nil
+	}
+
+	if err = a.credentials(); err != nil {
+		return
+	}
+
+	listURL := fmt.Sprintf("https://%s.%s/%s?restype=container&comp=list", a.account, azureBlobServiceHost, a.container)
+	req, err := http.NewRequest(http.MethodGet, listURL, nil)
+	if err != nil {
+		return
+	}
+
+	body, err := a.do(req, fmt.Sprintf("/%s/%s\ncomp:list\nrestype:container", a.account, a.container), "")
+	if err != nil {
+		return fmt.Errorf("failed to list Azure Blob container for pruning: %w", err)
+	}
+
+	var result azureListBlobsResult
+	if err = xml.Unmarshal(body, &result); err != nil {
+		return
+	}
+
+	blobs := result.Blobs.Blob
+	sort.Slice(blobs, func(i, j int) bool {
+		// Last-Modified is an RFC1123 date, which does not sort chronologically as a string
+		ti, _ := http.ParseTime(blobs[i].Properties.LastModified)
+		tj, _ := http.ParseTime(blobs[j].Properties.LastModified)
+		return ti.After(tj)
+	})
+
+	if retention >= len(blobs) {
+		return nil
+	}
+
+	for _, blob := range blobs[retention:] {
+		deleteURL := fmt.Sprintf("https://%s.%s/%s/%s", a.account, azureBlobServiceHost, a.container, blob.Name)
+		req, err := http.NewRequest(http.MethodDelete, deleteURL, nil)
+		if err != nil {
+			return err
+		}
+		if _, err = a.do(req, fmt.Sprintf("/%s/%s/%s", a.account, a.container, blob.Name), ""); err != nil {
+			return fmt.Errorf("failed to prune old backup blob %s: %w", blob.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *AzureBlob) credentials() (err error) {
+	api, err := a.Backup.clientTools.ApiClient()
+	if err != nil {
+		return
+	}
+
+	secret, err := api.CoreV1().
+		Secrets(a.syndesis.Namespace).
+		Get(a.context, azureSecret, metav1.GetOptions{
+			TypeMeta: metav1.TypeMeta{},
+		})
+	if err != nil {
+		return
+	}
+
+	a.account = string(secret.Data[azureAccountName])
+	a.accessKey = string(secret.Data[azureAccountKey])
+	a.container = string(secret.Data[azureContainerName])
+
+	if len(a.account) == 0 || len(a.accessKey) == 0 {
+		return fmt.Errorf("one of either 'account name' or 'account key' is empty")
+	}
+
+	return nil
+}
+
+// do signs req with the account's Shared Key, following the canonicalization rules of the Azure
+// Storage Services REST API for canonicalizedResource, then issues it and returns the response body.
+func (a *AzureBlob) do(req *http.Request, canonicalizedResource string, contentLength string) ([]byte, error) {
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", azureBlobAPIVersion)
+
+	canonicalizedHeaders := fmt.Sprintf("x-ms-date:%s\nx-ms-version:%s\n", req.Header.Get("x-ms-date"), azureBlobAPIVersion)
+	if blobType := req.Header.Get("x-ms-blob-type"); blobType != "" {
+		canonicalizedHeaders = fmt.Sprintf("x-ms-blob-type:%s\n", blobType) + canonicalizedHeaders
+	}
+
+	stringToSign := fmt.Sprintf("%s\n\n\n%s\n\n\n\n\n\n\n\n\n%s%s",
+		req.Method, contentLength, canonicalizedHeaders, canonicalizedResource)
+
+	key, err := base64.StdEncoding.DecodeString(a.accessKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode Azure storage account key: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(stringToSign))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	req.Header.Set("Authorization", fmt.Sprintf("SharedKey %s:%s", a.account, signature))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%s: %s", resp.Status, string(body))
+	}
+
+	return body, nil
+}
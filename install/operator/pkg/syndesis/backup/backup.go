@@ -39,6 +39,7 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/operation"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -80,19 +81,29 @@ type Backup struct {
 	customOptions   string                   // Custom options required for restoring
 	backupDesign    backupDesign             // The credentials for the backup/restore operation
 	payloadComplete bool                     // Is uploading of the restore payload complete
+	retention       int                      // Number of most recent remote backups to retain; 0 keeps them all
 }
 
 type backupDesign struct {
-	Job           string // Name of the unique job
-	Name          string // Name of the database
-	User          string // User used to access the database
-	Password      string // Password to access the database
-	Host          string // Hostname of the database server
-	Port          string // Port of the database service
-	FileDir       string // Directory where the remote backup file is stored
-	FileName      string // Name of the backup file
-	Timestamp     string // Value used as sub-directory name for restoring a backup
-	CustomOptions string // String of custom options for use with pg_restore (use-cases where alternatives will be required)
+	Job           string                        // Name of the unique job
+	Name          string                        // Name of the database
+	User          string                        // User used to access the database
+	Password      string                        // Password to access the database
+	Host          string                        // Hostname of the database server
+	Port          string                        // Port of the database service
+	FileDir       string                        // Directory where the remote backup file is stored
+	FileName      string                        // Name of the backup file
+	Timestamp     string                        // Value used as sub-directory name for restoring a backup
+	CustomOptions string                        // String of custom options for use with pg_restore (use-cases where alternatives will be required)
+	SecurityModel v1beta1.SyndesisSecurityModel // How restrictive a securityContext the job's pod is rendered with. Mirrors Spec.SecurityModel
+}
+
+type walRestoreDesign struct {
+	Job           string                        // Name of the unique job
+	Image         string                        // wal-g sidecar image to fetch the base backup and replay WAL with
+	Basebackup    string                        // wal-g backup name to fetch, "LATEST" for the most recent
+	RecoverToTime string                        // recovery_target_time value, empty to replay to the end of the WAL stream
+	SecurityModel v1beta1.SyndesisSecurityModel // How restrictive a securityContext the job's pod is rendered with. Mirrors Spec.SecurityModel
 }
 
 type BkpJobTask func(bkpPod *corev1.Pod) (bool, error)
@@ -103,6 +114,7 @@ type Runner interface {
 	Validate() error
 	RestoreResources() error
 	RestoreDb() error
+	RestoreWAL(basebackup string, recoverTo *metav1.Time) error
 	BuildBackupDir(path string) (r *Backup, err error)
 }
 
@@ -117,6 +129,10 @@ type Uploader interface {
 
 	// Can this uploader be used with current settings
 	Enabled() (result bool)
+
+	// Prune deletes backups beyond the retention most recently uploaded ones. A retention of zero
+	// or less leaves every backup in place.
+	Prune(retention int) (err error)
 }
 
 // downloader interface has methods to download backup files
@@ -167,6 +183,17 @@ func (b *Backup) SetLocalOnly(localOnly bool) error {
 	return nil
 }
 
+// SetRetention sets how many of the most recent remote backups to keep; older ones are pruned
+// after a successful upload. A retention of zero or less leaves every backup in place.
+func (b *Backup) SetRetention(retention int) error {
+	if err := b.inited(); err != nil {
+		return err
+	}
+
+	b.retention = retention
+	return nil
+}
+
 func (b *Backup) SetCustomOptions(customOptions string) error {
 	if err := b.inited(); err != nil {
 		return err
@@ -212,7 +239,7 @@ func (b *Backup) Run() (err error) {
 	}
 
 	if !b.localOnly {
-		uploader := []Uploader{&S3{Backup: b, file: zipped}}
+		uploader := []Uploader{&S3{Backup: b, file: zipped}, &AzureBlob{Backup: b, file: zipped}}
 
 		for _, u := range uploader {
 			if u.Enabled() {
@@ -220,6 +247,10 @@ func (b *Backup) Run() (err error) {
 					b.log.Error(err, "error uploading backup file to source", "source", u)
 					return
 				}
+				if err = u.Prune(b.retention); err != nil {
+					b.log.Error(err, "error pruning old backups from source", "source", u)
+					return
+				}
 				break
 			}
 		}
@@ -447,6 +478,81 @@ func (b *Backup) Validate() (err error) {
 	return
 }
 
+// SchemaVersion returns the syndesis-server image tag backed up amongst this backup's resources, which
+// is the version whose database schema the dump was taken from.
+func (b *Backup) SchemaVersion() (string, error) {
+	data, err := ioutil.ReadFile(filepath.Join(b.backupDir, "resources", "deployment-syndesis-server.yaml"))
+	if err != nil {
+		return "", fmt.Errorf("could not read backed up syndesis-server deployment: %w", err)
+	}
+
+	var dep struct {
+		Spec struct {
+			Template struct {
+				Spec struct {
+					Containers []struct {
+						Image string `yaml:"image"`
+					} `yaml:"containers"`
+				} `yaml:"spec"`
+			} `yaml:"template"`
+		} `yaml:"spec"`
+	}
+	if err := yaml.Unmarshal(data, &dep); err != nil {
+		return "", err
+	}
+
+	if len(dep.Spec.Template.Spec.Containers) == 0 {
+		return "", fmt.Errorf("backed up syndesis-server deployment has no containers")
+	}
+
+	image := dep.Spec.Template.Spec.Containers[0].Image
+	idx := strings.LastIndex(image, ":")
+	if idx < 0 {
+		return "", fmt.Errorf("could not determine syndesis version from backed up image %q", image)
+	}
+
+	return image[idx+1:], nil
+}
+
+// ValidateSchemaVersion checks that this backup's schema version has the same major.minor as running,
+// the version of the Syndesis installation it is about to be restored into. The database schema is not
+// guaranteed compatible across minor releases, so a mismatch aborts the restore rather than risk
+// corrupting it.
+func (b *Backup) ValidateSchemaVersion(running string) error {
+	backedUp, err := b.SchemaVersion()
+	if err != nil {
+		return err
+	}
+
+	if majorMinor(backedUp) != majorMinor(running) {
+		return fmt.Errorf("backup schema version %s is not compatible with running Syndesis version %s", backedUp, running)
+	}
+
+	return nil
+}
+
+func majorMinor(version string) string {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return version
+	}
+	return parts[0] + "." + parts[1]
+}
+
+// Downloader returns the S3 or AzureBlob remote source, matching sourceType, configured to fetch
+// remoteFile into a local directory. Its Enabled method reports whether the corresponding
+// syndesis-backup-s3/syndesis-backup-azure secret is actually present.
+func (b *Backup) Downloader(sourceType v1beta1.RestoreSourceType, remoteFile string) (Downloader, error) {
+	switch sourceType {
+	case v1beta1.RestoreSourceS3:
+		return &S3{Backup: b, file: remoteFile}, nil
+	case v1beta1.RestoreSourceAzure:
+		return &AzureBlob{Backup: b, file: remoteFile}, nil
+	default:
+		return nil, fmt.Errorf("unsupported remote restore source type %q", sourceType)
+	}
+}
+
 /*
  * Because there is some incoherency with the path for backup and for restore,
  * it is needed to transform it from backup to restore so that the restore
@@ -502,7 +608,11 @@ func (b *Backup) BuildBackupDir(path string) (r *Backup, err error) {
  * Cannot use 1 container since the termination state change is what
  * is detected for triggering the execution of the remote command
  */
-// Create a database backup
+// Create a database backup. Database.DirectURL always resolves straight to the syndesis-db
+// Service, which only ever selects the primary pod, so this keeps working unchanged when
+// Database.Replicas adds streaming replicas (never backed up directly, since they hold the same
+// data as the primary at a slight replication lag) or when Database.Pooler fronts Database.URL
+// with PgBouncer.
 func (b *Backup) backupDatabase() error {
 
 	b.log.Info("Initiating database backup ...")
@@ -518,7 +628,7 @@ func (b *Backup) backupDatabase() error {
 		return err
 	}
 
-	dbURL, err := url.Parse(sc.Syndesis.Components.Database.URL)
+	dbURL, err := url.Parse(sc.Syndesis.Components.Database.DirectURL)
 	if err != nil {
 		return err
 	}
@@ -526,14 +636,15 @@ func (b *Backup) backupDatabase() error {
 	suffix := strconv.FormatInt(time.Now().Unix(), 10)
 
 	b.backupDesign = backupDesign{
-		Job:      "db-backup-" + suffix,
-		Name:     sc.Syndesis.Components.Database.Name,
-		User:     sc.Syndesis.Components.Database.User,
-		Password: sc.Syndesis.Components.Database.Password,
-		Host:     dbURL.Hostname(),
-		Port:     dbURL.Port(),
-		FileDir:  "/pgdata/" + dbURL.Hostname() + "-backups/*",
-		FileName: dumpFilename,
+		Job:           "db-backup-" + suffix,
+		Name:          sc.Syndesis.Components.Database.Name,
+		User:          sc.Syndesis.Components.Database.User,
+		Password:      sc.Syndesis.Components.Database.Password,
+		Host:          dbURL.Hostname(),
+		Port:          dbURL.Port(),
+		FileDir:       "/pgdata/" + dbURL.Hostname() + "-backups/*",
+		FileName:      dumpFilename,
+		SecurityModel: sc.Syndesis.SecurityModel,
 	}
 
 	// Get migration resources, this should be the db migration job
@@ -597,7 +708,7 @@ func (b *Backup) RestoreDb() (err error) {
 		return err
 	}
 
-	dbURL, err := url.Parse(sc.Syndesis.Components.Database.URL)
+	dbURL, err := url.Parse(sc.Syndesis.Components.Database.DirectURL)
 	if err != nil {
 		return err
 	}
@@ -621,6 +732,7 @@ func (b *Backup) RestoreDb() (err error) {
 		FileDir:       dataDir,
 		FileName:      dumpFilename,
 		CustomOptions: customOptions,
+		SecurityModel: sc.Syndesis.SecurityModel,
 	}
 
 	// Get migration resources, this should be the db migration job
@@ -646,9 +758,130 @@ func (b *Backup) RestoreDb() (err error) {
 	return b.execJob(b.restoreTask)
 }
 
-//
+// RestoreWAL performs point-in-time recovery from the wal-g backups shipped by the WalArchiving
+// sidecar (see database.WalArchiving), instead of a pg_dump archive. It scales syndesis-db down,
+// runs a Job that fetches basebackup ("LATEST" for the most recent) onto the existing syndesis-db
+// volume and writes a recovery signal, then scales syndesis-db back up so Postgres replays WAL on
+// startup, stopping at recoverTo if it is set.
+func (b *Backup) RestoreWAL(basebackup string, recoverTo *metav1.Time) (err error) {
+	if err := b.inited(); err != nil {
+		return err
+	}
+
+	b.log.Info("starting point-in-time restore for syndesis database", "backup", basebackup)
+
+	sc, err := configuration.GetProperties(b.context, configuration.TemplateConfig, b.clientTools, b.syndesis)
+	if err != nil {
+		return err
+	}
+
+	if !sc.Syndesis.Components.Database.WalArchiving.Enabled {
+		return fmt.Errorf("components.database.walArchiving.enabled must be true to restore from a wal backup")
+	}
+
+	client, err := b.clientTools.RuntimeClient()
+	if err != nil {
+		return err
+	}
+
+	if err := scaleDeployment(b.context, client, b.syndesis.Namespace, "syndesis-db", 0, sc.Syndesis.Components.Database.StatefulSet); err != nil {
+		return fmt.Errorf("failed to scale down syndesis-db: %w", err)
+	}
+
+	recoverToTime := ""
+	if recoverTo != nil {
+		recoverToTime = recoverTo.UTC().Format("2006-01-02 15:04:05 MST")
+	}
+
+	suffix := strconv.FormatInt(time.Now().Unix(), 10)
+	design := walRestoreDesign{
+		Job:           "db-restore-wal-" + suffix,
+		Image:         sc.Syndesis.Components.Database.WalArchiving.Image,
+		Basebackup:    basebackup,
+		RecoverToTime: recoverToTime,
+		SecurityModel: sc.Syndesis.SecurityModel,
+	}
+
+	resources, err := generator.Render("./backup/syndesis-restore-wal-job.yml.tmpl", design)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range resources {
+		operation.SetNamespaceAndOwnerReference(res, b.syndesis)
+		if _, _, err := util.CreateOrUpdate(b.context, client, &res); err != nil {
+			return err
+		}
+	}
+
+	// Wait for the wal-g restore Job to finish
+	if err := wait.Poll(5*time.Second, 15*time.Minute, func() (done bool, err error) {
+		job := &batchv1.Job{}
+		if err := client.Get(b.context, types.NamespacedName{Namespace: b.syndesis.Namespace, Name: design.Job}, job); err != nil {
+			return false, err
+		}
+
+		if job.Status.Failed != 0 {
+			return false, fmt.Errorf("wal restore job failed, %d", job.Status.Failed)
+		}
+		if job.Status.Succeeded != 0 {
+			return true, nil
+		}
+
+		b.log.Info("waiting for wal restore job to finish", "active jobs", job.Status.Active)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	return scaleDeployment(b.context, client, b.syndesis.Namespace, "syndesis-db", 1, sc.Syndesis.Components.Database.StatefulSet)
+}
+
+// scaleDeployment sets name's replica count and waits for it to be reflected in Status.Replicas.
+// statefulSet selects whether name is a StatefulSet (see Database.StatefulSet) instead of a Deployment.
+func scaleDeployment(ctx context.Context, cl rc.Client, namespace string, name string, replicas int32, statefulSet bool) error {
+	if statefulSet {
+		sts := &appsv1.StatefulSet{}
+		if err := cl.Get(ctx, util.NewObjectKey(name, namespace), sts); err != nil {
+			return err
+		}
+
+		if *sts.Spec.Replicas != replicas {
+			sts.Spec.Replicas = &replicas
+			if err := cl.Update(ctx, sts); err != nil {
+				return err
+			}
+		}
+
+		return wait.Poll(5*time.Second, 180*time.Second, func() (bool, error) {
+			if err := cl.Get(ctx, util.NewObjectKey(name, namespace), sts); err != nil {
+				return false, err
+			}
+			return sts.Status.Replicas == replicas, nil
+		})
+	}
+
+	dep := &appsv1.Deployment{}
+	if err := cl.Get(ctx, util.NewObjectKey(name, namespace), dep); err != nil {
+		return err
+	}
+
+	if *dep.Spec.Replicas != replicas {
+		dep.Spec.Replicas = &replicas
+		if err := cl.Update(ctx, dep); err != nil {
+			return err
+		}
+	}
+
+	return wait.Poll(5*time.Second, 180*time.Second, func() (bool, error) {
+		if err := cl.Get(ctx, util.NewObjectKey(name, namespace), dep); err != nil {
+			return false, err
+		}
+		return dep.Status.Replicas == replicas, nil
+	})
+}
+
 // Execute a task within a Job
-//
 func (b *Backup) execJob(jobTask BkpJobTask) error {
 	//
 	// Wait for the job
@@ -737,11 +970,9 @@ func (b *Backup) podInJob(job *batchv1.Job) (*corev1.Pod, error) {
 	return &podList.Items[0], nil
 }
 
-//
 // This will monitor a backup pod for its progress and
 // status before extracting the backup dump file to the
 // backup directory
-//
 func (b *Backup) backupTask(bkpPod *corev1.Pod) (bool, error) {
 	//
 	// The backup pod has gone wrong and failed
@@ -834,11 +1065,9 @@ func (b *Backup) backupTask(bkpPod *corev1.Pod) (bool, error) {
 	return false, nil
 }
 
-//
 // Creates a Conditional Function (ConditionFunc) for processing
 // by a polling wait function. This will monitor the restore pod
 // for its progress and status before returning and finishing.
-//
 func (b *Backup) restoreTask(bkpPod *corev1.Pod) (bool, error) {
 	//
 	// The backup pod has gone wrong and failed
@@ -19,9 +19,11 @@ package backup
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -35,13 +37,17 @@ const (
 	secretKeyID     = "secret-key-id"
 	bucketName      = "bucket-name"
 	region          = "region"
+	// endpoint is optional and lets this uploader target any S3-compatible store, e.g. GCS via
+	// its Cloud Storage interoperability endpoint (https://storage.googleapis.com), or minio.
+	endpoint = "endpoint"
 )
 
 type S3 struct {
 	*Backup
-	bucket string
-	region string
-	file   string
+	bucket   string
+	region   string
+	endpoint string
+	file     string
 }
 
 func (s *S3) Enabled() (result bool) {
@@ -65,14 +71,13 @@ func (s *S3) Upload(dir string) (err error) {
 		return
 	}
 
-	// Create a single AWS session (we can re use this if we're uploading many files)
-	session, err := session.NewSession(&aws.Config{Region: aws.String(s.region)})
+	sess, err := s.session()
 	if err != nil {
 		return
 	}
 
 	// Upload
-	err = s.addFileToS3(session, s.file)
+	err = s.addFileToS3(sess, s.file)
 
 	return
 }
@@ -81,6 +86,89 @@ func (s *S3) Status() (err error) {
 	return nil
 }
 
+// Download fetches the object named by s.file, the object key of a backup archive previously uploaded
+// by Upload, into dir.
+func (s *S3) Download(dir string) (err error) {
+	if err = s.credentials(true); err != nil {
+		return
+	}
+
+	sess, err := s.session()
+	if err != nil {
+		return
+	}
+
+	key := filepath.Base(s.file)
+	resp, err := s3.New(sess).GetObject(&s3.GetObjectInput{Bucket: aws.String(s.bucket), Key: aws.String(key)})
+	if err != nil {
+		return fmt.Errorf("failed to download backup object %s from S3: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	out, err := os.Create(filepath.Join(dir, key))
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, resp.Body)
+	return err
+}
+
+// Prune keeps the retention most recently modified objects in the bucket and deletes the rest.
+// A retention of zero or less is a no-op, since it means backups are never pruned.
+func (s *S3) Prune(retention int) (err error) {
+	if retention <= 0 {
+		return nil
+	}
+
+	if err = s.credentials(true); err != nil {
+		return
+	}
+
+	sess, err := s.session()
+	if err != nil {
+		return
+	}
+	client := s3.New(sess)
+
+	var objects []*s3.Object
+	err = client.ListObjectsV2Pages(&s3.ListObjectsV2Input{Bucket: aws.String(s.bucket)}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		objects = append(objects, page.Contents...)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list S3 bucket for pruning: %w", err)
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].LastModified.After(*objects[j].LastModified)
+	})
+
+	if retention >= len(objects) {
+		return nil
+	}
+
+	for _, object := range objects[retention:] {
+		if _, err = client.DeleteObject(&s3.DeleteObjectInput{Bucket: aws.String(s.bucket), Key: object.Key}); err != nil {
+			return fmt.Errorf("failed to prune old backup object %s: %w", aws.StringValue(object.Key), err)
+		}
+	}
+
+	return nil
+}
+
+// session builds an AWS session configured for this uploader's bucket, region and optional
+// S3-compatible endpoint.
+func (s *S3) session() (*session.Session, error) {
+	config := &aws.Config{Region: aws.String(s.region)}
+	if len(s.endpoint) > 0 {
+		config.Endpoint = aws.String(s.endpoint)
+		config.S3ForcePathStyle = aws.Bool(true)
+	}
+	return session.NewSession(config)
+}
+
 // Setup AWS environment variables to sign requests to AWS
 func (s *S3) credentials(unset bool) (err error) {
 	if unset {
@@ -104,6 +192,7 @@ func (s *S3) credentials(unset bool) (err error) {
 
 	s.bucket = string(secret.Data[bucketName])
 	s.region = string(secret.Data[region])
+	s.endpoint = string(secret.Data[endpoint])
 	keyID := string(secret.Data[secretKeyID])
 	accessKey := string(secret.Data[secretAccessKey])
 
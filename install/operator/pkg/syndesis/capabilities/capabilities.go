@@ -31,6 +31,7 @@ type ApiServerSpec struct {
 	EmbeddedProvider bool   // Set to true if the API Server support an embedded authenticaion provider, eg. openshift
 	OlmSupport       bool   // Set to true if the API Server supports an Operation-Lifecyle-Manager
 	ConsoleLink      bool   // Set to true if the API Server support the openshift console link API
+	CertManager      bool   // Set to true if cert-manager's CRDs are installed on the API Server
 }
 
 type RequiredApiSpec struct {
@@ -39,6 +40,7 @@ type RequiredApiSpec struct {
 	oauthclientauthorizations string
 	catalogsources            string
 	consolelinks              string
+	certmanagerCertificates   string
 }
 
 var RequiredApi = RequiredApiSpec{
@@ -47,6 +49,7 @@ var RequiredApi = RequiredApiSpec{
 	oauthclientauthorizations: "oauthclientauthorizations.oauth.openshift.io/v1",
 	catalogsources:            "catalogsources.operators.coreos.com/v1alpha1",
 	consolelinks:              "consolelinks.console.openshift.io/v1",
+	certmanagerCertificates:   "certificates.cert-manager.io/v1",
 }
 
 func contains(a []string, x string) bool {
@@ -98,6 +101,7 @@ func ApiCapabilities(clientTools *clienttools.ClientTools) (*ApiServerSpec, erro
 	apiSpec.EmbeddedProvider = contains(resIndex, RequiredApi.oauthclientauthorizations)
 	apiSpec.OlmSupport = contains(resIndex, RequiredApi.catalogsources)
 	apiSpec.ConsoleLink = contains(resIndex, RequiredApi.consolelinks)
+	apiSpec.CertManager = contains(resIndex, RequiredApi.certmanagerCertificates)
 
 	return &apiSpec, nil
 }
@@ -19,10 +19,15 @@ package configuration
 import (
 	"bufio"
 	"context"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"math/rand"
 	"net/url"
 	"os"
@@ -30,10 +35,12 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	consolev1 "github.com/openshift/api/console/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/imdario/mergo"
 	errs "github.com/pkg/errors"
@@ -51,6 +58,7 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/capabilities"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/digest"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 )
 
@@ -72,18 +80,78 @@ type Config struct {
 	OpenShiftProject           string                     // The name of the OpenShift project Syndesis is being deployed into
 	OpenShiftOauthClientSecret string                     // OpenShift OAuth client secret
 	OpenShiftConsoleURL        string                     // The URL to the OpenShift console
+	HTTPProxy                  string                     // Proxy to use for plain HTTP requests, propagated to all components. Auto-populated from the cluster Proxy resource when present
+	HTTPSProxy                 string                     // Proxy to use for HTTPS requests, propagated to all components. Auto-populated from the cluster Proxy resource when present
+	NoProxy                    string                     // Comma separated list of hosts that should not be proxied. Auto-populated from the cluster Proxy resource when present
 	ImagePullSecrets           []string                   // Pull secrets attached to services accounts. This field is generated by the operator
 	DatabaseNeedsUpgrade       bool                       // Enabled the image running the database doesn't match the operator's configured image spec
 	ApiServer                  capabilities.ApiServerSpec // Metadata of the API Server providing the application
 	Syndesis                   SyndesisConfig             // Configuration for syndesis components and addons. This fields are overwritten from environment variables and from the custom resource
+	InternalCerts              InternalCertsInfo          // Serving-certificate secrets provisioned for internal service-to-service TLS. Populated by the operator, not user configured
+	ResolvedImageDigests       map[string]string          // Digest each first-party image's tag was resolved to, when Syndesis.SHA is true. Populated by the operator, not user configured
 }
 
 type SyndesisConfig struct {
-	DemoData      bool           // Enables starting up with demo data
-	SHA           bool           // Whether we use SHA reference for docker images. If false, tag are used instead
-	RouteHostname string         // The external hostname to access Syndesis
-	Components    ComponentsSpec // Server, Meta, Ui, Name specifications and configurations
-	Addons        AddonsSpec     // Addons specifications and configurations
+	DemoData                   bool                          // Enables starting up with demo data
+	SHA                        bool                          // Whether we use SHA reference for docker images. If false, tag are used instead
+	RouteHostname              string                        // The external hostname to access Syndesis
+	Components                 ComponentsSpec                // Server, Meta, Ui, Name specifications and configurations
+	Addons                     AddonsSpec                    // Addons specifications and configurations
+	Scheduling                 SchedulingConfiguration       // Node selector, tolerations and affinity applied to every Syndesis component pod
+	AdditionalLabels           map[string]string             // Additional labels merged into every resource generated by the operator
+	AdditionalAnnotations      map[string]string             // Additional annotations merged into every resource generated by the operator
+	ImagePullPolicy            string                        // Default imagePullPolicy for every component pod. Overridden per-component when set. Falls back to Always/IfNotPresent based on DevSupport when empty
+	Certificates               CertificateConfiguration      // Internal TLS certificate settings for service-to-service traffic between server, meta and prometheus
+	CertManager                CertManagerConfiguration      // References the cert-manager Issuer/ClusterIssuer used to request route certificates
+	RouteCertificateSecretData map[string][]byte             // Contents of the cert-manager-issued certificate for the Syndesis route. Populated by CheckRouteCertificateSecret, not user configured
+	SecurityModel              v1beta1.SyndesisSecurityModel // How restrictive a securityContext component pods are rendered with. Mirrors Spec.SecurityModel
+	DisableNetworkPolicies     bool                          // Stops the operator from rendering NetworkPolicies restricting traffic between components. Disabled (i.e. NetworkPolicies are rendered) by default
+	FIPSMode                   bool                          // Requires FIPS-approved randomness/algorithms for generated secrets and FIPS-tagged first-party images. Mirrors Spec.FIPSMode
+	CosignPublicKey            string                        // PEM-encoded public key resolved image digests are verified against when SHA is true. Mirrors Spec.CosignPublicKey
+}
+
+// CertManagerConfiguration references the cert-manager Issuer/ClusterIssuer the operator requests
+// route certificates from. Has no effect unless cert-manager's CRDs are installed on the cluster
+type CertManagerConfiguration struct {
+	IssuerName string // Name of the Issuer or ClusterIssuer to request certificates from. Left empty, cert-manager integration is disabled
+	IssuerKind string // Kind of the referenced issuer, Issuer or ClusterIssuer. Defaults to ClusterIssuer when left empty
+}
+
+// publicAPICertManagerSecretName and routeCertManagerSecretName are the fixed names of the Secrets
+// the operator asks cert-manager to write issued certificates into, so they can be located again
+// without threading the name through the CR
+const (
+	routeCertManagerSecretName     = "syndesis-route-tls-certmanager"
+	publicAPICertManagerSecretName = "syndesis-public-api-tls-certmanager"
+)
+
+// CertificateConfiguration controls the internal serving certificates issued for service-to-service
+// traffic between the server, meta and prometheus components. Has no effect on OpenShift, where the
+// platform's service-serving-cert signer issues and rotates them instead.
+type CertificateConfiguration struct {
+	ValidityDays    int32 // How many days a self-signed certificate generated by the operator remains valid for. Defaults to 365 when left empty
+	RenewBeforeDays int32 // How many days before expiry the operator rotates a self-signed certificate. Defaults to 30 when left empty
+	MutualTLS       bool  // Additionally enables mutual TLS between server, meta, db-exporter and prometheus, always via the operator's own internal CA
+}
+
+// InternalCertsInfo records the secrets holding the internal serving certificates the operator
+// provisioned for the server, meta and prometheus components, and the generation marker used by the
+// infrastructure templates to trigger a coordinated pod restart when they are rotated. It is computed
+// by tls.EnsureCertificates while resolving prerequisites, not read from the custom resource.
+type InternalCertsInfo struct {
+	ServerSecret     string // Name of the secret holding the server component's serving certificate
+	MetaSecret       string // Name of the secret holding the meta component's serving certificate
+	PrometheusSecret string // Name of the secret holding the prometheus component's serving certificate
+	DbExporterSecret string // Name of the secret holding the db-exporter component's certificate. Only populated when MutualTLS is enabled
+	Generation       string // Short hash identifying the current certificate set; empty when the platform (e.g. OpenShift) manages rotation itself
+}
+
+// SchedulingConfiguration is applied verbatim to the pod spec of every Syndesis component
+type SchedulingConfiguration struct {
+	NodeSelector      map[string]string   // Node selector applied to all Syndesis component pods
+	Tolerations       []corev1.Toleration // Tolerations applied to all Syndesis component pods
+	Affinity          *corev1.Affinity    // Affinity rules applied to all Syndesis component pods
+	PriorityClassName string              // PriorityClassName applied to all Syndesis component pods
 }
 
 // Components
@@ -110,13 +178,37 @@ type OauthConfiguration struct {
 	CookieSecret          string            // Secret to use to encrypt oauth cookies
 	DisableSarCheck       bool              // Enable or disable SAR checks all together
 	SarNamespace          string            // The user needs to have permissions to at least get a list of pods in the given project in order to be granted access to the Syndesis installation
+	SarResource           string            // Resource the SAR check verifies access to. Defaults to "pods" when left empty
+	SarVerb               string            // Verb the SAR check verifies against SarResource. Defaults to "get" when left empty
+	SarAPIGroup           string            // API group SarResource belongs to. Defaults to the core API group when left empty
 	CredentialsSecret     string            // The name of the secret used to store provider credentials
 	CredentialsSecretData map[string][]byte // The data of the credentials secret
 	CryptoCommsSecret     string            // The name of the secret used to provide the TLS certificate for secure communication
+	Replicas              int32             // Number of Oauth proxy pod replicas to run
+	CookieExpire          string            // How long an authenticated session stays valid for. Mirrors Spec.Components.Oauth.CookieExpire
+	CookieRefresh         string            // How often the oauth proxy refreshes the access token backing a session. Mirrors Spec.Components.Oauth.CookieRefresh
+	Scopes                []string          // Additional OAuth scopes requested alongside the built-in ones
+	SkipAuthRegex         []string          // Additional request paths let through without authentication
+	ExtraArguments        []string          // Extra command line arguments appended to the oauth proxy container
+	IssuerURL             string            // Issuer URL of a generic OIDC provider, for clusters without an embedded auth provider
 }
 
 type UIConfiguration struct {
-	Image string // Docker image for UI
+	Image           string             // Docker image for UI
+	Replicas        int32              // Number of UI pod replicas to run
+	Probes          ProbeConfiguration // Liveness/readiness probe timings
+	ImagePullPolicy string             // Overrides Syndesis.ImagePullPolicy for the UI pod
+}
+
+// Timings for a component's liveness/readiness probes. A zero value for any field means
+// "use the built-in default", so the zero value of ProbeConfiguration is fully backward compatible
+type ProbeConfiguration struct {
+	LivenessInitialDelaySeconds  int32 // Liveness probe initialDelaySeconds
+	LivenessPeriodSeconds        int32 // Liveness probe periodSeconds
+	LivenessFailureThreshold     int32 // Liveness probe failureThreshold
+	ReadinessInitialDelaySeconds int32 // Readiness probe initialDelaySeconds
+	ReadinessPeriodSeconds       int32 // Readiness probe periodSeconds
+	ReadinessFailureThreshold    int32 // Readiness probe failureThreshold
 }
 
 type S2IConfiguration struct {
@@ -124,25 +216,74 @@ type S2IConfiguration struct {
 }
 
 type DatabaseConfiguration struct {
-	Image            string                        // Docker image for Database
-	User             string                        // Username for PostgreSQL user that will be used for accessing the database
-	Name             string                        // Name of the PostgreSQL database accessed
-	URL              string                        // Host and port of the PostgreSQL database to access
-	ExternalDbURL    string                        // If specified, use an external database instead of the installed by syndesis
-	Resources        ResourcesWithPersistentVolume // Resources, memory and database volume size
-	Exporter         ExporterConfiguration         // The exporter exports metrics in prometheus format
-	Password         string                        // Password for the PostgreSQL connection user
-	SampledbPassword string                        // Password for the PostgreSQL sampledb user
+	Image                       string                        // Docker image for Database
+	User                        string                        // Username for PostgreSQL user that will be used for accessing the database
+	Name                        string                        // Name of the PostgreSQL database accessed
+	URL                         string                        // Host and port of the PostgreSQL database to access
+	DirectURL                   string                        // URL always resolving straight to the database, bypassing Pooler even when it fronts URL. Used by backup/restore and the upgrade job, which need a real session
+	ExternalDbURL               string                        // If specified, use an external database instead of the installed by syndesis
+	ExternalDbSSLMode           string                        // TLS mode used to connect to an external database (disable, require, verify-ca, verify-full)
+	ExternalDbCertificateSecret string                        // Name of the secret holding the CA bundle (ca.crt) and, optionally, the client certificate (tls.crt) and key (tls.key)
+	ExternalDbCACertPresent     bool                          // Whether ExternalDbCertificateSecret carries a ca.crt entry
+	ExternalDbClientCertPresent bool                          // Whether ExternalDbCertificateSecret carries tls.crt/tls.key entries
+	ExternalDbCredentialsSecret string                        // Name of a user-provided secret holding POSTGRESQL_USER and POSTGRESQL_PASSWORD, in place of User/Password
+	ExternalDbIAMAuth           bool                          // Whether ExternalDbURL uses AWS RDS IAM database authentication instead of a static password
+	ExternalDbIAMRoleArn        string                        // ARN of the IAM role syndesis-server assumes via IRSA to obtain RDS auth tokens
+	ExternalDbProxy             ExternalDbProxyConfiguration  // Optional cloud database proxy sidecar injected into syndesis-server and syndesis-meta
+	Resources                   ResourcesWithPersistentVolume // Resources, memory and database volume size
+	Exporter                    ExporterConfiguration         // The exporter exports metrics in prometheus format
+	Password                    string                        // Password for the PostgreSQL connection user
+	SampledbPassword            string                        // Password for the PostgreSQL sampledb user
+	Sampledb                    SampledbConfiguration         // Whether the sample database and demo connections are provisioned
+	ImagePullPolicy             string                        // Overrides Syndesis.ImagePullPolicy for the database and exporter containers
+	Replicas                    int32                         // Number of database pods to run. Values greater than 1 add streaming replicas alongside the primary
+	StandbyReplicas             int32                         // Number of streaming replica pods to run, computed as Replicas-1. Zero disables the syndesis-db-replica StatefulSet
+	ReplicationUser             string                        // Username of the PostgreSQL replication role used by streaming replicas to connect to the primary
+	ReplicationPassword         string                        // Password for the PostgreSQL replication role
+	Pooler                      PoolerConfiguration           // Optional PgBouncer connection pooler deployed in front of the database
+	WalArchiving                WalArchivingConfiguration     // Optional sidecar that continuously ships WAL segments for point-in-time recovery
+	Tuning                      map[string]string             // PostgreSQL configuration parameters merged into postgresql.conf on top of the built-in defaults
+	PasswordRotation            string                        // How often the operator rotates the internal database password, e.g. "90d". Empty disables rotation
+	InitScripts                 string                        // Inline SQL run once, against the database, the first time it is provisioned
+	InitScriptsConfigMap        string                        // Name of a user-provided ConfigMap whose *.sql keys are run once, in filename order, instead of/alongside InitScripts
+	StatefulSet                 bool                          // Run the primary database as a StatefulSet, with a stable pod identity, instead of a Deployment
+}
+
+type ExternalDbProxyConfiguration struct {
+	Enabled bool     // Whether to inject the proxy sidecar into syndesis-server and syndesis-meta. Disabled by default
+	Image   string   // Docker image for the proxy sidecar
+	Args    []string // Arguments passed to the proxy sidecar's entrypoint
+	Port    int32    // Local port the proxy sidecar listens on. Defaults to 5432
+}
+
+type WalArchivingConfiguration struct {
+	Enabled bool   // Whether to deploy the WAL archiving sidecar. Disabled by default
+	Image   string // Docker image for the wal-g sidecar
+}
+
+type PoolerConfiguration struct {
+	Enabled         bool      // Whether to deploy PgBouncer in front of the database. Disabled by default
+	Image           string    // Docker image for PgBouncer
+	MaxClientConn   int32     // Maximum number of client connections PgBouncer accepts
+	DefaultPoolSize int32     // Number of server connections PgBouncer keeps open per user/database pair
+	PoolMode        string    // PgBouncer pool_mode: session, transaction or statement
+	Resources       Resources // Resources reserved for the pooler pod
+}
+
+type SampledbConfiguration struct {
+	Enabled bool // Whether the sampledb is created and demo connections are provisioned. Disable for production installs
 }
 
 type ExporterConfiguration struct {
-	Image string // Docker image for database exporter
+	Image   string // Docker image for database exporter
+	Queries string // Extra queries appended to the built-in query set, in postgres_exporter's queries.yaml format
 }
 
 type PrometheusConfiguration struct {
-	Image     string              // Docker image for prometheus
-	Rules     string              // Monitoring rules for prometheus
-	Resources ResourcesWithVolume // Set volume size for prometheus pod, where metrics are stored
+	Image           string              // Docker image for prometheus
+	Rules           string              // Monitoring rules for prometheus
+	Resources       ResourcesWithVolume // Set volume size for prometheus pod, where metrics are stored
+	ImagePullPolicy string              // Overrides Syndesis.ImagePullPolicy for the prometheus pod
 }
 
 type GrafanaConfiguration struct {
@@ -150,17 +291,29 @@ type GrafanaConfiguration struct {
 }
 
 type ServerConfiguration struct {
-	Image                        string         // Docker image for syndesis server
-	Resources                    Resources      // Resources reserved for server pod
-	Features                     ServerFeatures // Server features: integration limits and check interval, support for demo data and more
-	SyndesisEncryptKey           string         // The encryption key used to encrypt/decrypt stored secrets
-	ClientStateAuthenticationKey string         // Key used to perform authentication of client side stored state
-	ClientStateEncryptionKey     string         // Key used to perform encryption of client side stored state
+	Image                              string             // Docker image for syndesis server
+	Resources                          Resources          // Resources reserved for server pod
+	Features                           ServerFeatures     // Server features: integration limits and check interval, support for demo data and more
+	SyndesisEncryptKey                 string             // The encryption key used to encrypt/decrypt stored secrets
+	ClientStateAuthenticationKey       string             // Key used to perform authentication of client side stored state
+	ClientStateEncryptionKey           string             // Key used to perform encryption of client side stored state
+	Replicas                           int32              // Number of server pod replicas to run
+	Probes                             ProbeConfiguration // Liveness/readiness probe timings
+	ImagePullPolicy                    string             // Overrides Syndesis.ImagePullPolicy for the server pod
+	AdditionalCA                       string             // Name of a ConfigMap holding additional CA certificates to trust, mounted into server, meta and generated integrations
+	AdditionalCACertData               map[string]string  // Contents of AdditionalCA's ConfigMap, keyed by file name. Populated by CheckAdditionalCAConfigMap, not user configured
+	RequestedEncryptKey                string             // A newly requested SyndesisEncryptKey, pending re-encryption of stored secrets. Mirrors Spec.Components.Server.RequestedEncryptKey
+	ClientStateKeyLength               int                // Length, in random bytes before base64 encoding, of a freshly generated ClientState*Key. Zero (the default) keeps generating the legacy fixed 32-character alphanumeric key. Mirrors Spec.Components.Server.ClientStateKeyLength
+	ClientStateAuthenticationAlgorithm string             // Mac algorithm the server authenticates client-side state with, e.g. HmacSHA256. Defaults to HmacSHA1, or HmacSHA256 when FIPSMode is true, when left empty. Mirrors Spec.Components.Server.ClientStateAuthenticationAlgorithm
+	ClientStateEncryptionAlgorithm     string             // Cipher transformation the server encrypts client-side state with, e.g. AES/GCM/NoPadding. Defaults to AES/CBC/PKCS5Padding when left empty. Mirrors Spec.Components.Server.ClientStateEncryptionAlgorithm
 }
 
 type MetaConfiguration struct {
-	Image     string              // Docker image for syndesis meta
-	Resources ResourcesWithVolume // Resources for meta pod, memory
+	Image           string              // Docker image for syndesis meta
+	Resources       ResourcesWithVolume // Resources for meta pod, memory
+	Replicas        int32               // Number of meta pod replicas to run
+	Probes          ProbeConfiguration  // Liveness/readiness probe timings
+	ImagePullPolicy string              // Overrides Syndesis.ImagePullPolicy for the meta pod
 }
 
 type UpgradeConfiguration struct {
@@ -196,33 +349,52 @@ type ServerFeatures struct {
 	DeployIntegrations            bool              // Whether we deploy integrations
 	TestSupport                   bool              // Enables test-support endpoint on backend API
 	OpenShiftMaster               string            // Public OpenShift master address
-	ManagementURLFor3scale        string            // 3scale management URL
 	MavenRepositories             map[string]string // Set repositories for maven
+	MavenMirrors                  map[string]string // Set mirrors for maven
 }
 
 // Addons
 type AddonsSpec struct {
-	Jaeger    JaegerConfiguration
-	Ops       OpsConfiguration
-	Todo      TodoConfiguration
-	Knative   KnativeConfiguration
-	DV        DvConfiguration
-	CamelK    CamelKConfiguration
-	PublicAPI PublicAPIConfiguration
+	Jaeger     JaegerConfiguration
+	Ops        OpsConfiguration
+	SampleApps SampleAppsConfiguration
+	Knative    KnativeConfiguration
+	DV         DvConfiguration
+	CamelK     CamelKConfiguration
+	Kafka      KafkaConfiguration
+	Apicurito  ApicuritoConfiguration
+	ThreeScale ThreeScaleConfiguration
+	PublicAPI  PublicAPIConfiguration
+	Keycloak   KeycloakConfiguration
+	Logging    LoggingConfiguration
+}
+
+// ThreeScaleConfiguration mirrors v1beta1.ThreeScaleConfiguration. Unlike the other addons it
+// doesn't deploy any resources of its own, so it isn't part of GetAddonsInfo(): it only configures
+// how syndesis-server surfaces the 3scale tenant to integration authors and whether the services
+// backing published integrations get annotated for 3scale's service discovery.
+type ThreeScaleConfiguration struct {
+	Enabled              bool
+	ManagementURL        string // URL of the 3scale tenant's management/admin API
+	AccessTokenSecret    string // Name of the secret holding the access token for ManagementURL
+	AutoServiceDiscovery bool   // Annotate integration services so 3scale discovers them automatically
 }
 
 type JaegerConfiguration struct {
-	Enabled       bool // Whether the addon is enabled
-	Olm           OlmSpec
-	ClientOnly    bool
-	OperatorOnly  bool
-	QueryURI      string
-	CollectorURI  string
-	SamplerType   string
-	SamplerParam  string
-	ImageAgent    string
-	ImageAllInOne string
-	ImageOperator string
+	Enabled           bool // Whether the addon is enabled
+	Olm               OlmSpec
+	ClientOnly        bool
+	OperatorOnly      bool
+	QueryURI          string
+	CollectorURI      string
+	SamplerType       string
+	SamplerParam      string
+	ImageAgent        string
+	ImageAllInOne     string
+	ImageOperator     string
+	AuthType          string // How CredentialsSecret authenticates against the endpoints above: bearer or basic
+	CredentialsSecret string // Name of the secret holding the bearer token or basic auth username/password
+	CertificateSecret string // Name of the secret holding the CA bundle (ca.crt) for a TLS-secured endpoint
 }
 
 func (j JaegerConfiguration) Name() string {
@@ -245,6 +417,16 @@ func (j JaegerConfiguration) GetOlmSpec() *OlmSpec {
 	return &j.Olm
 }
 
+func (j JaegerConfiguration) Validate() error {
+	if j.ClientOnly && j.OperatorOnly {
+		return errs.New("jaeger addon: ClientOnly and OperatorOnly are mutually exclusive")
+	}
+	return nil
+}
+func (j JaegerConfiguration) Requires() []string {
+	return nil
+}
+
 type OpsConfiguration struct {
 	AddonConfiguration
 }
@@ -253,37 +435,178 @@ func (o OpsConfiguration) Name() string {
 	return "ops"
 }
 
-type TodoConfiguration struct {
-	Image string // Docker image for todo sample app
-	AddonConfiguration
+// SampleAppConfiguration mirrors v1beta1.SampleAppConfiguration.
+type SampleAppConfiguration struct {
+	Name           string
+	Image          string
+	Env            map[string]string
+	DatabaseSchema string
+	RouteHostname  string
 }
 
-func (t TodoConfiguration) Name() string {
-	return "todo"
+// SampleAppsConfiguration is the list of example applications the operator deploys purely for demos
+// and QE testing. Unlike the other addons it has no single Enabled flag or Olm spec, so it implements
+// AddonInfo directly instead of embedding AddonConfiguration: it's enabled when the list is non-empty,
+// and it never installs via OLM.
+type SampleAppsConfiguration []SampleAppConfiguration
+
+func (s SampleAppsConfiguration) Name() string {
+	return "sampleapps"
+}
+
+func (s SampleAppsConfiguration) IsEnabled() bool {
+	return len(s) > 0
+}
+
+func (s SampleAppsConfiguration) GetOlmSpec() *OlmSpec {
+	return nil
+}
+
+func (s SampleAppsConfiguration) Validate() error {
+	seen := map[string]bool{}
+	for _, app := range s {
+		if app.Name == "" {
+			return errs.New("sampleapps addon: every sample app needs a name")
+		}
+		if seen[app.Name] {
+			return errs.Errorf("sampleapps addon: duplicate sample app name %q", app.Name)
+		}
+		seen[app.Name] = true
+	}
+	return nil
+}
+
+func (s SampleAppsConfiguration) Requires() []string {
+	return nil
 }
 
 type DvConfiguration struct {
 	Image string // Docker image for dv
 	AddonConfiguration
-	Resources Resources
+	Resources    DvResources
+	Replicas     *int32              // Number of dv pod replicas to run, including 0. Defaults to 1 when nil
+	NodeSelector map[string]string   // Node selector applied to the dv pod, in addition to Scheduling.NodeSelector
+	Tolerations  []corev1.Toleration // Tolerations applied to the dv pod, in addition to Scheduling.Tolerations
 }
 
 func (dv DvConfiguration) Name() string {
 	return "dv"
 }
 
+type DvResources struct {
+	Memory         string
+	CPU            string // CPU limit for the dv pod, eg. "750m". Left empty to not set a CPU limit
+	VolumeCapacity string // Capacity of the persistent volume used to cache materialized views. Left empty to run without one
+}
+
 type KnativeConfiguration struct {
 	AddonConfiguration
+	BrokerClass           string
+	ChannelImplementation string
+	InjectNamespaceLabel  bool
 }
 
 func (k KnativeConfiguration) Name() string {
 	return "knative"
 }
 
+// KafkaConfiguration configures the Kafka addon, which either connects Syndesis to an existing
+// Kafka cluster or has the operator provision a small AMQ Streams/Strimzi Kafka cluster of its own
+type KafkaConfiguration struct {
+	AddonConfiguration
+	Provision        bool   // Provision a Strimzi Kafka cluster instead of connecting to an existing one
+	Replicas         int32  // Number of broker replicas for the provisioned Kafka cluster. Defaults to 1 when 0
+	StorageCapacity  string // Capacity of the persistent volume backing each provisioned broker. Left empty to run without one
+	Version          string // Kafka version to provision. Defaults to the Strimzi operator's own default when empty
+	BootstrapServers string // Bootstrap servers of an existing Kafka cluster to connect to. Ignored when Provision is true
+	CredentialSecret string // Name of the secret holding credentials for BootstrapServers. Ignored when Provision is true
+}
+
+func (k KafkaConfiguration) Name() string {
+	return "kafka"
+}
+
+// ApicuritoConfiguration configures the Apicurito (API Designer) addon, deployed alongside
+// Syndesis so syndesis-ui can embed it for designing the OpenAPI specifications used by
+// API-provider integrations
+type ApicuritoConfiguration struct {
+	Image string // Docker image for Apicurito
+	AddonConfiguration
+	Resources     Resources
+	RouteHostname string // Hostname of the Route exposing Apicurito. Defaults to a generated hostname when empty
+}
+
+func (a ApicuritoConfiguration) Name() string {
+	return "apicurito"
+}
+
+// KeycloakConfiguration configures the Keycloak addon, which either connects Syndesis to an
+// existing Keycloak/RH-SSO realm or has the operator provision a Keycloak instance of its own,
+// so users can authenticate against LDAP/SAML-federated identities instead of only OpenShift OAuth
+type KeycloakConfiguration struct {
+	AddonConfiguration
+	Provision         bool   // Provision a Keycloak instance instead of connecting to an existing one
+	Image             string // Docker image for the provisioned Keycloak instance. Ignored when Provision is false
+	RouteHostname     string // Hostname of the Route exposing the provisioned Keycloak instance. Ignored when Provision is false
+	URL               string // Base URL of an existing Keycloak/RH-SSO instance to connect to. Ignored when Provision is true
+	Realm             string // Realm to authenticate Syndesis users against
+	CredentialsSecret string // Name of the secret holding the client ID/secret used to register Syndesis as a client of the realm
+}
+
+func (k KeycloakConfiguration) Name() string {
+	return "keycloak"
+}
+
+func (k KeycloakConfiguration) Validate() error {
+	if !k.Provision && k.URL == "" {
+		return errs.New("keycloak addon: URL is required when Provision is false")
+	}
+	return nil
+}
+
+// LoggingConfiguration configures the log forwarding addon, which injects a log-shipping sidecar
+// into syndesis-server so integration and component logs also reach an external Elasticsearch or
+// Loki endpoint, in addition to the container's own stdout
+type LoggingConfiguration struct {
+	AddonConfiguration
+	Image             string // Docker image for the log-shipping sidecar
+	Type              string // Type of the external log store to forward to: elasticsearch or loki
+	Endpoint          string // Endpoint URL of the external log store
+	Index             string // Index or log stream name logs are shipped under. Uses the sidecar's own default when empty
+	CredentialsSecret string // Name of the secret holding the credentials used to authenticate against Endpoint
+
+	// The data of CredentialsSecret, populated by CheckLoggingCredentialsSecret
+	CredentialsSecretData map[string][]byte
+}
+
+func (l LoggingConfiguration) Name() string {
+	return "logging"
+}
+
+func (l LoggingConfiguration) Validate() error {
+	if l.Endpoint == "" {
+		return errs.New("logging addon: Endpoint is required")
+	}
+	if l.Type != "elasticsearch" && l.Type != "loki" {
+		return errs.Errorf("logging addon: Type must be elasticsearch or loki, got %q", l.Type)
+	}
+	return nil
+}
+
 type PublicAPIConfiguration struct {
 	AddonConfiguration
-	RouteHostname   string
-	DisableSarCheck bool
+	RouteHostname        string
+	DisableSarCheck      bool
+	SarResource          string // Resource the SAR check verifies access to. Defaults to "pods" when left empty
+	SarVerb              string // Verb the SAR check verifies against SarResource. Defaults to "get" when left empty
+	SarAPIGroup          string // API group SarResource belongs to. Defaults to the core API group when left empty
+	CertificateSecret    string
+	TerminationPolicy    string
+	RateLimitConnections int
+	IPAllowList          []string
+
+	// The data of CertificateSecret, populated by CheckPublicAPICertificateSecret
+	CertificateSecretData map[string][]byte
 }
 
 func (p PublicAPIConfiguration) Name() string {
@@ -295,12 +618,36 @@ type CamelKConfiguration struct {
 	AddonConfiguration
 	CamelVersion  string
 	CamelKRuntime string
+
+	// Channel overrides the OLM channel to subscribe to when installing the Camel K operator
+	// automatically. Defaults to the channel configured for this Syndesis release when empty
+	Channel string
+
+	// CatalogSource overrides the CatalogSource the Camel K operator is installed from. Defaults
+	// to the CatalogSource that publishes the resolved package when empty
+	CatalogSource string
+
+	// CatalogSourceNamespace overrides the namespace of CatalogSource. Defaults to CatalogSource's
+	// own namespace when empty
+	CatalogSourceNamespace string
 }
 
 func (c CamelKConfiguration) Name() string {
 	return "camelk"
 }
 
+// GetOlmSpec overrides AddonConfiguration.GetOlmSpec to apply the CR-configurable Channel,
+// CatalogSource and CatalogSourceNamespace on top of the operator's default OlmSpec for this addon
+func (c CamelKConfiguration) GetOlmSpec() *OlmSpec {
+	olmSpec := c.Olm
+	if c.Channel != "" {
+		olmSpec.Channel = c.Channel
+	}
+	olmSpec.CatalogSource = c.CatalogSource
+	olmSpec.CatalogSourceNamespace = c.CatalogSourceNamespace
+	return &olmSpec
+}
+
 type AddonConfiguration struct {
 	Enabled bool    // Whether the addon is enabled
 	Olm     OlmSpec // The specification for the Operator-Lifecyle-Manager
@@ -309,6 +656,14 @@ type AddonConfiguration struct {
 type OlmSpec struct {
 	Package string // The name of the package if available in the operator-lifecycle-manager
 	Channel string // The preferred channel from which to take the operator
+
+	// CatalogSource optionally overrides the CatalogSource the operator is installed from. Left
+	// empty to use the CatalogSource that publishes the resolved package
+	CatalogSource string
+
+	// CatalogSourceNamespace optionally overrides the namespace of CatalogSource. Left empty to
+	// use CatalogSource's own namespace
+	CatalogSourceNamespace string
 }
 
 func (ac AddonConfiguration) IsEnabled() bool {
@@ -317,6 +672,12 @@ func (ac AddonConfiguration) IsEnabled() bool {
 func (ac AddonConfiguration) GetOlmSpec() *OlmSpec {
 	return &ac.Olm
 }
+func (ac AddonConfiguration) Validate() error {
+	return nil
+}
+func (ac AddonConfiguration) Requires() []string {
+	return nil
+}
 
 type AddonInfo interface {
 	Name() string
@@ -324,8 +685,63 @@ type AddonInfo interface {
 	GetOlmSpec() *OlmSpec
 }
 
+// Addon extends AddonInfo with a Validate hook, so an addon's own configuration is checked before
+// its resources are ever rendered. It deliberately has no Resources or Status methods: every
+// addon's resources already live under the generic "./addons/<Name()>/" template directory (rendered
+// by action/install.go via generator.RenderDir), and its Status is already computed generically by
+// addonhealth.go, which defaults to SyndesisAddonPhaseInstalled unless the addon registers a special
+// case there. Neither needs a per-addon override to be pluggable.
+type Addon interface {
+	AddonInfo
+	// Validate checks this addon's own configuration, independent of the rest of Config. A non-nil
+	// error prevents the addon from being installed.
+	Validate() error
+	// Requires lists the Name() of other addons that must also be enabled for this addon to work.
+	// Returns nil when there are no hard dependencies; an optional integration that already guards
+	// itself (eg. CamelK's Knative example profile, only rendered when Knative is enabled) doesn't
+	// belong here.
+	Requires() []string
+}
+
+// ValidateAddonDependencies checks that every enabled addon's Requires() are themselves enabled,
+// so a CR enabling an addon without its prerequisites fails install with a clear reason instead of
+// installing a partially-working addon.
+func ValidateAddonDependencies(addons []Addon) error {
+	enabled := map[string]bool{}
+	for _, addon := range addons {
+		enabled[addon.Name()] = addon.IsEnabled()
+	}
+	for _, addon := range addons {
+		if !addon.IsEnabled() {
+			continue
+		}
+		for _, dep := range addon.Requires() {
+			if !enabled[dep] {
+				return errs.Errorf("addon %q requires addon %q to also be enabled", addon.Name(), dep)
+			}
+		}
+	}
+	return nil
+}
+
+// addonRegistry holds addon factories registered via RegisterAddon, layered on top of the built-in
+// addons GetAddonsInfo lists directly. This lets a downstream build compile in extra addons - each a
+// self-contained package that calls RegisterAddon from its own init() - without touching AddonsSpec
+// or GetAddonsInfo.
+var addonRegistry []func(Config) Addon
+
+// RegisterAddon adds factory to the addon registry, so GetAddonsInfo includes the Addon it produces
+// on every subsequent call. Intended to be called from an init() function in the addon's own package.
+func RegisterAddon(factory func(Config) Addon) {
+	addonRegistry = append(addonRegistry, factory)
+}
+
 const (
 	SyndesisGlobalConfigSecret = "syndesis-global-config"
+
+	// Where the external database TLS secret (ExternalDbCertificateSecret) is mounted in the server
+	// pod and, when ExternalDbURL is set, the standalone syndesis-db-metrics exporter deployment
+	externalDbTLSMountPath = "/etc/syndesis/external-db-tls"
 )
 
 // matches anything followed by space followed by number.number followed (optionally) by another .number and an optional space
@@ -335,22 +751,41 @@ var postgresVersionRegex = regexp.MustCompile(`^.* (\d+\.\d+)(?:\.d+)? ?`)
 /*
 / Returns an array of the addons metadata
 */
-func GetAddonsInfo(configuration Config) []AddonInfo {
-	return []AddonInfo{
+func GetAddonsInfo(configuration Config) []Addon {
+	addons := []Addon{
 		configuration.Syndesis.Addons.Jaeger,
 		configuration.Syndesis.Addons.Ops,
 		configuration.Syndesis.Addons.DV,
 		configuration.Syndesis.Addons.CamelK,
 		configuration.Syndesis.Addons.Knative,
+		configuration.Syndesis.Addons.Kafka,
+		configuration.Syndesis.Addons.Apicurito,
 		configuration.Syndesis.Addons.PublicAPI,
-		configuration.Syndesis.Addons.Todo,
+		configuration.Syndesis.Addons.Keycloak,
+		configuration.Syndesis.Addons.Logging,
+		configuration.Syndesis.Addons.SampleApps,
+	}
+	for _, factory := range addonRegistry {
+		addons = append(addons, factory(configuration))
 	}
+	return addons
+}
+
+// Hash returns a stable hex-encoded digest of the effective Config, so callers can tell whether
+// anything that affects rendered resources changed since a previous reconcile without having to
+// compare the rendered resources themselves.
+func (config *Config) Hash() (string, error) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", errs.Wrap(err, "failed to hash configuration")
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
 }
 
-//
 // Route resources set the RouteHostname if not defined by the CR
 // but ingresses will not so check the CR has provided one
-//
 func (config *Config) CheckRouteHostname() error {
 	if config.ApiServer.Routes {
 		return nil
@@ -380,12 +815,120 @@ func findSecret(ctx context.Context, rtClient client.Client, secretName string,
 	return secret, nil
 }
 
+func findConfigMap(ctx context.Context, rtClient client.Client, configMapName string, namespace string) (*corev1.ConfigMap, error) {
+	if len(configMapName) == 0 {
+		return nil, fmt.Errorf("The operator is expecting the name of a ConfigMap but none has been specified.")
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err := rtClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configMapName}, configMap)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("The ConfigMap %s has not been installed", configMapName)
+		}
+		return nil, err
+	}
+	return configMap, nil
+}
+
 type ByName []corev1.EnvVar
 
 func (k ByName) Len() int           { return len(k) }
 func (k ByName) Swap(i, j int)      { k[i], k[j] = k[j], k[i] }
 func (k ByName) Less(i, j int) bool { return k[i].Name < k[j].Name }
 
+// CACertificatePaths returns the sorted, comma-separated absolute paths of every file a ConfigMap
+// mounted at mountPath will contain, for pointing a JVM's CONTAINER_CA_CERTIFICATES import at it.
+func CACertificatePaths(mountPath string, certData map[string]string) string {
+	fileNames := make([]string, 0, len(certData))
+	for fileName := range certData {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	paths := make([]string, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		paths = append(paths, mountPath+"/"+fileName)
+	}
+	return strings.Join(paths, ",")
+}
+
+// SarFlag builds the JSON value of the oauth proxy's --openshift-sar argument, checking verb (defaulting
+// to "get") against resource (defaulting to "pods") in namespace, optionally scoped to apiGroup. Left at
+// their defaults, this reproduces the proxy's original hard-coded "can this user list pods" check
+func SarFlag(namespace, resource, verb, apiGroup string) (string, error) {
+	if resource == "" {
+		resource = "pods"
+	}
+	if verb == "" {
+		verb = "get"
+	}
+
+	sar := map[string]string{
+		"namespace": namespace,
+		"resource":  resource,
+		"verb":      verb,
+	}
+	if apiGroup != "" {
+		sar["group"] = apiGroup
+	}
+
+	data, err := json.Marshal(sar)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// RestrictedPodSecurityContext is the pod-level securityContext applied to every component pod when
+// SecurityModel is v1beta1.SyndesisSecurityModelRestricted, or nil otherwise
+func RestrictedPodSecurityContext(model v1beta1.SyndesisSecurityModel) *corev1.PodSecurityContext {
+	if model != v1beta1.SyndesisSecurityModelRestricted {
+		return nil
+	}
+
+	runAsNonRoot := true
+	return &corev1.PodSecurityContext{
+		RunAsNonRoot: &runAsNonRoot,
+	}
+}
+
+// RestrictedPodAnnotations returns the pod annotations needed to run under SecurityModel
+// v1beta1.SyndesisSecurityModelRestricted, or nil otherwise. The vendored Kubernetes API predates the
+// typed PodSecurityContext.SeccompProfile field, so the runtime default seccomp profile is requested
+// through the legacy alpha annotation instead
+func RestrictedPodAnnotations(model v1beta1.SyndesisSecurityModel) map[string]string {
+	if model != v1beta1.SyndesisSecurityModelRestricted {
+		return nil
+	}
+
+	return map[string]string{
+		"seccomp.security.alpha.kubernetes.io/pod": "runtime/default",
+	}
+}
+
+// RestrictedContainerSecurityContext is the container-level securityContext applied to every
+// container when SecurityModel is v1beta1.SyndesisSecurityModelRestricted, or nil otherwise: non-root,
+// no privilege escalation, a read-only root filesystem and every capability dropped, compatible with
+// the restricted SCC on OpenShift and the Pod Security Standards "restricted" level everywhere else
+func RestrictedContainerSecurityContext(model v1beta1.SyndesisSecurityModel) *corev1.SecurityContext {
+	if model != v1beta1.SyndesisSecurityModelRestricted {
+		return nil
+	}
+
+	runAsNonRoot := true
+	allowPrivilegeEscalation := false
+	readOnlyRootFilesystem := true
+	return &corev1.SecurityContext{
+		RunAsNonRoot:             &runAsNonRoot,
+		AllowPrivilegeEscalation: &allowPrivilegeEscalation,
+		ReadOnlyRootFilesystem:   &readOnlyRootFilesystem,
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+		},
+	}
+}
+
 func SecretToEnvVars(secretName string, secretData map[string][]byte, indents int) (string, error) {
 	envVars := make([]corev1.EnvVar, 0)
 	for key, _ := range secretData {
@@ -432,11 +975,87 @@ func SecretToEnvVars(secretName string, secretData map[string][]byte, indents in
 	return indentData, nil
 }
 
+// CheckPublicAPICertificateSecret loads CertificateSecretData from the addon's CertificateSecret,
+// when one is configured, so the route template can embed the custom certificate/key/CA in place
+// of the router's default. A missing secret fails install rather than falling silently back to
+// the default certificate.
 //
+// When CertificateSecret is left empty but CertManager is configured, the cert-manager-issued
+// secret requested for the public API route is used instead, keeping the route in sync with
+// whatever certificate cert-manager currently has issued.
+func (config *Config) CheckPublicAPICertificateSecret(ctx context.Context, rtClient client.Client, syndesis *v1beta1.Syndesis) error {
+	certificateSecret := config.Syndesis.Addons.PublicAPI.CertificateSecret
+	if certificateSecret == "" && config.Syndesis.Addons.PublicAPI.Enabled && config.Syndesis.CertManager.IssuerName != "" {
+		certificateSecret = publicAPICertManagerSecretName
+	}
+	if certificateSecret == "" {
+		return nil
+	}
+
+	secret, err := findSecret(ctx, rtClient, certificateSecret, syndesis.Namespace)
+	if err != nil {
+		return errs.Wrap(err, "Failed to find the public API certificate secret")
+	}
+	config.Syndesis.Addons.PublicAPI.CertificateSecretData = secret.Data
+
+	return nil
+}
+
+// CheckRouteCertificateSecret loads RouteCertificateSecretData from the cert-manager-issued secret
+// requested for the Syndesis route, when CertManager is configured, so the route template can embed
+// the currently issued certificate in place of the router's default.
+func (config *Config) CheckRouteCertificateSecret(ctx context.Context, rtClient client.Client, syndesis *v1beta1.Syndesis) error {
+	if config.Syndesis.CertManager.IssuerName == "" {
+		return nil
+	}
+
+	secret, err := findSecret(ctx, rtClient, routeCertManagerSecretName, syndesis.Namespace)
+	if err != nil {
+		return errs.Wrap(err, "Failed to find the cert-manager route certificate secret")
+	}
+	config.Syndesis.RouteCertificateSecretData = secret.Data
+
+	return nil
+}
+
+// CheckAdditionalCAConfigMap loads AdditionalCACertData from the server's AdditionalCA ConfigMap,
+// when one is configured, so the server, meta and generated integration pods can mount its
+// certificates and add them to their JVM truststore. A missing ConfigMap fails install rather than
+// silently running without the additional CA trusted.
+func (config *Config) CheckAdditionalCAConfigMap(ctx context.Context, rtClient client.Client, syndesis *v1beta1.Syndesis) error {
+	if config.Syndesis.Components.Server.AdditionalCA == "" {
+		return nil
+	}
+
+	configMap, err := findConfigMap(ctx, rtClient, config.Syndesis.Components.Server.AdditionalCA, syndesis.Namespace)
+	if err != nil {
+		return errs.Wrap(err, "Failed to find the additional CA ConfigMap")
+	}
+	config.Syndesis.Components.Server.AdditionalCACertData = configMap.Data
+
+	return nil
+}
+
+// CheckLoggingCredentialsSecret loads CredentialsSecretData from the logging addon's
+// CredentialsSecret, when the addon is enabled and a secret is configured, so the log-shipping
+// sidecar can authenticate against Endpoint.
+func (config *Config) CheckLoggingCredentialsSecret(ctx context.Context, rtClient client.Client, syndesis *v1beta1.Syndesis) error {
+	if !config.Syndesis.Addons.Logging.Enabled || config.Syndesis.Addons.Logging.CredentialsSecret == "" {
+		return nil
+	}
+
+	secret, err := findSecret(ctx, rtClient, config.Syndesis.Addons.Logging.CredentialsSecret, syndesis.Namespace)
+	if err != nil {
+		return errs.Wrap(err, "Failed to find the logging addon credentials secret")
+	}
+	config.Syndesis.Addons.Logging.CredentialsSecretData = secret.Data
+
+	return nil
+}
+
 // If provider is embedded then the OAuth credentials set to the internal
 // authentication server so no provider is required. Therefore,
 // no provider, clientId or clientSecret is required.
-//
 func (config *Config) CheckOAuthCredentialSecret(ctx context.Context, rtClient client.Client, syndesis *v1beta1.Syndesis) error {
 	if config.ApiServer.EmbeddedProvider {
 		return nil
@@ -460,11 +1079,11 @@ func (config *Config) CheckOAuthCredentialSecret(ctx context.Context, rtClient c
 /*
 / Returns all processed configurations for Syndesis
 
- - Default values for configuration are loaded from file
- - Secrets and passwords are loaded from syndesis-global-config Secret if they exits
- and generated if they dont
- - For QE, some fields are loaded from environment variables
- - Users might define fields using the syndesis custom resource
+  - Default values for configuration are loaded from file
+  - Secrets and passwords are loaded from syndesis-global-config Secret if they exits
+    and generated if they dont
+  - For QE, some fields are loaded from environment variables
+  - Users might define fields using the syndesis custom resource
 */
 func GetProperties(ctx context.Context, file string, clientTools *clienttools.ClientTools, syndesis *v1beta1.Syndesis) (*Config, error) {
 	configuration := &Config{}
@@ -498,8 +1117,14 @@ func GetProperties(ctx context.Context, file string, clientTools *clienttools.Cl
 		if err := configuration.setPasswordsFromSecret(ctx, rtClient, syndesis); err != nil {
 			return nil, err
 		}
+
+		if err := configuration.setProxyFromCluster(ctx, rtClient); err != nil {
+			return nil, err
+		}
+	}
+	if err := configuration.generatePasswords(syndesis.Spec.FIPSMode); err != nil {
+		return nil, err
 	}
-	configuration.generatePasswords()
 
 	if err := configuration.setConfigFromEnv(); err != nil {
 		return nil, err
@@ -509,6 +1134,37 @@ func GetProperties(ctx context.Context, file string, clientTools *clienttools.Cl
 		return nil, err
 	}
 
+	configuration.applyFIPSImages()
+
+	if err := configuration.resolveDigests(ctx, syndesis); err != nil {
+		return nil, err
+	}
+
+	if configuration.Syndesis.Components.Database.Replicas > 1 {
+		configuration.Syndesis.Components.Database.StandbyReplicas = configuration.Syndesis.Components.Database.Replicas - 1
+
+		if configuration.Syndesis.Components.Database.ReplicationUser == "" {
+			configuration.Syndesis.Components.Database.ReplicationUser = "replicator"
+		}
+		if configuration.Syndesis.Components.Database.ReplicationPassword == "" {
+			v, err := GenerateFIPSPassword(16, configuration.Syndesis.FIPSMode)
+			if err != nil {
+				return nil, err
+			}
+			configuration.Syndesis.Components.Database.ReplicationPassword = v
+		}
+	}
+
+	configuration.Syndesis.Components.Database.DirectURL = configuration.Syndesis.Components.Database.URL
+
+	//
+	// If PgBouncer is enabled in front of the installed database, point syndesis-server at the
+	// pooler's service instead of connecting to syndesis-db directly
+	//
+	if len(syndesis.Spec.Components.Database.ExternalDbURL) == 0 && configuration.Syndesis.Components.Database.Pooler.Enabled {
+		configuration.Syndesis.Components.Database.URL = fmt.Sprintf("postgresql://syndesis-db-pooler:5432/%s?sslmode=disable", configuration.Syndesis.Components.Database.Name)
+	}
+
 	//
 	// If an external database has been defined then reset properties appropriately
 	//
@@ -521,26 +1177,69 @@ func GetProperties(ctx context.Context, file string, clientTools *clienttools.Cl
 	return configuration, nil
 }
 
+// templateConfigCache caches the JSON-converted contents of a template
+// configuration file, keyed by path, so that the hot reconcile path run for
+// every Syndesis CR doesn't hit disk and re-run the YAML-to-JSON conversion
+// on every call. The cache entry is invalidated whenever the file's mtime
+// changes.
+type templateConfigCacheEntry struct {
+	modTime time.Time
+	json    []byte
+}
+
+var (
+	templateConfigCacheMu sync.RWMutex
+	templateConfigCache   = map[string]templateConfigCacheEntry{}
+)
+
 // Load configuration from config file. Config file is expected to be a yaml
 // The returned configuration is parsed to JSON and returned as a Config object
 func (config *Config) loadFromFile(file string) error {
-	data, err := ioutil.ReadFile(file)
+	data, err := readTemplateConfigJSON(file)
 	if err != nil {
 		return err
 	}
 
+	if err := json.Unmarshal(data, config); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// readTemplateConfigJSON returns the JSON-converted contents of file,
+// reusing a cached copy if the file's modification time hasn't changed
+// since it was last read.
+func readTemplateConfigJSON(file string) ([]byte, error) {
+	info, err := os.Stat(file)
+	if err != nil {
+		return nil, err
+	}
+
+	templateConfigCacheMu.RLock()
+	entry, ok := templateConfigCache[file]
+	templateConfigCacheMu.RUnlock()
+	if ok && entry.modTime.Equal(info.ModTime()) {
+		return entry.json, nil
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
 	if strings.HasSuffix(file, ".yaml") || strings.HasSuffix(file, ".yml") {
 		data, err = yaml.ToJSON(data)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	if err := json.Unmarshal(data, config); err != nil {
-		return err
-	}
+	templateConfigCacheMu.Lock()
+	templateConfigCache[file] = templateConfigCacheEntry{modTime: info.ModTime(), json: data}
+	templateConfigCacheMu.Unlock()
 
-	return nil
+	return data, nil
 }
 
 // Set Config.RouteHostname based on the Spec.Host property of the syndesis route
@@ -571,7 +1270,59 @@ func (config *Config) externalDatabase(ctx context.Context, client client.Client
 			externalDbURL.Path = syndesis.Spec.Components.Database.Name
 		}
 
+		if config.Syndesis.Components.Database.ExternalDbSSLMode == "" {
+			config.Syndesis.Components.Database.ExternalDbSSLMode = "disable"
+		}
+
+		query := externalDbURL.Query()
+		if query.Get("sslmode") == "" {
+			query.Set("sslmode", config.Syndesis.Components.Database.ExternalDbSSLMode)
+		}
+
+		if secretName := config.Syndesis.Components.Database.ExternalDbCredentialsSecret; secretName != "" && client != nil {
+			secret := &corev1.Secret{}
+			if err := client.Get(ctx, util.NewObjectKey(secretName, syndesis.Namespace), secret); err != nil {
+				return err
+			}
+
+			if user, ok := secret.Data["POSTGRESQL_USER"]; ok {
+				config.Syndesis.Components.Database.User = string(user)
+			}
+			if password, ok := secret.Data["POSTGRESQL_PASSWORD"]; ok && !config.Syndesis.Components.Database.ExternalDbIAMAuth {
+				config.Syndesis.Components.Database.Password = string(password)
+			}
+		}
+
+		// IAM database authentication replaces the static password with a token syndesis-server
+		// obtains at runtime for the role granted via ExternalDbIAMRoleArn, so no password is
+		// ever generated or persisted to the syndesis-global-config secret for it
+		if config.Syndesis.Components.Database.ExternalDbIAMAuth {
+			config.Syndesis.Components.Database.Password = ""
+		}
+
+		if secretName := config.Syndesis.Components.Database.ExternalDbCertificateSecret; secretName != "" && client != nil {
+			secret := &corev1.Secret{}
+			if err := client.Get(ctx, util.NewObjectKey(secretName, syndesis.Namespace), secret); err != nil {
+				return err
+			}
+
+			_, config.Syndesis.Components.Database.ExternalDbCACertPresent = secret.Data["ca.crt"]
+			_, hasCert := secret.Data["tls.crt"]
+			_, hasKey := secret.Data["tls.key"]
+			config.Syndesis.Components.Database.ExternalDbClientCertPresent = hasCert && hasKey
+
+			if config.Syndesis.Components.Database.ExternalDbCACertPresent {
+				query.Set("sslrootcert", externalDbTLSMountPath+"/ca.crt")
+			}
+			if config.Syndesis.Components.Database.ExternalDbClientCertPresent {
+				query.Set("sslcert", externalDbTLSMountPath+"/tls.crt")
+				query.Set("sslkey", externalDbTLSMountPath+"/tls.key")
+			}
+		}
+
+		externalDbURL.RawQuery = query.Encode()
 		config.Syndesis.Components.Database.URL = externalDbURL.String()
+		config.Syndesis.Components.Database.DirectURL = externalDbURL.String()
 	}
 
 	return nil
@@ -590,25 +1341,36 @@ func (config *Config) setPasswordsFromSecret(ctx context.Context, client client.
 		return nil
 	}
 
-	secret, err := getSyndesisConfigurationSecret(ctx, client, syndesis.Namespace)
+	store := NewSecretStore(client, syndesis.Namespace)
+	secretData, err := store.GetSecrets(ctx)
 	if err != nil {
-		if k8serrors.IsNotFound(err) {
-			return nil
-		}
-
 		return err
 	}
 
+	if len(secretData) == 0 {
+		if !store.GeneratesMissingSecrets() {
+			// Unlike the Kubernetes-backed store, Vault and mounted-file stores have no write-back
+			// path for a freshly generated password or encryption key: generating one here would
+			// mint a value that's used once and then lost on the next reconcile, diverging from
+			// whatever was actually applied to the live database or already used to encrypt data.
+			return fmt.Errorf("no secrets found for this Syndesis installation in the configured secret store; " +
+				"passwords and encryption keys must be pre-provisioned there, since Syndesis cannot write generated ones back to it")
+		}
+		// Nothing stored yet, passwords are generated as a result of the
+		// call to generatePasswords() following execution of this function
+		return nil
+	}
+
 	/*
 	 * If none exist in the secret then config property is set to ""
 	 * If this is the case then passwords are generated as a result of
 	 * the call to generatePasswords() following execution of this function
 	 */
-	if _, ok := secret.Data["POSTGRESQL_PASSWORD"]; !ok {
+	if _, ok := secretData["POSTGRESQL_PASSWORD"]; !ok {
 		// This is an indicator that the secret has the old format. We need to extract the
 		// secrets from the `params` section instead
 		// TODO: Delete for 1.10
-		envFromSecret, err := getSyndesisEnvVarsFromOpenShiftNamespace(secret)
+		envFromSecret, err := getSyndesisEnvVarsFromOpenShiftNamespace(secretData)
 		if err != nil {
 			return err
 		}
@@ -622,13 +1384,48 @@ func (config *Config) setPasswordsFromSecret(ctx context.Context, client client.
 		config.Syndesis.Components.Server.ClientStateEncryptionKey = envFromSecret["CLIENT_STATE_ENCRYPTION_KEY"]
 	} else {
 		// This is the behaviour we want
-		config.OpenShiftOauthClientSecret = string(secret.Data["OPENSHIFT_OAUTH_CLIENT_SECRET"])
-		config.Syndesis.Components.Database.Password = string(secret.Data["POSTGRESQL_PASSWORD"])
-		config.Syndesis.Components.Database.SampledbPassword = string(secret.Data["POSTGRESQL_SAMPLEDB_PASSWORD"])
-		config.Syndesis.Components.Oauth.CookieSecret = string(secret.Data["OAUTH_COOKIE_SECRET"])
-		config.Syndesis.Components.Server.SyndesisEncryptKey = string(secret.Data["SYNDESIS_ENCRYPT_KEY"])
-		config.Syndesis.Components.Server.ClientStateAuthenticationKey = string(secret.Data["CLIENT_STATE_AUTHENTICATION_KEY"])
-		config.Syndesis.Components.Server.ClientStateEncryptionKey = string(secret.Data["CLIENT_STATE_ENCRYPTION_KEY"])
+		config.OpenShiftOauthClientSecret = string(secretData["OPENSHIFT_OAUTH_CLIENT_SECRET"])
+		config.Syndesis.Components.Database.Password = string(secretData["POSTGRESQL_PASSWORD"])
+		config.Syndesis.Components.Database.SampledbPassword = string(secretData["POSTGRESQL_SAMPLEDB_PASSWORD"])
+		config.Syndesis.Components.Database.ReplicationPassword = string(secretData["POSTGRESQL_REPLICATION_PASSWORD"])
+		config.Syndesis.Components.Oauth.CookieSecret = string(secretData["OAUTH_COOKIE_SECRET"])
+		config.Syndesis.Components.Server.SyndesisEncryptKey = string(secretData["SYNDESIS_ENCRYPT_KEY"])
+		config.Syndesis.Components.Server.ClientStateAuthenticationKey = string(secretData["CLIENT_STATE_AUTHENTICATION_KEY"])
+		config.Syndesis.Components.Server.ClientStateEncryptionKey = string(secretData["CLIENT_STATE_ENCRYPTION_KEY"])
+	}
+
+	return nil
+}
+
+// setProxyFromCluster populates HTTPProxy, HTTPSProxy and NoProxy from the
+// status of the cluster-wide Proxy resource (config.openshift.io/v1), when
+// the cluster exposes one. Vanilla Kubernetes clusters don't have this API,
+// so a missing resource or API group is not treated as an error.
+func (config *Config) setProxyFromCluster(ctx context.Context, rtClient client.Client) error {
+	proxy := &unstructured.Unstructured{}
+	proxy.SetAPIVersion("config.openshift.io/v1")
+	proxy.SetKind("Proxy")
+
+	if err := rtClient.Get(ctx, types.NamespacedName{Name: "cluster"}, proxy); err != nil {
+		// The config.openshift.io/v1 API group doesn't exist on vanilla
+		// Kubernetes clusters, and OpenShift clusters may not define a
+		// Proxy resource. Either way, proxy propagation is best-effort.
+		return nil
+	}
+
+	status, found, err := unstructured.NestedMap(proxy.Object, "status")
+	if err != nil || !found {
+		return nil
+	}
+
+	if v, ok := status["httpProxy"].(string); ok {
+		config.HTTPProxy = v
+	}
+	if v, ok := status["httpsProxy"].(string); ok {
+		config.HTTPSProxy = v
+	}
+	if v, ok := status["noProxy"].(string); ok {
+		config.NoProxy = v
 	}
 
 	return nil
@@ -639,9 +1436,11 @@ func (config *Config) setConfigFromEnv() error {
 	imgEnv := Config{
 		Syndesis: SyndesisConfig{
 			Addons: AddonsSpec{
-				DV:     DvConfiguration{Image: os.Getenv("RELATED_IMAGE_DV")},
-				CamelK: CamelKConfiguration{Image: os.Getenv("RELATED_IMAGE_CAMELK")},
-				Todo:   TodoConfiguration{Image: os.Getenv("RELATED_IMAGE_TODO")},
+				DV:        DvConfiguration{Image: os.Getenv("RELATED_IMAGE_DV")},
+				CamelK:    CamelKConfiguration{Image: os.Getenv("RELATED_IMAGE_CAMELK")},
+				Apicurito: ApicuritoConfiguration{Image: os.Getenv("RELATED_IMAGE_APICURITO")},
+				Keycloak:  KeycloakConfiguration{Image: os.Getenv("RELATED_IMAGE_KEYCLOAK")},
+				Logging:   LoggingConfiguration{Image: os.Getenv("RELATED_IMAGE_LOGGING")},
 			},
 			Components: ComponentsSpec{
 				Oauth:      OauthConfiguration{Image: os.Getenv("RELATED_IMAGE_OAUTH")},
@@ -721,38 +1520,185 @@ func (config *Config) setSyndesisFromCustomResource(syndesis *v1beta1.Syndesis)
 }
 
 // Generate random expressions for passwords and secrets
-func (config *Config) generatePasswords() {
+func (config *Config) generatePasswords(fipsMode bool) error {
+	generate := func(current *string, size int) error {
+		if *current != "" {
+			return nil
+		}
+		v, err := GenerateFIPSPassword(size, fipsMode)
+		if err != nil {
+			return err
+		}
+		*current = v
+		return nil
+	}
 
-	if config.OpenShiftOauthClientSecret == "" {
-		config.OpenShiftOauthClientSecret = generatePassword(64)
+	if err := generate(&config.OpenShiftOauthClientSecret, 64); err != nil {
+		return err
 	}
 
-	if config.Syndesis.Components.Database.Password == "" {
-		config.Syndesis.Components.Database.Password = generatePassword(16)
+	if err := generate(&config.Syndesis.Components.Database.Password, 16); err != nil {
+		return err
 	}
 
-	if config.Syndesis.Components.Database.SampledbPassword == "" {
-		config.Syndesis.Components.Database.SampledbPassword = generatePassword(16)
+	if config.Syndesis.Components.Database.Sampledb.Enabled {
+		if err := generate(&config.Syndesis.Components.Database.SampledbPassword, 16); err != nil {
+			return err
+		}
 	}
 
-	if config.Syndesis.Components.Oauth.CookieSecret == "" {
-		config.Syndesis.Components.Oauth.CookieSecret = generatePassword(32)
+	if err := generate(&config.Syndesis.Components.Oauth.CookieSecret, 32); err != nil {
+		return err
 	}
 
-	if config.Syndesis.Components.Server.SyndesisEncryptKey == "" {
-		config.Syndesis.Components.Server.SyndesisEncryptKey = generatePassword(64)
+	if err := generate(&config.Syndesis.Components.Server.SyndesisEncryptKey, 64); err != nil {
+		return err
 	}
 
-	if config.Syndesis.Components.Server.ClientStateAuthenticationKey == "" {
-		config.Syndesis.Components.Server.ClientStateAuthenticationKey = generatePassword(32)
+	generateClientStateKey := func(current *string) error {
+		if *current != "" {
+			return nil
+		}
+		v, err := GenerateClientStateKey(config.Syndesis.Components.Server.ClientStateKeyLength, fipsMode)
+		if err != nil {
+			return err
+		}
+		*current = v
+		return nil
 	}
 
-	if config.Syndesis.Components.Server.ClientStateEncryptionKey == "" {
-		config.Syndesis.Components.Server.ClientStateEncryptionKey = generatePassword(32)
+	if err := generateClientStateKey(&config.Syndesis.Components.Server.ClientStateAuthenticationKey); err != nil {
+		return err
 	}
+
+	if err := generateClientStateKey(&config.Syndesis.Components.Server.ClientStateEncryptionKey); err != nil {
+		return err
+	}
+
+	return nil
 }
 
-func generatePassword(size int) string {
+// fipsImageSuffix is the tag suffix Syndesis' own FIPS-compliant image builds use.
+const fipsImageSuffix = "-fips"
+
+// applyFIPSImages appends fipsImageSuffix to every first-party component image that doesn't already
+// carry it, when FIPSMode is enabled. It's a no-op otherwise. Third-party addon images aren't touched,
+// since this project doesn't publish FIPS builds of them; Validate() rejects a first-party image left
+// on the floating "latest" tag instead of guessing at a FIPS-tagged equivalent for it
+func (config *Config) applyFIPSImages() {
+	if !config.Syndesis.FIPSMode {
+		return
+	}
+
+	components := &config.Syndesis.Components
+	images := []*string{
+		&components.UI.Image,
+		&components.S2I.Image,
+		&components.Oauth.Image,
+		&components.Server.Image,
+		&components.Meta.Image,
+		&components.Database.Image,
+	}
+	for _, image := range images {
+		if *image != "" && !strings.HasSuffix(*image, fipsImageSuffix) {
+			*image = *image + fipsImageSuffix
+		}
+	}
+}
+
+// resolveDigests re-points every first-party component image at its current digest, when
+// Syndesis.SHA is enabled, so a later pod restart always pulls the exact image validated at install
+// time rather than whatever the tag has since moved on to. It's a no-op otherwise. A tag already
+// present in syndesis.Status.ResolvedImageDigests is reused as-is rather than re-resolved: Execute
+// runs on every reconcile of an already-Installed CR, not just at install time, so re-resolving (and
+// re-verifying) an unchanged tag on every pass would mean a transient registry or cosign endpoint
+// blip degrades a healthy, unchanged install for reasons unrelated to the deployed state. Every
+// resolved tag is recorded in config.ResolvedImageDigests, ready to be persisted to status. When
+// Syndesis.CosignPublicKey is also set, a newly resolved digest's cosign signature is verified before
+// its image is repointed; the install fails fast on the first image that is unsigned or doesn't
+// verify, rather than deploying a mix of verified and unverified images.
+func (config *Config) resolveDigests(ctx context.Context, syndesis *v1beta1.Syndesis) error {
+	if !config.Syndesis.SHA {
+		return nil
+	}
+
+	components := &config.Syndesis.Components
+	images := []*string{
+		&components.UI.Image,
+		&components.S2I.Image,
+		&components.Oauth.Image,
+		&components.Server.Image,
+		&components.Meta.Image,
+		&components.Database.Image,
+	}
+
+	config.ResolvedImageDigests = map[string]string{}
+	for _, image := range images {
+		if *image == "" || strings.Contains(*image, "@") {
+			continue
+		}
+
+		imageDigest, alreadyResolved := syndesis.Status.ResolvedImageDigests[*image]
+		if !alreadyResolved {
+			var err error
+			imageDigest, err = digest.Resolve(ctx, *image)
+			if err != nil {
+				return fmt.Errorf("resolving image digest: %w", err)
+			}
+
+			if config.Syndesis.CosignPublicKey != "" {
+				if err := digest.VerifySignature(ctx, *image, imageDigest, config.Syndesis.CosignPublicKey); err != nil {
+					return fmt.Errorf("verifying image signature: %w", err)
+				}
+			}
+		}
+
+		config.ResolvedImageDigests[*image] = imageDigest
+
+		repository := *image
+		if idx := strings.LastIndex(repository, ":"); idx != -1 {
+			repository = repository[:idx]
+		}
+		*image = repository + "@" + imageDigest
+	}
+	return nil
+}
+
+// GeneratePassword returns a randomly generated alphanumeric password of the given length, using
+// math/rand. Prefer GenerateFIPSPassword wherever the effective configuration's FIPSMode is relevant.
+func GeneratePassword(size int) string {
+	alphabet := alphanumericAlphabet()
+	result := make([]rune, size)
+	for i := 0; i < size; i++ {
+		result[i] = alphabet[random.Intn(len(alphabet))]
+	}
+	return string(result)
+}
+
+// GenerateFIPSPassword returns a randomly generated alphanumeric password of the given length. When
+// fipsMode is true it draws from crypto/rand, the approved random source FIPS 140 requires, and fails
+// fast rather than falling back to GeneratePassword's plain math/rand if that source errors. When
+// fipsMode is false it's equivalent to GeneratePassword.
+func GenerateFIPSPassword(size int, fipsMode bool) (string, error) {
+	if !fipsMode {
+		return GeneratePassword(size), nil
+	}
+
+	alphabet := alphanumericAlphabet()
+	bound := big.NewInt(int64(len(alphabet)))
+	result := make([]rune, size)
+	for i := 0; i < size; i++ {
+		n, err := crand.Int(crand.Reader, bound)
+		if err != nil {
+			return "", fmt.Errorf("fipsMode requires a FIPS-approved random source: %w", err)
+		}
+		result[i] = alphabet[n.Int64()]
+	}
+	return string(result), nil
+}
+
+// alphanumericAlphabet is the character set GeneratePassword and GenerateFIPSPassword draw from.
+func alphanumericAlphabet() []rune {
 	alphabet := make([]rune, (26*2)+10)
 	i := 0
 	for c := 'a'; c <= 'z'; c++ {
@@ -767,13 +1713,24 @@ func generatePassword(size int) string {
 		alphabet[i] = c
 		i++
 	}
+	return alphabet
+}
+
+// GenerateClientStateKey returns a freshly generated client-state authentication/encryption key.
+// When length is zero, it falls back to GenerateFIPSPassword's legacy fixed 32-character alphanumeric
+// key, for backward compatibility with existing installations. Otherwise it returns length random
+// bytes, drawn from crypto/rand regardless of fipsMode since that source is always FIPS-approved,
+// base64-encoded as ClientStateKeyLength documents.
+func GenerateClientStateKey(length int, fipsMode bool) (string, error) {
+	if length == 0 {
+		return GenerateFIPSPassword(32, fipsMode)
+	}
 
-	result := make([]rune, size)
-	for i := 0; i < size; i++ {
-		result[i] = alphabet[random.Intn(len(alphabet))]
+	key := make([]byte, length)
+	if _, err := crand.Read(key); err != nil {
+		return "", fmt.Errorf("generating client-state key: %w", err)
 	}
-	s := string(result)
-	return s
+	return base64.StdEncoding.EncodeToString(key), nil
 }
 
 // Needed for the first run after upgrade, due to compatibilities with old
@@ -798,8 +1755,8 @@ func parseConfigurationBlob(blob []byte) map[string]string {
 }
 
 // TODO: Delete for 1.10
-func getSyndesisEnvVarsFromOpenShiftNamespace(secret *corev1.Secret) (map[string]string, error) {
-	if envBlob, present := secret.Data["params"]; present {
+func getSyndesisEnvVarsFromOpenShiftNamespace(secretData map[string][]byte) (map[string]string, error) {
+	if envBlob, present := secretData["params"]; present {
 		return parseConfigurationBlob(envBlob), nil
 	}
 
@@ -29,6 +29,7 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/capabilities"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func Test_GetAddons(t *testing.T) {
@@ -52,15 +53,27 @@ func Test_GetAddons(t *testing.T) {
 		case "camelk":
 			assert.Equal(t, config.Syndesis.Addons.CamelK.Name(), addon.Name())
 			assert.Equal(t, config.Syndesis.Addons.CamelK.Enabled, addon.IsEnabled())
+		case "kafka":
+			assert.Equal(t, config.Syndesis.Addons.Kafka.Name(), addon.Name())
+			assert.Equal(t, config.Syndesis.Addons.Kafka.Enabled, addon.IsEnabled())
+		case "apicurito":
+			assert.Equal(t, config.Syndesis.Addons.Apicurito.Name(), addon.Name())
+			assert.Equal(t, config.Syndesis.Addons.Apicurito.Enabled, addon.IsEnabled())
 		case "knative":
 			assert.Equal(t, config.Syndesis.Addons.Knative.Name(), addon.Name())
 			assert.Equal(t, config.Syndesis.Addons.Knative.Enabled, addon.IsEnabled())
-		case "todo":
-			assert.Equal(t, config.Syndesis.Addons.Todo.Name(), addon.Name())
-			assert.Equal(t, config.Syndesis.Addons.Todo.Enabled, addon.IsEnabled())
+		case "sampleapps":
+			assert.Equal(t, config.Syndesis.Addons.SampleApps.Name(), addon.Name())
+			assert.Equal(t, config.Syndesis.Addons.SampleApps.IsEnabled(), addon.IsEnabled())
 		case "publicApi":
 			assert.Equal(t, config.Syndesis.Addons.PublicAPI.Name(), addon.Name())
 			assert.Equal(t, config.Syndesis.Addons.PublicAPI.Enabled, addon.IsEnabled())
+		case "keycloak":
+			assert.Equal(t, config.Syndesis.Addons.Keycloak.Name(), addon.Name())
+			assert.Equal(t, config.Syndesis.Addons.Keycloak.Enabled, addon.IsEnabled())
+		case "logging":
+			assert.Equal(t, config.Syndesis.Addons.Logging.Name(), addon.Name())
+			assert.Equal(t, config.Syndesis.Addons.Logging.Enabled, addon.IsEnabled())
 		default:
 			t.Errorf("addon name %s not recognised", addon.Name())
 		}
@@ -128,7 +141,6 @@ func Test_setConfigFromEnv(t *testing.T) {
 							Image:              "DV_IMAGE",
 						},
 						CamelK: CamelKConfiguration{Image: "CAMELK_IMAGE"},
-						Todo:   TodoConfiguration{Image: "TODO_IMAGE"},
 					},
 					Components: ComponentsSpec{
 						Oauth:      OauthConfiguration{Image: "OAUTH_IMAGE"},
@@ -201,7 +213,7 @@ func Test_setConfigFromEnv(t *testing.T) {
 				"RELATED_IMAGE_PSQL_EXPORTER": "PSQL_EXPORTER_IMAGE", "DEV_SUPPORT": "true", "TEST_SUPPORT": "false",
 				"INTEGRATION_LIMIT": "30", "DEPLOY_INTEGRATIONS": "true", "RELATED_IMAGE_CAMELK": "CAMELK_IMAGE",
 				"DATABASE_VOLUME_NAME": "nfs0002", "DATABASE_STORAGE_CLASS": "nfs-storage-class1",
-				"DATABASE_VOLUME_ACCESS_MODE": "ReadWriteOnce", "RELATED_IMAGE_TODO": "TODO_IMAGE", "RELATED_IMAGE_AMQ": "AMQ_IMAGE",
+				"DATABASE_VOLUME_ACCESS_MODE": "ReadWriteOnce", "RELATED_IMAGE_AMQ": "AMQ_IMAGE",
 			},
 			wantErr: false,
 		},
@@ -264,11 +276,13 @@ func Test_setSyndesisFromCustomResource(t *testing.T) {
 							ImageAllInOne: "jaegertracing/all-in-one:1.13",
 							ImageOperator: "jaegertracing/jaeger-operator:1.13",
 						},
-						Todo: v1beta1.AddonSpec{Enabled: true},
+						SampleApps: []v1beta1.SampleAppConfiguration{
+							{Name: "todo", Image: "docker.io/centos/php-71-centos7"},
+						},
 						DV: v1beta1.DvConfiguration{
 							Enabled: true,
 						},
-						CamelK: v1beta1.AddonSpec{Enabled: true},
+						CamelK: v1beta1.CamelKConfiguration{Enabled: true},
 						PublicAPI: v1beta1.PublicAPIConfiguration{
 							Enabled:       true,
 							RouteHostname: "mypublichost.com",
@@ -294,16 +308,15 @@ func Test_setSyndesisFromCustomResource(t *testing.T) {
 						Ops: OpsConfiguration{
 							AddonConfiguration: AddonConfiguration{Enabled: false},
 						},
-						Todo: TodoConfiguration{
-							AddonConfiguration: AddonConfiguration{Enabled: true},
-							Image:              "docker.io/centos/php-71-centos7",
+						SampleApps: SampleAppsConfiguration{
+							{Name: "todo", Image: "docker.io/centos/php-71-centos7"},
 						},
 						Knative: KnativeConfiguration{
 							AddonConfiguration: AddonConfiguration{Enabled: false},
 						},
 						DV: DvConfiguration{
 							AddonConfiguration: AddonConfiguration{Enabled: true},
-							Resources:          Resources{Memory: "1024Mi"},
+							Resources:          DvResources{Memory: "1024Mi"},
 							Image:              "docker.io/teiid/syndesis-dv:latest",
 						},
 						CamelK: CamelKConfiguration{
@@ -345,8 +358,14 @@ func Test_generatePasswords(t *testing.T) {
 		length [7]int
 	}{
 		{
-			name:   "Passwords and secrets should be generated when they values are empty",
-			got:    &Config{},
+			name: "Passwords and secrets should be generated when they values are empty",
+			got: &Config{
+				Syndesis: SyndesisConfig{
+					Components: ComponentsSpec{
+						Database: DatabaseConfiguration{Sampledb: SampledbConfiguration{Enabled: true}},
+					},
+				},
+			},
 			length: [7]int{64, 16, 16, 32, 64, 32, 32},
 		},
 		{
@@ -372,7 +391,8 @@ func Test_generatePasswords(t *testing.T) {
 		},
 	}
 	for _, tt := range tests {
-		tt.got.generatePasswords()
+		err := tt.got.generatePasswords(false)
+		assert.NoError(t, err)
 		t.Run(tt.name, func(t *testing.T) {
 			assert.Len(t, tt.got.OpenShiftOauthClientSecret, tt.length[0])
 			assert.Len(t, tt.got.Syndesis.Components.Database.Password, tt.length[1])
@@ -418,17 +438,13 @@ func getConfigLiteral() *Config {
 				Ops: OpsConfiguration{
 					AddonConfiguration: AddonConfiguration{Enabled: false},
 				},
-				Todo: TodoConfiguration{
-					AddonConfiguration: AddonConfiguration{Enabled: false},
-					Image:              "docker.io/centos/php-71-centos7",
-				},
 				Knative: KnativeConfiguration{
 					AddonConfiguration: AddonConfiguration{Enabled: false},
 				},
 				DV: DvConfiguration{
 					AddonConfiguration: AddonConfiguration{Enabled: false},
 					Image:              "docker.io/teiid/syndesis-dv:latest",
-					Resources:          Resources{Memory: "1024Mi"},
+					Resources:          DvResources{Memory: "1024Mi"},
 				},
 				CamelK: CamelKConfiguration{
 					AddonConfiguration: AddonConfiguration{Enabled: false},
@@ -475,10 +491,11 @@ func getConfigLiteral() *Config {
 					},
 				},
 				Database: DatabaseConfiguration{
-					Image: "postgresql:9.6",
-					User:  "syndesis",
-					Name:  "syndesis",
-					URL:   "postgresql://syndesis-db:5432/syndesis?sslmode=disable",
+					Image:    "postgresql:9.6",
+					User:     "syndesis",
+					Name:     "syndesis",
+					URL:      "postgresql://syndesis-db:5432/syndesis?sslmode=disable",
+					Sampledb: SampledbConfiguration{Enabled: true},
 					Exporter: ExporterConfiguration{
 						Image: "docker.io/wrouesnel/postgres_exporter:v0.4.7",
 					},
@@ -625,6 +642,15 @@ func Test_setIntFromEnv(t *testing.T) {
 	}
 }
 
+func Benchmark_loadFromFile(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		got := &Config{}
+		if err := got.loadFromFile("../../../build/conf/config-test.yaml"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func Test_secretToEnvVars(t *testing.T) {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -663,3 +689,28 @@ func Test_secretToEnvVars(t *testing.T) {
 
 	assert.Equal(t, expected, string(data))
 }
+
+func Test_setPasswordsFromSecret_refusesToGenerateForAnUnseededExternalStore(t *testing.T) {
+	defer os.Unsetenv("SECRETS_MOUNT_PATH")
+	os.Setenv("SECRETS_MOUNT_PATH", t.TempDir())
+
+	config := &Config{}
+	cl := fake.NewFakeClient()
+	syndesis, err := v1beta1.NewSyndesis("syndesis")
+	require.NoError(t, err)
+
+	err = config.setPasswordsFromSecret(context.TODO(), cl, syndesis)
+
+	assert.Error(t, err, "SECRETS_MOUNT_PATH is set but empty: Syndesis must not mint secrets it cannot write back there")
+}
+
+func Test_setPasswordsFromSecret_generatesForAnEmptyKubernetesStore(t *testing.T) {
+	config := &Config{}
+	cl := fake.NewFakeClient()
+	syndesis, err := v1beta1.NewSyndesis("syndesis")
+	require.NoError(t, err)
+
+	err = config.setPasswordsFromSecret(context.TODO(), cl, syndesis)
+
+	assert.NoError(t, err, "no syndesis-global-config Secret yet is the normal state for a first install")
+}
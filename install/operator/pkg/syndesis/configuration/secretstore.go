@@ -0,0 +1,225 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SecretStore abstracts where Syndesis reads its generated passwords and
+// encryption keys from. The default implementation reads from the
+// syndesis-global-config Kubernetes Secret, but deployments that keep their
+// secret material out of etcd can point Syndesis at a HashiCorp Vault
+// instance instead.
+type SecretStore interface {
+	// GetSecrets returns the stored secret values keyed by their name, eg.
+	// POSTGRESQL_PASSWORD. A key that is absent from the result indicates
+	// that no value has been stored yet; GeneratesMissingSecrets reports
+	// whether a new one may be generated for it.
+	GetSecrets(ctx context.Context) (map[string][]byte, error)
+
+	// GeneratesMissingSecrets reports whether the caller may safely mint a fresh value for a
+	// secret GetSecrets didn't return. It's true for the Kubernetes-backed store, whose caller
+	// writes whatever it generates straight back into the same Secret it just read. It's false
+	// for the Vault- and mounted-file-backed stores, which have no write-back path: an empty
+	// result from one of those normally means the backend hasn't been seeded yet, not that
+	// Syndesis should mint (and then have no way to persist) a new password or encryption key.
+	GeneratesMissingSecrets() bool
+}
+
+// kubernetesSecretStore reads secrets from the syndesis-global-config
+// Kubernetes Secret. This is the historical, default behaviour.
+type kubernetesSecretStore struct {
+	client    client.Client
+	namespace string
+}
+
+// NewKubernetesSecretStore returns a SecretStore backed by the
+// syndesis-global-config Secret in the given namespace.
+func NewKubernetesSecretStore(rtClient client.Client, namespace string) SecretStore {
+	return &kubernetesSecretStore{client: rtClient, namespace: namespace}
+}
+
+func (s *kubernetesSecretStore) GetSecrets(ctx context.Context) (map[string][]byte, error) {
+	secret, err := getSyndesisConfigurationSecret(ctx, s.client, s.namespace)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return map[string][]byte{}, nil
+		}
+		return nil, err
+	}
+
+	return secret.Data, nil
+}
+
+func (s *kubernetesSecretStore) GeneratesMissingSecrets() bool {
+	return true
+}
+
+// vaultSecretStore reads secrets from a single path of a HashiCorp Vault
+// KV version 2 secrets engine.
+type vaultSecretStore struct {
+	httpClient *http.Client
+	address    string
+	token      string
+	mountPath  string
+	secretPath string
+}
+
+// NewVaultSecretStore returns a SecretStore backed by a HashiCorp Vault
+// KV version 2 secrets engine mounted at mountPath, reading the secret at
+// secretPath.
+func NewVaultSecretStore(address, token, mountPath, secretPath string) SecretStore {
+	return &vaultSecretStore{
+		httpClient: &http.Client{},
+		address:    address,
+		token:      token,
+		mountPath:  mountPath,
+		secretPath: secretPath,
+	}
+}
+
+// vaultKvV2Response is the subset of the Vault KV v2 read response body
+// that we care about. See https://www.vaultproject.io/api-docs/secret/kv/kv-v2
+type vaultKvV2Response struct {
+	Data struct {
+		Data map[string]string `json:"data"`
+	} `json:"data"`
+}
+
+func (s *vaultSecretStore) GetSecrets(ctx context.Context) (map[string][]byte, error) {
+	url := fmt.Sprintf("%s/v1/%s/data/%s", strings.TrimRight(s.address, "/"), s.mountPath, s.secretPath)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", s.token)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach Vault at %s: %w", s.address, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		// No secret stored yet, the caller will generate one.
+		return map[string][]byte{}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Vault returned status %d reading secret/%s", resp.StatusCode, s.secretPath)
+	}
+
+	parsed := vaultKvV2Response{}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("failed to decode Vault response: %w", err)
+	}
+
+	secrets := make(map[string][]byte, len(parsed.Data.Data))
+	for key, value := range parsed.Data.Data {
+		secrets[key] = []byte(value)
+	}
+
+	return secrets, nil
+}
+
+func (s *vaultSecretStore) GeneratesMissingSecrets() bool {
+	return false
+}
+
+// mountedSecretKeys lists the credential names that setPasswordsFromSecret
+// looks up. A mountedSecretStore reads each of these as a separate file
+// underneath its base directory, matching the layout the secrets-store CSI
+// driver projects into a pod.
+var mountedSecretKeys = []string{
+	"OPENSHIFT_OAUTH_CLIENT_SECRET",
+	"POSTGRESQL_PASSWORD",
+	"POSTGRESQL_SAMPLEDB_PASSWORD",
+	"POSTGRESQL_REPLICATION_PASSWORD",
+	"OAUTH_COOKIE_SECRET",
+	"SYNDESIS_ENCRYPT_KEY",
+	"CLIENT_STATE_AUTHENTICATION_KEY",
+	"CLIENT_STATE_ENCRYPTION_KEY",
+}
+
+// mountedSecretStore reads secrets from a directory of mounted files, one
+// file per credential, as projected by the secrets-store CSI driver.
+type mountedSecretStore struct {
+	directory string
+}
+
+// NewMountedSecretStore returns a SecretStore that reads each credential
+// from its own file underneath directory, eg. <directory>/POSTGRESQL_PASSWORD.
+func NewMountedSecretStore(directory string) SecretStore {
+	return &mountedSecretStore{directory: directory}
+}
+
+func (s *mountedSecretStore) GetSecrets(ctx context.Context) (map[string][]byte, error) {
+	secrets := make(map[string][]byte)
+	for _, key := range mountedSecretKeys {
+		data, err := ioutil.ReadFile(filepath.Join(s.directory, key))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read mounted secret %s: %w", key, err)
+		}
+		secrets[key] = data
+	}
+
+	return secrets, nil
+}
+
+func (s *mountedSecretStore) GeneratesMissingSecrets() bool {
+	return false
+}
+
+// NewSecretStore picks the SecretStore implementation to use based on
+// environment configuration. If VAULT_ADDR is set the operator reads
+// secrets from Vault, if SECRETS_MOUNT_PATH is set it reads secrets from a
+// directory of mounted files, otherwise it falls back to the
+// syndesis-global-config Secret.
+func NewSecretStore(rtClient client.Client, namespace string) SecretStore {
+	if address := os.Getenv("VAULT_ADDR"); address != "" {
+		mountPath := os.Getenv("VAULT_SECRET_MOUNT")
+		if mountPath == "" {
+			mountPath = "secret"
+		}
+		secretPath := os.Getenv("VAULT_SECRET_PATH")
+		if secretPath == "" {
+			secretPath = "syndesis"
+		}
+
+		return NewVaultSecretStore(address, os.Getenv("VAULT_TOKEN"), mountPath, secretPath)
+	}
+
+	if directory := os.Getenv("SECRETS_MOUNT_PATH"); directory != "" {
+		return NewMountedSecretStore(directory)
+	}
+
+	return NewKubernetesSecretStore(rtClient, namespace)
+}
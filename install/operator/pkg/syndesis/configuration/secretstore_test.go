@@ -0,0 +1,32 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SecretStore_GeneratesMissingSecrets(t *testing.T) {
+	assert.True(t, NewKubernetesSecretStore(nil, "syndesis").GeneratesMissingSecrets(),
+		"the Kubernetes-backed store writes what it generates back into the Secret it read from")
+	assert.False(t, NewVaultSecretStore("http://vault", "token", "secret", "syndesis").GeneratesMissingSecrets(),
+		"Vault has no write-back path for a freshly generated secret")
+	assert.False(t, NewMountedSecretStore(t.TempDir()).GeneratesMissingSecrets(),
+		"a mounted-file secret source has no write-back path for a freshly generated secret")
+}
@@ -0,0 +1,311 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package digest resolves a component image's floating tag to the digest the registry currently
+// serves it under, and optionally verifies a cosign signature over that digest, for Spec.SHA/
+// Spec.CosignPublicKey. It talks to the registry's Docker Registry HTTP API V2 directly rather than
+// pulling in a full registry client library, since resolving a manifest digest and fetching a cosign
+// signature manifest are both a couple of plain HTTP requests.
+package digest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("digest")
+
+// requestTimeout bounds every individual registry HTTP request, so a slow or unreachable registry
+// never holds up a reconcile indefinitely.
+const requestTimeout = 30 * time.Second
+
+// manifestAccept lists the manifest media types Resolve is willing to accept, in order of preference.
+var manifestAccept = strings.Join([]string{
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.oci.image.index.v1+json",
+}, ", ")
+
+// ref is a parsed "registry/repository:tag" image reference.
+type ref struct {
+	registry   string
+	repository string
+	tag        string
+}
+
+// parseRef splits image into its registry, repository and tag, defaulting an unqualified image to
+// Docker Hub and an untagged one to "latest", the same defaulting the registry/runtime applies.
+func parseRef(image string) (ref, error) {
+	if image == "" {
+		return ref{}, fmt.Errorf("empty image reference")
+	}
+
+	name, tag := image, "latest"
+	if idx := strings.LastIndex(image, ":"); idx != -1 && !strings.Contains(image[idx:], "/") {
+		name, tag = image[:idx], image[idx+1:]
+	}
+
+	registry := "registry-1.docker.io"
+	repository := name
+	if idx := strings.Index(name, "/"); idx != -1 && (strings.Contains(name[:idx], ".") || strings.Contains(name[:idx], ":")) {
+		registry = name[:idx]
+		repository = name[idx+1:]
+	} else if !strings.Contains(name, "/") {
+		repository = "library/" + name
+	}
+
+	return ref{registry: registry, repository: repository, tag: tag}, nil
+}
+
+// Resolve looks up the digest the registry currently serves image's tag under, returning it in
+// "sha256:..." form. It does not attempt to authenticate against a private registry beyond an
+// anonymous bearer token challenge, the same access an unauthenticated `docker pull` would have.
+func Resolve(ctx context.Context, image string) (string, error) {
+	r, err := parseRef(image)
+	if err != nil {
+		return "", err
+	}
+
+	digest, err := headManifest(ctx, r, "")
+	if err != nil {
+		return "", fmt.Errorf("resolving digest for %q: %w", image, err)
+	}
+	return digest, nil
+}
+
+// headManifest issues a HEAD request for repository/tag's manifest, retrying once with a bearer
+// token if the registry challenges the anonymous request, and returns the Docker-Content-Digest.
+func headManifest(ctx context.Context, r ref, token string) (string, error) {
+	httpClient := &http.Client{Timeout: requestTimeout}
+	url := fmt.Sprintf("https://%s/v2/%s/manifests/%s", r.registry, r.repository, r.tag)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", manifestAccept)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized && token == "" {
+		challenge := resp.Header.Get("Www-Authenticate")
+		newToken, err := fetchToken(ctx, challenge)
+		if err != nil {
+			return "", fmt.Errorf("authenticating with registry %s: %w", r.registry, err)
+		}
+		return headManifest(ctx, r, newToken)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("registry %s returned %s for %s/%s:%s", r.registry, resp.Status, r.registry, r.repository, r.tag)
+	}
+
+	contentDigest := resp.Header.Get("Docker-Content-Digest")
+	if contentDigest == "" {
+		return "", fmt.Errorf("registry %s did not return a Docker-Content-Digest header", r.registry)
+	}
+	return contentDigest, nil
+}
+
+// fetchToken parses the fields of a "Bearer realm=...,service=...,scope=..." Www-Authenticate header
+// and requests an anonymous bearer token from its realm.
+func fetchToken(ctx context.Context, challenge string) (string, error) {
+	if !strings.HasPrefix(challenge, "Bearer ") {
+		return "", fmt.Errorf("unsupported auth challenge %q", challenge)
+	}
+
+	fields := map[string]string{}
+	for _, part := range strings.Split(strings.TrimPrefix(challenge, "Bearer "), ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) == 2 {
+			fields[kv[0]] = strings.Trim(kv[1], `"`)
+		}
+	}
+	if fields["realm"] == "" {
+		return "", fmt.Errorf("auth challenge %q is missing a realm", challenge)
+	}
+
+	tokenURL := fmt.Sprintf("%s?service=%s&scope=%s", fields["realm"], fields["service"], fields["scope"])
+	httpClient := &http.Client{Timeout: requestTimeout}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token endpoint %s returned %s", fields["realm"], resp.Status)
+	}
+
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", err
+	}
+	if body.Token != "" {
+		return body.Token, nil
+	}
+	return body.AccessToken, nil
+}
+
+// cosignSimpleSigningPayload is the JSON payload cosign signs, identifying the exact digest it attests to.
+type cosignSimpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// VerifySignature fetches the cosign signature attached to repository@digest (stored, by cosign
+// convention, as an OCI artifact tagged "sha256-<digest>.sig") and verifies it against publicKeyPEM,
+// an ECDSA public key in PEM form - the default key type `cosign generate-key-pair` produces. It
+// fails closed: an unsigned image, an unsupported key type or a bad signature are all reported as
+// errors rather than silently treated as passing.
+func VerifySignature(ctx context.Context, image string, imageDigest string, publicKeyPEM string) error {
+	r, err := parseRef(image)
+	if err != nil {
+		return err
+	}
+
+	block, _ := pem.Decode([]byte(publicKeyPEM))
+	if block == nil {
+		return fmt.Errorf("cosignPublicKey is not a valid PEM block")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("parsing cosignPublicKey: %w", err)
+	}
+	ecdsaKey, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("cosignPublicKey must be an ECDSA public key, got %T", pub)
+	}
+
+	sigTag := "sha256-" + strings.TrimPrefix(imageDigest, "sha256:") + ".sig"
+	payload, signature, err := fetchSignature(ctx, r, sigTag)
+	if err != nil {
+		return fmt.Errorf("fetching signature for %s@%s: %w", image, imageDigest, err)
+	}
+
+	var simpleSigning cosignSimpleSigningPayload
+	if err := json.Unmarshal(payload, &simpleSigning); err != nil {
+		return fmt.Errorf("decoding signed payload for %s@%s: %w", image, imageDigest, err)
+	}
+	if simpleSigning.Critical.Image.DockerManifestDigest != imageDigest {
+		return fmt.Errorf("signed payload for %s attests to digest %s, not the resolved %s", image,
+			simpleSigning.Critical.Image.DockerManifestDigest, imageDigest)
+	}
+
+	sum := sha256.Sum256(payload)
+	if !ecdsa.VerifyASN1(ecdsaKey, sum[:], signature) {
+		return fmt.Errorf("cosign signature for %s@%s does not verify against cosignPublicKey", image, imageDigest)
+	}
+
+	log.Info("verified cosign signature", "image", image, "digest", imageDigest)
+	return nil
+}
+
+// fetchSignature retrieves the base64-encoded signature and signed payload cosign attaches to sigTag,
+// as the single "dev.cosignproject.cosign/signature" annotated layer of its manifest.
+func fetchSignature(ctx context.Context, r ref, sigTag string) (payload []byte, signature []byte, err error) {
+	httpClient := &http.Client{Timeout: requestTimeout}
+	manifestURL := fmt.Sprintf("https://%s/v2/%s/manifests/%s", r.registry, r.repository, sigTag)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, manifestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.oci.image.manifest.v1+json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("no signature manifest found (registry returned %s)", resp.Status)
+	}
+
+	var manifest struct {
+		Layers []struct {
+			Digest      string            `json:"digest"`
+			Annotations map[string]string `json:"annotations"`
+		} `json:"layers"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&manifest); err != nil {
+		return nil, nil, err
+	}
+
+	for _, layer := range manifest.Layers {
+		sigB64, ok := layer.Annotations["dev.cosignproject.cosign/signature"]
+		if !ok {
+			continue
+		}
+		signature, err = base64.StdEncoding.DecodeString(sigB64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("decoding signature annotation: %w", err)
+		}
+		payload, err = fetchBlob(ctx, httpClient, r, layer.Digest)
+		if err != nil {
+			return nil, nil, err
+		}
+		return payload, signature, nil
+	}
+	return nil, nil, fmt.Errorf("signature manifest has no signed layer")
+}
+
+func fetchBlob(ctx context.Context, httpClient *http.Client, r ref, blobDigest string) ([]byte, error) {
+	blobURL := fmt.Sprintf("https://%s/v2/%s/blobs/%s", r.registry, r.repository, blobDigest)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, blobURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching blob %s returned %s", blobDigest, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
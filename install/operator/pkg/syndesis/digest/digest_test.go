@@ -0,0 +1,309 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package digest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseRef(t *testing.T) {
+	tests := []struct {
+		name       string
+		image      string
+		registry   string
+		repository string
+		tag        string
+	}{
+		{
+			name:       "unqualified official image defaults to Docker Hub and latest",
+			image:      "postgres",
+			registry:   "registry-1.docker.io",
+			repository: "library/postgres",
+			tag:        "latest",
+		},
+		{
+			name:       "unqualified user image defaults to Docker Hub",
+			image:      "syndesis/syndesis-server:1.2",
+			registry:   "registry-1.docker.io",
+			repository: "syndesis/syndesis-server",
+			tag:        "1.2",
+		},
+		{
+			name:       "fully qualified registry with port and tag",
+			image:      "quay.io:443/syndesisio/syndesis-server:1.2",
+			registry:   "quay.io:443",
+			repository: "syndesisio/syndesis-server",
+			tag:        "1.2",
+		},
+		{
+			name:       "fully qualified registry without a tag defaults to latest",
+			image:      "quay.io/syndesisio/syndesis-server",
+			registry:   "quay.io",
+			repository: "syndesisio/syndesis-server",
+			tag:        "latest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := parseRef(tt.image)
+			require.NoError(t, err)
+			assert.Equal(t, tt.registry, r.registry)
+			assert.Equal(t, tt.repository, r.repository)
+			assert.Equal(t, tt.tag, r.tag)
+		})
+	}
+}
+
+func Test_parseRef_empty(t *testing.T) {
+	_, err := parseRef("")
+	assert.Error(t, err)
+}
+
+// withRewrittenDefaultTransport points http.DefaultTransport (what headManifest/fetchToken/
+// fetchSignature/fetchBlob all use, since none of them set a client Transport) at target for the
+// duration of the test, so a "https://<fake-registry>/..." request actually reaches an
+// httptest.Server without needing a trusted TLS certificate. Restores the original on cleanup.
+func withRewrittenDefaultTransport(t *testing.T, target string) {
+	t.Helper()
+	targetURL, err := url.Parse(target)
+	require.NoError(t, err)
+
+	original := http.DefaultTransport
+	http.DefaultTransport = rewriteTransport{target: targetURL, next: original}
+	t.Cleanup(func() { http.DefaultTransport = original })
+}
+
+type rewriteTransport struct {
+	target *url.URL
+	next   http.RoundTripper
+}
+
+func (rt rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = rt.target.Scheme
+	req.URL.Host = rt.target.Host
+	return rt.next.RoundTrip(req)
+}
+
+func Test_Resolve(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/syndesisio/syndesis-server/manifests/1.2" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Docker-Content-Digest", "sha256:deadbeef")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	withRewrittenDefaultTransport(t, server.URL)
+
+	digest, err := Resolve(context.Background(), "quay.io/syndesisio/syndesis-server:1.2")
+
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:deadbeef", digest)
+}
+
+func Test_Resolve_retriesWithBearerTokenOnChallenge(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/v2/syndesisio/syndesis-server/manifests/1.2", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer good-token" {
+			w.Header().Set("Www-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="quay.io",scope="repository:syndesisio/syndesis-server:pull"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Docker-Content-Digest", "sha256:cafef00d")
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": "good-token"})
+	})
+
+	withRewrittenDefaultTransport(t, server.URL)
+
+	digest, err := Resolve(context.Background(), "quay.io/syndesisio/syndesis-server:1.2")
+
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:cafef00d", digest)
+}
+
+func Test_Resolve_missingDigestHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	withRewrittenDefaultTransport(t, server.URL)
+
+	_, err := Resolve(context.Background(), "quay.io/syndesisio/syndesis-server:1.2")
+
+	assert.Error(t, err)
+}
+
+// signPayload builds a cosign "simple signing" payload attesting to imageDigest, signs it with key
+// and returns the raw payload bytes and its base64-encoded ASN.1 signature.
+func signPayload(t *testing.T, key *ecdsa.PrivateKey, imageDigest string) (payload []byte, signatureB64 string) {
+	t.Helper()
+	var simpleSigning cosignSimpleSigningPayload
+	simpleSigning.Critical.Image.DockerManifestDigest = imageDigest
+	payload, err := json.Marshal(simpleSigning)
+	require.NoError(t, err)
+
+	sum := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, sum[:])
+	require.NoError(t, err)
+
+	return payload, base64.StdEncoding.EncodeToString(signature)
+}
+
+func publicKeyPEM(t *testing.T, key *ecdsa.PrivateKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func Test_VerifySignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	const imageDigest = "sha256:deadbeef"
+	payload, signatureB64 := signPayload(t, key, imageDigest)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/syndesisio/syndesis-server/manifests/sha256-deadbeef.sig", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"layers": []map[string]interface{}{
+				{
+					"digest":      "sha256:blob",
+					"annotations": map[string]string{"dev.cosignproject.cosign/signature": signatureB64},
+				},
+			},
+		})
+	})
+	mux.HandleFunc("/v2/syndesisio/syndesis-server/blobs/sha256:blob", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(payload)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	withRewrittenDefaultTransport(t, server.URL)
+
+	err = VerifySignature(context.Background(), "quay.io/syndesisio/syndesis-server:1.2", imageDigest, publicKeyPEM(t, key))
+
+	assert.NoError(t, err)
+}
+
+func Test_VerifySignature_digestMismatch(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	// Signed payload attests to a different digest than the one we ask VerifySignature to check
+	payload, signatureB64 := signPayload(t, key, "sha256:someotherdigest")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/syndesisio/syndesis-server/manifests/sha256-deadbeef.sig", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"layers": []map[string]interface{}{
+				{
+					"digest":      "sha256:blob",
+					"annotations": map[string]string{"dev.cosignproject.cosign/signature": signatureB64},
+				},
+			},
+		})
+	})
+	mux.HandleFunc("/v2/syndesisio/syndesis-server/blobs/sha256:blob", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(payload)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	withRewrittenDefaultTransport(t, server.URL)
+
+	err = VerifySignature(context.Background(), "quay.io/syndesisio/syndesis-server:1.2", "sha256:deadbeef", publicKeyPEM(t, key))
+
+	assert.Error(t, err)
+}
+
+func Test_VerifySignature_badSignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	const imageDigest = "sha256:deadbeef"
+	// Sign with otherKey, but verify against key's public key: should fail to verify
+	payload, signatureB64 := signPayload(t, otherKey, imageDigest)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/syndesisio/syndesis-server/manifests/sha256-deadbeef.sig", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"layers": []map[string]interface{}{
+				{
+					"digest":      "sha256:blob",
+					"annotations": map[string]string{"dev.cosignproject.cosign/signature": signatureB64},
+				},
+			},
+		})
+	})
+	mux.HandleFunc("/v2/syndesisio/syndesis-server/blobs/sha256:blob", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(payload)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	withRewrittenDefaultTransport(t, server.URL)
+
+	err = VerifySignature(context.Background(), "quay.io/syndesisio/syndesis-server:1.2", imageDigest, publicKeyPEM(t, key))
+
+	assert.Error(t, err)
+}
+
+func Test_VerifySignature_notPEM(t *testing.T) {
+	err := VerifySignature(context.Background(), "quay.io/syndesisio/syndesis-server:1.2", "sha256:deadbeef", "not-pem")
+
+	assert.Error(t, err)
+}
+
+func Test_VerifySignature_noSignatureManifest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	withRewrittenDefaultTransport(t, server.URL)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	err = VerifySignature(context.Background(), "quay.io/syndesisio/syndesis-server:1.2", "sha256:deadbeef", publicKeyPEM(t, key))
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package integration provides a small client for the subset of the syndesis-server REST API the
+// operator needs to redeploy integrations after an upgrade.
+package integration
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// caSecretName holds the operator's internal CA (see pkg/syndesis/tls), used here to trust
+// syndesis-server's serving certificate when the operator issued it itself.
+const caSecretName = "syndesis-internal-ca"
+
+// Client talks to the syndesis-server REST API from inside the cluster, authenticating with a
+// bearer token obtained from a service account.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	token      string
+}
+
+// NewClient builds a Client for the syndesis-server instance running in namespace. It trusts the
+// operator's internal CA when one has been provisioned, and falls back to skipping verification
+// when the platform manages the certificate itself (eg. OpenShift's service-serving-cert signer),
+// since the connection never leaves the cluster network.
+func NewClient(ctx context.Context, cl client.Client, namespace string, token string) (*Client, error) {
+	tlsConfig := &tls.Config{}
+
+	ca := &corev1.Secret{}
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: namespace, Name: caSecretName}, ca); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+		tlsConfig.InsecureSkipVerify = true
+	} else {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca.Data[corev1.TLSCertKey]) {
+			return nil, fmt.Errorf("could not parse CA certificate from secret %s", caSecretName)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return &Client{
+		httpClient: &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}},
+		baseURL:    fmt.Sprintf("https://syndesis-server.%s.svc:8443/api/v1", namespace),
+		token:      token,
+	}, nil
+}
+
+type integrationSummary struct {
+	ID string `json:"id"`
+}
+
+// ListIntegrationIDs returns the IDs of every integration syndesis-server currently knows about.
+func (c *Client) ListIntegrationIDs(ctx context.Context) ([]string, error) {
+	body, err := c.do(ctx, http.MethodGet, "/integrations", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var summaries []integrationSummary
+	if err := json.Unmarshal(body, &summaries); err != nil {
+		return nil, fmt.Errorf("failed to decode integrations list: %w", err)
+	}
+
+	ids := make([]string, 0, len(summaries))
+	for _, s := range summaries {
+		ids = append(ids, s.ID)
+	}
+	return ids, nil
+}
+
+// Redeploy triggers a new deployment of the integration with the given ID.
+func (c *Client) Redeploy(ctx context.Context, id string) error {
+	_, err := c.do(ctx, http.MethodPost, "/integrations/"+id+"/deployments", nil)
+	return err
+}
+
+func (c *Client) do(ctx context.Context, method string, path string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach syndesis-server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("syndesis-server returned status %d calling %s %s", resp.StatusCode, method, path)
+	}
+
+	return respBody, nil
+}
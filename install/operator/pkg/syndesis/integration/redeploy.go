@@ -0,0 +1,86 @@
+/*
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"sync"
+)
+
+// Redeployer is the subset of Client's behaviour RedeployAll needs, so it can be exercised in
+// tests without a real syndesis-server.
+type Redeployer interface {
+	ListIntegrationIDs(ctx context.Context) ([]string, error)
+	Redeploy(ctx context.Context, id string) error
+}
+
+// RedeployAll redeploys every integration known to r, running at most concurrency redeploys at
+// once so a large number of integrations doesn't overwhelm the syndesis-server at once. It
+// returns the IDs that were redeployed successfully and a map of the ones that failed to the
+// error message encountered redeploying them.
+func RedeployAll(ctx context.Context, r Redeployer, concurrency int) (redeployed []string, failed map[string]string, err error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ids, err := r.ListIntegrationIDs(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type result struct {
+		id  string
+		err error
+	}
+
+	work := make(chan string)
+	results := make(chan result)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range work {
+				results <- result{id: id, err: r.Redeploy(ctx, id)}
+			}
+		}()
+	}
+
+	go func() {
+		for _, id := range ids {
+			work <- id
+		}
+		close(work)
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	failed = map[string]string{}
+	for res := range results {
+		if res.err != nil {
+			failed[res.id] = res.err.Error()
+			continue
+		}
+		redeployed = append(redeployed, res.id)
+	}
+
+	return redeployed, failed, nil
+}
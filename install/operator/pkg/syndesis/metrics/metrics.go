@@ -0,0 +1,74 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package metrics holds the Prometheus collectors the operator exposes about its own reconcile
+// pipeline, on the same /metrics endpoint controller-runtime already serves for its built-in
+// controller/workqueue metrics.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// ReconcileDuration observes how long a single Reconcile call took, by outcome.
+	ReconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "syndesis_operator_reconcile_duration_seconds",
+		Help:    "Time spent handling a single Reconcile call for a Syndesis resource.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"outcome"})
+
+	// ActionDuration observes how long a single install action took to execute, by action name and outcome.
+	ActionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "syndesis_operator_action_duration_seconds",
+		Help:    "Time spent executing a single install action.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"action", "outcome"})
+
+	// ResourceApplyTotal counts how many resources the operator has created or updated, by kind and outcome.
+	ResourceApplyTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "syndesis_operator_resource_apply_total",
+		Help: "Number of resources created or updated by the operator.",
+	}, []string{"kind", "outcome"})
+
+	// DatabaseUpgradeAttemptsTotal counts how many times the operator has attempted a syndesis-db
+	// upgrade, by outcome.
+	DatabaseUpgradeAttemptsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "syndesis_operator_database_upgrade_attempts_total",
+		Help: "Number of syndesis-db upgrade attempts.",
+	}, []string{"outcome"})
+
+	// ResourcePruneTotal counts how many resources the operator has deleted because they are owned
+	// by a Syndesis resource but are no longer produced by rendering it (e.g. an addon was disabled),
+	// by kind.
+	ResourcePruneTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "syndesis_operator_resource_prune_total",
+		Help: "Number of orphaned resources pruned by the operator.",
+	}, []string{"kind"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(ReconcileDuration, ActionDuration, ResourceApplyTotal, DatabaseUpgradeAttemptsTotal, ResourcePruneTotal)
+}
+
+// Outcome maps an error to the "success"/"failure" label value used by every collector above.
+func Outcome(err error) string {
+	if err != nil {
+		return "failure"
+	}
+	return "success"
+}
@@ -391,7 +391,6 @@ func (c *csv) loadDeploymentFromTemplate() (r interface{}, err error) {
 		DvImage         string
 		AmqImage        string
 		CamelKImage     string
-		TodoImage       string
 		OauthImage      string
 		UiImage         string
 		S2iImage        string
@@ -406,7 +405,6 @@ func (c *csv) loadDeploymentFromTemplate() (r interface{}, err error) {
 		DatabaseImage:   c.config.Syndesis.Components.Database.Image,
 		DvImage:         c.config.Syndesis.Addons.DV.Image,
 		CamelKImage:     c.config.Syndesis.Addons.CamelK.Image,
-		TodoImage:       c.config.Syndesis.Addons.Todo.Image,
 		AmqImage:        c.config.Syndesis.Components.AMQ.Image,
 		OauthImage:      c.config.Syndesis.Components.Oauth.Image,
 		UiImage:         c.config.Syndesis.Components.UI.Image,
@@ -101,7 +101,7 @@ func SubscribeOperator(ctx context.Context, clientTools *clienttools.ClientTools
 	//
 	// 4b. No csv listed so create the subscription and accompanying operator group
 	//
-	sub, err := createSubscription(ctx, rtClient, configuration, pkgManifest, channel)
+	sub, err := createSubscription(ctx, rtClient, configuration, pkgManifest, channel, olmSpec)
 	if err != nil {
 		return err
 	}
@@ -169,11 +169,20 @@ func findPackageCSV(ctx context.Context, rtClient client.Client, channel *olmpkg
 	return &csv, nil
 }
 
-func createSubscription(ctx context.Context, rtClient client.Client, configuration *conf.Config, pkgManifest *olmpkgsvr.PackageManifest, channel *olmpkgsvr.PackageChannel) (*olmapiv1alpha1.Subscription, error) {
+func createSubscription(ctx context.Context, rtClient client.Client, configuration *conf.Config, pkgManifest *olmpkgsvr.PackageManifest, channel *olmpkgsvr.PackageChannel, olmSpec *conf.OlmSpec) (*olmapiv1alpha1.Subscription, error) {
 	sublog.Info("Creating subsription for package in namespace", "Channel", channel.Name, "Namespace", configuration.OpenShiftProject)
 
 	ogName := fmt.Sprintf("%s-%s-og", configuration.OpenShiftProject, pkgManifest.Status.PackageName)
 
+	catalogSource := pkgManifest.Status.CatalogSource
+	catalogSourceNamespace := pkgManifest.Status.CatalogSourceNamespace
+	if olmSpec.CatalogSource != "" {
+		catalogSource = olmSpec.CatalogSource
+	}
+	if olmSpec.CatalogSourceNamespace != "" {
+		catalogSourceNamespace = olmSpec.CatalogSourceNamespace
+	}
+
 	//
 	// Create an operator group allowing the OLM to see the namespace
 	//
@@ -197,8 +206,8 @@ func createSubscription(ctx context.Context, rtClient client.Client, configurati
 		Spec: &olmapiv1alpha1.SubscriptionSpec{
 			InstallPlanApproval:    olmapiv1alpha1.ApprovalAutomatic,
 			Package:                pkgManifest.Status.PackageName,
-			CatalogSourceNamespace: pkgManifest.Status.CatalogSourceNamespace,
-			CatalogSource:          pkgManifest.Status.CatalogSource,
+			CatalogSourceNamespace: catalogSourceNamespace,
+			CatalogSource:          catalogSource,
 			Channel:                channel.Name,
 		},
 	}
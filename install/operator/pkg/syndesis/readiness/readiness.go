@@ -0,0 +1,83 @@
+/*
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package readiness serves a JSON summary of every Syndesis custom resource's install progress,
+// richer than controller-runtime's built-in healthz/readyz checks (which only report the operator
+// process itself), so external tooling can poll install progress without the cluster-admin access
+// a `kubectl get syndesis` would require.
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("readiness")
+
+// installState is the JSON shape reported for a single Syndesis custom resource.
+type installState struct {
+	Name               string `json:"name"`
+	Namespace          string `json:"namespace"`
+	Phase              string `json:"phase"`
+	Version            string `json:"version,omitempty"`
+	TargetVersion      string `json:"targetVersion,omitempty"`
+	PendingUpgrade     bool   `json:"pendingUpgrade"`
+	LastReconcileError string `json:"lastReconcileError,omitempty"`
+}
+
+// newInstallState summarizes syndesis' status into the reported shape.
+func newInstallState(syndesis v1beta1.Syndesis) installState {
+	state := installState{
+		Name:           syndesis.Name,
+		Namespace:      syndesis.Namespace,
+		Phase:          string(syndesis.Status.Phase),
+		Version:        syndesis.Status.Version,
+		TargetVersion:  syndesis.Status.TargetVersion,
+		PendingUpgrade: syndesis.Status.TargetVersion != "" && syndesis.Status.TargetVersion != syndesis.Status.Version,
+	}
+	if degraded := syndesis.Status.GetSyndesisCondition(v1beta1.SyndesisConditionTypeDegraded); degraded != nil && degraded.Status == "True" {
+		state.LastReconcileError = degraded.Message
+	}
+	return state
+}
+
+// Handler returns an http.Handler reporting the install state of every Syndesis custom resource in
+// namespace, or across every namespace when namespace is empty (cluster-scoped mode), as a JSON array.
+func Handler(cl client.Client, namespace string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		list := &v1beta1.SyndesisList{}
+		if err := cl.List(context.Background(), list, client.InNamespace(namespace)); err != nil {
+			log.Error(err, "failed to list Syndesis resources")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		states := make([]installState, 0, len(list.Items))
+		for _, syndesis := range list.Items {
+			states = append(states, newInstallState(syndesis))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(states); err != nil {
+			log.Error(err, "failed to encode install state")
+		}
+	})
+}
@@ -0,0 +1,278 @@
+// Package tls provisions and rotates the internal serving certificates used for service-to-service
+// traffic between the server, meta and prometheus components, and, when mutual TLS is enabled, the
+// dual-purpose certificates used to additionally authenticate the db-exporter component and verify
+// every peer's identity.
+package tls
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/operation"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// caSecretName holds the operator's internal CA, used to sign every component's leaf certificate
+	caSecretName = "syndesis-internal-ca"
+	// caValidity is fixed rather than user configurable: rotating the CA would invalidate every leaf
+	// certificate it signed, so it is given a long lifetime instead
+	caValidity = 10 * 365 * 24 * time.Hour
+
+	defaultValidityDays    = 365
+	defaultRenewBeforeDays = 30
+)
+
+// component describes a single service that needs an internal serving certificate
+type component struct {
+	secretName string
+	dnsNames   []string
+}
+
+// EnsureCertificates provisions and rotates the internal serving certificates used for
+// service-to-service traffic between the server, meta and prometheus components, and records the
+// secret names (and, when the operator issues them itself, a generation marker) on config so the
+// infrastructure templates can mount them.
+//
+// On OpenShift, the platform's service-serving-cert signer issues and rotates these certificates once
+// the corresponding Service is annotated for it (done in the infrastructure templates), so there is
+// nothing further for the operator to do. Everywhere else there is no such signer, so the operator
+// maintains its own internal CA and uses it to issue and rotate a leaf certificate per component.
+//
+// When MutualTLS is enabled, the platform signer is skipped even on OpenShift, since it only ever
+// issues one-way serving certificates: the operator's own internal CA instead issues every component
+// (including db-exporter, which otherwise has no certificate of its own) a dual-purpose certificate,
+// so each component can also present it as a client certificate when calling its peers, and the CA
+// bundle distributed alongside it lets every peer verify the caller's identity.
+func EnsureCertificates(ctx context.Context, cl client.Client, syndesis *v1beta1.Syndesis, config *configuration.Config) error {
+	mutualTLS := config.Syndesis.Certificates.MutualTLS
+
+	components := []component{
+		{"syndesis-server-tls", []string{"syndesis-server", "syndesis-server." + syndesis.Namespace + ".svc"}},
+		{"syndesis-meta-tls", []string{"syndesis-meta", "syndesis-meta." + syndesis.Namespace + ".svc"}},
+		{"syndesis-prometheus-tls", []string{"syndesis-prometheus", "syndesis-prometheus." + syndesis.Namespace + ".svc"}},
+	}
+	if mutualTLS {
+		components = append(components, component{"syndesis-db-exporter-tls", []string{"syndesis-db-metrics", "syndesis-db-metrics." + syndesis.Namespace + ".svc"}})
+	}
+
+	config.InternalCerts.ServerSecret = components[0].secretName
+	config.InternalCerts.MetaSecret = components[1].secretName
+	config.InternalCerts.PrometheusSecret = components[2].secretName
+	if mutualTLS {
+		config.InternalCerts.DbExporterSecret = components[3].secretName
+	}
+
+	if config.ApiServer.Routes && !mutualTLS {
+		// OpenShift provisions and rotates these itself once the Service is annotated for it.
+		return nil
+	}
+
+	validityDays := config.Syndesis.Certificates.ValidityDays
+	if validityDays <= 0 {
+		validityDays = defaultValidityDays
+	}
+	renewBeforeDays := config.Syndesis.Certificates.RenewBeforeDays
+	if renewBeforeDays <= 0 {
+		renewBeforeDays = defaultRenewBeforeDays
+	}
+
+	ca, err := ensureCA(ctx, cl, syndesis)
+	if err != nil {
+		return fmt.Errorf("provisioning internal CA: %w", err)
+	}
+
+	hash := sha256.New()
+	for _, c := range components {
+		certPEM, err := ensureLeafCertificate(ctx, cl, syndesis, ca, c, time.Duration(validityDays)*24*time.Hour, time.Duration(renewBeforeDays)*24*time.Hour)
+		if err != nil {
+			return fmt.Errorf("provisioning certificate for secret %s: %w", c.secretName, err)
+		}
+		hash.Write(certPEM)
+	}
+	// Generation changes whenever any certificate is (re)issued, so templates can use it as a pod
+	// template annotation that forces a coordinated rolling restart of the affected components.
+	config.InternalCerts.Generation = hex.EncodeToString(hash.Sum(nil))[:12]
+
+	return nil
+}
+
+type caKeyPair struct {
+	cert    *x509.Certificate
+	key     *rsa.PrivateKey
+	certPEM []byte
+}
+
+func ensureCA(ctx context.Context, cl client.Client, syndesis *v1beta1.Syndesis) (*caKeyPair, error) {
+	secret := &corev1.Secret{}
+	err := cl.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: caSecretName}, secret)
+	if err == nil {
+		if ca, err := decodeCA(secret); err == nil && time.Now().Before(ca.cert.NotAfter) {
+			return ca, nil
+		}
+	} else if !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	ca, err := generateCA()
+	if err != nil {
+		return nil, err
+	}
+
+	newSecret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: caSecretName, Labels: map[string]string{"app": "syndesis"}},
+		Type:       corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       ca.certPEM,
+			corev1.TLSPrivateKeyKey: encodeRSAKey(ca.key),
+		},
+	}
+	operation.SetNamespaceAndOwnerReference(newSecret, syndesis)
+	if _, _, err := util.CreateOrUpdate(ctx, cl, newSecret); err != nil {
+		return nil, err
+	}
+	return ca, nil
+}
+
+func decodeCA(secret *corev1.Secret) (*caKeyPair, error) {
+	certPEM := secret.Data[corev1.TLSCertKey]
+	cert, err := parseCert(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	key, err := parseRSAKey(secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, err
+	}
+	return &caKeyPair{cert: cert, key: key, certPEM: certPEM}, nil
+}
+
+func generateCA() (*caKeyPair, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := randomSerial()
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "syndesis-internal-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	return &caKeyPair{cert: cert, key: key, certPEM: encodeCert(der)}, nil
+}
+
+// ensureLeafCertificate returns the PEM-encoded certificate currently stored in comp's secret,
+// (re)issuing it first if it is missing, expired, or due to expire within renewBefore.
+func ensureLeafCertificate(ctx context.Context, cl client.Client, syndesis *v1beta1.Syndesis, ca *caKeyPair, comp component, validity, renewBefore time.Duration) ([]byte, error) {
+	secret := &corev1.Secret{}
+	err := cl.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: comp.secretName}, secret)
+	if err == nil {
+		if certPEM := secret.Data[corev1.TLSCertKey]; certPEM != nil {
+			if cert, err := parseCert(certPEM); err == nil && time.Now().Add(renewBefore).Before(cert.NotAfter) {
+				return certPEM, nil
+			}
+		}
+	} else if !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := randomSerial()
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: comp.dnsNames[0]},
+		DNSNames:     comp.dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		return nil, err
+	}
+	certPEM := encodeCert(der)
+
+	newSecret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: comp.secretName, Labels: map[string]string{"app": "syndesis"}},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: encodeRSAKey(key),
+			"ca.crt":                ca.certPEM,
+		},
+	}
+	operation.SetNamespaceAndOwnerReference(newSecret, syndesis)
+	if _, _, err := util.CreateOrUpdate(ctx, cl, newSecret); err != nil {
+		return nil, err
+	}
+	return certPEM, nil
+}
+
+func randomSerial() (*big.Int, error) {
+	limit := new(big.Int).Lsh(big.NewInt(1), 128)
+	return rand.Int(rand.Reader, limit)
+}
+
+func encodeCert(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func encodeRSAKey(key *rsa.PrivateKey) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+func parseCert(certPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func parseRSAKey(keyPEM []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in private key")
+	}
+	return x509.ParsePKCS1PrivateKey(block.Bytes)
+}
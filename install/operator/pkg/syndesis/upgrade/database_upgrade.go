@@ -20,35 +20,45 @@ import (
 	"bufio"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	oappsv1 "github.com/openshift/api/apps/v1"
 	"github.com/spf13/afero"
+	"github.com/syndesisio/syndesis/install/operator/pkg"
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	sbackup "github.com/syndesisio/syndesis/install/operator/pkg/syndesis/backup"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/metrics"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const upgradeDeploymentName = "syndesis-db-upgrade"
+const upgradeJobName = "syndesis-db-upgrade"
+
+// upgradeLogsConfigMapName holds the captured log of each upgrade hop's Job, keyed by hop name,
+// so it survives the Job's pod being garbage-collected once the Job completes
+const upgradeLogsConfigMapName = "syndesis-db-upgrade-logs"
 
 var upgradeLabels = map[string]string{
 	"syndesis.io/app":       "syndesis",
-	"syndesis.io/component": upgradeDeploymentName,
+	"syndesis.io/component": upgradeJobName,
 }
 
 var upgradeMetadata = metav1.ObjectMeta{
-	Name:   upgradeDeploymentName,
+	Name:   upgradeJobName,
 	Labels: upgradeLabels,
 }
 
@@ -56,18 +66,61 @@ var upgradeMetadata = metav1.ObjectMeta{
 // meant to parse strings like "PostgreSQL 9.5.14" to "9.5" and "postgres (PostgreSQL) 10.6 (Debian 10.6-1.pgdg90+1)" to "10.6"
 var postgresVersionRegex = regexp.MustCompile(`^.* (\d+\.\d+)(?:\.d+)? ?`)
 
+// SupportedPostgresVersions lists the PostgreSQL major versions this operator knows how to move
+// through, oldest first. A jump between two non-adjacent entries (e.g. 9.6 to 12) is broken down
+// into one pg_upgrade hop per adjacent pair, each checkpointed independently in the Syndesis
+// resource's status, since the postgresql-upgrade image can only bridge from the major version
+// immediately below its own
+var SupportedPostgresVersions = []float64{9.6, 10, 12}
+
+// postgresImageForVersion returns the image reference carrying the given PostgreSQL major
+// version's binaries, following the naming convention of Database.Image's default value of
+// centos/postgresql-96-centos7
+func postgresImageForVersion(version float64) string {
+	tag := strings.Replace(strconv.FormatFloat(version, 'f', -1, 64), ".", "", 1)
+	return fmt.Sprintf("centos/postgresql-%s-centos7", tag)
+}
+
+// postgresHops returns the ordered list of intermediate major versions to pg_upgrade through to
+// get from current to target, one entry per adjacent pair in SupportedPostgresVersions. Returns
+// ok=false when current isn't one of SupportedPostgresVersions, meaning no chain of adjacent
+// hops can be computed and a dump/restore upgrade has to be used instead
+func postgresHops(current, target float64) (hops []float64, ok bool) {
+	start := -1
+	for i, v := range SupportedPostgresVersions {
+		if v == current {
+			start = i
+			break
+		}
+	}
+	if start == -1 {
+		return nil, false
+	}
+
+	for i := start + 1; i < len(SupportedPostgresVersions) && SupportedPostgresVersions[i] <= target; i++ {
+		hops = append(hops, SupportedPostgresVersions[i])
+	}
+
+	return hops, true
+}
+
 // upgrades the database by leveraging the builtin functionality of the PostgreSQL image from Software Collections
 // key functionality is that the upgrade can be triggered by specifying the `POSTGRESQL_UPGRADE=copy` environment
-// variable
+// variable. Each hop runs as a tracked, owned batch Job rather than a long-lived Deployment, so success is the
+// Job actually completing rather than a Deployment merely reaching readiness. A jump spanning more than one
+// major version is carried out as a chain of such Jobs, one per adjacent pair in SupportedPostgresVersions, each
+// recorded in Status.UpgradeSteps as it completes so that an operator restart partway through resumes from the
+// first hop that hadn't finished yet
 type databaseUpgrade struct {
 	step
 	syndesis *v1beta1.Syndesis
+	backup   sbackup.Runner
 	target   func() (float64, error) // target version of PostgreSQL as detected at runtime from the file left by the init container
 	current  func() (float64, error) // current version of PostgreSQL as detected at runtime by querying the running database
 	cleanup  func() error            // how to perform cleanup, that is what to do in case of rollback or when we're done with the upgrade
 }
 
-func newDatabaseUpgrade(base step, s *v1beta1.Syndesis) stepRunner {
+func newDatabaseUpgrade(base step, s *v1beta1.Syndesis, b sbackup.Runner) stepRunner {
 	sharedFile := &sharedFileTarget{
 		fs: afero.NewOsFs(),
 	}
@@ -75,9 +128,20 @@ func newDatabaseUpgrade(base step, s *v1beta1.Syndesis) stepRunner {
 	u := databaseUpgrade{
 		step:     base,
 		syndesis: s,
+		backup:   b,
 		target:   sharedFile.version,
 	}
 
+	// A configured TargetVersion caps how far the upgrade goes, in place of the version baked
+	// into Image, so a chain of hops can be stopped short of the newest version this operator knows
+	if s != nil {
+		if v := s.Spec.Components.Database.TargetVersion; v != "" {
+			u.target = func() (float64, error) {
+				return strconv.ParseFloat(v, 64)
+			}
+		}
+	}
+
 	u.current = u.currentFromRunningDatabase
 	u.cleanup = u.deleteUpgrade
 
@@ -87,38 +151,140 @@ func newDatabaseUpgrade(base step, s *v1beta1.Syndesis) stepRunner {
 func (u *databaseUpgrade) run() (err error) {
 	u.log.Info("Upgrading database")
 
+	defer func() {
+		metrics.DatabaseUpgradeAttemptsTotal.WithLabelValues(metrics.Outcome(err)).Inc()
+	}()
+
+	current, err := u.current()
+	if err != nil {
+		return err
+	}
+
+	target, err := u.target()
+	if err != nil {
+		return err
+	}
+
 	// scales down the databasae (`syndesis-db`)
 	if err := u.scaleDownDatabase(); err != nil {
 		return err
 	}
 
-	// deploys a new Deployment (`syndesis-db-upgrade`)
-	// with the image of the new (target) version with
-	// the environment variable set to perform the
+	hops, ok := postgresHops(current, target)
+	if !ok {
+		return u.runDumpRestore(current, target)
+	}
+
+	from := current
+	for _, to := range hops {
+		if err := u.runHop(from, to); err != nil {
+			return err
+		}
+		from = to
+	}
+
+	return nil
+}
+
+// runHop performs a single pg_upgrade hop between two adjacent supported PostgreSQL major
+// versions, skipping it if a previous, interrupted attempt already completed it
+func (u *databaseUpgrade) runHop(from, to float64) error {
+	name := u.hopName(from, to)
+	if step := u.syndesis.Status.GetUpgradeStep(name); step != nil && step.State == v1beta1.SyndesisInstallStageStateCompleted {
+		u.log.Info("Skipping already completed database upgrade hop", "from", from, "to", to)
+		return nil
+	}
+
+	u.log.Info("Running database upgrade hop", "from", from, "to", to)
+	u.recordHopStarted(name)
+
+	// deploys a new Job (`syndesis-db-upgrade`) owned by the Syndesis resource, running the
+	// image of the new (target) version with the environment variable set to perform the
 	// upgrade by running pg_upgrade
-	if err := u.deployUpgrade(); err != nil {
+	if err := u.deployUpgrade(postgresImageForVersion(to)); err != nil {
+		u.recordHopFinished(name, v1beta1.SyndesisInstallStageStateFailed, err.Error())
 		return err
 	}
 
-	// make sure we delete the syndesis-db-upgrade Deployment
+	// make sure we delete the syndesis-db-upgrade Job
 	defer func() {
 		if err := u.cleanup(); err != nil {
-			u.log.Error(err, "Failed to cleanup database upgrade deployment")
+			u.log.Error(err, "Failed to cleanup database upgrade job")
+		}
+	}()
+
+	// wait for the `syndesis-db-upgrade` Job to run to completion; this marks the end of the hop
+	jobErr := u.awaitJobCompletion(upgradeJobName)
+
+	if logErr := u.captureUpgradeJobLogs(name); logErr != nil {
+		u.log.Error(logErr, "Failed to capture database upgrade job logs")
+	}
+
+	if jobErr != nil {
+		u.recordHopFinished(name, v1beta1.SyndesisInstallStageStateFailed, jobErr.Error())
+		return jobErr
+	}
+
+	u.recordHopFinished(name, v1beta1.SyndesisInstallStageStateCompleted, "")
+	return nil
+}
+
+// runDumpRestore upgrades the database when no chain of adjacent pg_upgrade hops can be
+// computed, for a current version that isn't in SupportedPostgresVersions. Rather than an
+// in-place pg_upgrade, it wipes the data volume and lets the normal reconcile loop bring
+// `syndesis-db` back up on the target image, then restores the dump taken earlier in this same
+// upgrade attempt by the backup step that always runs ahead of the database upgrade step
+func (u *databaseUpgrade) runDumpRestore(current, target float64) (err error) {
+	name := u.hopName(current, target)
+	if step := u.syndesis.Status.GetUpgradeStep(name); step != nil && step.State == v1beta1.SyndesisInstallStageStateCompleted {
+		u.log.Info("Skipping already completed database dump/restore upgrade", "from", current, "to", target)
+		return nil
+	}
+
+	u.log.Info("PostgreSQL version has no known pg_upgrade path, falling back to dump/restore", "from", current, "to", target)
+	u.recordHopStarted(name)
+	defer func() {
+		if err != nil {
+			u.recordHopFinished(name, v1beta1.SyndesisInstallStageStateFailed, err.Error())
 		}
 	}()
 
-	// wait for the `syndesis-db-upgrade` to scale up
-	// this marks the end of the upgrade
-	if err := u.awaitScale(upgradeDeploymentName, newDeploymentTracker()); err != nil {
+	if err := u.wipeDatabaseVolume(); err != nil {
+		return err
+	}
+
+	if err := u.startDatabase(postgresImageForVersion(target)); err != nil {
 		return err
 	}
 
+	if err := u.backup.RestoreDb(); err != nil {
+		return err
+	}
+
+	u.recordHopFinished(name, v1beta1.SyndesisInstallStageStateCompleted, "")
 	return nil
 }
 
+// hopName identifies a single upgrade hop (or dump/restore fallback) in Status.UpgradeSteps
+func (u *databaseUpgrade) hopName(from, to float64) v1beta1.UpgradeStep {
+	return v1beta1.UpgradeStep(fmt.Sprintf("database-%.1f-to-%.1f", from, to))
+}
+
+func (u *databaseUpgrade) recordHopStarted(name v1beta1.UpgradeStep) {
+	u.updateStatus(func(target *v1beta1.Syndesis) {
+		target.Status.SetUpgradeStepStarted(name)
+	})
+}
+
+func (u *databaseUpgrade) recordHopFinished(name v1beta1.UpgradeStep, state v1beta1.SyndesisInstallStageState, message string) {
+	u.updateStatus(func(target *v1beta1.Syndesis) {
+		target.Status.SetUpgradeStepFinished(name, state, message)
+	})
+}
+
 func (u *databaseUpgrade) rollback() (err error) {
 	if err := u.deleteUpgrade(); err != nil {
-		u.log.Error(err, "Unable to delete database upgrade Deployment during rollback")
+		u.log.Error(err, "Unable to delete database upgrade Job during rollback")
 	}
 
 	return nil
@@ -144,7 +310,53 @@ func (u *databaseUpgrade) canRun() bool {
 	u.name = fmt.Sprintf("Database upgrade from %f to %f", current, target)
 
 	u.log.Info("Determined versions of PostgreSQL database", "target", target, "current", current)
-	return target > current
+
+	if target <= current {
+		u.recordUpgradeCondition(corev1.ConditionFalse, "UpToDate", "")
+		return false
+	}
+
+	message := fmt.Sprintf("PostgreSQL %.1f is running, upgrade to %.1f is available", current, target)
+	if !u.syndesis.Spec.Components.Database.ApproveUpgrade {
+		u.recordUpgradeCondition(corev1.ConditionTrue, "AwaitingApproval",
+			message+"; set spec.components.database.approveUpgrade to true to proceed with this destructive operation")
+		return false
+	}
+
+	u.recordUpgradeCondition(corev1.ConditionTrue, "Approved", message)
+	return true
+}
+
+// recordUpgradeCondition surfaces the detected current/target PostgreSQL versions on the Syndesis
+// resource, so the decision to run (or hold back) a destructive database upgrade isn't only visible
+// in the operator's own logs
+func (u *databaseUpgrade) recordUpgradeCondition(status corev1.ConditionStatus, reason string, message string) {
+	u.updateStatus(func(target *v1beta1.Syndesis) {
+		target.Status.SetSyndesisCondition(v1beta1.SyndesisConditionTypeDatabaseUpgradeRequired, status, reason, message)
+	})
+}
+
+// updateStatus applies mutate to a fresh copy of the Syndesis resource's status and persists it,
+// keeping u.syndesis in sync with what was actually written so later reads (e.g. GetUpgradeStep)
+// see the update. A nil clientTools (as used from tests) makes this a no-op
+func (u *databaseUpgrade) updateStatus(mutate func(*v1beta1.Syndesis)) {
+	if u.clientTools == nil {
+		return
+	}
+
+	rtClient, err := u.clientTools.RuntimeClient()
+	if err != nil {
+		u.log.Error(err, "failed to persist database upgrade status")
+		return
+	}
+
+	target := u.syndesis.DeepCopy()
+	mutate(target)
+	if err := rtClient.Update(u.context, target); err != nil {
+		u.log.Error(err, "failed to persist database upgrade status")
+		return
+	}
+	u.syndesis = target
 }
 
 // Connects to the running version of the database and queries it's version
@@ -184,14 +396,93 @@ func (u *databaseUpgrade) scaleDownDatabase() error {
 		return err
 	}
 
+	return u.scaleDownReplicas()
+}
+
+// Scales the `syndesis-db-replica` StatefulSet down to 0, so that streaming replicas don't try
+// to keep following a primary that is about to be upgraded in place. Not every installation runs
+// with replicas, so a missing StatefulSet is not treated as an error. The normal reconcile loop
+// scales it back up to Database.Replicas-1 once the upgrade completes.
+func (u *databaseUpgrade) scaleDownReplicas() error {
+	if err := u.client().Patch(u.context, &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "syndesis-db-replica",
+			Namespace: u.namespace,
+		},
+	}, client.RawPatch(types.MergePatchType, []byte(`{"spec":{"replicas":0}}`))); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	return u.awaitScale("syndesis-db-replica", newStatefulSetTracker())
+}
+
+// wipeDatabaseVolume deletes the `syndesis-db` PersistentVolumeClaim so that the next time
+// `syndesis-db` starts up it initialises a fresh, empty data directory instead of trying (and
+// failing) to start a newer PostgreSQL major version against an older version's data files.
+// This is only safe to call once a backup of the data being wiped already exists
+func (u *databaseUpgrade) wipeDatabaseVolume() error {
+	u.log.Info("Wiping database volume ahead of dump/restore upgrade", "claim", "syndesis-db")
+	if err := u.client().Delete(u.context, &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "syndesis-db",
+			Namespace: u.namespace,
+		},
+	}); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
 	return nil
 }
 
-// Deploys the `syndesis-db-upgrade` Deployment with the same `syndesis-db-data` volume
-// as the current `syndesis-db` DeploymentConfig. Specifying `POSTGRESQL_UPGRADE=copy`
-// instructs the startup scripts within the centos/postgresql image to run pg_upgrade
-// to migrate the data files
-func (u *databaseUpgrade) deployUpgrade() error {
+// startDatabase patches the `syndesis-db` DeploymentConfig to run the given image and scales it
+// back up to 1, then waits for it to become ready. Used by the dump/restore fallback, where the
+// upgrade continues on the regular `syndesis-db` deployment rather than a temporary one, since
+// there is no pg_upgrade step to run in isolation first
+func (u *databaseUpgrade) startDatabase(image string) error {
+	u.log.Info("Starting database on target image", "deployment", "syndesis-db", "image", image)
+	patch := fmt.Sprintf(`[{"op":"replace","path":"/spec/replicas","value":1},{"op":"replace","path":"/spec/template/spec/containers/0/image","value":%q}]`, image)
+	if err := u.client().Patch(u.context, &oappsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "syndesis-db",
+			Namespace: u.namespace,
+		},
+	}, client.RawPatch(types.JSONPatchType, []byte(patch))); err != nil {
+		return err
+	}
+
+	return u.awaitScale("syndesis-db", newDeploymentConfigTracker())
+}
+
+// upgradeWrapperScript starts the centos/postgresql image's own entrypoint (which, given
+// `POSTGRESQL_UPGRADE=copy`, runs pg_upgrade before starting the server) in the background, polls
+// it the same way the old readiness probe did, and once it answers, stops it and exits 0 so the
+// Job that runs it is marked Succeeded. run-postgresql normally never exits on its own, which is
+// why a plain Job of it would just time out instead of completing
+const upgradeWrapperScript = `
+set -e
+run-postgresql &
+PG_PID=$!
+for i in $(seq 1 90); do
+	if psql -h 127.0.0.1 -U "$POSTGRESQL_USER" -q -d "$POSTGRESQL_DATABASE" -c 'SELECT 1' >/dev/null 2>&1; then
+		kill -TERM "$PG_PID"
+		wait "$PG_PID"
+		exit 0
+	fi
+	sleep 10
+done
+kill -TERM "$PG_PID" 2>/dev/null || true
+exit 1
+`
+
+// Deploys the `syndesis-db-upgrade` Job with the same `syndesis-db-data` volume as the current
+// `syndesis-db` DeploymentConfig, running the given image. Specifying `POSTGRESQL_UPGRADE=copy`
+// instructs the startup scripts within the centos/postgresql image to run pg_upgrade to migrate
+// the data files from whatever version is already on the volume. The Job is owned by the Syndesis
+// resource so it is cleaned up automatically if the resource itself is deleted mid-upgrade
+func (u *databaseUpgrade) deployUpgrade(image string) error {
 	config, err := configuration.GetProperties(
 		u.context, configuration.TemplateConfig,
 		u.clientTools,
@@ -200,26 +491,33 @@ func (u *databaseUpgrade) deployUpgrade() error {
 		return err
 	}
 
-	one := int32(1)
 	memory, err := resource.ParseQuantity(config.Syndesis.Components.Database.Resources.Memory)
 	if err != nil {
 		return err
 	}
 
+	// pg_upgrade must not be retried automatically: a failed attempt leaves the data directory in
+	// a state a second attempt cannot safely resume from
+	backoffLimit := int32(0)
+
 	metadata := upgradeMetadata.DeepCopy()
 	metadata.SetNamespace(u.namespace)
+	metadata.SetOwnerReferences([]metav1.OwnerReference{
+		*metav1.NewControllerRef(u.syndesis, v1beta1.SchemeGroupVersion.WithKind(u.syndesis.Kind)),
+	})
+	// Jobs do not like being labelled with an owner but have to have controller-uid instead,
+	// same as operation.SetNamespaceAndOwnerReference applies to generator-rendered Jobs
+	metadata.Labels[pkg.ControllerUIDLabel] = string(u.syndesis.GetUID())
 
-	return u.client().Create(u.context, &appsv1.Deployment{
+	return u.client().Create(u.context, &batchv1.Job{
 		ObjectMeta: *metadata,
-		Spec: appsv1.DeploymentSpec{
-			Replicas: &one,
-			Selector: &metav1.LabelSelector{
-				MatchLabels: upgradeLabels,
-			},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: upgradeMetadata,
 				Spec: corev1.PodSpec{
 					ServiceAccountName: "syndesis-default",
+					RestartPolicy:      corev1.RestartPolicyNever,
 					Volumes: []corev1.Volume{
 						{
 							Name: "syndesis-db-data",
@@ -232,7 +530,8 @@ func (u *databaseUpgrade) deployUpgrade() error {
 					},
 					Containers: []corev1.Container{
 						{
-							Name: "postgresql",
+							Name:    "postgresql",
+							Command: []string{"/bin/sh", "-c", upgradeWrapperScript},
 							Env: []corev1.EnvVar{
 								{
 									Name:  "POSTGRESQL_USER",
@@ -251,14 +550,8 @@ func (u *databaseUpgrade) deployUpgrade() error {
 									Value: "copy",
 								},
 							},
-							Ports: []corev1.ContainerPort{
-								{
-									ContainerPort: 5432,
-									Protocol:      corev1.ProtocolTCP,
-								},
-							},
 							ImagePullPolicy: corev1.PullIfNotPresent,
-							Image:           config.Syndesis.Components.Database.Image,
+							Image:           image,
 							Resources: corev1.ResourceRequirements{
 								Limits: corev1.ResourceList{
 									corev1.ResourceMemory: memory,
@@ -273,31 +566,6 @@ func (u *databaseUpgrade) deployUpgrade() error {
 									MountPath: "/var/lib/pgsql/data",
 								},
 							},
-							ReadinessProbe: &corev1.Probe{
-								Handler: corev1.Handler{
-									Exec: &corev1.ExecAction{
-										Command: []string{
-											"/bin/sh",
-											"-i",
-											"-c",
-											"psql -h 127.0.0.1 -U $POSTGRESQL_USER -q -d $POSTGRESQL_DATABASE -c 'SELECT 1'",
-										},
-									},
-								},
-								InitialDelaySeconds: 5,
-								PeriodSeconds:       10,
-								SuccessThreshold:    1,
-							},
-							LivenessProbe: &corev1.Probe{
-								Handler: corev1.Handler{
-									TCPSocket: &corev1.TCPSocketAction{
-										Port: intstr.FromInt(5432),
-									},
-								},
-								InitialDelaySeconds: 60,
-								PeriodSeconds:       10,
-								SuccessThreshold:    1,
-							},
 						},
 					},
 				},
@@ -311,22 +579,22 @@ type scaleTracker interface {
 	hasScaled() bool
 }
 
-type deploymentTracker struct {
-	deployment appsv1.Deployment
+type statefulSetTracker struct {
+	statefulSet appsv1.StatefulSet
 }
 
-func newDeploymentTracker() scaleTracker {
-	return &deploymentTracker{
-		deployment: appsv1.Deployment{},
+func newStatefulSetTracker() scaleTracker {
+	return &statefulSetTracker{
+		statefulSet: appsv1.StatefulSet{},
 	}
 }
 
-func (d *deploymentTracker) obj() runtime.Object {
-	return &d.deployment
+func (d *statefulSetTracker) obj() runtime.Object {
+	return &d.statefulSet
 }
 
-func (d *deploymentTracker) hasScaled() bool {
-	return d.deployment.Status.Replicas == d.deployment.Status.ReadyReplicas
+func (d *statefulSetTracker) hasScaled() bool {
+	return d.statefulSet.Status.Replicas == d.statefulSet.Status.ReadyReplicas
 }
 
 type deploymentConfigTracker struct {
@@ -368,8 +636,108 @@ func (u *databaseUpgrade) awaitScale(name string, tracker scaleTracker) error {
 	return nil
 }
 
+// Waits at most 15min for the `syndesis-db-upgrade` Job to run to completion, mirroring the
+// timeout budget the previous Deployment-based upgrade allowed itself to reach readiness
+func (u *databaseUpgrade) awaitJobCompletion(name string) error {
+	job := &batchv1.Job{}
+	if err := wait.PollImmediate(time.Second*3, time.Minute*15, func() (done bool, err error) {
+		if err = u.client().Get(u.context, types.NamespacedName{Namespace: u.namespace, Name: name}, job); err != nil {
+			return false, err
+		}
+
+		if job.Status.Failed != 0 {
+			return false, fmt.Errorf("database upgrade job %s failed", name)
+		}
+
+		if job.Status.Succeeded != 0 {
+			return true, nil
+		}
+
+		u.log.Info("Waiting for the database upgrade job to complete", "job", name)
+		return false, nil
+	}); err != nil {
+		u.log.Error(err, "Failed waiting for the database upgrade job to complete", "job", name)
+		return err
+	}
+
+	return nil
+}
+
+// captureUpgradeJobLogs fetches the log of the `syndesis-db-upgrade` Job's pod, writes it to the
+// operator log so it shows up alongside the rest of the upgrade's progress, and appends it to the
+// syndesis-db-upgrade-logs ConfigMap under a key named after the hop, so the log outlives the
+// Job's own pod once that pod is garbage-collected
+func (u *databaseUpgrade) captureUpgradeJobLogs(hop v1beta1.UpgradeStep) error {
+	api, err := u.api()
+	if err != nil {
+		return err
+	}
+
+	pods, err := api.CoreV1().Pods(u.namespace).List(u.context, metav1.ListOptions{LabelSelector: "job-name=" + upgradeJobName})
+	if err != nil {
+		return err
+	}
+	if len(pods.Items) == 0 {
+		return fmt.Errorf("no pod found for database upgrade job %s", upgradeJobName)
+	}
+
+	stream, err := api.CoreV1().Pods(u.namespace).GetLogs(pods.Items[0].Name, &corev1.PodLogOptions{}).Stream(u.context)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	logs, err := ioutil.ReadAll(stream)
+	if err != nil {
+		return err
+	}
+
+	u.log.Info("Database upgrade job log", "hop", hop, "log", string(logs))
+
+	return u.saveUpgradeJobLog(hop, logs)
+}
+
+// saveUpgradeJobLog persists a hop's captured log into the syndesis-db-upgrade-logs ConfigMap,
+// creating it on the first hop and adding to it on each subsequent one
+func (u *databaseUpgrade) saveUpgradeJobLog(hop v1beta1.UpgradeStep, logs []byte) error {
+	key := types.NamespacedName{Namespace: u.namespace, Name: upgradeLogsConfigMapName}
+
+	cm := &corev1.ConfigMap{}
+	if err := u.client().Get(u.context, key, cm); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+
+		return u.client().Create(u.context, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    upgradeLabels,
+			},
+			Data: map[string]string{
+				string(hop) + ".log": string(logs),
+			},
+		})
+	}
+
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[string(hop)+".log"] = string(logs)
+	return u.client().Update(u.context, cm)
+}
+
 func (u *databaseUpgrade) deleteUpgrade() error {
-	return u.client().DeleteAllOf(u.context, &appsv1.Deployment{}, client.InNamespace(u.namespace), client.MatchingLabels(upgradeLabels))
+	if err := u.client().DeleteAllOf(u.context, &batchv1.Job{},
+		client.InNamespace(u.namespace), client.MatchingLabels(upgradeLabels),
+		client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+		return err
+	}
+
+	// Jobs do not cascade-delete their pods on a fake client, and even on a real cluster GC of
+	// the pods is asynchronous, so clean them up explicitly rather than leaving them around
+	return u.client().DeleteAllOf(u.context, &corev1.Pod{},
+		client.InNamespace(u.namespace), client.MatchingLabels(map[string]string{"job-name": upgradeJobName}))
 }
 
 func (u *databaseUpgrade) client() client.Client {
@@ -381,7 +749,9 @@ func (u *databaseUpgrade) client() client.Client {
 	return client
 }
 
-// simple strategy to load the version of the database left in /data/postgresql.txt by the init container
+// simple strategy to load the version of the database left in /data/postgresql.txt by the init container.
+// The init container prefers the POSTGRESQL_VERSION baked into the target image and only falls back to
+// parsing the `postgres -V` banner for images that don't set it.
 // we use afero filesystem to mock the filesystem in tests
 type sharedFileTarget struct {
 	fs afero.Fs
@@ -405,6 +775,12 @@ func (sharedFile *sharedFileTarget) version() (float64, error) {
 
 	line := s.Text()
 
+	// images that set POSTGRESQL_VERSION leave behind a plain `major.minor`
+	// value, which we can parse directly without regexing a version banner
+	if version, err := strconv.ParseFloat(line, 64); err == nil {
+		return version, nil
+	}
+
 	extracted := postgresVersionRegex.FindStringSubmatch(line)
 	if len(extracted) < 2 {
 		return 0.0, fmt.Errorf("Unable to parse PostgreSQL version from version string: `%s`", line)
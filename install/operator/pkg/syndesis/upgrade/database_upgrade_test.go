@@ -22,21 +22,21 @@ import (
 	"testing"
 
 	"github.com/go-logr/zapr"
-	oappsv1 "github.com/openshift/api/apps/v1"
 	"github.com/spf13/afero"
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
 	syntesting "github.com/syndesisio/syndesis/install/operator/pkg/syndesis/testing"
 	"go.uber.org/zap"
-	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestShouldCreateNewInstance(t *testing.T) {
-	if newDatabaseUpgrade(step{}, nil) == nil {
+	if newDatabaseUpgrade(step{}, nil, nil) == nil {
 		t.Fatal("Should create new instance, got nil")
 	}
 }
@@ -50,7 +50,7 @@ func TestShouldNotRunForExternalDatabases(t *testing.T) {
 				},
 			},
 		},
-	})
+	}, nil)
 
 	if u.canRun() == true {
 		t.Fatal("We do not want to run database upgrade for for external databases")
@@ -81,6 +81,7 @@ func TestShouldDetermineTargetDatabaseVersion(t *testing.T) {
 	}{
 		{"postgres (PostgreSQL) 10.6 (Debian 10.6-1.pgdg90+1)", 10.6},
 		{"PostgreSQL 9.5.14", 9.5},
+		{"13", 13},
 	}
 
 	fs := afero.NewMemMapFs()
@@ -105,6 +106,53 @@ func TestShouldDetermineTargetDatabaseVersion(t *testing.T) {
 	}
 }
 
+func Test_PostgresHops(t *testing.T) {
+	tests := []struct {
+		current  float64
+		target   float64
+		expected []float64
+		ok       bool
+	}{
+		{9.6, 12, []float64{10, 12}, true},
+		{9.6, 10, []float64{10}, true},
+		{10, 12, []float64{12}, true},
+		{12, 12, nil, true},
+		{9.4, 12, nil, false},
+	}
+
+	for _, test := range tests {
+		hops, ok := postgresHops(test.current, test.target)
+		if ok != test.ok {
+			t.Fatalf("postgresHops(%v, %v): expected ok=%v, got %v", test.current, test.target, test.ok, ok)
+		}
+		if len(hops) != len(test.expected) {
+			t.Fatalf("postgresHops(%v, %v): expected hops %v, got %v", test.current, test.target, test.expected, hops)
+		}
+		for i := range hops {
+			if hops[i] != test.expected[i] {
+				t.Fatalf("postgresHops(%v, %v): expected hops %v, got %v", test.current, test.target, test.expected, hops)
+			}
+		}
+	}
+}
+
+func Test_PostgresImageForVersion(t *testing.T) {
+	tests := []struct {
+		version  float64
+		expected string
+	}{
+		{9.6, "centos/postgresql-96-centos7"},
+		{10, "centos/postgresql-10-centos7"},
+		{12, "centos/postgresql-12-centos7"},
+	}
+
+	for _, test := range tests {
+		if got := postgresImageForVersion(test.version); got != test.expected {
+			t.Fatalf("postgresImageForVersion(%v): expected %s, got %s", test.version, test.expected, got)
+		}
+	}
+}
+
 func TestShouldRunOnlyWhenTargetVersionIsNewerThanCurrent(t *testing.T) {
 	tests := []struct {
 		target   float64
@@ -124,7 +172,7 @@ func TestShouldRunOnlyWhenTargetVersionIsNewerThanCurrent(t *testing.T) {
 			syndesis: &v1beta1.Syndesis{
 				Spec: v1beta1.SyndesisSpec{
 					Components: v1beta1.ComponentsSpec{
-						Database: v1beta1.DatabaseConfiguration{},
+						Database: v1beta1.DatabaseConfiguration{ApproveUpgrade: true},
 					},
 				},
 			},
@@ -138,23 +186,31 @@ func TestShouldRunOnlyWhenTargetVersionIsNewerThanCurrent(t *testing.T) {
 	}
 }
 
-func TestRunDatabaseUpgrade(t *testing.T) {
-	configuration.TemplateConfig = "../../../build/conf/config-test.yaml"
+func TestShouldNotRunWithoutApproval(t *testing.T) {
+	u := databaseUpgrade{
+		step: step{
+			log: zapr.NewLogger(zap.NewNop()),
+		},
+		syndesis: &v1beta1.Syndesis{
+			Spec: v1beta1.SyndesisSpec{
+				Components: v1beta1.ComponentsSpec{
+					Database: v1beta1.DatabaseConfiguration{},
+				},
+			},
+		},
+		target:  func() (float64, error) { return 2.0, nil },
+		current: func() (float64, error) { return 1.0, nil },
+	}
 
-	schemeToUse := scheme.Scheme
-	if err := oappsv1.Install(schemeToUse); err != nil {
-		t.Fatal(err)
+	if u.canRun() {
+		t.Fatal("A newer target version should not be applied until spec.components.database.approveUpgrade is set")
 	}
+}
 
-	cl := fake.NewFakeClientWithScheme(schemeToUse, &oappsv1.DeploymentConfig{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: "syndesis-db",
-		},
-		Status: oappsv1.DeploymentConfigStatus{
-			Replicas:      1,
-			ReadyReplicas: 1,
-		},
-	})
+func TestDeployUpgradeCreatesJob(t *testing.T) {
+	configuration.TemplateConfig = "../../../build/conf/config-test.yaml"
+
+	cl := fake.NewFakeClientWithScheme(scheme.Scheme)
 	clientTools := syntesting.FakeClientTools()
 	clientTools.SetRuntimeClient(cl)
 	u := databaseUpgrade{
@@ -164,36 +220,38 @@ func TestRunDatabaseUpgrade(t *testing.T) {
 			clientTools: clientTools,
 		},
 		syndesis: &v1beta1.Syndesis{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"},
 			Spec: v1beta1.SyndesisSpec{
 				Components: v1beta1.ComponentsSpec{
 					Database: v1beta1.DatabaseConfiguration{},
 				},
 			},
 		},
-		target:  func() (float64, error) { return 2.0, nil },
-		current: func() (float64, error) { return 1.0, nil },
-		cleanup: func() error { return nil },
 	}
 
-	if err := u.run(); err != nil {
+	if err := u.deployUpgrade("centos/postgresql-10-centos7"); err != nil {
 		t.Fatal(err)
 	}
 
-	deployments := appsv1.DeploymentList{}
-	if err := cl.List(u.context, &deployments); err != nil {
+	jobs := batchv1.JobList{}
+	if err := cl.List(u.context, &jobs); err != nil {
 		t.Fatal(err)
 	}
 
-	if len(deployments.Items) != 1 {
-		t.Fatalf("Expected the database upgrade Deployment to be created, but there are %v deployments", len(deployments.Items))
+	if len(jobs.Items) != 1 {
+		t.Fatalf("Expected the database upgrade Job to be created, but there are %v jobs", len(jobs.Items))
+	}
+
+	job := jobs.Items[0]
+	if !strings.HasPrefix(job.ObjectMeta.Name, "syndesis-db-upgrade") {
+		t.Fatalf("Expected the database upgrade job to be created, but there's a job named: %v", job.ObjectMeta.Name)
 	}
 
-	deployment := deployments.Items[0]
-	if !strings.HasPrefix(deployment.ObjectMeta.Name, "syndesis-db-upgrade") {
-		t.Fatalf("Expected the database upgrade deployment to be created, but there's a deployment named: %v", deployment.ObjectMeta.Name)
+	if job.Spec.Template.Spec.RestartPolicy != corev1.RestartPolicyNever {
+		t.Fatalf("Expected the database upgrade job's pod to never restart, got: %v", job.Spec.Template.Spec.RestartPolicy)
 	}
 
-	for _, container := range deployment.Spec.Template.Spec.Containers {
+	for _, container := range job.Spec.Template.Spec.Containers {
 		if container.Name == "postgresql" {
 			for _, env := range container.Env {
 				if env.Name == "POSTGRESQL_UPGRADE" && env.Value == "copy" {
@@ -203,14 +261,15 @@ func TestRunDatabaseUpgrade(t *testing.T) {
 		}
 	}
 
-	t.Fatalf("Could not find the `postgresql` container with environment variable `POSTGRESQL_UPGRADE=copy` in deployment: %v", deployment)
+	t.Fatalf("Could not find the `postgresql` container with environment variable `POSTGRESQL_UPGRADE=copy` in job: %v", job)
 }
 
-func TestShouldDeleteUpgradeDeployment(t *testing.T) {
+func TestShouldDeleteUpgradeJob(t *testing.T) {
 	configuration.TemplateConfig = "../../../build/conf/config-test.yaml"
-	cl := fake.NewFakeClient(&appsv1.Deployment{
-		ObjectMeta: upgradeMetadata,
-	})
+	cl := fake.NewFakeClient(
+		&batchv1.Job{ObjectMeta: upgradeMetadata},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "syndesis-db-upgrade-abcde", Labels: map[string]string{"job-name": upgradeJobName}}},
+	)
 	clientTools := clienttools.ClientTools{}
 	clientTools.SetRuntimeClient(cl)
 	u := databaseUpgrade{
@@ -225,21 +284,26 @@ func TestShouldDeleteUpgradeDeployment(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	deployments := appsv1.DeploymentList{}
-	if err := cl.List(u.context, &deployments); err != nil {
+	jobs := batchv1.JobList{}
+	if err := cl.List(u.context, &jobs); err != nil {
 		t.Fatal(err)
 	}
+	if len(jobs.Items) != 0 {
+		t.Fatalf("Expected the database upgrade Job to be deleted, but it wasn't, there are %v jobs", len(jobs.Items))
+	}
 
-	if len(deployments.Items) != 0 {
-		t.Fatalf("Expected the database upgrade Deployment to be deleted, but it wasn't, there are %v deployments", len(deployments.Items))
+	pods := corev1.PodList{}
+	if err := cl.List(u.context, &pods); err != nil {
+		t.Fatal(err)
+	}
+	if len(pods.Items) != 0 {
+		t.Fatalf("Expected the database upgrade job's pods to be deleted, but they weren't, there are %v pods", len(pods.Items))
 	}
 }
 
-func TestOnRollbackShouldDeleteUpgradeDeployment(t *testing.T) {
+func TestOnRollbackShouldDeleteUpgradeJob(t *testing.T) {
 	configuration.TemplateConfig = "../../../build/conf/config-test.yaml"
-	cl := fake.NewFakeClient(&appsv1.Deployment{
-		ObjectMeta: upgradeMetadata,
-	})
+	cl := fake.NewFakeClient(&batchv1.Job{ObjectMeta: upgradeMetadata})
 	clientTools := clienttools.ClientTools{}
 	clientTools.SetRuntimeClient(cl)
 	u := databaseUpgrade{
@@ -254,12 +318,12 @@ func TestOnRollbackShouldDeleteUpgradeDeployment(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	deployments := appsv1.DeploymentList{}
-	if err := cl.List(u.context, &deployments); err != nil {
+	jobs := batchv1.JobList{}
+	if err := cl.List(u.context, &jobs); err != nil {
 		t.Fatal(err)
 	}
 
-	if len(deployments.Items) != 0 {
-		t.Fatalf("Expected the database upgrade Deployment to be deleted, but it wasn't, there are %v deployments", len(deployments.Items))
+	if len(jobs.Items) != 0 {
+		t.Fatalf("Expected the database upgrade Job to be deleted, but it wasn't, there are %v jobs", len(jobs.Items))
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	sbackup "github.com/syndesisio/syndesis/install/operator/pkg/syndesis/backup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type BackupTester struct {
@@ -45,6 +46,10 @@ func (bt BackupTester) RestoreDb() error {
 	return nil
 }
 
+func (bt BackupTester) RestoreWAL(basebackup string, recoverTo *metav1.Time) error {
+	return nil
+}
+
 func (bt BackupTester) BuildBackupDir(path string) (b *sbackup.Backup, err error) {
 	return &sbackup.Backup{}, nil
 }
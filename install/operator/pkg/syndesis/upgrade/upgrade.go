@@ -65,6 +65,8 @@ type stepRunner interface {
 	canRollback() (r bool)
 	run() (err error)
 	rollback() (err error)
+	stepName() string
+	resume(executed bool)
 }
 
 type failure struct {
@@ -97,10 +99,13 @@ func (u *upgrade) Upgrade() (err error) {
 	for _, step := range u.steps {
 		if step.canRun() {
 			step.infoRun()
+			u.recordStepStarted(step.stepName())
 			if err = step.run(); err != nil {
+				u.recordStepFinished(step.stepName(), v1beta1.SyndesisInstallStageStateFailed, err.Error())
 				u.attempts = append(u.attempts, failure{S: step, T: time.Now(), Err: err})
 				return
 			}
+			u.recordStepFinished(step.stepName(), v1beta1.SyndesisInstallStageStateCompleted, "")
 		}
 	}
 
@@ -123,6 +128,7 @@ func (u *upgrade) Rollback() (err error) {
 		}
 
 		u.attempts = []result{}
+		u.resetStepStatus()
 	default:
 		u.log.Info("I should roll back from a Failure, but got something different", "last attempt", v)
 	}
@@ -130,6 +136,72 @@ func (u *upgrade) Rollback() (err error) {
 	return
 }
 
+// recordStepStarted persists that the named step has started, so a crash while it is running
+// leaves the CR showing it as InProgress rather than silently missing
+func (u *upgrade) recordStepStarted(name string) {
+	if u.clientTools == nil || u.syndesis == nil {
+		return
+	}
+
+	rtClient, err := u.clientTools.RuntimeClient()
+	if err != nil {
+		u.log.Error(err, "failed to record upgrade step as started", "step", name)
+		return
+	}
+
+	target := u.syndesis.DeepCopy()
+	target.Status.SetUpgradeStepStarted(v1beta1.UpgradeStep(name))
+	if err := rtClient.Update(u.ctx, target); err != nil {
+		u.log.Error(err, "failed to record upgrade step as started", "step", name)
+		return
+	}
+	u.syndesis = target
+}
+
+// recordStepFinished persists the outcome of the named step, so a later restart of the
+// operator can tell it was already completed and skip running it again
+func (u *upgrade) recordStepFinished(name string, state v1beta1.SyndesisInstallStageState, message string) {
+	if u.clientTools == nil || u.syndesis == nil {
+		return
+	}
+
+	rtClient, err := u.clientTools.RuntimeClient()
+	if err != nil {
+		u.log.Error(err, "failed to record upgrade step outcome", "step", name)
+		return
+	}
+
+	target := u.syndesis.DeepCopy()
+	target.Status.SetUpgradeStepFinished(v1beta1.UpgradeStep(name), state, message)
+	if err := rtClient.Update(u.ctx, target); err != nil {
+		u.log.Error(err, "failed to record upgrade step outcome", "step", name)
+		return
+	}
+	u.syndesis = target
+}
+
+// resetStepStatus clears the persisted step-by-step progress once a failed upgrade has been
+// rolled back, so the next attempt starts tracking a clean slate
+func (u *upgrade) resetStepStatus() {
+	if u.clientTools == nil || u.syndesis == nil {
+		return
+	}
+
+	rtClient, err := u.clientTools.RuntimeClient()
+	if err != nil {
+		u.log.Error(err, "failed to reset persisted upgrade step state after rollback")
+		return
+	}
+
+	target := u.syndesis.DeepCopy()
+	target.Status.UpgradeSteps = nil
+	if err := rtClient.Update(u.ctx, target); err != nil {
+		u.log.Error(err, "failed to reset persisted upgrade step state after rollback")
+		return
+	}
+	u.syndesis = target
+}
+
 // Add a failure for install step and return the total failures of this kind
 func (u *upgrade) InstallFailed() (count int) {
 	count = 0
@@ -180,11 +252,21 @@ func Build(ctx context.Context, log logr.Logger, syndesis *v1beta1.Syndesis, cli
 		newScale(base).down(),
 		bbkp,
 		newMigration(base, u.syndesis, u.backup),
-		newDatabaseUpgrade(base, syndesis),
+		newDatabaseUpgrade(base, syndesis, u.backup),
 		newInstall(base, u.backup),
 		newCleanup(base),
 	}
 
+	// Resume from where a previous attempt left off: if the operator restarted mid-upgrade,
+	// the CR still has a record of which steps already completed successfully, so mark those
+	// as executed rather than running the whole upgrade again from scratch
+	for _, s := range u.steps {
+		if persisted := syndesis.Status.GetUpgradeStep(v1beta1.UpgradeStep(s.stepName())); persisted != nil &&
+			persisted.State == v1beta1.SyndesisInstallStageStateCompleted {
+			s.resume(true)
+		}
+	}
+
 	return u, nil
 }
 
@@ -196,6 +278,16 @@ func (s step) canRollback() (r bool) {
 	return s.executed
 }
 
+func (s step) stepName() string {
+	return s.name
+}
+
+// resume seeds this step's executed flag from a previous attempt, so that a step already
+// completed before an operator restart is skipped rather than run again
+func (s *step) resume(executed bool) {
+	s.executed = executed
+}
+
 func (s step) infoRun() {
 	s.log.Info("running step", "step", s.name)
 }
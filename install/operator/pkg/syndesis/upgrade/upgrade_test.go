@@ -29,6 +29,7 @@ func (stepTestOk) run() (err error)      { return nil }
 func (stepTestOk) rollback() (err error) { return nil }
 func (stepTestOk) infoRun()              {}
 func (stepTestOk) infoRollback()         {}
+func (stepTestOk) resume(executed bool)  {}
 
 type stepTestFail struct{ step }
 
@@ -36,6 +37,7 @@ func (stepTestFail) run() (err error)      { return fmt.Errorf("") }
 func (stepTestFail) rollback() (err error) { return nil }
 func (stepTestFail) infoRun()              {}
 func (stepTestFail) infoRollback()         {}
+func (stepTestFail) resume(executed bool)  {}
 
 func TestUpgrade_InstallFailed(t *testing.T) {
 	u := &upgrade{attempts: []result{}}
@@ -45,18 +45,62 @@ type SyndesisAPIMigrator interface {
 }
 
 type syndesisAPI struct {
-	client           client.Client
-	context          context.Context
-	log              logr.Logger
-	unstructuredApis *unstructured.UnstructuredList
-	v1alpha1         *v1alpha1.Syndesis
-	v1beta1          *v1beta1.Syndesis
+	client   client.Client
+	context  context.Context
+	log      logr.Logger
+	v1alpha1 *v1alpha1.Syndesis
+	v1beta1  *v1beta1.Syndesis
 }
 
-// APIMigrator build and return an SyndesisAPIMigrator interface
+// APIMigrator build and return an SyndesisAPIMigrator interface for the single namespace n.
+// It assumes at most one Syndesis CR (of either API version) exists in that namespace.
 func APIMigrator(ctx context.Context, c client.Client, n string) (r SyndesisAPIMigrator, err error) {
-	// Fetch all existing apis in an unstructured list. It is necessary to use an unstructured list
-	// because different apis might have a different structure
+	v1alpha1s, v1beta1s, err := listApis(ctx, c, n)
+	if err != nil {
+		return nil, err
+	}
+	return buildMigrator(c, ctx, n, v1alpha1s, v1beta1s)
+}
+
+// APIMigrators build and return one SyndesisAPIMigrator per namespace that has a Syndesis CR.
+// It is used in cluster-scoped (WATCH_NAMESPACE="") mode, where several namespaces may each hold
+// their own, independent Syndesis installation, so the "at most one CR" rule from APIMigrator is
+// enforced per namespace rather than cluster-wide.
+func APIMigrators(ctx context.Context, c client.Client) (migrators []SyndesisAPIMigrator, err error) {
+	v1alpha1s, v1beta1s, err := listApis(ctx, c, "")
+	if err != nil {
+		return nil, err
+	}
+
+	byNamespace := map[string]struct {
+		v1alpha1s []*v1alpha1.Syndesis
+		v1beta1s  []*v1beta1.Syndesis
+	}{}
+	for _, sa := range v1alpha1s {
+		entry := byNamespace[sa.Namespace]
+		entry.v1alpha1s = append(entry.v1alpha1s, sa)
+		byNamespace[sa.Namespace] = entry
+	}
+	for _, sb := range v1beta1s {
+		entry := byNamespace[sb.Namespace]
+		entry.v1beta1s = append(entry.v1beta1s, sb)
+		byNamespace[sb.Namespace] = entry
+	}
+
+	for namespace, entry := range byNamespace {
+		migrator, err := buildMigrator(c, ctx, namespace, entry.v1alpha1s, entry.v1beta1s)
+		if err != nil {
+			return nil, err
+		}
+		migrators = append(migrators, migrator)
+	}
+	return migrators, nil
+}
+
+// listApis fetches all existing Syndesis apis in namespace n (or every namespace, when n is empty)
+// as an unstructured list, since different api versions have a different structure, and splits
+// them by the version each item actually is.
+func listApis(ctx context.Context, c client.Client, n string) (v1alpha1s []*v1alpha1.Syndesis, v1beta1s []*v1beta1.Syndesis, err error) {
 	list := &unstructured.UnstructuredList{
 		Object: map[string]interface{}{
 			"kind":       "Syndesis",
@@ -74,25 +118,11 @@ func APIMigrator(ctx context.Context, c client.Client, n string) (r SyndesisAPIM
 		},
 	}
 	if err := c.List(ctx, list, options); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	api := syndesisAPI{
-		client:           c,
-		context:          ctx,
-		log:              logf.Log.WithName("versions").WithValues("version from", "v1alpha1", "version to", "v1beta1"),
-		unstructuredApis: list,
-		v1beta1: &v1beta1.Syndesis{
-			TypeMeta: metav1.TypeMeta{Kind: "Syndesis", APIVersion: "syndesis.io/v1beta1"},
-		},
-		v1alpha1: &v1alpha1.Syndesis{
-			TypeMeta: metav1.TypeMeta{Kind: "Syndesis", APIVersion: "syndesis.io/v1alpha1"},
-		},
-	}
-
-	v1alpha1s := []*v1alpha1.Syndesis{}
-	v1beta1s := []*v1beta1.Syndesis{}
-	for _, a := range api.unstructuredApis.Items {
+	api := syndesisAPI{}
+	for _, a := range list.Items {
 		sb, err := api.unstructuredToV1Beta1(a)
 		if err != nil {
 			sa, err := api.unstructuredToV1Alpha1(a)
@@ -103,16 +133,32 @@ func APIMigrator(ctx context.Context, c client.Client, n string) (r SyndesisAPIM
 			v1beta1s = append(v1beta1s, sb)
 		}
 	}
+	return v1alpha1s, v1beta1s, nil
+}
+
+// buildMigrator builds a syndesisAPI scoped to namespace n from the api instances already found there
+func buildMigrator(c client.Client, ctx context.Context, n string, v1alpha1s []*v1alpha1.Syndesis, v1beta1s []*v1beta1.Syndesis) (r SyndesisAPIMigrator, err error) {
+	api := syndesisAPI{
+		client:  c,
+		context: ctx,
+		log:     logf.Log.WithName("versions").WithValues("version from", "v1alpha1", "version to", "v1beta1", "namespace", n),
+		v1beta1: &v1beta1.Syndesis{
+			TypeMeta: metav1.TypeMeta{Kind: "Syndesis", APIVersion: "syndesis.io/v1beta1"},
+		},
+		v1alpha1: &v1alpha1.Syndesis{
+			TypeMeta: metav1.TypeMeta{Kind: "Syndesis", APIVersion: "syndesis.io/v1alpha1"},
+		},
+	}
 
 	/*
-	 * We support at most, one instance of each api. We can have:
+	 * We support at most, one instance of each api per namespace. We can have:
 	 * - 1x v1alpha1 0x v1beta1. It can be an upgrade where the administrator installing the operator didn't create
 	 * a v1beta1. In this case, we will create an empty v1beta1 and migrate from v1alpha1
 	 *
 	 * - 0x v1alpha1 1x v1beta1. This is the desired state and we do nothing
 	 */
 	if len(v1alpha1s)+len(v1beta1s) > 1 {
-		return nil, fmt.Errorf("unsupported ammount of apis v1alpha: %d, v1beta1: %d", len(v1alpha1s), len(v1beta1s))
+		return nil, fmt.Errorf("unsupported ammount of apis in namespace %s, v1alpha: %d, v1beta1: %d", n, len(v1alpha1s), len(v1beta1s))
 	}
 
 	// Fetch v1alpha1 from kubernetes if it exists
@@ -181,123 +227,135 @@ func (api syndesisAPI) Migrate() (err error) {
 func (api syndesisAPI) v1alpha1ToV1beta1() error {
 	// We migrate only if v1alpha1 wasn't migrated before and v1beta1 explicitly indicates to be migrated
 	if api.v1alpha1 != nil && api.v1alpha1.Status.Phase == v1alpha1.SyndesisPhaseInstalled && api.v1beta1.Spec.ForceMigration {
-		// Migrate addons
-		for k, addon := range api.v1alpha1.Spec.Addons {
-			switch k {
-			case "ops":
-				api.v1beta1.Spec.Addons.Ops.Enabled = addon["enabled"] == "true"
-			case "todo":
-				api.v1beta1.Spec.Addons.Todo.Enabled = addon["enabled"] == "true"
-			case "camelk":
-				api.v1beta1.Spec.Addons.CamelK.Enabled = addon["enabled"] == "true"
-			case "komodo":
-				api.v1beta1.Spec.Addons.DV.Enabled = addon["enabled"] == "true"
-			case "jaeger":
-				api.v1beta1.Spec.Addons.Jaeger.Enabled = addon["enabled"] == "true"
-			}
-		}
-
-		// Migrate maven repositories
-		if len(api.v1alpha1.Spec.MavenRepositories) != 0 {
-			api.v1beta1.Spec.Components.Server.Features.MavenRepositories = map[string]string{}
-			for k, v := range api.v1alpha1.Spec.MavenRepositories {
-				api.v1beta1.Spec.Components.Server.Features.MavenRepositories[k] = v
-			}
-		}
+		ConvertV1Alpha1ToV1Beta1(api.v1alpha1, api.v1beta1)
+	}
 
-		// Migrate Integrations
-		if api.v1alpha1.Spec.Integration.Limit != nil {
-			api.v1beta1.Spec.Components.Server.Features.IntegrationLimit = *api.v1alpha1.Spec.Integration.Limit
-		}
-		if api.v1alpha1.Spec.Integration.StateCheckInterval != nil {
-			api.v1beta1.Spec.Components.Server.Features.IntegrationStateCheckInterval = *api.v1alpha1.Spec.Integration.StateCheckInterval
-		}
+	return nil
+}
 
-		// Server
-		if api.v1alpha1.Spec.Components.Server.Features.ManagementURLFor3scale != "" {
-			api.v1beta1.Spec.Components.Server.Features.ManagementURLFor3scale = api.v1alpha1.Spec.Components.Server.Features.ManagementURLFor3scale
-		}
-		if api.v1alpha1.Spec.Components.Server.Resources.Limits != nil {
-			if m, ok := api.v1alpha1.Spec.Components.Server.Resources.Limits[v1.ResourceMemory]; ok {
-				api.v1beta1.Spec.Components.Server.Resources.Memory = m.String()
+// ConvertV1Alpha1ToV1Beta1 copies the src fields mapped below onto dst, and marks dst as having
+// been migrated. Other src fields, such as demoData and deployIntegrations, are not carried over. It performs no I/O and is safe to call outside a reconcile,
+// which keeps it reusable both by the startup migration this package drives today and, once
+// sigs.k8s.io/controller-runtime/pkg/webhook/conversion is vendored into this tree (it is not, as
+// of this snapshot), by a CRD conversion webhook that would otherwise need this same mapping.
+func ConvertV1Alpha1ToV1Beta1(src *v1alpha1.Syndesis, dst *v1beta1.Syndesis) {
+	// Migrate addons
+	for k, addon := range src.Spec.Addons {
+		switch k {
+		case "ops":
+			dst.Spec.Addons.Ops.Enabled = addon["enabled"] == "true"
+		case "todo":
+			if addon["enabled"] == "true" {
+				dst.Spec.Addons.SampleApps = append(dst.Spec.Addons.SampleApps, v1beta1.SampleAppConfiguration{Name: "todo"})
 			}
+		case "camelk":
+			dst.Spec.Addons.CamelK.Enabled = addon["enabled"] == "true"
+		case "komodo":
+			dst.Spec.Addons.DV.Enabled = addon["enabled"] == "true"
+		case "jaeger":
+			dst.Spec.Addons.Jaeger.Enabled = addon["enabled"] == "true"
 		}
+	}
 
-		// Database
-		if api.v1alpha1.Spec.Components.Db.Database != "" {
-			api.v1beta1.Spec.Components.Database.Name = api.v1alpha1.Spec.Components.Db.Database
-		}
-		if api.v1alpha1.Spec.Components.Db.Resources.Limits != nil {
-			if m, ok := api.v1alpha1.Spec.Components.Db.Resources.Limits[v1.ResourceMemory]; ok {
-				api.v1beta1.Spec.Components.Database.Resources.Memory = m.String()
-			}
-		}
-		if api.v1alpha1.Spec.Components.Db.Resources.VolumeCapacity != "" {
-			api.v1beta1.Spec.Components.Database.Resources.VolumeCapacity = api.v1alpha1.Spec.Components.Db.Resources.VolumeCapacity
-		}
-		if api.v1alpha1.Spec.Components.Db.User != "" {
-			api.v1beta1.Spec.Components.Database.User = api.v1alpha1.Spec.Components.Db.User
+	// Migrate maven repositories
+	if len(src.Spec.MavenRepositories) != 0 {
+		dst.Spec.Components.Server.Features.MavenRepositories = map[string]string{}
+		for k, v := range src.Spec.MavenRepositories {
+			dst.Spec.Components.Server.Features.MavenRepositories[k] = v
 		}
+	}
 
-		// Oauth
-		if api.v1alpha1.Spec.Components.Oauth.DisableSarCheck != nil {
-			api.v1beta1.Spec.Components.Oauth.DisableSarCheck = *api.v1alpha1.Spec.Components.Oauth.DisableSarCheck
-		}
+	// Migrate Integrations
+	if src.Spec.Integration.Limit != nil {
+		dst.Spec.Components.Server.Features.IntegrationLimit = *src.Spec.Integration.Limit
+	}
+	if src.Spec.Integration.StateCheckInterval != nil {
+		dst.Spec.Components.Server.Features.IntegrationStateCheckInterval = *src.Spec.Integration.StateCheckInterval
+	}
 
-		// Meta
-		if api.v1alpha1.Spec.Components.Meta.Resources.Limits != nil {
-			if m, ok := api.v1alpha1.Spec.Components.Meta.Resources.Limits[v1.ResourceMemory]; ok {
-				api.v1beta1.Spec.Components.Meta.Resources.Memory = m.String()
-			}
-		}
-		if api.v1alpha1.Spec.Components.Meta.Resources.VolumeCapacity != "" {
-			api.v1beta1.Spec.Components.Meta.Resources.VolumeCapacity = api.v1alpha1.Spec.Components.Meta.Resources.VolumeCapacity
+	// Server
+	if src.Spec.Components.Server.Features.ManagementURLFor3scale != "" {
+		dst.Spec.Addons.ThreeScale.Enabled = true
+		dst.Spec.Addons.ThreeScale.ManagementURL = src.Spec.Components.Server.Features.ManagementURLFor3scale
+	}
+	if src.Spec.Components.Server.Resources.Limits != nil {
+		if m, ok := src.Spec.Components.Server.Resources.Limits[v1.ResourceMemory]; ok {
+			dst.Spec.Components.Server.Resources.Memory = m.String()
 		}
+	}
 
-		// Prometheus
-		if api.v1alpha1.Spec.Components.Prometheus.Resources.Limits != nil {
-			if m, ok := api.v1alpha1.Spec.Components.Prometheus.Resources.Limits[v1.ResourceMemory]; ok {
-				api.v1beta1.Spec.Components.Prometheus.Resources.Memory = m.String()
-			}
-		}
-		if api.v1alpha1.Spec.Components.Prometheus.Resources.VolumeCapacity != "" {
-			api.v1beta1.Spec.Components.Prometheus.Resources.VolumeCapacity = api.v1alpha1.Spec.Components.Prometheus.Resources.VolumeCapacity
+	// Database
+	if src.Spec.Components.Db.Database != "" {
+		dst.Spec.Components.Database.Name = src.Spec.Components.Db.Database
+	}
+	if src.Spec.Components.Db.Resources.Limits != nil {
+		if m, ok := src.Spec.Components.Db.Resources.Limits[v1.ResourceMemory]; ok {
+			dst.Spec.Components.Database.Resources.Memory = m.String()
 		}
+	}
+	if src.Spec.Components.Db.Resources.VolumeCapacity != "" {
+		dst.Spec.Components.Database.Resources.VolumeCapacity = src.Spec.Components.Db.Resources.VolumeCapacity
+	}
+	if src.Spec.Components.Db.User != "" {
+		dst.Spec.Components.Database.User = src.Spec.Components.Db.User
+	}
 
-		// Grafana
-		if api.v1alpha1.Spec.Components.Grafana.Resources.Limits != nil {
-			if m, ok := api.v1alpha1.Spec.Components.Grafana.Resources.Limits[v1.ResourceMemory]; ok {
-				api.v1beta1.Spec.Components.Grafana.Resources.Memory = m.String()
-			}
+	// Oauth
+	if src.Spec.Components.Oauth.DisableSarCheck != nil {
+		dst.Spec.Components.Oauth.DisableSarCheck = *src.Spec.Components.Oauth.DisableSarCheck
+	}
+
+	// Meta
+	if src.Spec.Components.Meta.Resources.Limits != nil {
+		if m, ok := src.Spec.Components.Meta.Resources.Limits[v1.ResourceMemory]; ok {
+			dst.Spec.Components.Meta.Resources.Memory = m.String()
 		}
+	}
+	if src.Spec.Components.Meta.Resources.VolumeCapacity != "" {
+		dst.Spec.Components.Meta.Resources.VolumeCapacity = src.Spec.Components.Meta.Resources.VolumeCapacity
+	}
 
-		// Komodo
-		if api.v1alpha1.Spec.Components.Komodo.Resources.Limits != nil {
-			if m, ok := api.v1alpha1.Spec.Components.Komodo.Resources.Limits[v1.ResourceMemory]; ok {
-				api.v1beta1.Spec.Addons.DV.Resources.Memory = m.String()
-			}
+	// Prometheus
+	if src.Spec.Components.Prometheus.Resources.Limits != nil {
+		if m, ok := src.Spec.Components.Prometheus.Resources.Limits[v1.ResourceMemory]; ok {
+			dst.Spec.Components.Prometheus.Resources.Memory = m.String()
 		}
+	}
+	if src.Spec.Components.Prometheus.Resources.VolumeCapacity != "" {
+		dst.Spec.Components.Prometheus.Resources.VolumeCapacity = src.Spec.Components.Prometheus.Resources.VolumeCapacity
+	}
 
-		// General
-		if api.v1alpha1.Spec.RouteHostname != "" {
-			api.v1beta1.Spec.RouteHostname = api.v1alpha1.Spec.RouteHostname
+	// Grafana
+	if src.Spec.Components.Grafana.Resources.Limits != nil {
+		if m, ok := src.Spec.Components.Grafana.Resources.Limits[v1.ResourceMemory]; ok {
+			dst.Spec.Components.Grafana.Resources.Memory = m.String()
 		}
+	}
 
-		if api.v1alpha1.Spec.SarNamespace != "" {
-			api.v1beta1.Spec.Components.Oauth.SarNamespace = api.v1alpha1.Spec.SarNamespace
+	// Komodo
+	if src.Spec.Components.Komodo.Resources.Limits != nil {
+		if m, ok := src.Spec.Components.Komodo.Resources.Limits[v1.ResourceMemory]; ok {
+			dst.Spec.Addons.DV.Resources.Memory = m.String()
 		}
+	}
 
-		// We dont want to migrate again more than once
-		api.v1beta1.Spec.ForceMigration = false
+	// General
+	if src.Spec.RouteHostname != "" {
+		dst.Spec.RouteHostname = src.Spec.RouteHostname
+	}
 
-		// We need the same status and version in the target as in the origin
-		api.v1beta1.Status.Version = api.v1alpha1.Status.Version
-		api.v1beta1.Status.Phase = v1beta1.SyndesisPhaseInstalled
-		api.v1beta1.Status.Reason = v1beta1.SyndesisStatusReasonMigrated
-		api.v1beta1.Status.Description = fmt.Sprintf("App migrated from %s to %s", v1alpha1.SchemeGroupVersion.String(), v1beta1.SchemeGroupVersion.String())
+	if src.Spec.SarNamespace != "" {
+		dst.Spec.Components.Oauth.SarNamespace = src.Spec.SarNamespace
 	}
 
-	return nil
+	// We dont want to migrate again more than once
+	dst.Spec.ForceMigration = false
+
+	// We need the same status and version in the target as in the origin
+	dst.Status.Version = src.Status.Version
+	dst.Status.Phase = v1beta1.SyndesisPhaseInstalled
+	dst.Status.Reason = v1beta1.SyndesisStatusReasonMigrated
+	dst.Status.Description = fmt.Sprintf("App migrated from %s to %s", v1alpha1.SchemeGroupVersion.String(), v1beta1.SchemeGroupVersion.String())
 }
 
 // Write back apis
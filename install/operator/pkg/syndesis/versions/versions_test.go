@@ -91,8 +91,8 @@ func Test_syndesisAPI_unstructuredToV1Beta1(t *testing.T) {
 		},
 		{
 			"An instance v1alpha1 with mismatching data should return error",
-			args{obj: getRuntimeObjectAsUnstructured(&v1beta1.Syndesis{Spec: v1beta1.SyndesisSpec{Addons: v1beta1.AddonsSpec{Todo: v1beta1.AddonSpec{Enabled: true}}}})},
-			&v1beta1.Syndesis{Spec: v1beta1.SyndesisSpec{Addons: v1beta1.AddonsSpec{Todo: v1beta1.AddonSpec{Enabled: true}}}}, false,
+			args{obj: getRuntimeObjectAsUnstructured(&v1beta1.Syndesis{Spec: v1beta1.SyndesisSpec{Addons: v1beta1.AddonsSpec{Ops: v1beta1.AddonSpec{Enabled: true}}}})},
+			&v1beta1.Syndesis{Spec: v1beta1.SyndesisSpec{Addons: v1beta1.AddonsSpec{Ops: v1beta1.AddonSpec{Enabled: true}}}}, false,
 		},
 	}
 	for _, tt := range tests {
@@ -269,11 +269,12 @@ func Test_syndesisAPI_v1alpha1ToV1beta1(t *testing.T) {
 					ForceMigration: false,
 					RouteHostname:  "routehostname",
 					Addons: v1beta1.AddonsSpec{
-						Jaeger: v1beta1.JaegerConfiguration{Enabled: false},
-						Ops:    v1beta1.AddonSpec{Enabled: true},
-						Todo:   v1beta1.AddonSpec{Enabled: true},
-						DV:     v1beta1.DvConfiguration{Enabled: true, Resources: v1beta1.Resources{Memory: "500m"}},
-						CamelK: v1beta1.AddonSpec{Enabled: false},
+						Jaeger:     v1beta1.JaegerConfiguration{Enabled: false},
+						Ops:        v1beta1.AddonSpec{Enabled: true},
+						SampleApps: []v1beta1.SampleAppConfiguration{{Name: "todo"}},
+						DV:         v1beta1.DvConfiguration{Enabled: true, Resources: v1beta1.DvResources{Memory: "500m"}},
+						CamelK:     v1beta1.CamelKConfiguration{Enabled: false},
+						ThreeScale: v1beta1.ThreeScaleConfiguration{Enabled: true, ManagementURL: "ManagementURLFor3scale"},
 					},
 					Components: v1beta1.ComponentsSpec{
 						Oauth: v1beta1.OauthConfiguration{SarNamespace: "sar namespace", DisableSarCheck: dsc},
@@ -281,7 +282,6 @@ func Test_syndesisAPI_v1alpha1ToV1beta1(t *testing.T) {
 							Features: v1beta1.ServerFeatures{
 								IntegrationLimit:              il,
 								IntegrationStateCheckInterval: ici,
-								ManagementURLFor3scale:        "ManagementURLFor3scale",
 								MavenRepositories: map[string]string{
 									"repo1": "repo1url",
 									"repo2": "repo2url",
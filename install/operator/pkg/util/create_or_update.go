@@ -157,7 +157,17 @@ func mergeValue(path string, to interface{}, from interface{}, skip map[string]b
 	case "apps/v1/Deployment/spec/triggers/#/imageChangeParams/from/namespace":
 		return to
 	case "v1/PersistentVolumeClaim/spec/resources/requests/storage":
-		return to
+		// Kubernetes rejects a decrease outright and only permits an increase when the PVC's
+		// storage class has allowVolumeExpansion enabled (the API server itself reports the error
+		// otherwise, which propagates up through CreateOrUpdate to the install action's status
+		// reporting) - so patch the PVC when Database.Resources.VolumeCapacity grows, but never
+		// attempt to shrink it back down to match a lowered value.
+		fromQ := resource.MustParse(fmt.Sprint(from))
+		toQ := resource.MustParse(fmt.Sprint(to))
+		if fromQ.Cmp(toQ) <= 0 {
+			return to
+		}
+		return from
 	case "apps/v1/Deployment/spec/template/spec/containers/#/resources/limits/memory":
 		// This might be the same value, in a different format.
 		fromQ := resource.MustParse(fmt.Sprint(from))